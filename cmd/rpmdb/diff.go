@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// diffCommand reports the packages that are only installed in one of the two databases: lines prefixed with "-"
+// are only in the first database, lines prefixed with "+" are only in the second.
+func diffCommand(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return fmt.Errorf("expected two database paths, got %d arguments", fs.NArg())
+	}
+
+	a, err := nevraSet(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	b, err := nevraSet(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	type line struct {
+		sign  string
+		nevra string
+	}
+
+	var lines []line
+	for n := range a {
+		if _, ok := b[n]; !ok {
+			lines = append(lines, line{sign: "-", nevra: n})
+		}
+	}
+	for n := range b {
+		if _, ok := a[n]; !ok {
+			lines = append(lines, line{sign: "+", nevra: n})
+		}
+	}
+
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].nevra == lines[j].nevra {
+			return lines[i].sign < lines[j].sign
+		}
+		return lines[i].nevra < lines[j].nevra
+	})
+
+	for _, l := range lines {
+		if _, err := fmt.Fprintf(stdout, "%s %s\n", l.sign, l.nevra); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func nevraSet(path string) (map[string]struct{}, error) {
+	pkgList, err := listPackages(path)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]struct{}, len(pkgList))
+	for _, p := range pkgList {
+		set[nevra(p)] = struct{}{}
+	}
+	return set, nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	rpmdb "github.com/anchore/go-rpmdb/pkg"
+)
+
+func filesCommand(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("files", flag.ContinueOnError)
+	dbPath := fs.String("db", defaultDBPath, "path to the rpm database")
+	format := fs.String("format", "plain", "output format: plain or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected a single package name, got %d arguments", fs.NArg())
+	}
+	if *format != "plain" && *format != formatJSON {
+		return fmt.Errorf("unsupported format: %q", *format)
+	}
+	name := fs.Arg(0)
+
+	pkgList, err := listPackages(*dbPath, rpmdb.WithFiles(false))
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, p := range pkgList {
+		if p.Name != name && nevra(p) != name {
+			continue
+		}
+		found = true
+
+		files, err := p.FileList()
+		if err != nil {
+			return err
+		}
+
+		if *format == formatJSON {
+			if err := writeJSON(stdout, files); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, f := range files {
+			if _, err := fmt.Fprintln(stdout, f.Path); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("package %s is not installed", name)
+	}
+	return nil
+}
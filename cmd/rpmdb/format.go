@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	rpmdb "github.com/anchore/go-rpmdb/pkg"
+)
+
+const (
+	formatNEVRA = "nevra"
+	formatJSON  = "json"
+	formatQF    = "qf"
+)
+
+// nevra formats the package the same way as rpm's %{NEVRA} tag, omitting an unset epoch and a missing arch
+// (as is the case for gpg-pubkey entries).
+func nevra(p *rpmdb.PackageInfo) string {
+	var sb strings.Builder
+	sb.WriteString(p.Name)
+	sb.WriteString("-")
+	sb.WriteString(evr(p))
+	if p.Arch != "" {
+		sb.WriteString(".")
+		sb.WriteString(p.Arch)
+	}
+	return sb.String()
+}
+
+func evr(p *rpmdb.PackageInfo) string {
+	if p.Epoch != nil {
+		return fmt.Sprintf("%d:%s-%s", *p.Epoch, p.Version, p.Release)
+	}
+	return p.Version + "-" + p.Release
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// queryFormat is a subset of rpm's --queryformat: %{TAG} substitution (optionally with a field width, e.g.
+// %-20{NAME}), %% for a literal percent, and the \n, \t and \\ escapes.
+type queryFormat struct {
+	template string
+}
+
+var qfTags = map[string]func(p *rpmdb.PackageInfo) string{
+	"NAME":              func(p *rpmdb.PackageInfo) string { return p.Name },
+	"VERSION":           func(p *rpmdb.PackageInfo) string { return p.Version },
+	"RELEASE":           func(p *rpmdb.PackageInfo) string { return p.Release },
+	"ARCH":              func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Arch) },
+	"OS":                func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.OS) },
+	"PLATFORM":          func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Platform) },
+	"SOURCERPM":         func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.SourceRpm) },
+	"SUMMARY":           func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Summary) },
+	"DESCRIPTION":       func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Description) },
+	"GROUP":             func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Group) },
+	"URL":               func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.URL) },
+	"SIZE":              func(p *rpmdb.PackageInfo) string { return strconv.Itoa(p.Size) },
+	"LONGSIZE":          func(p *rpmdb.PackageInfo) string { return strconv.FormatInt(p.LongSize, 10) },
+	"LICENSE":           func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.License) },
+	"VENDOR":            func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Vendor) },
+	"PACKAGER":          func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Packager) },
+	"DISTRIBUTION":      func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Distribution) },
+	"DISTTAG":           func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.DistTag) },
+	"DISTURL":           func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.DistURL) },
+	"MODULARITYLABEL":   func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Modularitylabel) },
+	"RPMVERSION":        func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.RPMVersion) },
+	"COOKIE":            func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Cookie) },
+	"OPTFLAGS":          func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.OptFlags) },
+	"BUILDTIME":         func(p *rpmdb.PackageInfo) string { return strconv.Itoa(p.BuildTime) },
+	"BUILDHOST":         func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.BuildHost) },
+	"INSTALLTID":        func(p *rpmdb.PackageInfo) string { return strconv.Itoa(p.InstallTID) },
+	"INSTALLTIME":       func(p *rpmdb.PackageInfo) string { return strconv.Itoa(p.InstallTime) },
+	"PAYLOADFORMAT":     func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.PayloadFormat) },
+	"PAYLOADCOMPRESSOR": func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.PayloadCompressor) },
+	"PAYLOADFLAGS":      func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.PayloadFlags) },
+	"PAYLOADDIGEST":     func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.PayloadDigest) },
+	"PAYLOADDIGESTALGO": func(p *rpmdb.PackageInfo) string {
+		if p.PayloadDigestAlgorithm == 0 {
+			return "(none)"
+		}
+		return strconv.Itoa(int(p.PayloadDigestAlgorithm))
+	},
+	"PKGID":        func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.PkgID) },
+	"SOURCEPKGID":  func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.SourcePkgID) },
+	"SHA1HEADER":   func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Signatures.HeaderSHA1) },
+	"SHA256HEADER": func(p *rpmdb.PackageInfo) string { return noneIfEmpty(p.Signatures.HeaderSHA256) },
+	"NEVRA":        nevra,
+	"EPOCH": func(p *rpmdb.PackageInfo) string {
+		if p.Epoch == nil {
+			return "(none)"
+		}
+		return strconv.Itoa(*p.Epoch)
+	},
+}
+
+func noneIfEmpty(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+func newQueryFormat(template string) (*queryFormat, error) {
+	qf := &queryFormat{template: template}
+	// render against an empty package to surface syntax errors and unknown tags before doing any work
+	if _, err := qf.render(&rpmdb.PackageInfo{}); err != nil {
+		return nil, err
+	}
+	return qf, nil
+}
+
+func (qf *queryFormat) render(p *rpmdb.PackageInfo) (string, error) {
+	var sb strings.Builder
+	t := qf.template
+	for i := 0; i < len(t); i++ {
+		c := t[i]
+		switch {
+		case c == '\\' && i+1 < len(t):
+			i++
+			switch t[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(t[i])
+			}
+		case c == '%' && i+1 < len(t) && t[i+1] == '%':
+			i++
+			sb.WriteByte('%')
+		case c == '%':
+			open := strings.IndexByte(t[i:], '{')
+			end := strings.IndexByte(t[i:], '}')
+			if open < 0 || end < open {
+				return "", fmt.Errorf("invalid query format at offset %d: %q", i, t[i:])
+			}
+			width := t[i+1 : i+open]
+			tag := strings.ToUpper(t[i+open+1 : i+end])
+			getter, ok := qfTags[tag]
+			if !ok {
+				return "", fmt.Errorf("unknown tag: %q", tag)
+			}
+			value := getter(p)
+			if width != "" {
+				if _, err := strconv.Atoi(width); err != nil {
+					return "", fmt.Errorf("invalid field width: %q", width)
+				}
+				value = fmt.Sprintf("%"+width+"s", value)
+			}
+			sb.WriteString(value)
+			i += end
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String(), nil
+}
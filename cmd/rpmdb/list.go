@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	rpmdb "github.com/anchore/go-rpmdb/pkg"
+)
+
+func listCommand(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	dbPath := fs.String("db", defaultDBPath, "path to the rpm database")
+	format := fs.String("format", formatNEVRA, "output format: nevra, json or qf")
+	template := fs.String("qf", `%{NEVRA}\n`, "query format template, used with --format qf")
+	withFiles := fs.Bool("files", false, "include the file listing of each package, used with --format json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	var qf *queryFormat
+	switch *format {
+	case formatNEVRA, formatJSON:
+	case formatQF:
+		var err error
+		if qf, err = newQueryFormat(*template); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported format: %q", *format)
+	}
+
+	// the file listing is only needed for json output, and only when requested
+	pkgList, err := listPackages(*dbPath, rpmdb.WithFiles(*format == formatJSON && *withFiles))
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case formatJSON:
+		return writeJSON(stdout, pkgList)
+	case formatQF:
+		for _, p := range pkgList {
+			out, err := qf.render(p)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(stdout, out); err != nil {
+				return err
+			}
+		}
+	default:
+		for _, p := range pkgList {
+			if _, err := fmt.Fprintln(stdout, nevra(p)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func listPackages(path string, opts ...rpmdb.Option) ([]*rpmdb.PackageInfo, error) {
+	db, err := rpmdb.Open(path, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %q: %w", path, err)
+	}
+	defer db.Close()
+	pkgList, err := db.ListPackages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list packages from %q: %w", path, err)
+	}
+	return pkgList, nil
+}
@@ -1,26 +1,57 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-	"log"
-
-	rpmdb "github.com/anchore/go-rpmdb/pkg"
+	"io"
+	"os"
 )
 
+const defaultDBPath = "/var/lib/rpm/Packages"
+
+const usage = `usage: rpmdb <command> [flags] [args]
+
+commands:
+  list                    list all installed packages
+  files <package>         list the files owned by a package
+  whatprovides <path>     list the packages that own the given path
+  diff <db-a> <db-b>      compare the packages installed in two databases
+
+run "rpmdb <command> -h" for command specific flags
+`
+
+type command func(args []string, stdout io.Writer) error
+
+var commands = map[string]command{
+	"list":         listCommand,
+	"files":        filesCommand,
+	"whatprovides": whatProvidesCommand,
+	"diff":         diffCommand,
+}
+
 func main() {
-	db, err := rpmdb.Open("./Packages")
-	if err != nil {
-		log.Fatal(err)
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
 	}
-	pkgList, err := db.ListPackages()
-	if err != nil {
-		log.Fatal(err)
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command: %q\n\n%s", args[0], usage)
+		return 2
 	}
 
-	fmt.Println("Packages:")
-	for _, pkg := range pkgList {
-		fmt.Printf("\t%+v\n", *pkg)
+	if err := cmd(args[1:], stdout); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "rpmdb %s: %v\n", args[0], err)
+		return 1
 	}
-	fmt.Printf("[Total Packages: %d]\n", len(pkgList))
+	return 0
 }
-
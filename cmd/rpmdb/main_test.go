@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	rpmdb "github.com/anchore/go-rpmdb/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+const (
+	centos7Plain  = "../../pkg/testdata/centos7-plain/Packages"
+	centos7Httpd  = "../../pkg/testdata/centos7-httpd24/Packages"
+	centos6Plain  = "../../pkg/testdata/centos6-plain/Packages"
+	missingDBPath = "testdata/does-not-exist/Packages"
+)
+
+func TestCommands(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "list-nevra",
+			args: []string{"list", "--db", centos7Plain},
+		},
+		{
+			name: "list-epoch-nevra",
+			args: []string{"list", "--db", centos6Plain, "--format", "nevra"},
+		},
+		{
+			name: "list-qf",
+			args: []string{"list", "--db", centos7Plain, "--format", "qf", "--qf", `%-30{NAME} %{EPOCH}:%{VERSION}-%{RELEASE}\t%{SIZE}\t%{VENDOR}\n`},
+		},
+		{
+			name: "list-qf-info",
+			args: []string{"list", "--db", centos7Plain, "--format", "qf", "--qf", `%{NAME}\t%{GROUP}\t%{URL}\t%{BUILDHOST}\n%{SUMMARY}\n%{DESCRIPTION}\n\n`},
+		},
+		{
+			name: "files",
+			args: []string{"files", "--db", centos7Plain, "ncurses"},
+		},
+		{
+			name: "files-by-nevra",
+			args: []string{"files", "--db", centos7Plain, "ncurses-5.9-14.20130511.el7_4.x86_64"},
+		},
+		{
+			name: "whatprovides",
+			args: []string{"whatprovides", "--db", centos7Plain, "/usr/bin/bash"},
+		},
+		{
+			name: "whatprovides-shared-dir",
+			args: []string{"whatprovides", "--db", centos7Plain, "/usr/share/licenses/"},
+		},
+		{
+			name: "diff",
+			args: []string{"diff", centos7Plain, centos7Httpd},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(test.args, &stdout, &stderr)
+			require.Equal(t, 0, code, "stderr: %s", stderr.String())
+			assert.Empty(t, stderr.String())
+
+			golden := filepath.Join("testdata", test.name+".golden")
+			if *update {
+				require.NoError(t, ioutil.WriteFile(golden, stdout.Bytes(), 0644))
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), stdout.String())
+		})
+	}
+}
+
+func TestCommands_JSON(t *testing.T) {
+	t.Run("list", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, run([]string{"list", "--db", centos7Plain, "--format", "json"}, &stdout, &stderr), stderr.String())
+
+		var pkgList []rpmdb.PackageInfo
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &pkgList))
+		require.Equal(t, 144, len(pkgList))
+		assert.Equal(t, "tzdata", pkgList[0].Name)
+		assert.Equal(t, "2018e", pkgList[0].Version)
+		for _, p := range pkgList {
+			assert.Empty(t, p.Files, "files should only be included on request")
+		}
+		assert.NotContains(t, stdout.String(), `"FileStats"`)
+	})
+
+	t.Run("list with files", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, run([]string{"list", "--db", centos7Plain, "--format", "json", "--files"}, &stdout, &stderr), stderr.String())
+
+		var pkgList []struct {
+			rpmdb.PackageInfo
+			FileStats *rpmdb.FileStats
+		}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &pkgList))
+		require.Equal(t, 144, len(pkgList))
+		assert.NotEmpty(t, pkgList[0].Files)
+		for _, p := range pkgList {
+			if p.Name == "ncurses" {
+				require.NotNil(t, p.FileStats)
+				assert.Equal(t, 29, p.FileStats.Files)
+				assert.Equal(t, 7, p.FileStats.ELF)
+			}
+		}
+	})
+
+	t.Run("files", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, run([]string{"files", "--db", centos7Plain, "--format", "json", "ncurses"}, &stdout, &stderr), stderr.String())
+
+		var files []rpmdb.FileInfo
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &files))
+		require.Len(t, files, 29)
+		assert.Equal(t, "/usr/bin/captoinfo", files[0].Path)
+	})
+}
+
+func TestCommands_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		code     int
+		expected string
+	}{
+		{
+			name:     "no command",
+			args:     nil,
+			code:     2,
+			expected: "usage: rpmdb",
+		},
+		{
+			name:     "unknown command",
+			args:     []string{"query"},
+			code:     2,
+			expected: `unknown command: "query"`,
+		},
+		{
+			name:     "missing database",
+			args:     []string{"list", "--db", missingDBPath},
+			code:     1,
+			expected: "unable to open",
+		},
+		{
+			name:     "unknown format",
+			args:     []string{"list", "--db", centos7Plain, "--format", "yaml"},
+			code:     1,
+			expected: `unsupported format: "yaml"`,
+		},
+		{
+			name:     "unknown query format tag",
+			args:     []string{"list", "--db", centos7Plain, "--format", "qf", "--qf", "%{NOPE}"},
+			code:     1,
+			expected: `unknown tag: "NOPE"`,
+		},
+		{
+			name:     "package not installed",
+			args:     []string{"files", "--db", centos7Plain, "emacs"},
+			code:     1,
+			expected: "package emacs is not installed",
+		},
+		{
+			name:     "path not owned",
+			args:     []string{"whatprovides", "--db", centos7Plain, "/usr/bin/emacs"},
+			code:     1,
+			expected: "no package provides /usr/bin/emacs",
+		},
+		{
+			name:     "diff requires two databases",
+			args:     []string{"diff", centos7Plain},
+			code:     1,
+			expected: "expected two database paths",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			assert.Equal(t, test.code, run(test.args, &stdout, &stderr))
+			assert.Contains(t, stderr.String(), test.expected)
+		})
+	}
+}
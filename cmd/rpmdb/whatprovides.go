@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"path"
+)
+
+func whatProvidesCommand(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("whatprovides", flag.ContinueOnError)
+	dbPath := fs.String("db", defaultDBPath, "path to the rpm database")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected a single path, got %d arguments", fs.NArg())
+	}
+	target := path.Clean(fs.Arg(0))
+
+	pkgList, err := listPackages(*dbPath)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, p := range pkgList {
+		for _, f := range p.Files {
+			if f.Path != target {
+				continue
+			}
+			found = true
+			if _, err := fmt.Fprintln(stdout, nevra(p)); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("no package provides %s", target)
+	}
+	return nil
+}
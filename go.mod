@@ -1,10 +1,31 @@
 module github.com/anchore/go-rpmdb
 
-go 1.14
+go 1.21
 
 require (
 	github.com/go-restruct/restruct v0.0.0-20191227155143-5734170a48a1
 	github.com/go-test/deep v1.0.7
+	github.com/mattn/go-sqlite3 v1.14.22
 	github.com/stretchr/testify v1.4.0
-	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543
+	modernc.org/sqlite v1.29.10
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/dustin/go-humanize v1.0.1 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/hashicorp/golang-lru/v2 v2.0.7 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/ncruces/go-strftime v0.1.9 // indirect
+	github.com/pkg/errors v0.8.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/remyoudompheng/bigfft v0.0.0-20230129092748-24d4a6f8daec // indirect
+	golang.org/x/sys v0.19.0 // indirect
+	gopkg.in/yaml.v2 v2.2.2 // indirect
+	modernc.org/gc/v3 v3.0.0-20240107210532-573471604cb6 // indirect
+	modernc.org/libc v1.49.3 // indirect
+	modernc.org/mathutil v1.6.0 // indirect
+	modernc.org/memory v1.8.0 // indirect
+	modernc.org/strutil v1.2.0 // indirect
+	modernc.org/token v1.1.0 // indirect
 )
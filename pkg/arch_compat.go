@@ -0,0 +1,141 @@
+package rpmdb
+
+// archCompat lists the architectures each architecture can directly run packages for, the full set of compatible
+// architectures is the transitive closure (e.g. x86_64 -> athlon -> i686 -> i586 -> i486 -> i386 -> noarch).
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/rpmrc.in (arch_compat)
+var archCompat = map[string][]string{
+	// alpha
+	"alphaev67":  {"alphaev6"},
+	"alphaev6":   {"alphapca56"},
+	"alphapca56": {"alphaev56"},
+	"alphaev56":  {"alphaev5"},
+	"alphaev5":   {"alpha"},
+	"alpha":      {"axp", "noarch"},
+
+	// x86
+	"athlon":   {"i686"},
+	"geode":    {"i686"},
+	"pentium4": {"pentium3"},
+	"pentium3": {"i686"},
+	"i686":     {"i586"},
+	"i586":     {"i486"},
+	"i486":     {"i386"},
+	"i386":     {"noarch"},
+
+	"x86_64":    {"amd64", "em64t", "athlon", "noarch"},
+	"amd64":     {"x86_64", "em64t", "athlon", "noarch"},
+	"ia32e":     {"x86_64", "em64t", "athlon", "noarch"},
+	"x86_64_v2": {"x86_64"},
+	"x86_64_v3": {"x86_64_v2"},
+	"x86_64_v4": {"x86_64_v3"},
+
+	"ia64": {"noarch"},
+
+	// ppc
+	"powerpc":      {"ppc"},
+	"powerppc":     {"ppc"},
+	"ppc8260":      {"ppc"},
+	"ppc8560":      {"ppc"},
+	"ppc32dy4":     {"ppc"},
+	"ppciseries":   {"ppc"},
+	"ppcpseries":   {"ppc"},
+	"ppc64":        {"ppc"},
+	"ppc":          {"rs6000"},
+	"rs6000":       {"noarch", "fat"},
+	"ppc64pseries": {"ppc64"},
+	"ppc64iseries": {"ppc64"},
+	"ppc64p7":      {"ppc64"},
+	"ppc64le":      {"noarch"},
+
+	// sparc
+	"sun4c":    {"sparc"},
+	"sun4d":    {"sparc"},
+	"sun4m":    {"sparc"},
+	"sun4u":    {"sparc64"},
+	"sparc64v": {"sparc64"},
+	"sparc64":  {"sparcv9"},
+	"sparcv9v": {"sparcv9"},
+	"sparcv9":  {"sparcv8"},
+	"sparcv8":  {"sparc"},
+	"sparc":    {"noarch"},
+
+	// mips
+	"mips":       {"noarch"},
+	"mipsel":     {"noarch"},
+	"mips64":     {"mips"},
+	"mips64el":   {"mipsel"},
+	"mipsr6":     {"noarch"},
+	"mipsr6el":   {"noarch"},
+	"mips64r6":   {"mipsr6"},
+	"mips64r6el": {"mipsr6el"},
+
+	// hppa
+	"hppa2.0": {"hppa1.2"},
+	"hppa1.2": {"hppa1.1"},
+	"hppa1.1": {"hppa1.0"},
+	"hppa1.0": {"parisc"},
+	"parisc":  {"noarch"},
+
+	// arm
+	"armv7hnl":  {"armv7hl"},
+	"armv7hl":   {"armv6hl"},
+	"armv6hl":   {"noarch"},
+	"armv7l":    {"armv6l"},
+	"armv6l":    {"armv5tejl"},
+	"armv5tejl": {"armv5tel"},
+	"armv5tel":  {"armv5tl"},
+	"armv5tl":   {"armv4tl"},
+	"armv4tl":   {"armv4l"},
+	"armv4l":    {"armv3l"},
+	"armv3l":    {"noarch"},
+	"armv4b":    {"noarch"},
+	"aarch64":   {"noarch"},
+
+	// s390
+	"i370":  {"noarch"},
+	"s390":  {"noarch"},
+	"s390x": {"s390", "noarch"},
+
+	// sh
+	"sh3":  {"noarch"},
+	"sh4":  {"noarch"},
+	"sh4a": {"sh4"},
+
+	// riscv
+	"riscv":   {"noarch"},
+	"riscv64": {"noarch"},
+
+	"loongarch64": {"noarch"},
+}
+
+// ArchCompatible indicates if a package built for pkgArch can be installed on a host of hostArch, following rpm's
+// arch_compat table (e.g. i686 packages on x86_64, ppc packages on ppc64, noarch packages everywhere). Packages
+// without an architecture (such as gpg-pubkey entries) are treated as noarch. Hosts that are not in the table are
+// only compatible with their own architecture and noarch.
+func ArchCompatible(pkgArch, hostArch string) bool {
+	if pkgArch == "" || pkgArch == "noarch" || pkgArch == hostArch {
+		return true
+	}
+
+	visited := map[string]bool{hostArch: true}
+	pending := []string{hostArch}
+	for len(pending) > 0 {
+		arch := pending[0]
+		pending = pending[1:]
+		for _, compat := range archCompat[arch] {
+			if compat == pkgArch {
+				return true
+			}
+			if !visited[compat] {
+				visited[compat] = true
+				pending = append(pending, compat)
+			}
+		}
+	}
+	return false
+}
+
+// CompatibleWith indicates if the package can be installed on a host of the given architecture (see ArchCompatible).
+func (p *PackageInfo) CompatibleWith(hostArch string) bool {
+	return ArchCompatible(p.Arch, hostArch)
+}
@@ -0,0 +1,140 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchCompatible(t *testing.T) {
+	tests := []struct {
+		pkgArch    string
+		hostArch   string
+		compatible bool
+	}{
+		// identity and noarch
+		{pkgArch: "x86_64", hostArch: "x86_64", compatible: true},
+		{pkgArch: "noarch", hostArch: "x86_64", compatible: true},
+		{pkgArch: "noarch", hostArch: "aarch64", compatible: true},
+		{pkgArch: "noarch", hostArch: "s390x", compatible: true},
+		{pkgArch: "noarch", hostArch: "unknown-arch", compatible: true},
+		{pkgArch: "", hostArch: "x86_64", compatible: true},
+		{pkgArch: "unknown-arch", hostArch: "unknown-arch", compatible: true},
+		{pkgArch: "x86_64", hostArch: "unknown-arch", compatible: false},
+
+		// alpha
+		{pkgArch: "alphaev6", hostArch: "alphaev67", compatible: true},
+		{pkgArch: "alpha", hostArch: "alphaev67", compatible: true},
+		{pkgArch: "axp", hostArch: "alpha", compatible: true},
+		{pkgArch: "alphaev6", hostArch: "alpha", compatible: false},
+
+		// x86
+		{pkgArch: "i686", hostArch: "athlon", compatible: true},
+		{pkgArch: "i686", hostArch: "geode", compatible: true},
+		{pkgArch: "pentium3", hostArch: "pentium4", compatible: true},
+		{pkgArch: "i386", hostArch: "pentium4", compatible: true},
+		{pkgArch: "i586", hostArch: "i686", compatible: true},
+		{pkgArch: "i486", hostArch: "i586", compatible: true},
+		{pkgArch: "i386", hostArch: "i486", compatible: true},
+		{pkgArch: "i686", hostArch: "i386", compatible: false},
+		{pkgArch: "athlon", hostArch: "i686", compatible: false},
+		{pkgArch: "x86_64", hostArch: "i686", compatible: false},
+
+		// x86_64 multilib
+		{pkgArch: "i686", hostArch: "x86_64", compatible: true},
+		{pkgArch: "i386", hostArch: "x86_64", compatible: true},
+		{pkgArch: "athlon", hostArch: "x86_64", compatible: true},
+		{pkgArch: "amd64", hostArch: "x86_64", compatible: true},
+		{pkgArch: "em64t", hostArch: "x86_64", compatible: true},
+		{pkgArch: "x86_64", hostArch: "amd64", compatible: true},
+		{pkgArch: "x86_64", hostArch: "ia32e", compatible: true},
+		{pkgArch: "x86_64", hostArch: "x86_64_v2", compatible: true},
+		{pkgArch: "i686", hostArch: "x86_64_v4", compatible: true},
+		{pkgArch: "x86_64_v3", hostArch: "x86_64_v4", compatible: true},
+		{pkgArch: "x86_64_v3", hostArch: "x86_64", compatible: false},
+		{pkgArch: "ia64", hostArch: "x86_64", compatible: false},
+		{pkgArch: "x86_64", hostArch: "aarch64", compatible: false},
+
+		// ppc
+		{pkgArch: "ppc", hostArch: "ppc64", compatible: true},
+		{pkgArch: "rs6000", hostArch: "ppc64", compatible: true},
+		{pkgArch: "fat", hostArch: "ppc", compatible: true},
+		{pkgArch: "ppc", hostArch: "powerpc", compatible: true},
+		{pkgArch: "ppc", hostArch: "ppciseries", compatible: true},
+		{pkgArch: "ppc64", hostArch: "ppc64p7", compatible: true},
+		{pkgArch: "ppc", hostArch: "ppc64pseries", compatible: true},
+		{pkgArch: "ppc64", hostArch: "ppc", compatible: false},
+		{pkgArch: "ppc64", hostArch: "ppc64le", compatible: false},
+		{pkgArch: "ppc", hostArch: "ppc64le", compatible: false},
+
+		// sparc
+		{pkgArch: "sparc", hostArch: "sun4m", compatible: true},
+		{pkgArch: "sparcv9", hostArch: "sun4u", compatible: true},
+		{pkgArch: "sparc", hostArch: "sparc64", compatible: true},
+		{pkgArch: "sparcv8", hostArch: "sparcv9v", compatible: true},
+		{pkgArch: "sparc64", hostArch: "sparcv9", compatible: false},
+
+		// mips
+		{pkgArch: "mips", hostArch: "mips64", compatible: true},
+		{pkgArch: "mipsel", hostArch: "mips64el", compatible: true},
+		{pkgArch: "mipsr6el", hostArch: "mips64r6el", compatible: true},
+		{pkgArch: "mipsel", hostArch: "mips64", compatible: false},
+
+		// hppa
+		{pkgArch: "parisc", hostArch: "hppa2.0", compatible: true},
+		{pkgArch: "hppa1.1", hostArch: "hppa1.2", compatible: true},
+		{pkgArch: "hppa2.0", hostArch: "hppa1.0", compatible: false},
+
+		// arm
+		{pkgArch: "armv6hl", hostArch: "armv7hl", compatible: true},
+		{pkgArch: "armv7hl", hostArch: "armv7hnl", compatible: true},
+		{pkgArch: "armv3l", hostArch: "armv7l", compatible: true},
+		{pkgArch: "armv5tel", hostArch: "armv6l", compatible: true},
+		{pkgArch: "armv7l", hostArch: "armv7hl", compatible: false},
+		{pkgArch: "armv7hl", hostArch: "armv7l", compatible: false},
+		{pkgArch: "armv7hl", hostArch: "aarch64", compatible: false},
+		{pkgArch: "aarch64", hostArch: "armv7hl", compatible: false},
+
+		// s390
+		{pkgArch: "s390", hostArch: "s390x", compatible: true},
+		{pkgArch: "s390x", hostArch: "s390", compatible: false},
+		{pkgArch: "s390", hostArch: "i370", compatible: false},
+
+		// sh
+		{pkgArch: "sh4", hostArch: "sh4a", compatible: true},
+		{pkgArch: "sh3", hostArch: "sh4", compatible: false},
+
+		// riscv
+		{pkgArch: "riscv64", hostArch: "riscv64", compatible: true},
+		{pkgArch: "riscv64", hostArch: "x86_64", compatible: false},
+		{pkgArch: "x86_64", hostArch: "riscv64", compatible: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pkgArch+" on "+test.hostArch, func(t *testing.T) {
+			assert.Equal(t, test.compatible, ArchCompatible(test.pkgArch, test.hostArch))
+		})
+	}
+}
+
+func TestArchCompatible_DirectEntries(t *testing.T) {
+	// every arch_compat entry is compatible with each architecture listed for it, and every chain reaches noarch
+	for arch, compats := range archCompat {
+		for _, compat := range compats {
+			assert.True(t, ArchCompatible(compat, arch), "%s should run %s", arch, compat)
+		}
+		assert.True(t, ArchCompatible("noarch", arch), arch)
+	}
+}
+
+func TestPackageInfo_CompatibleWith(t *testing.T) {
+	pkgs := listFixturePackages(t, "testdata/centos7-many/Packages")
+
+	for _, pkg := range pkgs {
+		assert.True(t, pkg.CompatibleWith("x86_64"), "%s.%s", pkg.Name, pkg.Arch)
+		if pkg.Arch == "x86_64" {
+			assert.False(t, pkg.CompatibleWith("i686"), pkg.Name)
+			assert.False(t, pkg.CompatibleWith("aarch64"), pkg.Name)
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package bdb
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
+	"math/bits"
 	"os"
+
+	"github.com/anchore/go-rpmdb/pkg/dbi"
 )
 
 var validPageSizes = map[uint32]struct{}{
@@ -18,14 +22,21 @@ var validPageSizes = map[uint32]struct{}{
 }
 
 type BerkeleyDB struct {
-	file         *os.File
+	file *io.SectionReader
+	// closer releases the file opened by Open, it is nil for databases read with NewReader
+	closer io.Closer
+	// Metadata is the metadata page common to both access methods
+	Metadata *GenericMetadataPage
+	// HashMetadata is the metadata page of databases using the hash access method (rpm's default), it is nil for
+	// databases using the btree access method
 	HashMetadata *HashMetadataPage
+	// Swapped indicates the database was written by a host of the other byte order (e.g. big-endian s390x), every
+	// page is byte-swapped as it is read
+	Swapped bool
+	status  dbi.Status
 }
 
-type Entry struct {
-	Value []byte
-	Err   error
-}
+type Entry = dbi.Entry
 
 func Open(path string) (*BerkeleyDB, error) {
 	file, err := os.Open(path)
@@ -33,9 +44,29 @@ func Open(path string) (*BerkeleyDB, error) {
 		return nil, err
 	}
 
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to stat db file: %w", err)
+	}
+
+	db, err := NewReader(file, info.Size())
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	db.closer = file
+	inspectEnvironment(&db.status, path)
+	return db, nil
+}
+
+// NewReader reads the database from the given reader (e.g. a database held in memory) of the given size.
+func NewReader(reader io.ReaderAt, size int64) (*BerkeleyDB, error) {
+	file := io.NewSectionReader(reader, 0, size)
+
 	// read just a bit in to parse at least the metadata...
 	metadataBuff := make([]byte, 512)
-	_, err = file.Read(metadataBuff)
+	_, err := file.Read(metadataBuff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read metadata: %w", err)
 	}
@@ -45,18 +76,40 @@ func Open(path string) (*BerkeleyDB, error) {
 		return nil, fmt.Errorf("failed to seek db file: %w", err)
 	}
 
-	hashMetadata, err := ParseHashMetadataPage(metadataBuff)
+	// note: the magic number is the only way to tell the byte order of the host that wrote the database
+	swapped := len(metadataBuff) >= 16 && isSwappedMagic(binary.LittleEndian.Uint32(metadataBuff[12:]))
+	if swapped {
+		swapMetadataPage(metadataBuff)
+	}
+
+	metadata, err := ParseGenericMetadataPage(metadataBuff)
 	if err != nil {
 		return nil, err
 	}
 
-	if _, ok := validPageSizes[hashMetadata.PageSize]; !ok {
-		return nil, fmt.Errorf("unexpected page size: %+v", hashMetadata.PageSize)
+	var hashMetadata *HashMetadataPage
+	if metadata.Magic == BtreeMagicNumber {
+		if metadata.PageType != BtreeMetadataPageType {
+			return nil, fmt.Errorf("unexpected page type: %+v: %w", metadata.PageType, dbi.ErrUnsupported)
+		}
+	} else {
+		hashMetadata, err = ParseHashMetadataPage(metadataBuff)
+		if err != nil {
+			return nil, err
+		}
+		metadata = &hashMetadata.GenericMetadataPage
+	}
+
+	if _, ok := validPageSizes[metadata.PageSize]; !ok {
+		return nil, fmt.Errorf("unexpected page size: %+v: %w", metadata.PageSize, dbi.ErrCorruptDatabase)
 	}
 
 	return &BerkeleyDB{
 		file:         file,
+		Metadata:     metadata,
 		HashMetadata: hashMetadata,
+		Swapped:      swapped,
+		status:       inspect(size, metadata),
 	}, nil
 
 }
@@ -67,6 +120,11 @@ func (db *BerkeleyDB) Read() <-chan Entry {
 	go func() {
 		defer close(entries)
 
+		if db.HashMetadata == nil {
+			db.readBtree(entries)
+			return
+		}
+
 		// the first content entry (idx=0) is the db metadata, skip to the first real entry and keep reading content values
 		for pageNum := uint32(1); pageNum <= db.HashMetadata.LastPageNo; pageNum++ {
 			pageData, err := slice(db.file, int(db.HashMetadata.PageSize))
@@ -77,6 +135,10 @@ func (db *BerkeleyDB) Read() <-chan Entry {
 				return
 			}
 
+			if db.Swapped {
+				swapPage(pageData)
+			}
+
 			// keep track of the start of the next page for the next iteration...
 			endOfPageOffset, err := db.file.Seek(0, io.SeekCurrent)
 			if err != nil {
@@ -107,7 +169,7 @@ func (db *BerkeleyDB) Read() <-chan Entry {
 				return
 			}
 
-			for _, hashPageIndex := range hashPageIndexes {
+			for pair, hashPageIndex := range hashPageIndexes {
 				// the first byte is the page type, so we can peek at it first before parsing further...
 				valuePageType := pageData[hashPageIndex]
 
@@ -117,16 +179,18 @@ func (db *BerkeleyDB) Read() <-chan Entry {
 				}
 
 				// Traverse the page to concatenate the data that may span multiple pages.
-				valueContent, err := HashPageValueContent(
+				valueContent, err := hashPageValueContent(
 					db.file,
 					pageData,
 					hashPageIndex,
 					db.HashMetadata.PageSize,
+					db.Swapped,
 				)
 
 				entries <- Entry{
-					Value: valueContent,
-					Err:   err,
+					Instance: db.instance(HashPageKeyInstance(pageData, pair)),
+					Value:    valueContent,
+					Err:      err,
 				}
 
 				if err != nil {
@@ -148,3 +212,80 @@ func (db *BerkeleyDB) Read() <-chan Entry {
 
 	return entries
 }
+
+func (db *BerkeleyDB) Close() error {
+	if db.closer == nil {
+		return nil
+	}
+	return db.closer.Close()
+}
+
+// readBtree reads the data items of every leaf page, in page order (as the hash pages are read). The key of each pair
+// is the header instance.
+func (db *BerkeleyDB) readBtree(entries chan<- Entry) {
+	pageSize := db.Metadata.PageSize
+	pageData := make([]byte, pageSize)
+	for pageNum := uint32(1); pageNum <= db.Metadata.LastPageNo; pageNum++ {
+		if _, err := db.file.ReadAt(pageData, int64(pageNum)*int64(pageSize)); err != nil {
+			entries <- Entry{
+				Err: fmt.Errorf("failed to read page=%d: %w", pageNum, err),
+			}
+			return
+		}
+
+		if db.Swapped {
+			swapPage(pageData)
+		}
+
+		page, err := ParseHashPage(pageData)
+		if err != nil {
+			entries <- Entry{
+				Err: err,
+			}
+			return
+		}
+		if page.PageType != BtreeLeafPageType {
+			// skip over internal, overflow and free pages
+			continue
+		}
+
+		items, err := BtreeLeafItems(pageData, page.NumEntries)
+		if err != nil {
+			entries <- Entry{
+				Err: err,
+			}
+			return
+		}
+		for i := 0; i < len(items); i += 2 {
+			key, value := items[i], items[i+1]
+
+			var instance uint32
+			if key.Type == BtreeKeyDataItemType && len(key.Data) == 4 {
+				instance = db.instance(binary.LittleEndian.Uint32(key.Data))
+			}
+
+			valueContent, err := value.value(db.file, pageSize, db.Swapped)
+			if err == nil && value.Type == BtreeKeyDataItemType {
+				// on-page data refers to the page buffer, which is reused for the next page
+				valueContent = append([]byte{}, valueContent...)
+			}
+			entries <- Entry{
+				Instance: instance,
+				Value:    valueContent,
+				Err:      err,
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// instance corrects the byte order of a header instance read from a key, which rpm stores in the byte order of the
+// host that wrote the database.
+func (db *BerkeleyDB) instance(key uint32) uint32 {
+	if db.Swapped {
+		return bits.ReverseBytes32(key)
+	}
+	return key
+}
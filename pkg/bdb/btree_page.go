@@ -0,0 +1,88 @@
+package bdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/anchore/go-rpmdb/pkg/dbi"
+)
+
+// BtreeItem is a key or data item on a btree leaf page, either stored on the page (B_KEYDATA) or on a chain of
+// overflow pages (B_OVERFLOW).
+// source: https://github.com/berkeleydb/libdb/blob/5b7b02ae052442626af54c176335b67ecc613a30/src/dbinc/db_page.h#L738
+type BtreeItem struct {
+	Type uint8
+	// Data is the data of an on-page item
+	Data []byte
+	// PageNo is the first overflow page of an overflow item
+	PageNo uint32
+	// Length is the total length of the data of an overflow item
+	Length uint32
+}
+
+// BtreeLeafItems returns the items on a btree leaf page, which alternate between keys and data items. Deleted pairs
+// are skipped.
+func BtreeLeafItems(data []byte, entries uint16) ([]BtreeItem, error) {
+	if entries%2 != 0 {
+		return nil, fmt.Errorf("invalid btree leaf: entries should only come in pairs (%+v): %w", entries, dbi.ErrCorruptDatabase)
+	}
+	indexEnd := PageHeaderSize + int(entries)*HashIndexEntrySize
+	if indexEnd > len(data) {
+		return nil, fmt.Errorf("invalid btree leaf: %d entries overflow the page: %w", entries, dbi.ErrCorruptDatabase)
+	}
+
+	var items []BtreeItem
+	for idx := PageHeaderSize; idx < indexEnd; idx += 2 * HashIndexEntrySize {
+		key, err := btreeItem(data, binary.LittleEndian.Uint16(data[idx:]))
+		if err != nil {
+			return nil, err
+		}
+		value, err := btreeItem(data, binary.LittleEndian.Uint16(data[idx+HashIndexEntrySize:]))
+		if err != nil {
+			return nil, err
+		}
+		if key.Type&BtreeDeletedItemFlag != 0 || value.Type&BtreeDeletedItemFlag != 0 {
+			continue
+		}
+		items = append(items, key, value)
+	}
+	return items, nil
+}
+
+func btreeItem(data []byte, offset uint16) (BtreeItem, error) {
+	// both item layouts store the type as the third byte
+	if int(offset)+3 > len(data) {
+		return BtreeItem{}, fmt.Errorf("btree item offset %d beyond the page: %w", offset, dbi.ErrCorruptDatabase)
+	}
+	item := BtreeItem{Type: data[offset+2]}
+
+	switch item.Type &^ BtreeDeletedItemFlag {
+	case BtreeKeyDataItemType:
+		// length (2), type (1), data
+		length := int(binary.LittleEndian.Uint16(data[offset:]))
+		if int(offset)+3+length > len(data) {
+			return BtreeItem{}, fmt.Errorf("btree item at offset %d overflows the page: %w", offset, dbi.ErrCorruptDatabase)
+		}
+		item.Data = data[int(offset)+3 : int(offset)+3+length]
+	case BtreeOverflowItemType:
+		// unused (2), type (1), unused (1), page number (4), total length (4)
+		if int(offset)+BtreeOverflowEntrySize > len(data) {
+			return BtreeItem{}, fmt.Errorf("btree overflow item at offset %d overflows the page: %w", offset, dbi.ErrCorruptDatabase)
+		}
+		item.PageNo = binary.LittleEndian.Uint32(data[offset+4:])
+		item.Length = binary.LittleEndian.Uint32(data[offset+8:])
+	default:
+		// e.g. off-page duplicates (B_DUPLICATE), which rpm does not create
+		return BtreeItem{}, fmt.Errorf("unsupported btree item type %d: %w", item.Type, dbi.ErrUnsupported)
+	}
+	return item, nil
+}
+
+// value returns the data of the item, reading the overflow pages of overflow items.
+func (i BtreeItem) value(db io.ReadSeeker, pageSize uint32, swapped bool) ([]byte, error) {
+	if i.Type&^BtreeDeletedItemFlag == BtreeOverflowItemType {
+		return overflowValue(db, i.PageNo, i.Length, pageSize, swapped)
+	}
+	return i.Data, nil
+}
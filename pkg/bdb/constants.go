@@ -3,7 +3,8 @@ package bdb
 const (
 	NoEncryptionAlgorithm = 0
 
-	HashMagicNumber = 0x061561
+	HashMagicNumber  = 0x061561
+	BtreeMagicNumber = 0x053162
 
 	// the size (in bytes) of an in-page offset
 	HashIndexEntrySize = 2
@@ -11,11 +12,24 @@ const (
 	PageHeaderSize = 26
 
 	// all page types supported
+	HashKeyDataPageType  PageType = 1 // a.k.a H_KEYDATA
 	HashMetadataPageType PageType = 8
 	HashPageType         PageType = 13
 	HashOffIndexPageType PageType = 3 // a.k.a HOFFPAGE
 
 	HashOffPageSize = 12 // (in bytes)
+
+	// btree page types
+	BtreeLeafPageType     PageType = 5 // a.k.a P_LBTREE
+	OverflowPageType      PageType = 7 // a.k.a P_OVERFLOW
+	BtreeMetadataPageType PageType = 9 // a.k.a P_BTREEMETA
+
+	// btree item types, the high bit flags a deleted item
+	BtreeKeyDataItemType  = 1 // a.k.a B_KEYDATA
+	BtreeOverflowItemType = 3 // a.k.a B_OVERFLOW
+	BtreeDeletedItemFlag  = 0x80
+
+	BtreeOverflowEntrySize = 12 // (in bytes)
 )
 
 type PageType = uint8
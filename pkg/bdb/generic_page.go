@@ -3,6 +3,7 @@ package bdb
 import (
 	"encoding/binary"
 	"fmt"
+	"github.com/anchore/go-rpmdb/pkg/dbi"
 	"github.com/go-restruct/restruct"
 )
 
@@ -39,7 +40,7 @@ func ParseGenericMetadataPage(data []byte) (*GenericMetadataPage, error) {
 
 func (p *GenericMetadataPage) validate() error {
 	if p.EncryptionAlg != NoEncryptionAlgorithm {
-		return fmt.Errorf("unexpected encryption algorithm: %+v", p.EncryptionAlg)
+		return fmt.Errorf("unexpected encryption algorithm: %+v: %w", p.EncryptionAlg, dbi.ErrUnsupported)
 	}
 
 	return nil
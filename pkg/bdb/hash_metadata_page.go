@@ -3,6 +3,7 @@ package bdb
 import (
 	"encoding/binary"
 	"fmt"
+	"github.com/anchore/go-rpmdb/pkg/dbi"
 	"github.com/go-restruct/restruct"
 )
 
@@ -26,7 +27,7 @@ func ParseHashMetadataPage(data []byte) (*HashMetadataPage, error) {
 		return nil, fmt.Errorf("failed to unpack HashMetadataPage: %w", err)
 	}
 
-	return &metadata, nil
+	return &metadata, metadata.validate()
 }
 
 func (p *HashMetadataPage) validate() error {
@@ -36,11 +37,11 @@ func (p *HashMetadataPage) validate() error {
 	}
 
 	if p.Magic != HashMagicNumber {
-		return fmt.Errorf("unexpected DB magic number: %+v", p.Magic)
+		return fmt.Errorf("unexpected DB magic number: %+v: %w", p.Magic, dbi.ErrUnsupported)
 	}
 
 	if p.PageType != HashMetadataPageType {
-		return fmt.Errorf("unexpected page type: %+v", p.PageType)
+		return fmt.Errorf("unexpected page type: %+v: %w", p.PageType, dbi.ErrUnsupported)
 	}
 
 	return nil
@@ -3,9 +3,9 @@ package bdb
 import (
 	"encoding/binary"
 	"fmt"
+	"github.com/anchore/go-rpmdb/pkg/dbi"
 	"github.com/go-restruct/restruct"
 	"io"
-	"os"
 )
 
 // source: https://github.com/berkeleydb/libdb/blob/5b7b02ae052442626af54c176335b67ecc613a30/src/dbinc/db_page.h#L259
@@ -31,13 +31,17 @@ func ParseHashPage(data []byte) (*HashPage, error) {
 	return &hashPage, nil
 }
 
-func HashPageValueContent(db *os.File, pageData []byte, hashPageIndex uint16, pageSize uint32) ([]byte, error) {
+func HashPageValueContent(db io.ReadSeeker, pageData []byte, hashPageIndex uint16, pageSize uint32) ([]byte, error) {
+	return hashPageValueContent(db, pageData, hashPageIndex, pageSize, false)
+}
+
+func hashPageValueContent(db io.ReadSeeker, pageData []byte, hashPageIndex uint16, pageSize uint32, swapped bool) ([]byte, error) {
 	// the first byte is the page type, so we can peek at it first before parsing further...
 	valuePageType := pageData[hashPageIndex]
 
 	// only HOFFPAGE page types have data of interest
 	if valuePageType != HashOffIndexPageType {
-		return nil, fmt.Errorf("only HOFFPAGE types supported (%+v)", valuePageType)
+		return nil, fmt.Errorf("only HOFFPAGE types supported (%+v): %w", valuePageType, dbi.ErrUnsupported)
 	}
 
 	hashOffPageEntryBuff := pageData[hashPageIndex : hashPageIndex+HashOffPageSize]
@@ -47,9 +51,17 @@ func HashPageValueContent(db *os.File, pageData []byte, hashPageIndex uint16, pa
 		return nil, err
 	}
 
+	return overflowValue(db, entry.PageNo, entry.Length, pageSize, swapped)
+}
+
+// overflowValue concatenates the data of the chain of overflow pages starting at the given page, the pages are
+// byte-swapped first when the database was written by a host of the other byte order. The chain must hold exactly the
+// total length recorded by the item pointing to it, so that a broken (or looping) chain cannot truncate the value.
+func overflowValue(db io.ReadSeeker, pageNo uint32, length uint32, pageSize uint32, swapped bool) ([]byte, error) {
+	// note: the length is not trusted for allocating the value, it may be corrupt (or crafted)
 	var hashValue []byte
 
-	for currentPageNo := entry.PageNo; currentPageNo != 0; {
+	for currentPageNo := pageNo; currentPageNo != 0; {
 		pageStart := pageSize * currentPageNo
 
 		_, err := db.Seek(int64(pageStart), io.SeekStart)
@@ -61,6 +73,9 @@ func HashPageValueContent(db *os.File, pageData []byte, hashPageIndex uint16, pa
 		if err != nil {
 			return nil, fmt.Errorf("failed to read page=%d: %w", currentPageNo, err)
 		}
+		if swapped {
+			swapPage(currentPageBuff)
+		}
 
 		currentPage, err := ParseHashPage(currentPageBuff)
 		if err != nil {
@@ -69,24 +84,34 @@ func HashPageValueContent(db *os.File, pageData []byte, hashPageIndex uint16, pa
 
 		var hashValueBytes []byte
 		if currentPage.NextPageNo == 0 {
-			// this is the last page, the whole page contains content
-			hashValueBytes = currentPageBuff[PageHeaderSize : PageHeaderSize+currentPage.FreeAreaOffset]
+			// this is the last page, the content ends at the free area offset
+			end := PageHeaderSize + int(currentPage.FreeAreaOffset)
+			if end > len(currentPageBuff) {
+				return nil, fmt.Errorf("free area offset %d of page=%d is beyond the page size %d: %w", currentPage.FreeAreaOffset, currentPageNo, pageSize, dbi.ErrCorruptDatabase)
+			}
+			hashValueBytes = currentPageBuff[PageHeaderSize:end]
 		} else {
 			hashValueBytes = currentPageBuff[PageHeaderSize:]
 		}
 
 		hashValue = append(hashValue, hashValueBytes...)
+		if len(hashValue) > int(length) {
+			return nil, fmt.Errorf("overflow pages from page=%d hold more than %d bytes: %w", pageNo, length, dbi.ErrCorruptDatabase)
+		}
 
 		currentPageNo = currentPage.NextPageNo
 	}
 
+	if len(hashValue) != int(length) {
+		return nil, fmt.Errorf("overflow pages from page=%d hold %d of %d bytes: %w", pageNo, len(hashValue), length, dbi.ErrCorruptDatabase)
+	}
 	return hashValue, nil
 }
 
 func HashPageValueIndexes(data []byte, entries uint16) ([]uint16, error) {
 	var hashIndexValues = make([]uint16, 0)
 	if entries%2 != 0 {
-		return nil, fmt.Errorf("invalid hash index: entries should only come in pairs (%+v)", entries)
+		return nil, fmt.Errorf("invalid hash index: entries should only come in pairs (%+v): %w", entries, dbi.ErrCorruptDatabase)
 	}
 
 	// Every entry is a 2-byte offset that points somewhere in the current database page.
@@ -106,6 +131,22 @@ func HashPageValueIndexes(data []byte, entries uint16) ([]uint16, error) {
 	return hashIndexValues, nil
 }
 
+// HashPageKeyInstance returns the header instance number stored as the key of the given key-value pair on the page,
+// or zero if the key is not a (4 byte) header instance.
+func HashPageKeyInstance(data []byte, pair int) uint32 {
+	keyIndexOffset := PageHeaderSize + pair*2*HashIndexEntrySize
+	if keyIndexOffset+HashIndexEntrySize > len(data) {
+		return 0
+	}
+
+	// note: the key is stored in the byte order of the host that wrote the database, as with the page data
+	keyOffset := int(binary.LittleEndian.Uint16(data[keyIndexOffset:]))
+	if keyOffset+5 > len(data) || data[keyOffset] != HashKeyDataPageType {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(data[keyOffset+1:])
+}
+
 func slice(reader io.Reader, n int) ([]byte, error) {
 	newBuff := make([]byte, n)
 	numRead, err := reader.Read(newBuff)
@@ -113,7 +154,7 @@ func slice(reader io.Reader, n int) ([]byte, error) {
 		return nil, fmt.Errorf("failed to read page: %w", err)
 	}
 	if numRead != n {
-		return nil, fmt.Errorf("short page size: %d!=%d", n, numRead)
+		return nil, fmt.Errorf("short page size: %d!=%d: %w", n, numRead, io.ErrUnexpectedEOF)
 	}
 	return newBuff, nil
 }
@@ -0,0 +1,44 @@
+package bdb
+
+import (
+	"path/filepath"
+
+	"github.com/anchore/go-rpmdb/pkg/dbi"
+)
+
+// inspect looks for signs that the database was in use when it was copied. Note that there is no persisted "dirty"
+// flag on the metadata page, so the page count recorded there is checked against the file size instead.
+func inspect(size int64, metadata *GenericMetadataPage) dbi.Status {
+	status := dbi.Status{
+		Cleanliness: dbi.Clean,
+	}
+
+	pageSize := int64(metadata.PageSize)
+	expected := (int64(metadata.LastPageNo) + 1) * pageSize
+	switch {
+	case size%pageSize != 0:
+		status.Mark(dbi.RecoveryRequired, "db file size (%d bytes) is not a multiple of the page size (%d bytes), a page write may be incomplete", size, pageSize)
+	case size < expected:
+		status.Mark(dbi.RecoveryRequired, "db file is truncated (%d bytes, the metadata page references %d bytes)", size, expected)
+	case size > expected:
+		status.Mark(dbi.PossiblyDirty, "db file has pages beyond the last page recorded in the metadata page (%d bytes > %d bytes)", size, expected)
+	}
+
+	return status
+}
+
+// inspectEnvironment looks for the environment region files next to the database at the given path. They are created
+// by every rpm process using the database, and are removed when the environment is cleanly torn down (e.g. rpm
+// --rebuilddb or images that remove them).
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/backend/db3.c
+func inspectEnvironment(status *dbi.Status, path string) {
+	regions, _ := filepath.Glob(filepath.Join(filepath.Dir(path), "__db.[0-9][0-9][0-9]"))
+	for _, region := range regions {
+		status.Mark(dbi.PossiblyDirty, "environment region file %q is present, the database may have been in use", filepath.Base(region))
+	}
+}
+
+// Inspect reports how consistent the database files appeared when opened.
+func (db *BerkeleyDB) Inspect() dbi.Status {
+	return db.status
+}
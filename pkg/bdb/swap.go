@@ -0,0 +1,92 @@
+package bdb
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// the item and page types that are only relevant when swapping pages
+// source: https://github.com/berkeleydb/libdb/blob/5b7b02ae052442626af54c176335b67ecc613a30/src/dbinc/db_page.h#L568
+const (
+	hashOffDupItemType     = 4 // a.k.a H_OFFDUP
+	btreeDuplicateItemType = 2 // a.k.a B_DUPLICATE
+
+	hashUnsortedPageType PageType = 2 // a.k.a P_HASH_UNSORTED
+)
+
+// isSwappedMagic indicates that the metadata magic number was written by a host of the other byte order (e.g. a
+// database created on s390x), in which case every integer within the pages is byte-swapped.
+func isSwappedMagic(magic uint32) bool {
+	magic = bits.ReverseBytes32(magic)
+	return magic == HashMagicNumber || magic == BtreeMagicNumber
+}
+
+// swapMetadataPage converts a byte-swapped metadata page (hash or btree) to little-endian, in place.
+// source: https://github.com/berkeleydb/libdb/blob/5b7b02ae052442626af54c176335b67ecc613a30/src/db/db_conv.c (__db_byteswap)
+func swapMetadataPage(data []byte) {
+	// LSN, page number, magic, version and page size
+	swap32(data, 0, 4, 8, 12, 16, 20)
+	// free list page, last page, partitions, key count, record count and flags
+	swap32(data, 28, 32, 36, 40, 44, 48)
+	// the access method specific fields parsed here (hash: max bucket ... h_charkey, btree: unused ... root)
+	swap32(data, 72, 76, 80, 84, 88, 92)
+}
+
+// swapPage converts a byte-swapped page to little-endian, in place: the page header, the item index and the integers
+// within the items of hash and btree leaf pages. The item data (e.g. keys, which rpm writes in host byte order) is
+// left as it is.
+// source: https://github.com/berkeleydb/libdb/blob/5b7b02ae052442626af54c176335b67ecc613a30/src/db/db_conv.c (__db_byteswap)
+func swapPage(data []byte) {
+	if len(data) < PageHeaderSize {
+		return
+	}
+	// LSN, page number, previous and next page
+	swap32(data, 0, 4, 8, 12, 16)
+	// entries and free area offset
+	swap16(data, 20, 22)
+
+	pageType := data[25]
+	if pageType != HashPageType && pageType != hashUnsortedPageType && pageType != BtreeLeafPageType {
+		return
+	}
+
+	entries := int(binary.LittleEndian.Uint16(data[20:]))
+	for i := 0; i < entries; i++ {
+		offset := PageHeaderSize + i*HashIndexEntrySize
+		if offset+HashIndexEntrySize > len(data) {
+			return
+		}
+		swap16(data, offset)
+		item := int(binary.LittleEndian.Uint16(data[offset:]))
+
+		switch pageType {
+		case HashPageType, hashUnsortedPageType:
+			// type (1), unused (3), page number (4), total length (4)
+			if item+HashOffPageSize <= len(data) && (data[item] == HashOffIndexPageType || data[item] == hashOffDupItemType) {
+				swap32(data, item+4, item+8)
+			}
+		case BtreeLeafPageType:
+			if item+3 > len(data) {
+				continue
+			}
+			// both item layouts start with a 16 bit integer (the length of on-page items)
+			swap16(data, item)
+			itemType := data[item+2] &^ BtreeDeletedItemFlag
+			if (itemType == BtreeOverflowItemType || itemType == btreeDuplicateItemType) && item+BtreeOverflowEntrySize <= len(data) {
+				swap32(data, item+4, item+8)
+			}
+		}
+	}
+}
+
+func swap16(data []byte, offsets ...int) {
+	for _, offset := range offsets {
+		binary.LittleEndian.PutUint16(data[offset:], binary.BigEndian.Uint16(data[offset:]))
+	}
+}
+
+func swap32(data []byte, offsets ...int) {
+	for _, offset := range offsets {
+		binary.LittleEndian.PutUint32(data[offset:], binary.BigEndian.Uint32(data[offset:]))
+	}
+}
@@ -0,0 +1,218 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/anchore/go-rpmdb/pkg/bdb"
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildBtree lays out the blobs as a Berkeley DB btree database: the metadata page, a single leaf page holding the
+// header instances (as on-page keys) and the blobs (as overflow items), followed by the overflow pages. A deleted pair
+// precedes the blobs. The integers (including the instance keys) are written in the given byte order.
+func buildBtree(t *testing.T, blobs [][]byte, order binary.ByteOrder) []byte {
+	const pageSize = 4096
+	le := order
+
+	page := func(pageNo uint32, pageType uint8) []byte {
+		data := make([]byte, pageSize)
+		le.PutUint32(data[8:], pageNo)
+		data[25] = pageType
+		return data
+	}
+
+	leaf := page(1, bdb.BtreeLeafPageType)
+	leaf[24] = 1
+	var overflow [][]byte
+	itemEnd := pageSize
+	entries := 0
+	addItem := func(item []byte) {
+		itemEnd -= len(item)
+		copy(leaf[itemEnd:], item)
+		le.PutUint16(leaf[bdb.PageHeaderSize+entries*bdb.HashIndexEntrySize:], uint16(itemEnd))
+		entries++
+	}
+	keyDataItem := func(itemType uint8, data []byte) []byte {
+		item := make([]byte, 3, 3+len(data))
+		le.PutUint16(item, uint16(len(data)))
+		item[2] = itemType
+		return append(item, data...)
+	}
+	instanceKey := func(instance uint32) []byte {
+		key := make([]byte, 4)
+		le.PutUint32(key, instance)
+		return key
+	}
+
+	addItem(keyDataItem(bdb.BtreeKeyDataItemType|bdb.BtreeDeletedItemFlag, instanceKey(1000)))
+	addItem(keyDataItem(bdb.BtreeKeyDataItemType|bdb.BtreeDeletedItemFlag, []byte("deleted")))
+	for i, blob := range blobs {
+		addItem(keyDataItem(bdb.BtreeKeyDataItemType, instanceKey(uint32(i+1))))
+
+		item := make([]byte, bdb.BtreeOverflowEntrySize)
+		item[2] = bdb.BtreeOverflowItemType
+		le.PutUint32(item[4:], uint32(2+len(overflow)))
+		le.PutUint32(item[8:], uint32(len(blob)))
+		addItem(item)
+
+		for offset := 0; offset < len(blob); offset += pageSize - bdb.PageHeaderSize {
+			pageNo := uint32(2 + len(overflow))
+			chunk := blob[offset:]
+			next := uint32(0)
+			if len(chunk) > pageSize-bdb.PageHeaderSize {
+				chunk, next = chunk[:pageSize-bdb.PageHeaderSize], pageNo+1
+			}
+			data := page(pageNo, bdb.OverflowPageType)
+			le.PutUint32(data[16:], next)
+			le.PutUint16(data[22:], uint16(len(chunk)))
+			copy(data[bdb.PageHeaderSize:], chunk)
+			overflow = append(overflow, data)
+		}
+	}
+	le.PutUint16(leaf[20:], uint16(entries))
+	require.Less(t, bdb.PageHeaderSize+entries*bdb.HashIndexEntrySize, itemEnd, "the leaf page is full")
+
+	metadata := page(0, bdb.BtreeMetadataPageType)
+	le.PutUint32(metadata[12:], bdb.BtreeMagicNumber)
+	le.PutUint32(metadata[16:], 9)
+	le.PutUint32(metadata[20:], pageSize)
+	le.PutUint32(metadata[32:], uint32(1+len(overflow)))
+
+	data := append(metadata, leaf...)
+	for _, o := range overflow {
+		data = append(data, o...)
+	}
+	return data
+}
+
+func TestPackageList_BerkeleyDBBtree(t *testing.T) {
+	expected := listFixturePackages(t, "testdata/centos7-plain/Packages")
+	require.Len(t, expected, 144)
+
+	tests := []struct {
+		name    string
+		order   binary.ByteOrder
+		swapped bool
+	}{
+		{name: "little-endian", order: binary.LittleEndian},
+		{name: "big-endian", order: binary.BigEndian, swapped: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "Packages")
+			writeFile(t, path, buildBtree(t, fixtureBlobs(t, "testdata/centos7-plain/Packages"), test.order))
+
+			source, err := Detect(path)
+			require.NoError(t, err)
+			assert.Equal(t, BackendBerkeleyDB, source.Backend)
+
+			db, err := Open(path)
+			require.NoError(t, err)
+			defer db.Close()
+
+			berkeleyDB, ok := db.db.(*bdb.BerkeleyDB)
+			require.True(t, ok, "expected the bdb backend, got %T", db.db)
+			assert.Nil(t, berkeleyDB.HashMetadata)
+			assert.Equal(t, test.swapped, berkeleyDB.Swapped)
+			assert.Equal(t, Clean, db.Info().Cleanliness, "warnings: %v", db.Info().Warnings)
+
+			var instances []uint32
+			for entry := range berkeleyDB.Read() {
+				require.NoError(t, entry.Err)
+				instances = append(instances, entry.Instance)
+			}
+			require.Len(t, instances, len(expected))
+			assert.Equal(t, []uint32{1, 2, 3}, instances[:3])
+
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			require.Len(t, pkgList, len(expected))
+			for i := range expected {
+				for _, d := range deep.Equal(expected[i], pkgList[i]) {
+					t.Errorf("%s: %s", expected[i].Name, d)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageList_BerkeleyDBOverflow(t *testing.T) {
+	const pageSize = 4096
+	blobs := fixtureBlobs(t, "testdata/centos7-many/Packages")
+
+	// the headers of packages with many files span several overflow pages
+	largest := 0
+	for i, blob := range blobs {
+		if len(blob) > len(blobs[largest]) {
+			largest = i
+		}
+	}
+	require.Greater(t, len(blobs[largest]), 2*pageSize)
+
+	data := buildBtree(t, blobs[largest:largest+1], binary.LittleEndian)
+
+	path := filepath.Join(t.TempDir(), "Packages")
+	writeFile(t, path, data)
+	pkgList := listFixturePackages(t, path)
+	require.Len(t, pkgList, 1)
+
+	expected, err := ParseHeader(blobs[largest])
+	require.NoError(t, err)
+	for _, d := range deep.Equal(expected, pkgList[0]) {
+		t.Error(d)
+	}
+
+	// a (corrupt) total length far beyond the chain is rejected rather than allocated up front
+	item := binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(nil, 2), uint32(len(blobs[largest])))
+	itemIdx := bytes.Index(data[pageSize:2*pageSize], item)
+	require.True(t, itemIdx >= 0, "overflow item not found")
+	lengthData := data[pageSize+itemIdx+4:]
+	binary.LittleEndian.PutUint32(lengthData, math.MaxUint32)
+	writeFile(t, path, data)
+
+	db, err := Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err = db.ListPackages()
+	runtime.ReadMemStats(&after)
+	assert.True(t, errors.Is(err, ErrCorruptDatabase), "unexpected error: %v", err)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(64<<20), "allocated bytes")
+	binary.LittleEndian.PutUint32(lengthData, uint32(len(blobs[largest])))
+
+	// the content of the last overflow page ends at its free area offset, which must be within the page
+	lastPage := data[len(data)-pageSize:]
+	freeAreaOffset := binary.LittleEndian.Uint16(lastPage[22:])
+	binary.LittleEndian.PutUint16(lastPage[22:], math.MaxUint16)
+	writeFile(t, path, data)
+
+	db, err = Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ListPackages()
+	assert.True(t, errors.Is(err, ErrCorruptDatabase), "unexpected error: %v", err)
+	binary.LittleEndian.PutUint16(lastPage[22:], freeAreaOffset)
+
+	// cutting the chain short (the overflow pages start at page 2) must not silently truncate the header
+	binary.LittleEndian.PutUint32(data[2*pageSize+16:], 0)
+	writeFile(t, path, data)
+
+	db, err = Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ListPackages()
+	assert.True(t, errors.Is(err, ErrCorruptDatabase), "unexpected error: %v", err)
+}
@@ -0,0 +1,55 @@
+package rpmdb
+
+import "fmt"
+
+// ChangelogEntry is a single %changelog entry of a package, newest entries come first.
+type ChangelogEntry struct {
+	Author string // the entry header following the date, usually "Name <email> - version-release"
+	Time   int    // seconds since the epoch, rpmbuild only records the day (at noon)
+	Text   string
+}
+
+// parseChangelog zips the changelog time, name and text arrays. A changelog with arrays that do not line up is
+// ignored (rpm refuses to format it as well), which is reported as a warning.
+func parseChangelog(indexEntries []indexEntry) ([]ChangelogEntry, []string, error) {
+	var times []int32
+	var names, texts []string
+	var err error
+
+	for _, entry := range indexEntries {
+		switch entry.Info.Tag {
+		case RPMTAG_CHANGELOGTIME:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, nil, newTagTypeError("changelog-times", entry.Info, RPM_INT32_TYPE)
+			}
+			times, err = parseInt32Array(entry.Data, int(entry.Info.Count)*sizeOfInt32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse changelog-times: %w", err)
+			}
+		case RPMTAG_CHANGELOGNAME:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError("changelog-names", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			names = parseStringArray(entry.Data, entry.Info.Count)
+		case RPMTAG_CHANGELOGTEXT:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError("changelog-texts", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			texts = parseStringArray(entry.Data, entry.Info.Count)
+		}
+	}
+
+	if len(times) == 0 && len(names) == 0 && len(texts) == 0 {
+		return nil, nil, nil
+	}
+	if len(names) != len(times) || len(texts) != len(times) {
+		warning := fmt.Sprintf("ignoring changelog: %d times, %d names and %d texts", len(times), len(names), len(texts))
+		return nil, []string{warning}, nil
+	}
+
+	changelog := make([]ChangelogEntry, len(times))
+	for i := range times {
+		changelog[i] = ChangelogEntry{Author: names[i], Time: int(times[i]), Text: texts[i]}
+	}
+	return changelog, nil, nil
+}
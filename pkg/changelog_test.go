@@ -0,0 +1,85 @@
+package rpmdb
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_Changelog(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			var bash *PackageInfo
+			for _, pkg := range listFixturePackages(t, fixture) {
+				if pkg.Name == "bash" {
+					bash = pkg
+				}
+			}
+			require.NotNil(t, bash)
+
+			require.Len(t, bash.Changelog, 353)
+			assert.Equal(t, ChangelogEntry{
+				Author: "Siteshwar Vashisht <svashisht@redhat.com> - 4.2.46-30",
+				Time:   1506340800,
+				Text:   "- Check for multibyte characters in commands\n  Resolves: #1487615",
+			}, bash.Changelog[0])
+
+			var cves []string
+			for _, entry := range bash.Changelog {
+				if strings.Contains(entry.Text, "CVE-2016-9401") {
+					cves = append(cves, entry.Author)
+				}
+			}
+			assert.Equal(t, []string{"Kamil Dudka <kdudka@redhat.com - 4.2.46-28"}, cves)
+		})
+	}
+}
+
+func TestParseChangelog(t *testing.T) {
+	t.Run("zipped", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_CHANGELOGTIME, Type: RPM_INT32_TYPE, Value: []int32{1700000000, 1600000000}},
+			testEntry{Tag: RPMTAG_CHANGELOGNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"Jane <jane@example.com> - 1.1-1", "John <john@example.com> - 1.0-1"}},
+			testEntry{Tag: RPMTAG_CHANGELOGTEXT, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"- Fix CVE-2023-0001", "- Initial package"}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []ChangelogEntry{
+			{Author: "Jane <jane@example.com> - 1.1-1", Time: 1700000000, Text: "- Fix CVE-2023-0001"},
+			{Author: "John <john@example.com> - 1.0-1", Time: 1600000000, Text: "- Initial package"},
+		}, pkg.Changelog)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Changelog)
+	})
+
+	t.Run("mismatched arrays", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_CHANGELOGTIME, Type: RPM_INT32_TYPE, Value: []int32{1700000000}},
+			testEntry{Tag: RPMTAG_CHANGELOGNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"Jane", "John"}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Changelog)
+		assert.Equal(t, []string{"ignoring changelog: 1 times, 2 names and 0 texts"}, pkg.Warnings)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_CHANGELOGTEXT, Type: RPM_STRING_TYPE, Value: "- Initial package"},
+		))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "changelog-texts", typeErr.Name)
+	})
+}
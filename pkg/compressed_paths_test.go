@@ -0,0 +1,59 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCompressedPaths(t *testing.T) {
+	fixtures := []string{
+		"testdata/centos6-many/Packages",
+		"testdata/centos6-plain/Packages",
+		"testdata/centos7-plain/Packages",
+		"testdata/centos7-many/Packages",
+		"testdata/centos7-plain-sqlite/rpmdb.sqlite",
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			expanded := listFixturePackages(t, fixture)
+			compressed := listFixturePackages(t, fixture, WithCompressedPaths())
+			require.Len(t, compressed, len(expanded))
+
+			for i, pkg := range compressed {
+				require.Len(t, pkg.Files, len(expanded[i].Files), pkg.Name)
+				if len(pkg.Files) > 0 {
+					assert.NotEmpty(t, pkg.DirNames, pkg.Name)
+				}
+				assert.Empty(t, expanded[i].DirNames, pkg.Name)
+
+				for j, f := range pkg.Files {
+					want := expanded[i].Files[j]
+					assert.Empty(t, f.Path)
+					assert.Equal(t, want.Path, pkg.FilePath(f))
+					assert.Equal(t, want.Path, expanded[i].FilePath(want))
+
+					// apart from the path representation the files are identical
+					f.DirIndex, f.Basename, f.Path = 0, "", want.Path
+					assert.Equal(t, want, f)
+				}
+			}
+		})
+	}
+}
+
+func TestWithCompressedPaths_Lazy(t *testing.T) {
+	eager := listFixturePackages(t, "testdata/centos7-plain/Packages", WithCompressedPaths())
+	lazy := listFixturePackages(t, "testdata/centos7-plain/Packages", WithCompressedPaths(), WithFiles(false))
+	require.Len(t, lazy, len(eager))
+
+	for i := range eager {
+		assert.Equal(t, eager[i].DirNames, lazy[i].DirNames)
+
+		files, err := lazy[i].FileList()
+		require.NoError(t, err)
+		assert.Equal(t, eager[i].Files, files)
+	}
+}
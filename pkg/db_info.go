@@ -0,0 +1,35 @@
+package rpmdb
+
+import "github.com/anchore/go-rpmdb/pkg/dbi"
+
+// Cleanliness indicates how consistent the database files appeared when opened, see DBInfo.
+type Cleanliness = dbi.Cleanliness
+
+const (
+	CleanlinessUnknown = dbi.Unknown
+	Clean              = dbi.Clean
+	PossiblyDirty      = dbi.PossiblyDirty
+	RecoveryRequired   = dbi.RecoveryRequired
+)
+
+// DBInfo describes the state of the database files when they were opened, allowing callers to annotate the
+// confidence of results read from a database that may have been copied from a live system.
+type DBInfo struct {
+	Cleanliness Cleanliness
+	// Warnings describe each finding that contributed to the cleanliness
+	Warnings []string
+}
+
+// Info reports the state of the database files when they were opened (this does not affect parsing).
+func (d *RpmDB) Info() DBInfo {
+	inspector, ok := d.db.(dbi.Inspector)
+	if !ok {
+		return DBInfo{Cleanliness: CleanlinessUnknown}
+	}
+
+	status := inspector.Inspect()
+	return DBInfo{
+		Cleanliness: status.Cleanliness,
+		Warnings:    status.Warnings,
+	}
+}
@@ -0,0 +1,144 @@
+package rpmdb
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpmDB_Info(t *testing.T) {
+	const bdbFixture = "testdata/centos7-plain/Packages"
+
+	tests := []struct {
+		name     string
+		fixture  string
+		setup    func(t *testing.T, path string)
+		expected Cleanliness
+		warnings int
+	}{
+		{
+			name:     "bdb clean",
+			fixture:  bdbFixture,
+			expected: Clean,
+		},
+		{
+			name:    "bdb with environment region files",
+			fixture: bdbFixture,
+			setup: func(t *testing.T, path string) {
+				for _, name := range []string{"__db.001", "__db.002"} {
+					writeFile(t, filepath.Join(filepath.Dir(path), name), make([]byte, 1024))
+				}
+			},
+			expected: PossiblyDirty,
+			warnings: 2,
+		},
+		{
+			name:    "bdb with pages beyond the metadata page",
+			fixture: bdbFixture,
+			setup: func(t *testing.T, path string) {
+				appendFile(t, path, make([]byte, 4096))
+			},
+			expected: PossiblyDirty,
+			warnings: 1,
+		},
+		{
+			name:    "bdb truncated",
+			fixture: bdbFixture,
+			setup: func(t *testing.T, path string) {
+				require.NoError(t, os.Truncate(path, 4096*100))
+			},
+			expected: RecoveryRequired,
+			warnings: 1,
+		},
+		{
+			name:    "bdb partial page",
+			fixture: bdbFixture,
+			setup: func(t *testing.T, path string) {
+				appendFile(t, path, make([]byte, 100))
+			},
+			expected: RecoveryRequired,
+			warnings: 1,
+		},
+		{
+			name:     "sqlite clean",
+			fixture:  sqliteFixture,
+			expected: Clean,
+		},
+		{
+			name:    "sqlite with write-ahead log",
+			fixture: sqliteFixture,
+			setup: func(t *testing.T, path string) {
+				writeFile(t, path+"-wal", make([]byte, 32))
+			},
+			expected: PossiblyDirty,
+			warnings: 1,
+		},
+		{
+			name:    "sqlite with persisted (zeroed) journal",
+			fixture: sqliteFixture,
+			setup: func(t *testing.T, path string) {
+				writeFile(t, path+"-journal", make([]byte, 512))
+			},
+			expected: Clean,
+		},
+		{
+			name:    "sqlite with hot journal",
+			fixture: sqliteFixture,
+			setup: func(t *testing.T, path string) {
+				writeFile(t, path+"-journal", append([]byte{0xd9, 0xd5, 0x05, 0xf9, 0x20, 0xa1, 0x63, 0xd7}, make([]byte, 504)...))
+			},
+			expected: RecoveryRequired,
+			warnings: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), filepath.Base(test.fixture))
+			contents, err := ioutil.ReadFile(test.fixture)
+			require.NoError(t, err)
+			writeFile(t, path, contents)
+			if test.setup != nil {
+				test.setup(t, path)
+			}
+
+			db, err := Open(path)
+			require.NoError(t, err)
+			defer db.Close()
+
+			info := db.Info()
+			assert.Equal(t, test.expected, info.Cleanliness, "warnings: %v", info.Warnings)
+			assert.Len(t, info.Warnings, test.warnings)
+		})
+	}
+}
+
+func TestRpmDB_Info_SuppliedConnection(t *testing.T) {
+	conn, err := sql.Open(sqlite.DriverName, sqliteFixture)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	db, err := Open("", WithSQLiteDB(conn))
+	require.NoError(t, err)
+	assert.Equal(t, CleanlinessUnknown, db.Info().Cleanliness)
+}
+
+func writeFile(t *testing.T, path string, contents []byte) {
+	t.Helper()
+	require.NoError(t, ioutil.WriteFile(path, contents, 0644))
+}
+
+func appendFile(t *testing.T, path string, contents []byte) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.Write(contents)
+	require.NoError(t, err)
+}
@@ -0,0 +1,15 @@
+package dbi
+
+// Entry is a single raw package header blob read from a database backend.
+type Entry struct {
+	// Instance is the header instance number (the database key of the header), zero when unknown.
+	Instance uint32
+	Value    []byte
+	Err      error
+}
+
+// DBI is implemented by each database backend (Berkeley DB, SQLite) to produce raw header blobs.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/dbi.h
+type DBI interface {
+	Read() <-chan Entry
+}
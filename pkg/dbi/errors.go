@@ -0,0 +1,22 @@
+package dbi
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmptyDatabase indicates that the database holds no data at all (e.g. a zero byte file), which is
+	// distinguishable from a corrupt database.
+	ErrEmptyDatabase = errors.New("empty database")
+	// ErrUnsupported indicates that the database is readable but is not an rpm database this library understands.
+	ErrUnsupported = errors.New("unsupported database")
+	// ErrUnsupportedSchema indicates that the database is an rpm database, but uses a schema (e.g. written by a future
+	// rpm version) that this library does not understand. This is also an ErrUnsupported error.
+	ErrUnsupportedSchema = fmt.Errorf("unsupported schema: %w", ErrUnsupported)
+	// ErrCorruptDatabase indicates that the database structure is malformed (e.g. truncated or inconsistent pages).
+	ErrCorruptDatabase = errors.New("corrupt database")
+	// ErrBlobChecksum indicates that a header blob does not match the checksum stored with it. Backends return the
+	// blob along with this error so that it can still be read leniently.
+	ErrBlobChecksum = errors.New("blob checksum mismatch")
+)
@@ -0,0 +1,50 @@
+package dbi
+
+import "fmt"
+
+// Cleanliness indicates how consistent the database files appeared when opened. A database copied from a live system
+// (e.g. with cp while rpm was running) may be missing writes that were in flight.
+type Cleanliness int
+
+const (
+	// Unknown is used when the backend cannot inspect the database files (e.g. a caller supplied connection).
+	Unknown Cleanliness = iota
+	// Clean indicates that nothing suggests the database was in use when it was copied.
+	Clean
+	// PossiblyDirty indicates the database may have been in use (results may be incomplete or inconsistent).
+	PossiblyDirty
+	// RecoveryRequired indicates rpm itself would need to recover the database before using it.
+	RecoveryRequired
+)
+
+func (c Cleanliness) String() string {
+	switch c {
+	case Clean:
+		return "clean"
+	case PossiblyDirty:
+		return "possibly dirty"
+	case RecoveryRequired:
+		return "recovery required"
+	default:
+		return "unknown"
+	}
+}
+
+// Status is the result of inspecting the database files.
+type Status struct {
+	Cleanliness Cleanliness
+	Warnings    []string
+}
+
+// Mark records a finding, raising the cleanliness to (at least) the given level.
+func (s *Status) Mark(c Cleanliness, format string, args ...interface{}) {
+	if c > s.Cleanliness {
+		s.Cleanliness = c
+	}
+	s.Warnings = append(s.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Inspector is implemented by backends that can detect a database that was not cleanly closed.
+type Inspector interface {
+	Inspect() Status
+}
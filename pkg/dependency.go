@@ -0,0 +1,146 @@
+package rpmdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DependencyFlags are the sense flags of a dependency: how the version is compared (if there is one), along with
+// when the dependency applies (e.g. only for a scriptlet).
+type DependencyFlags int32
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmds.h#L24-L61
+const (
+	RPMSENSE_ANY           DependencyFlags = 0
+	RPMSENSE_LESS          DependencyFlags = 1 << 1
+	RPMSENSE_GREATER       DependencyFlags = 1 << 2
+	RPMSENSE_EQUAL         DependencyFlags = 1 << 3
+	RPMSENSE_POSTTRANS     DependencyFlags = 1 << 5  /*!< %posttrans dependency */
+	RPMSENSE_PREREQ        DependencyFlags = 1 << 6  /* legacy prereq dependency */
+	RPMSENSE_PRETRANS      DependencyFlags = 1 << 7  /*!< Pre-transaction dependency. */
+	RPMSENSE_INTERP        DependencyFlags = 1 << 8  /*!< Interpreter used by scriptlet. */
+	RPMSENSE_SCRIPT_PRE    DependencyFlags = 1 << 9  /*!< %pre dependency. */
+	RPMSENSE_SCRIPT_POST   DependencyFlags = 1 << 10 /*!< %post dependency. */
+	RPMSENSE_SCRIPT_PREUN  DependencyFlags = 1 << 11 /*!< %preun dependency. */
+	RPMSENSE_SCRIPT_POSTUN DependencyFlags = 1 << 12 /*!< %postun dependency. */
+	RPMSENSE_SCRIPT_VERIFY DependencyFlags = 1 << 13 /*!< %verify dependency. */
+	RPMSENSE_FIND_REQUIRES DependencyFlags = 1 << 14 /*!< find-requires generated dependency. */
+	RPMSENSE_FIND_PROVIDES DependencyFlags = 1 << 15 /*!< find-provides generated dependency. */
+	RPMSENSE_TRIGGERIN     DependencyFlags = 1 << 16 /*!< %triggerin dependency. */
+	RPMSENSE_TRIGGERUN     DependencyFlags = 1 << 17 /*!< %triggerun dependency. */
+	RPMSENSE_TRIGGERPOSTUN DependencyFlags = 1 << 18 /*!< %triggerpostun dependency. */
+	RPMSENSE_MISSINGOK     DependencyFlags = 1 << 19 /*!< suggests/enhances hint. */
+	RPMSENSE_RPMLIB        DependencyFlags = 1 << 24 /*!< rpmlib(feature) dependency. */
+	RPMSENSE_TRIGGERPREIN  DependencyFlags = 1 << 25 /*!< %triggerprein dependency. */
+	RPMSENSE_KEYRING       DependencyFlags = 1 << 26
+	RPMSENSE_CONFIG        DependencyFlags = 1 << 28
+)
+
+// Comparison returns the operator the dependency version is compared with ("<", "<=", "=", ">=" or ">"), or an
+// empty string when any version satisfies the dependency.
+func (f DependencyFlags) Comparison() string {
+	var op string
+	if f&RPMSENSE_LESS != 0 {
+		op += "<"
+	}
+	if f&RPMSENSE_GREATER != 0 {
+		op += ">"
+	}
+	if f&RPMSENSE_EQUAL != 0 {
+		op += "="
+	}
+	return op
+}
+
+// Dependency is a single entry of one of the dependency lists of a package (e.g. Requires): a capability, optionally
+// constrained to a version.
+type Dependency struct {
+	Name    string
+	Version string
+	Flags   DependencyFlags
+}
+
+// String formats the dependency the way rpm lists it (e.g. "libc.so.6()(64bit)" or "rpmlib(FileDigests) <= 4.6.0-1").
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmds.c (rpmdsNewDNEVR)
+func (d Dependency) String() string {
+	op := d.Flags.Comparison()
+	if op == "" || d.Version == "" {
+		return d.Name
+	}
+	return strings.Join([]string{d.Name, op, d.Version}, " ")
+}
+
+// dependencyTags are the three parallel tag arrays that make up a dependency list.
+type dependencyTags struct {
+	name     string
+	names    Tag
+	versions Tag
+	flags    Tag
+}
+
+var (
+	requireTags  = dependencyTags{name: "require", names: RPMTAG_REQUIRENAME, versions: RPMTAG_REQUIREVERSION, flags: RPMTAG_REQUIREFLAGS}
+	provideTags  = dependencyTags{name: "provide", names: RPMTAG_PROVIDENAME, versions: RPMTAG_PROVIDEVERSION, flags: RPMTAG_PROVIDEFLAGS}
+	conflictTags = dependencyTags{name: "conflict", names: RPMTAG_CONFLICTNAME, versions: RPMTAG_CONFLICTVERSION, flags: RPMTAG_CONFLICTFLAGS}
+	obsoleteTags = dependencyTags{name: "obsolete", names: RPMTAG_OBSOLETENAME, versions: RPMTAG_OBSOLETEVERSION, flags: RPMTAG_OBSOLETEFLAGS}
+
+	// weak dependencies
+	recommendTags  = dependencyTags{name: "recommend", names: RPMTAG_RECOMMENDNAME, versions: RPMTAG_RECOMMENDVERSION, flags: RPMTAG_RECOMMENDFLAGS}
+	suggestTags    = dependencyTags{name: "suggest", names: RPMTAG_SUGGESTNAME, versions: RPMTAG_SUGGESTVERSION, flags: RPMTAG_SUGGESTFLAGS}
+	supplementTags = dependencyTags{name: "supplement", names: RPMTAG_SUPPLEMENTNAME, versions: RPMTAG_SUPPLEMENTVERSION, flags: RPMTAG_SUPPLEMENTFLAGS}
+	enhanceTags    = dependencyTags{name: "enhance", names: RPMTAG_ENHANCENAME, versions: RPMTAG_ENHANCEVERSION, flags: RPMTAG_ENHANCEFLAGS}
+)
+
+// parseDependencies zips the name, version and flags arrays of a dependency list. Like rpm, a list with a version or
+// flags array that does not match the names is ignored, which is reported as a warning.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmds.c (rpmdsNew)
+func parseDependencies(indexEntries []indexEntry, tags dependencyTags) ([]Dependency, []string, error) {
+	var names, versions []string
+	var flags []int32
+	var hasVersions, hasFlags bool
+	var err error
+
+	for _, entry := range indexEntries {
+		switch Tag(entry.Info.Tag) {
+		case tags.names:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-names", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			names = parseStringArray(entry.Data, entry.Info.Count)
+		case tags.versions:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-versions", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			versions, hasVersions = parseStringArray(entry.Data, entry.Info.Count), true
+		case tags.flags:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-flags", entry.Info, RPM_INT32_TYPE)
+			}
+			flags, err = parseInt32Array(entry.Data, int(entry.Info.Count)*sizeOfInt32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse %s-flags: %w", tags.name, err)
+			}
+			hasFlags = true
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, nil, nil
+	}
+	if (hasVersions && len(versions) != len(names)) || (hasFlags && len(flags) != len(names)) {
+		warning := fmt.Sprintf("ignoring %s dependencies: %d names, %d versions and %d flags", tags.name, len(names), len(versions), len(flags))
+		return nil, []string{warning}, nil
+	}
+
+	deps := make([]Dependency, len(names))
+	for i, name := range names {
+		deps[i].Name = name
+		if hasVersions {
+			deps[i].Version = versions[i]
+		}
+		if hasFlags {
+			deps[i].Flags = DependencyFlags(flags[i])
+		}
+	}
+	return deps, nil, nil
+}
@@ -0,0 +1,214 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependency_String(t *testing.T) {
+	tests := []struct {
+		dep      Dependency
+		expected string
+	}{
+		{dep: Dependency{Name: "/bin/sh", Flags: RPMSENSE_FIND_REQUIRES}, expected: "/bin/sh"},
+		{dep: Dependency{Name: "config(bash)", Version: "4.2.46-30.el7", Flags: RPMSENSE_EQUAL | RPMSENSE_CONFIG}, expected: "config(bash) = 4.2.46-30.el7"},
+		{dep: Dependency{Name: "rpmlib(FileDigests)", Version: "4.6.0-1", Flags: RPMSENSE_LESS | RPMSENSE_EQUAL | RPMSENSE_RPMLIB}, expected: "rpmlib(FileDigests) <= 4.6.0-1"},
+		{dep: Dependency{Name: "glibc", Version: "2.17", Flags: RPMSENSE_GREATER | RPMSENSE_EQUAL}, expected: "glibc >= 2.17"},
+		{dep: Dependency{Name: "python", Version: "3", Flags: RPMSENSE_LESS}, expected: "python < 3"},
+		{dep: Dependency{Name: "python", Version: "2", Flags: RPMSENSE_GREATER}, expected: "python > 2"},
+		// a comparison without a version (or a version without a comparison) is not printed
+		{dep: Dependency{Name: "foo", Flags: RPMSENSE_EQUAL}, expected: "foo"},
+		{dep: Dependency{Name: "foo", Version: "1.0"}, expected: "foo"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.dep.String())
+		})
+	}
+}
+
+func TestPackageInfo_Requires(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			var bash *PackageInfo
+			for _, pkg := range listFixturePackages(t, fixture) {
+				if pkg.Name == "bash" {
+					bash = pkg
+				}
+			}
+			require.NotNil(t, bash)
+
+			require.Len(t, bash.Requires, 20)
+			assert.Equal(t, Dependency{Name: "/bin/sh", Flags: RPMSENSE_FIND_REQUIRES}, bash.Requires[0])
+			assert.Equal(t, Dependency{Name: "config(bash)", Version: "4.2.46-30.el7", Flags: RPMSENSE_EQUAL | RPMSENSE_CONFIG}, bash.Requires[1])
+			assert.Equal(t, "libtinfo.so.5()(64bit)", bash.Requires[13].String())
+			assert.Equal(t, "rpmlib(FileDigests) <= 4.6.0-1", bash.Requires[16].String())
+		})
+	}
+}
+
+func TestPackageInfo_Provides(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			provides := map[string][]string{}
+			for _, pkg := range listFixturePackages(t, fixture) {
+				for _, dep := range pkg.Provides {
+					provides[pkg.Name] = append(provides[pkg.Name], dep.String())
+				}
+			}
+
+			assert.Equal(t, []string{
+				"/bin/bash",
+				"/bin/sh",
+				"bash = 4.2.46-30.el7",
+				"bash(x86-64) = 4.2.46-30.el7",
+				"config(bash) = 4.2.46-30.el7",
+			}, provides["bash"])
+			// sonames are provided by the library packages, generated by find-provides
+			assert.Contains(t, provides["ncurses-libs"], "libtinfo.so.5()(64bit)")
+		})
+	}
+}
+
+func TestPackageInfo_ConflictsObsoletes(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			var ncursesLibs *PackageInfo
+			for _, pkg := range listFixturePackages(t, fixture) {
+				if pkg.Name == "ncurses-libs" {
+					ncursesLibs = pkg
+				}
+			}
+			require.NotNil(t, ncursesLibs)
+
+			assert.Equal(t, []Dependency{
+				{Name: "ncurses", Version: "5.6-13", Flags: RPMSENSE_LESS},
+			}, ncursesLibs.Conflicts)
+			assert.Equal(t, []Dependency{
+				{Name: "ncurses", Version: "5.6-13", Flags: RPMSENSE_LESS},
+				{Name: "libtermcap", Version: "2.0.8-48", Flags: RPMSENSE_LESS},
+			}, ncursesLibs.Obsoletes)
+		})
+	}
+}
+
+func TestParseDependencies(t *testing.T) {
+	t.Run("zipped", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_REQUIREFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_INTERP | RPMSENSE_SCRIPT_POST), int32(RPMSENSE_GREATER | RPMSENSE_EQUAL)}},
+			testEntry{Tag: RPMTAG_REQUIRENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/bin/sh", "bar"}},
+			testEntry{Tag: RPMTAG_REQUIREVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"", "1:2.0-1"}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []Dependency{
+			{Name: "/bin/sh", Flags: RPMSENSE_INTERP | RPMSENSE_SCRIPT_POST},
+			{Name: "bar", Version: "1:2.0-1", Flags: RPMSENSE_GREATER | RPMSENSE_EQUAL},
+		}, pkg.Requires)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("names only", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_REQUIRENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar"}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []Dependency{{Name: "bar"}}, pkg.Requires)
+		assert.Nil(t, pkg.Provides)
+	})
+
+	t.Run("provides", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_PROVIDENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "libfoo.so.1()(64bit)"}},
+			testEntry{Tag: RPMTAG_PROVIDEFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_EQUAL), int32(RPMSENSE_FIND_PROVIDES)}},
+			testEntry{Tag: RPMTAG_PROVIDEVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"1.0-1", ""}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []Dependency{
+			{Name: "foo", Version: "1.0-1", Flags: RPMSENSE_EQUAL},
+			{Name: "libfoo.so.1()(64bit)", Flags: RPMSENSE_FIND_PROVIDES},
+		}, pkg.Provides)
+		assert.Nil(t, pkg.Requires)
+	})
+
+	t.Run("conflicts and obsoletes", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_CONFLICTFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_LESS)}},
+			testEntry{Tag: RPMTAG_CONFLICTNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar"}},
+			testEntry{Tag: RPMTAG_CONFLICTVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"2.0"}},
+			testEntry{Tag: RPMTAG_OBSOLETENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo-legacy"}},
+			testEntry{Tag: RPMTAG_OBSOLETEFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_LESS | RPMSENSE_EQUAL)}},
+			testEntry{Tag: RPMTAG_OBSOLETEVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"1:1.0"}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []Dependency{{Name: "bar", Version: "2.0", Flags: RPMSENSE_LESS}}, pkg.Conflicts)
+		assert.Equal(t, []Dependency{{Name: "foo-legacy", Version: "1:1.0", Flags: RPMSENSE_LESS | RPMSENSE_EQUAL}}, pkg.Obsoletes)
+		assert.Nil(t, pkg.Requires)
+		assert.Nil(t, pkg.Provides)
+	})
+
+	t.Run("weak dependencies", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_RECOMMENDNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo-doc"}},
+			testEntry{Tag: RPMTAG_RECOMMENDVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{""}},
+			testEntry{Tag: RPMTAG_RECOMMENDFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0}},
+			testEntry{Tag: RPMTAG_SUGGESTNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar", "(baz if qux)"}},
+			testEntry{Tag: RPMTAG_SUGGESTVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"2.0", ""}},
+			testEntry{Tag: RPMTAG_SUGGESTFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_GREATER | RPMSENSE_EQUAL), 0}},
+			testEntry{Tag: RPMTAG_SUPPLEMENTNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"packageand(foo:bar)"}},
+			testEntry{Tag: RPMTAG_SUPPLEMENTVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{""}},
+			testEntry{Tag: RPMTAG_SUPPLEMENTFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0}},
+			testEntry{Tag: RPMTAG_ENHANCENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"qux"}},
+			testEntry{Tag: RPMTAG_ENHANCEVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"1.0"}},
+			testEntry{Tag: RPMTAG_ENHANCEFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_LESS)}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []Dependency{{Name: "foo-doc"}}, pkg.Recommends)
+		assert.Equal(t, []Dependency{
+			{Name: "bar", Version: "2.0", Flags: RPMSENSE_GREATER | RPMSENSE_EQUAL},
+			{Name: "(baz if qux)"},
+		}, pkg.Suggests)
+		assert.Equal(t, []Dependency{{Name: "packageand(foo:bar)"}}, pkg.Supplements)
+		assert.Equal(t, []Dependency{{Name: "qux", Version: "1.0", Flags: RPMSENSE_LESS}}, pkg.Enhances)
+		assert.Nil(t, pkg.Requires)
+	})
+
+	t.Run("mismatched arrays", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_REQUIREFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0}},
+			testEntry{Tag: RPMTAG_REQUIRENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar", "baz"}},
+			testEntry{Tag: RPMTAG_REQUIREVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"", ""}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Requires)
+		assert.Equal(t, []string{"ignoring require dependencies: 2 names, 2 versions and 1 flags"}, pkg.Warnings)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_REQUIRENAME, Type: RPM_STRING_TYPE, Value: "bar"},
+		))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "require-names", typeErr.Name)
+
+		_, err = ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_OBSOLETENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar"}},
+			testEntry{Tag: RPMTAG_OBSOLETEFLAGS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"<"}},
+		))
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "obsolete-flags", typeErr.Name)
+		assert.Contains(t, err.Error(), "failed to read obsoletes")
+	})
+}
@@ -0,0 +1,101 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// the database backends, as named by Detect and Source.Backend
+const (
+	BackendBerkeleyDB = "bdb"
+	BackendSQLite     = "sqlite"
+	BackendNDB        = "ndb"
+)
+
+// sqliteMagic is the header of every SQLite database file
+var sqliteMagic = []byte("SQLite format 3\x00")
+
+// ndbMagic is the header of every ndb Packages.db file
+var ndbMagic = []byte("RpmP")
+
+// the magic numbers of the Berkeley DB hash and btree metadata pages, found after the LSN and page number
+// ref. https://github.com/berkeleydb/libdb/blob/5b7b02ae052442626af54c176335b67ecc613a30/src/dbinc/db_page.h#L73
+const (
+	bdbHashMagic   = 0x061561
+	bdbBtreeMagic  = 0x053162
+	bdbMagicOffset = 12
+)
+
+// dbFileNames are the database files looked for when a directory is given (e.g. /var/lib/rpm or
+// /usr/lib/sysimage/rpm), newest backend first since a migrated database may leave the old files behind.
+var dbFileNames = []string{"rpmdb.sqlite", "Packages.db", "Packages"}
+
+// Detect identifies the rpm database at the given path from the magic bytes of the file. The path may also be a
+// directory holding the database, in which case the returned Source names the database file found in it.
+func Detect(path string) (Source, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return Source{}, err
+	}
+	if info.IsDir() {
+		dir := path
+		for _, name := range dbFileNames {
+			path = filepath.Join(dir, name)
+			if info, err = os.Stat(path); err == nil && !info.IsDir() {
+				break
+			}
+		}
+		if err != nil || info.IsDir() {
+			return Source{}, fmt.Errorf("no rpm database found in %q: %w", dir, fs.ErrNotExist)
+		}
+	}
+	if info.Size() == 0 {
+		return Source{}, fmt.Errorf("%q is a zero byte file: %w", path, ErrEmptyDatabase)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return Source{}, err
+	}
+	defer file.Close()
+
+	backend, err := detectBackend(file)
+	if err != nil {
+		return Source{}, err
+	}
+	if backend == "" {
+		return Source{}, fmt.Errorf("%q is not a recognized rpm database: %w", path, ErrUnsupported)
+	}
+	return Source{Path: path, Backend: backend}, nil
+}
+
+// detectBackend names the backend from the magic bytes at the start of the database, or returns an empty name if
+// none matches.
+func detectBackend(reader io.ReaderAt) (string, error) {
+	header := make([]byte, len(sqliteMagic))
+	n, err := reader.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	header = header[:n]
+
+	switch {
+	case bytes.Equal(header, sqliteMagic):
+		return BackendSQLite, nil
+	case bytes.HasPrefix(header, ndbMagic):
+		return BackendNDB, nil
+	case len(header) >= bdbMagicOffset+4:
+		// note: the magic number is in the byte order of the host that wrote the database
+		for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+			if magic := order.Uint32(header[bdbMagicOffset:]); magic == bdbHashMagic || magic == bdbBtreeMagic {
+				return BackendBerkeleyDB, nil
+			}
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,101 @@
+package rpmdb
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	// a migrated database directory holding both the old and the new database
+	migrated := t.TempDir()
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		contents, err := os.ReadFile(fixture)
+		require.NoError(t, err)
+		writeFile(t, filepath.Join(migrated, filepath.Base(fixture)), contents)
+	}
+
+	ndbDir := t.TempDir()
+	writeFile(t, filepath.Join(ndbDir, "Packages.db"), buildNDB(fixtureBlobs(t, "testdata/centos7-plain/Packages")[:1]))
+
+	tests := []struct {
+		name        string
+		path        string
+		expected    Source
+		expectedErr error
+	}{
+		{
+			name:     "bdb file",
+			path:     "testdata/centos7-plain/Packages",
+			expected: Source{Path: "testdata/centos7-plain/Packages", Backend: BackendBerkeleyDB},
+		},
+		{
+			name:     "sqlite file",
+			path:     sqliteFixture,
+			expected: Source{Path: sqliteFixture, Backend: BackendSQLite},
+		},
+		{
+			name:     "bdb directory",
+			path:     "testdata/centos7-plain",
+			expected: Source{Path: "testdata/centos7-plain/Packages", Backend: BackendBerkeleyDB},
+		},
+		{
+			name:     "sqlite directory",
+			path:     "testdata/centos7-plain-sqlite",
+			expected: Source{Path: sqliteFixture, Backend: BackendSQLite},
+		},
+		{
+			name:     "ndb directory",
+			path:     ndbDir,
+			expected: Source{Path: filepath.Join(ndbDir, "Packages.db"), Backend: BackendNDB},
+		},
+		{
+			name:     "migrated directory prefers the newest backend",
+			path:     migrated,
+			expected: Source{Path: filepath.Join(migrated, "rpmdb.sqlite"), Backend: BackendSQLite},
+		},
+		{
+			name:        "directory without a database",
+			path:        t.TempDir(),
+			expectedErr: fs.ErrNotExist,
+		},
+		{
+			name:        "zero byte file",
+			path:        "testdata/empty/zero-bytes/Packages",
+			expectedErr: ErrEmptyDatabase,
+		},
+		{
+			name:        "not a database",
+			path:        "testdata/rpm/epel-release-7-5.noarch.rpm",
+			expectedErr: ErrUnsupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source, err := Detect(test.path)
+			if test.expectedErr != nil {
+				assert.True(t, errors.Is(err, test.expectedErr), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, source)
+		})
+	}
+}
+
+func TestOpen_Directory(t *testing.T) {
+	db, err := Open("testdata/centos7-plain")
+	require.NoError(t, err)
+	defer db.Close()
+
+	assert.Equal(t, BackendBerkeleyDB, db.backend())
+	pkgList, err := db.ListPackages()
+	require.NoError(t, err)
+	assert.Len(t, pkgList, 144)
+}
@@ -0,0 +1,24 @@
+package rpmdb
+
+// DigestCoverage reports how many of the regular files owned by the package have a digest recorded, as an indication
+// of how much of the package file verification can cover. Ghost files, files marked %verify(not md5) and packages
+// built without file digests all reduce the coverage. Directories, symlinks and other special files are not counted.
+// When the package was read with WithFiles(false) the file list is decoded first (a package whose files cannot be
+// decoded reports no coverage).
+func (p *PackageInfo) DigestCoverage() (withDigest, regularFiles int) {
+	files, err := p.FileList()
+	if err != nil {
+		return 0, 0
+	}
+
+	for _, f := range files {
+		if fileType(f.Mode) != fileTypeRegular {
+			continue
+		}
+		regularFiles++
+		if f.Digest != "" {
+			withDigest++
+		}
+	}
+	return withDigest, regularFiles
+}
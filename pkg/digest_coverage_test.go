@@ -0,0 +1,76 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_DigestCoverage(t *testing.T) {
+	// a directory, a normal file, a %ghost file and a symlink
+	files := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: []uint16{0040755, 0100644, 0100644, 0120777}},
+		{Tag: RPMTAG_FILEFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0, RPMFILE_CONFIG, RPMFILE_GHOST, 0}},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 1, 1, 1}},
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "foo.conf", "foo.log", "foo.link"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/etc/", "/etc/foo/"}},
+	}
+
+	tests := []struct {
+		name                 string
+		entries              []testEntry
+		expectedWithDigest   int
+		expectedRegularFiles int
+		expectedAbsent       bool
+	}{
+		{
+			name: "digests recorded",
+			entries: []testEntry{
+				{Tag: RPMTAG_FILEDIGESTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"", "d41d8cd98f00b204e9800998ecf8427e", "", ""}},
+			},
+			expectedWithDigest:   1,
+			expectedRegularFiles: 2,
+		},
+		{
+			name:                 "digests absent",
+			expectedWithDigest:   0,
+			expectedRegularFiles: 2,
+			expectedAbsent:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkg, err := ParseHeader(newTestHeader(append(files, test.entries...)...))
+			require.NoError(t, err)
+			require.Len(t, pkg.Files, 4)
+
+			for _, f := range pkg.Files {
+				assert.Equal(t, test.expectedAbsent, f.DigestAbsent, f.Path)
+			}
+
+			withDigest, regularFiles := pkg.DigestCoverage()
+			assert.Equal(t, test.expectedWithDigest, withDigest)
+			assert.Equal(t, test.expectedRegularFiles, regularFiles)
+		})
+	}
+}
+
+func TestPackageInfo_DigestCoverage_Fixture(t *testing.T) {
+	// ca-certificates owns directories, symlinks and %ghost files along with the bundles themselves
+	for _, opts := range [][]Option{nil, {WithFiles(false)}} {
+		var pkg *PackageInfo
+		for _, p := range listFixturePackages(t, "testdata/centos7-plain/Packages", opts...) {
+			if p.Name == "ca-certificates" {
+				pkg = p
+			}
+		}
+		require.NotNil(t, pkg)
+
+		withDigest, regularFiles := pkg.DigestCoverage()
+		assert.Equal(t, 21, withDigest)
+		assert.Equal(t, 22, regularFiles)
+	}
+}
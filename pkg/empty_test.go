@@ -0,0 +1,52 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen_EmptyDatabase(t *testing.T) {
+	tests := []struct {
+		name        string
+		fixture     string
+		expectedErr error
+	}{
+		{
+			name:        "zero byte file",
+			fixture:     "testdata/empty/zero-bytes/Packages",
+			expectedErr: ErrEmptyDatabase,
+		},
+		{
+			name:    "bdb metadata page only",
+			fixture: "testdata/empty/metadata-only/Packages",
+		},
+		{
+			name:    "sqlite schema without rows",
+			fixture: "testdata/empty/sqlite-no-rows/rpmdb.sqlite",
+		},
+		{
+			name:        "sqlite without schema",
+			fixture:     "testdata/empty/sqlite-no-schema/rpmdb.sqlite",
+			expectedErr: ErrEmptyDatabase,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := Open(test.fixture)
+			if test.expectedErr != nil {
+				assert.True(t, errors.Is(err, test.expectedErr), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			assert.Empty(t, pkgList)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package rpmdb
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// InvalidUTF8Policy determines how strings of a header that are not valid UTF-8 are returned. rpm itself does not
+// enforce any encoding, so headers built before RPMTAG_ENCODING was introduced may carry strings in whatever encoding
+// the packager used.
+type InvalidUTF8Policy int
+
+const (
+	// InvalidUTF8Keep returns the strings as stored in the header (the default).
+	InvalidUTF8Keep InvalidUTF8Policy = iota
+	// InvalidUTF8Replace replaces each run of invalid bytes with the Unicode replacement character (U+FFFD).
+	InvalidUTF8Replace
+	// InvalidUTF8Latin1 transcodes strings that are not valid UTF-8 from ISO-8859-1, the most common legacy encoding
+	// of package headers. Strings that are valid UTF-8 are left alone.
+	InvalidUTF8Latin1
+)
+
+// sanitizeStrings applies the policy to the strings of every string entry, returning a warning for each tag that
+// was changed. The entries are returned as-is when nothing needs to change.
+func sanitizeStrings(indexEntries []indexEntry, policy InvalidUTF8Policy) ([]indexEntry, []string) {
+	if policy == InvalidUTF8Keep {
+		return indexEntries, nil
+	}
+
+	var sanitized []indexEntry
+	var warnings []string
+	for i, entry := range indexEntries {
+		var values []string
+		switch entry.Info.Type {
+		case RPM_STRING_TYPE:
+			values = []string{parseString(entry.Data)}
+		case RPM_STRING_ARRAY_TYPE, RPM_I18NSTRING_TYPE:
+			values = parseStringArray(entry.Data, entry.Info.Count)
+		default:
+			continue
+		}
+
+		changed := false
+		for j, value := range values {
+			if utf8.ValidString(value) {
+				continue
+			}
+			values[j], changed = sanitizeString(value, policy), true
+		}
+		if !changed {
+			continue
+		}
+
+		if sanitized == nil {
+			sanitized = make([]indexEntry, len(indexEntries))
+			copy(sanitized, indexEntries)
+		}
+		sanitized[i].Data = []byte(strings.Join(values, "\x00") + "\x00")
+		warnings = append(warnings, fmt.Sprintf("replaced invalid UTF-8 in tag %d", entry.Info.Tag))
+	}
+
+	if sanitized == nil {
+		return indexEntries, nil
+	}
+	return sanitized, warnings
+}
+
+func sanitizeString(value string, policy InvalidUTF8Policy) string {
+	switch policy {
+	case InvalidUTF8Latin1:
+		// note: every byte is a valid ISO-8859-1 character with the same code point
+		runes := make([]rune, len(value))
+		for i := 0; i < len(value); i++ {
+			runes[i] = rune(value[i])
+		}
+		return string(runes)
+	case InvalidUTF8Replace:
+		return strings.ToValidUTF8(value, string(utf8.RuneError))
+	default:
+		return value
+	}
+}
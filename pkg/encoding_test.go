@@ -0,0 +1,83 @@
+package rpmdb
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHeader_InvalidUTF8(t *testing.T) {
+	header := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_VENDOR, Type: RPM_STRING_TYPE, Value: "Soci\xe9t\xe9 G\xe9n\xe9rale"},
+		testEntry{Tag: RPMTAG_CHANGELOGTIME, Type: RPM_INT32_TYPE, Value: []int32{1600000000, 1500000000}},
+		testEntry{Tag: RPMTAG_CHANGELOGNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"Jos\xe9 <jose@example.com> - 1.1-1", "Bob <bob@example.com> - 1.0-1"}},
+		testEntry{Tag: RPMTAG_CHANGELOGTEXT, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"- caf\xe9", "- initial package"}},
+	)
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		vendor   string
+		author   string
+		text     string
+		warnings []string
+	}{
+		{
+			name:   "keep",
+			vendor: "Soci\xe9t\xe9 G\xe9n\xe9rale",
+			author: "Jos\xe9 <jose@example.com> - 1.1-1",
+			text:   "- caf\xe9",
+		},
+		{
+			name:     "replace",
+			opts:     []Option{WithInvalidUTF8(InvalidUTF8Replace)},
+			vendor:   "Soci�t� G�n�rale",
+			author:   "Jos� <jose@example.com> - 1.1-1",
+			text:     "- caf�",
+			warnings: []string{"replaced invalid UTF-8 in tag 1011", "replaced invalid UTF-8 in tag 1081", "replaced invalid UTF-8 in tag 1082"},
+		},
+		{
+			name:     "latin1",
+			opts:     []Option{WithInvalidUTF8(InvalidUTF8Latin1)},
+			vendor:   "Société Générale",
+			author:   "José <jose@example.com> - 1.1-1",
+			text:     "- café",
+			warnings: []string{"replaced invalid UTF-8 in tag 1011", "replaced invalid UTF-8 in tag 1081", "replaced invalid UTF-8 in tag 1082"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkg, err := ParseHeader(header, test.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, test.vendor, pkg.Vendor)
+			require.Len(t, pkg.Changelog, 2)
+			assert.Equal(t, test.author, pkg.Changelog[0].Author)
+			assert.Equal(t, test.text, pkg.Changelog[0].Text)
+			assert.Equal(t, "Bob <bob@example.com> - 1.0-1", pkg.Changelog[1].Author)
+			assert.Equal(t, test.warnings, pkg.Warnings)
+
+			if test.warnings != nil {
+				data, err := json.Marshal(pkg)
+				require.NoError(t, err)
+				assert.True(t, utf8.Valid(data))
+				assert.Contains(t, string(data), test.vendor)
+			}
+		})
+	}
+}
+
+func TestParseHeader_Encoding(t *testing.T) {
+	pkg, err := ParseHeader(newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_ENCODING, Type: RPM_STRING_TYPE, Value: "utf-8"},
+	), WithInvalidUTF8(InvalidUTF8Latin1))
+	require.NoError(t, err)
+	assert.Equal(t, "foo", pkg.Name)
+	assert.Equal(t, "utf-8", pkg.Encoding)
+	assert.Empty(t, pkg.Warnings)
+}
@@ -3,11 +3,34 @@ package rpmdb
 import (
 	"bytes"
 	"encoding/binary"
-	"golang.org/x/xerrors"
+	"fmt"
 	"io"
-	"unsafe"
 )
 
+const (
+	// the header starts with the number of index entries (il) and the size of the data segment (dl), both int32
+	headerPreambleSize = 8
+	// every index entry is four big-endian 32-bit integers (see entryInfo)
+	entryInfoSize = 16
+
+	// sanity limits applied by rpm before trusting any header values
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header_internal.h
+	headerMaxTags = 0x0000ffff
+	headerMaxData = 0x0fffffff
+)
+
+// typeSizes is the size (in bytes) of a single element for the fixed-size tag types, all other types are variable
+// length with at least a single byte per element.
+var typeSizes = map[uint32]int64{
+	RPM_NULL_TYPE:  0,
+	RPM_CHAR_TYPE:  1,
+	RPM_INT8_TYPE:  1,
+	RPM_INT16_TYPE: 2,
+	RPM_INT32_TYPE: 4,
+	RPM_INT64_TYPE: 8,
+	RPM_BIN_TYPE:   1,
+}
+
 // ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header_internal.h#L13-L19
 type entryInfo struct {
 	Tag    int32  /*!< Tag identifier. */
@@ -31,55 +54,107 @@ func headerImport(data []byte) ([]indexEntry, error) {
 	reader := bytes.NewReader(data)
 
 	if err = binary.Read(reader, binary.BigEndian, &il); err != nil {
-		return nil, xerrors.Errorf("invalid index length: %w", err)
+		return nil, fmt.Errorf("invalid index length: %w: %w", ErrHeaderInvalid, err)
 	}
 	if err = binary.Read(reader, binary.BigEndian, &dl); err != nil {
-		return nil, xerrors.Errorf("invalid data length: %w", err)
+		return nil, fmt.Errorf("invalid data length: %w: %w", ErrHeaderInvalid, err)
 	}
 
-	dataStart := int32(unsafe.Sizeof(il)) + int32(unsafe.Sizeof(dl)) + il*int32(unsafe.Sizeof(entryInfo{}))
+	if il < 1 || il > headerMaxTags {
+		return nil, fmt.Errorf("index length %d out of range: %w", il, ErrHeaderInvalid)
+	}
+	if dl < 0 || dl > headerMaxData {
+		return nil, fmt.Errorf("data length %d out of range: %w", dl, ErrHeaderInvalid)
+	}
 
+	dataStart := headerPreambleSize + il*entryInfoSize
+	if int64(len(data)) < int64(dataStart)+int64(dl) {
+		return nil, fmt.Errorf("header is truncated (%d bytes < %d bytes): %w", len(data), int64(dataStart)+int64(dl), ErrHeaderInvalid)
+	}
+
+	// note: all header data is stored in network byte order, independent of the host that wrote the database
 	peList := make([]entryInfo, il)
 	for i := 0; i < int(il); i++ {
 		var pe entryInfo
-		err = binary.Read(reader, binary.LittleEndian, &pe)
+		err = binary.Read(reader, binary.BigEndian, &pe)
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return nil, xerrors.Errorf("failed to read entry info: %w", err)
+			return nil, fmt.Errorf("failed to read entry info: %w: %w", ErrHeaderInvalid, err)
 		}
 		peList[i] = pe
 	}
 
-	// Ignore negative offset
-	indexEntries := regionSwab(data, peList[1:], dataStart, int(dl))
-	return indexEntries, nil
+	// note: the region entry describes the layout of the header rather than being a tag of the package
+	if isRegionTag(peList[0].Tag) {
+		if _, err := headerRegion(data); err != nil {
+			return nil, err
+		}
+		peList = peList[1:]
+	}
+	return regionSwab(data, peList, dataStart, int(dl))
+}
+
+// verifyEntryInfo checks that the entry values are within the bounds of the data segment, before they are used for
+// any allocation or slicing.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header.c (hdrchkType, hdrchkData)
+func verifyEntryInfo(info entryInfo, dl int) error {
+	if info.Type > RPM_I18NSTRING_TYPE {
+		return fmt.Errorf("tag %d has an unknown type %d: %w", info.Tag, info.Type, ErrHeaderInvalid)
+	}
+
+	// note: the count is stored as an unsigned value, however, rpm treats it as a signed integer
+	count := int64(int32(info.Count))
+	if count < 0 || count > headerMaxData {
+		return fmt.Errorf("tag %d has an invalid count %d: %w", info.Tag, count, ErrHeaderInvalid)
+	}
+
+	if info.Offset < 0 || int(info.Offset) > dl {
+		return fmt.Errorf("tag %d has an invalid offset %d (data length %d): %w", info.Tag, info.Offset, dl, ErrHeaderInvalid)
+	}
+
+	available := int64(dl) - int64(info.Offset)
+	elementSize, fixed := typeSizes[info.Type]
+	if !fixed {
+		// variable length elements (strings) are at least one byte each (the NUL terminator)
+		elementSize = 1
+	}
+	if count*elementSize > available {
+		return fmt.Errorf("tag %d has an invalid count %d (only %d bytes of data available): %w", info.Tag, count, available, ErrHeaderInvalid)
+	}
+
+	return nil
 }
 
 // ref. https://github.com/rpm-software-management/rpm/blob/7a2f891d25d78cf797c789ac6859b5f2c589d296/lib/header.c#L498
-func regionSwab(data []byte, peList []entryInfo, dataStart int32, dl int) []indexEntry {
+func regionSwab(data []byte, peList []entryInfo, dataStart int32, dl int) ([]indexEntry, error) {
 	indexEntries := make([]indexEntry, len(peList))
 	for i := 0; i < len(peList); i++ {
-		pe := peList[i]
+		if err := verifyEntryInfo(peList[i], dl); err != nil {
+			return nil, err
+		}
+
 		indexEntry := indexEntry{
-			Info: entryInfo{
-				Type:   HtonlU(pe.Type),
-				Count:  HtonlU(pe.Count),
-				Offset: Htonl(pe.Offset),
-				Tag:    Htonl(pe.Tag),
-			},
+			Info: peList[i],
 		}
 		if i < len(peList)-1 {
-			indexEntry.Length = int(Htonl(peList[i+1].Offset) - indexEntry.Info.Offset)
+			indexEntry.Length = int(peList[i+1].Offset - indexEntry.Info.Offset)
 		} else {
 			indexEntry.Length = dl - int(indexEntry.Info.Offset)
 		}
 
-		start := dataStart + indexEntry.Info.Offset
-		end := int(start) + indexEntry.Length
+		if indexEntry.Length < 0 {
+			return nil, fmt.Errorf("tag %d has a negative data length %d: %w", indexEntry.Info.Tag, indexEntry.Length, ErrHeaderInvalid)
+		}
+
+		start := int(dataStart) + int(indexEntry.Info.Offset)
+		end := start + indexEntry.Length
+		if end > int(dataStart)+dl {
+			return nil, fmt.Errorf("tag %d data exceeds the data segment: %w", indexEntry.Info.Tag, ErrHeaderInvalid)
+		}
 		indexEntry.Data = data[start:end]
 
 		indexEntries[i] = indexEntry
 	}
-	return indexEntries
+	return indexEntries, nil
 }
@@ -0,0 +1,308 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEntry describes a single tag to be encoded by newTestHeader. The count is derived from the value.
+type testEntry struct {
+	Tag   int32
+	Type  uint32
+	Value interface{}
+}
+
+// newTestHeader builds a header blob the same way rpm lays one out within the database: an immutable region entry
+// first, index entries sorted by offset, data aligned per type, and the region trailer following the data.
+func newTestHeader(entries ...testEntry) []byte {
+	var data bytes.Buffer
+	var infos []entryInfo
+
+	for _, e := range entries {
+		raw, count, align := encodeTestEntry(e)
+		for data.Len()%align != 0 {
+			data.WriteByte(0)
+		}
+		infos = append(infos, entryInfo{Tag: e.Tag, Type: e.Type, Offset: int32(data.Len()), Count: count})
+		data.Write(raw)
+	}
+
+	il := int32(len(infos) + 1)
+	region := entryInfo{Tag: RPMTAG_HEADERIMMUTABLE, Type: RPM_BIN_TYPE, Offset: int32(data.Len()), Count: entryInfoSize}
+	trailer := entryInfo{Tag: RPMTAG_HEADERIMMUTABLE, Type: RPM_BIN_TYPE, Offset: -il * entryInfoSize, Count: entryInfoSize}
+	mustWrite(&data, trailer)
+
+	var blob bytes.Buffer
+	mustWrite(&blob, il)
+	mustWrite(&blob, int32(data.Len()))
+	mustWrite(&blob, region)
+	for _, info := range infos {
+		mustWrite(&blob, info)
+	}
+	blob.Write(data.Bytes())
+	return blob.Bytes()
+}
+
+func encodeTestEntry(e testEntry) ([]byte, uint32, int) {
+	var buf bytes.Buffer
+	switch v := e.Value.(type) {
+	case string:
+		buf.WriteString(v + "\x00")
+		return buf.Bytes(), 1, 1
+	case []string:
+		for _, s := range v {
+			buf.WriteString(s + "\x00")
+		}
+		return buf.Bytes(), uint32(len(v)), 1
+	case []byte:
+		return v, uint32(len(v)), 1
+	case []int8:
+		mustWrite(&buf, v)
+		return buf.Bytes(), uint32(len(v)), 1
+	case []uint16:
+		mustWrite(&buf, v)
+		return buf.Bytes(), uint32(len(v)), 2
+	case int32:
+		mustWrite(&buf, v)
+		return buf.Bytes(), 1, 4
+	case []int32:
+		mustWrite(&buf, v)
+		return buf.Bytes(), uint32(len(v)), 4
+	case []int64:
+		mustWrite(&buf, v)
+		return buf.Bytes(), uint32(len(v)), 8
+	default:
+		panic(fmt.Sprintf("unsupported test entry value: %T", e.Value))
+	}
+}
+
+func mustWrite(buf *bytes.Buffer, v interface{}) {
+	if err := binary.Write(buf, binary.BigEndian, v); err != nil {
+		panic(err)
+	}
+}
+
+func TestHeaderImport(t *testing.T) {
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_VERSION, Type: RPM_STRING_TYPE, Value: "1.2.3"},
+		testEntry{Tag: RPMTAG_RELEASE, Type: RPM_STRING_TYPE, Value: "1.el8"},
+		testEntry{Tag: RPMTAG_EPOCH, Type: RPM_INT32_TYPE, Value: int32(2)},
+		testEntry{Tag: RPMTAG_SIZE, Type: RPM_INT32_TYPE, Value: int32(0x01020304)},
+		testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: []uint16{0100644, 0040755}},
+		testEntry{Tag: RPMTAG_ARCH, Type: RPM_STRING_TYPE, Value: "x86_64"},
+	)
+
+	entries, err := headerImport(blob)
+	require.NoError(t, err)
+	require.Len(t, entries, 7)
+
+	assert.Equal(t, entryInfo{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Offset: 0, Count: 1}, entries[0].Info)
+	assert.Equal(t, entryInfo{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Offset: 24, Count: 2}, entries[5].Info)
+
+	pkg, err := newPackage(entries, options{})
+	require.NoError(t, err)
+	assert.Equal(t, "foo", pkg.Name)
+	assert.Equal(t, "1.2.3", pkg.Version)
+	assert.Equal(t, "1.el8", pkg.Release)
+	assert.Equal(t, "x86_64", pkg.Arch)
+	assert.Equal(t, intRef(2), pkg.Epoch)
+	assert.Equal(t, 0x01020304, pkg.Size)
+}
+
+func TestHeaderImport_ByteOrder(t *testing.T) {
+	// header data is big-endian regardless of the host that wrote (or reads) the database, so decoding must match a
+	// reference decoder that assembles each field byte by byte without relying on the host byte order
+	db, err := Open("testdata/centos7-plain/Packages")
+	require.NoError(t, err)
+
+	be32 := func(b []byte) uint32 {
+		return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	}
+
+	checked := 0
+	for entry := range db.db.Read() {
+		require.NoError(t, entry.Err)
+		blob := entry.Value
+
+		entries, err := headerImport(blob)
+		require.NoError(t, err)
+
+		il := be32(blob[0:])
+		require.Len(t, entries, int(il)-1)
+
+		for i, actual := range entries {
+			// the first (region) entry is skipped by headerImport
+			raw := blob[headerPreambleSize+(i+1)*entryInfoSize:]
+			expected := entryInfo{
+				Tag:    int32(be32(raw[0:])),
+				Type:   be32(raw[4:]),
+				Offset: int32(be32(raw[8:])),
+				Count:  be32(raw[12:]),
+			}
+			assert.Equal(t, expected, actual.Info)
+		}
+
+		checked++
+		if checked == 10 {
+			break
+		}
+	}
+	assert.Equal(t, 10, checked)
+}
+
+func TestHeaderImport_Invalid(t *testing.T) {
+	valid := func() []byte {
+		return newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: []uint16{0100644, 0040755}},
+		)
+	}
+	// entryField returns the offset of a field within the nth index entry (the region entry is 0)
+	entryField := func(n, field int) int {
+		return headerPreambleSize + n*entryInfoSize + field*4
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(blob []byte) []byte
+	}{
+		{
+			name: "negative index length",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[0:], 0x80000000)
+				return blob
+			},
+		},
+		{
+			name: "zero index length",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[0:], 0)
+				return blob
+			},
+		},
+		{
+			name: "absurd index length",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[0:], 0x7fffffff)
+				return blob
+			},
+		},
+		{
+			name: "negative data length",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[4:], 0xfffffff0)
+				return blob
+			},
+		},
+		{
+			name: "truncated data",
+			mutate: func(blob []byte) []byte {
+				return blob[:len(blob)-4]
+			},
+		},
+		{
+			name: "negative count",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[entryField(2, 3):], 0xffffffff)
+				return blob
+			},
+		},
+		{
+			name: "count exceeds data",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[entryField(2, 3):], 0x00100000)
+				return blob
+			},
+		},
+		{
+			name: "unknown type",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[entryField(1, 1):], 0x000000ff)
+				return blob
+			},
+		},
+		{
+			name: "negative offset",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[entryField(1, 2):], 0xfffffff0)
+				return blob
+			},
+		},
+		{
+			name: "offsets out of order",
+			mutate: func(blob []byte) []byte {
+				binary.BigEndian.PutUint32(blob[entryField(1, 2):], 6)
+				return blob
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := headerImport(test.mutate(valid()))
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestHeaderImport_InvalidFixtures(t *testing.T) {
+	// regression inputs that previously caused panics or huge allocations
+	for _, name := range []string{"negative-count.hdr", "negative-index-length.hdr"} {
+		t.Run(name, func(t *testing.T) {
+			blob, err := ioutil.ReadFile("testdata/invalid-headers/" + name)
+			require.NoError(t, err)
+
+			_, err = headerImport(blob)
+			assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestParseStringArray(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		count    uint32
+		expected []string
+	}{
+		{
+			name:     "exact",
+			data:     []byte("a\x00b\x00"),
+			count:    2,
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "empty strings",
+			data:     []byte("\x00\x00"),
+			count:    2,
+			expected: []string{"", ""},
+		},
+		{
+			name:     "trailing data is ignored",
+			data:     []byte("a\x00\x00\x00\x00?\xff\xff\xff\xc0"),
+			count:    1,
+			expected: []string{"a"},
+		},
+		{
+			name:     "fewer strings than the count",
+			data:     []byte("a\x00"),
+			count:    3,
+			expected: []string{"a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseStringArray(test.data, test.count))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package rpmdb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/anchore/go-rpmdb/pkg/dbi"
+)
+
+// ErrHeaderInvalid indicates that a package header blob is malformed (e.g. out of range counts, lengths or offsets).
+var ErrHeaderInvalid = errors.New("invalid header")
+
+// ErrBlobChecksum indicates that a package header blob does not match the digest stored with it, meaning the header
+// was modified or corrupted after rpm wrote it (see WithLenientChecksums).
+var ErrBlobChecksum = dbi.ErrBlobChecksum
+
+// ErrEmptyDatabase indicates that the database holds no data at all (e.g. a zero byte Packages file), as opposed to
+// a corrupt database. Note that a database with a valid structure but no packages is not an error.
+var ErrEmptyDatabase = dbi.ErrEmptyDatabase
+
+// ErrUnsupported indicates that the database is readable but is not an rpm database (e.g. a sqlite database without
+// the rpm schema).
+var ErrUnsupported = dbi.ErrUnsupported
+
+// ErrUnsupportedSchema indicates that the database is an rpm database with an unknown (e.g. newer) schema, rather than
+// returning wrong data. This is also an ErrUnsupported error.
+var ErrUnsupportedSchema = dbi.ErrUnsupportedSchema
+
+// ErrCorruptDatabase indicates that the database structure (rather than a single package header) is malformed, e.g.
+// a Berkeley DB page that points outside of the file.
+var ErrCorruptDatabase = dbi.ErrCorruptDatabase
+
+// TagTypeError indicates that a tag is stored with a different type than rpm writes it with (e.g. RPMTAG_NAME as a
+// string array), use errors.As to inspect it. This is also an ErrHeaderInvalid error.
+type TagTypeError struct {
+	// Name is the name of the tag as used in the error message (e.g. "name").
+	Name     string
+	Tag      int32
+	Type     uint32
+	Expected uint32
+}
+
+func newTagTypeError(name string, info entryInfo, expected uint32) error {
+	return &TagTypeError{Name: name, Tag: info.Tag, Type: info.Type, Expected: expected}
+}
+
+func (e *TagTypeError) Error() string {
+	return fmt.Sprintf("invalid tag %s: tag %d has type %d, expected type %d", e.Name, e.Tag, e.Type, e.Expected)
+}
+
+func (e *TagTypeError) Unwrap() error {
+	return ErrHeaderInvalid
+}
@@ -0,0 +1,136 @@
+package rpmdb
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagTypeError(t *testing.T) {
+	tests := []struct {
+		name     string
+		entry    testEntry
+		opts     []Option
+		expected TagTypeError
+	}{
+		{
+			name:     "package tag",
+			entry:    testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+			expected: TagTypeError{Name: "name", Tag: RPMTAG_NAME, Type: RPM_STRING_ARRAY_TYPE, Expected: RPM_STRING_TYPE},
+		},
+		{
+			name:     "file tag",
+			entry:    testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT32_TYPE, Value: []int32{0100644}},
+			expected: TagTypeError{Name: "file-modes", Tag: RPMTAG_FILEMODES, Type: RPM_INT32_TYPE, Expected: RPM_INT16_TYPE},
+		},
+		{
+			name:     "lazily decoded file tag",
+			entry:    testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT32_TYPE, Value: []int32{0100644}},
+			opts:     []Option{WithFiles(false)},
+			expected: TagTypeError{Name: "file-modes", Tag: RPMTAG_FILEMODES, Type: RPM_INT32_TYPE, Expected: RPM_INT16_TYPE},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkg, err := ParseHeader(newTestHeader(test.entry), test.opts...)
+			if err == nil {
+				_, err = pkg.FileList()
+			}
+			require.Error(t, err)
+
+			var typeErr *TagTypeError
+			require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+			assert.Equal(t, test.expected, *typeErr)
+			assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+			assert.Contains(t, err.Error(), "invalid tag "+test.expected.Name)
+		})
+	}
+}
+
+func TestErrorChains(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      func(t *testing.T) error
+		expected []error
+	}{
+		{
+			name: "truncated header",
+			err: func(t *testing.T) error {
+				_, err := ParseHeader([]byte{0, 0, 0, 1, 0, 0})
+				return err
+			},
+			expected: []error{ErrHeaderInvalid, io.ErrUnexpectedEOF},
+		},
+		{
+			name: "missing database",
+			err: func(t *testing.T) error {
+				_, err := Open("testdata/missing/Packages")
+				return err
+			},
+			expected: []error{fs.ErrNotExist},
+		},
+		{
+			name: "not a database",
+			err: func(t *testing.T) error {
+				_, err := Open("testdata/rpm/epel-release-7-5.noarch.rpm")
+				return err
+			},
+			expected: []error{ErrUnsupported},
+		},
+		{
+			name: "invalid page size",
+			err: func(t *testing.T) error {
+				data, err := os.ReadFile("testdata/centos7-plain/Packages")
+				require.NoError(t, err)
+				// the page size follows the LSN, page number, magic and version fields of the metadata page
+				binary.LittleEndian.PutUint32(data[20:], 3000)
+				path := filepath.Join(t.TempDir(), "Packages")
+				require.NoError(t, os.WriteFile(path, data, 0o600))
+
+				_, err = Open(path)
+				return err
+			},
+			expected: []error{ErrCorruptDatabase},
+		},
+		{
+			name: "shared sqlite connection",
+			err: func(t *testing.T) error {
+				conn, err := sql.Open(sqlite.DriverName, sqliteFixture)
+				require.NoError(t, err)
+				defer conn.Close()
+
+				_, err = OpenMulti([]string{sqliteFixture}, WithSQLiteDB(conn))
+				return err
+			},
+			expected: []error{ErrUnsupported},
+		},
+		{
+			name: "missing database in multiple databases",
+			err: func(t *testing.T) error {
+				_, err := OpenMulti([]string{multiFixtures[0], "testdata/multi/missing/Packages"})
+				return err
+			},
+			expected: []error{fs.ErrNotExist},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.err(t)
+			require.Error(t, err)
+			for _, expected := range test.expected {
+				assert.True(t, errors.Is(err, expected), "%v is not %v", err, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package rpmdb
+
+import (
+	"strconv"
+	"strings"
+)
+
+// EVR is the epoch, version and release of a package, which is what rpm compares to order packages of the same name.
+type EVR struct {
+	Epoch   *int // nil when the package has no epoch, which compares equal to an epoch of 0
+	Version string
+	Release string
+}
+
+// NEVRA identifies an installed package by its name, epoch, version, release and architecture.
+type NEVRA struct {
+	Name string
+	EVR
+	Arch string // empty for entries without an architecture (e.g. gpg-pubkey)
+}
+
+// EVR returns the epoch, version and release of the package.
+func (p *PackageInfo) EVR() EVR {
+	return EVR{Epoch: p.Epoch, Version: p.Version, Release: p.Release}
+}
+
+// NEVRA returns the name, epoch, version, release and architecture of the package.
+func (p *PackageInfo) NEVRA() NEVRA {
+	return NEVRA{Name: p.Name, EVR: p.EVR(), Arch: p.Arch}
+}
+
+// String formats the EVR the way rpm does ("[epoch:]version-release"), the epoch is only included when present.
+func (e EVR) String() string {
+	var b strings.Builder
+	if e.Epoch != nil {
+		b.WriteString(strconv.Itoa(*e.Epoch))
+		b.WriteByte(':')
+	}
+	b.WriteString(e.Version)
+	if e.Release != "" {
+		b.WriteByte('-')
+		b.WriteString(e.Release)
+	}
+	return b.String()
+}
+
+// Compare orders two EVRs the way rpm does: by epoch (a missing epoch being 0), then version and release, each
+// compared with rpmvercmp. It returns -1, 0 or 1 when e is older than, equal to or newer than other.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmvercmp.c (rpmVersionCompare)
+func (e EVR) Compare(other EVR) int {
+	epoch, otherEpoch := 0, 0
+	if e.Epoch != nil {
+		epoch = *e.Epoch
+	}
+	if other.Epoch != nil {
+		otherEpoch = *other.Epoch
+	}
+	switch {
+	case epoch < otherEpoch:
+		return -1
+	case epoch > otherEpoch:
+		return 1
+	}
+
+	if rc := rpmvercmp(e.Version, other.Version); rc != 0 {
+		return rc
+	}
+	return rpmvercmp(e.Release, other.Release)
+}
+
+// String formats the NEVRA the way rpm does ("name-[epoch:]version-release.arch").
+func (n NEVRA) String() string {
+	if n.Arch == "" {
+		return n.Name + "-" + n.EVR.String()
+	}
+	return n.Name + "-" + n.EVR.String() + "." + n.Arch
+}
+
+// rpmvercmp compares two versions (or releases) segment by segment, where numeric segments are newer than alphabetic
+// ones, "~" sorts before anything (e.g. "1.0~rc1" < "1.0") and "^" after the base version (e.g. "1.0" < "1.0^git1").
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.15.0-release/rpmio/rpmvercmp.c
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	isAlpha := func(c byte) bool { return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+	isDigit := func(c byte) bool { return c >= '0' && c <= '9' }
+	isSeparator := func(c byte) bool { return !isAlpha(c) && !isDigit(c) && c != '~' && c != '^' }
+
+	one, two := a, b
+	for len(one) > 0 || len(two) > 0 {
+		for len(one) > 0 && isSeparator(one[0]) {
+			one = one[1:]
+		}
+		for len(two) > 0 && isSeparator(two[0]) {
+			two = two[1:]
+		}
+
+		// the tilde separator sorts before everything else
+		if strings.HasPrefix(one, "~") || strings.HasPrefix(two, "~") {
+			if !strings.HasPrefix(one, "~") {
+				return 1
+			}
+			if !strings.HasPrefix(two, "~") {
+				return -1
+			}
+			one, two = one[1:], two[1:]
+			continue
+		}
+
+		// the caret separator is like the tilde, except that the base version (without the caret) is older
+		if strings.HasPrefix(one, "^") || strings.HasPrefix(two, "^") {
+			if len(one) == 0 {
+				return -1
+			}
+			if len(two) == 0 {
+				return 1
+			}
+			if !strings.HasPrefix(one, "^") {
+				return 1
+			}
+			if !strings.HasPrefix(two, "^") {
+				return -1
+			}
+			one, two = one[1:], two[1:]
+			continue
+		}
+
+		if len(one) == 0 || len(two) == 0 {
+			break
+		}
+
+		// grab the first completely alphabetic or completely numeric segment of both
+		isNum := isDigit(one[0])
+		matches := isAlpha
+		if isNum {
+			matches = isDigit
+		}
+		i, j := 0, 0
+		for i < len(one) && matches(one[i]) {
+			i++
+		}
+		for j < len(two) && matches(two[j]) {
+			j++
+		}
+		segment1, segment2 := one[:i], two[:j]
+		one, two = one[i:], two[j:]
+
+		// numeric segments are always newer than alphabetic ones
+		if len(segment2) == 0 {
+			if isNum {
+				return 1
+			}
+			return -1
+		}
+
+		if isNum {
+			segment1 = strings.TrimLeft(segment1, "0")
+			segment2 = strings.TrimLeft(segment2, "0")
+			if len(segment1) != len(segment2) {
+				if len(segment1) > len(segment2) {
+					return 1
+				}
+				return -1
+			}
+		}
+		if rc := strings.Compare(segment1, segment2); rc != 0 {
+			return rc
+		}
+	}
+
+	// all segments compared identically but the separators were different
+	if len(one) == 0 && len(two) == 0 {
+		return 0
+	}
+	// whichever version still has characters left over wins
+	if len(one) == 0 {
+		return -1
+	}
+	return 1
+}
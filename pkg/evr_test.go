@@ -0,0 +1,97 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRpmvercmp(t *testing.T) {
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.15.0-release/tests/rpmvercmp.at
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1.0", b: "1.0", expected: 0},
+		{a: "1.0", b: "2.0", expected: -1},
+		{a: "2.0.1", b: "2.0", expected: 1},
+		{a: "2.0.1a", b: "2.0.1", expected: 1},
+		{a: "5.5p1", b: "5.5p2", expected: -1},
+		{a: "5.5p10", b: "5.5p1", expected: 1},
+		{a: "10xyz", b: "10.1xyz", expected: -1},
+		{a: "xyz10", b: "xyz10.1", expected: -1},
+		{a: "xyz.4", b: "8", expected: -1},
+		{a: "6.0.rc1", b: "6.0", expected: 1},
+		{a: "1.0010", b: "1.9", expected: 1},
+		{a: "1.05", b: "1.5", expected: 0},
+		{a: "fc4", b: "fc.4", expected: 0},
+		{a: "FC5", b: "fc4", expected: -1},
+		{a: "2a", b: "2.0", expected: -1},
+		{a: "1.0a", b: "1.0aa", expected: -1},
+		{a: "1.0~rc1", b: "1.0", expected: -1},
+		{a: "1.0~rc1~git123", b: "1.0~rc1", expected: -1},
+		{a: "1.0^", b: "1.0", expected: 1},
+		{a: "1.0^git1", b: "1.01", expected: -1},
+		{a: "1.0^20160101", b: "1.0.1", expected: -1},
+		{a: "1.0~rc1^git1", b: "1.0~rc1", expected: 1},
+		{a: "1.0^git1~pre", b: "1.0^git1", expected: -1},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, rpmvercmp(test.a, test.b), "%s vs %s", test.a, test.b)
+		assert.Equal(t, -test.expected, rpmvercmp(test.b, test.a), "%s vs %s", test.b, test.a)
+	}
+}
+
+func TestEVR_Compare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     EVR
+		expected int
+	}{
+		{
+			name:     "missing epoch equals zero",
+			a:        EVR{Version: "1.0", Release: "1"},
+			b:        EVR{Epoch: intRef(0), Version: "1.0", Release: "1"},
+			expected: 0,
+		},
+		{
+			name:     "epoch wins over version",
+			a:        EVR{Epoch: intRef(1), Version: "1.0", Release: "1"},
+			b:        EVR{Version: "2.0", Release: "1"},
+			expected: 1,
+		},
+		{
+			name:     "version wins over release",
+			a:        EVR{Version: "1.0", Release: "10.el7"},
+			b:        EVR{Version: "1.1", Release: "1.el7"},
+			expected: -1,
+		},
+		{
+			name:     "release",
+			a:        EVR{Version: "5.9", Release: "14.20130511.el7_4"},
+			b:        EVR{Version: "5.9", Release: "13.20130511.el7"},
+			expected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.a.Compare(test.b))
+			assert.Equal(t, -test.expected, test.b.Compare(test.a))
+		})
+	}
+}
+
+func TestPackageInfo_NEVRA(t *testing.T) {
+	nevras := map[string]string{}
+	evrs := map[string]EVR{}
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-many/Packages") {
+		nevras[pkg.Name] = pkg.NEVRA().String()
+		evrs[pkg.Name] = pkg.EVR()
+	}
+
+	assert.Equal(t, "bash-4.2.46-30.el7.x86_64", nevras["bash"])
+	assert.Equal(t, EVR{Version: "4.2.46", Release: "30.el7"}, evrs["bash"])
+	assert.Equal(t, "gpg-pubkey-f4a80eb5-53a7ff4b", nevras["gpg-pubkey"])
+}
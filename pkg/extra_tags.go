@@ -0,0 +1,75 @@
+package rpmdb
+
+// RPMTAG_EXTERNAL_TAG is the start of the tag range rpm leaves to vendors (e.g. SUSE or appliance builders), such
+// tags are never mapped to a field and are only available through WithExtraTags (or ParseHeaderEntries).
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmtag.h
+const RPMTAG_EXTERNAL_TAG = 1000000
+
+// TagValue is a header entry that is not mapped to a field of PackageInfo (see WithExtraTags).
+type TagValue struct {
+	// Type is the type the entry is stored with (e.g. RPM_STRING_TYPE).
+	Type uint32
+	// Value is the decoded data of the entry: a string for RPM_STRING_TYPE, a []string for RPM_STRING_ARRAY_TYPE and
+	// RPM_I18NSTRING_TYPE (every translation), a []byte for RPM_BIN_TYPE, an []int8 for RPM_CHAR_TYPE and
+	// RPM_INT8_TYPE, and a []uint16, []int32 or []int64 for the other integer types. It is nil for RPM_NULL_TYPE.
+	Value interface{}
+}
+
+// structuredTags are the tags that are parsed into a field of PackageInfo outside of the tag switch of newPackage
+// (dependencies, scriptlets, triggers, selinux policy modules and the changelog). The file tags are listed by
+// isFileTag.
+var structuredTags = func() map[Tag]bool {
+	tags := map[Tag]bool{
+		RPMTAG_HEADERI18NTABLE:    true,
+		RPMTAG_POLICYNAMES:        true,
+		RPMTAG_POLICYTYPES:        true,
+		RPMTAG_POLICYTYPESINDEXES: true,
+		RPMTAG_POLICYFLAGS:        true,
+		RPMTAG_CHANGELOGTIME:      true,
+		RPMTAG_CHANGELOGNAME:      true,
+		RPMTAG_CHANGELOGTEXT:      true,
+	}
+	for _, t := range []dependencyTags{requireTags, provideTags, conflictTags, obsoleteTags, recommendTags, suggestTags,
+		supplementTags, enhanceTags} {
+		tags[t.names], tags[t.versions], tags[t.flags] = true, true, true
+	}
+	for _, t := range []scriptletTags{preInTags, postInTags, preUnTags, postUnTags, preTransTags, postTransTags} {
+		tags[t.script], tags[t.program] = true, true
+	}
+	for _, t := range []triggerTags{packageTriggerTags, fileTriggerTags, transFileTriggerTags} {
+		tags[t.scripts], tags[t.programs], tags[t.names], tags[t.versions], tags[t.flags], tags[t.index] = true, true, true, true, true, true
+		if t.priorities != 0 {
+			tags[t.priorities] = true
+		}
+	}
+	return tags
+}()
+
+// parseTagValue decodes the data of an entry according to its type.
+func parseTagValue(entry indexEntry) (TagValue, error) {
+	value := TagValue{Type: entry.Info.Type}
+	count := int(entry.Info.Count)
+	var err error
+
+	switch entry.Info.Type {
+	case RPM_NULL_TYPE:
+	case RPM_CHAR_TYPE, RPM_INT8_TYPE:
+		value.Value, err = parseInt8Array(entry.Data, count*sizeOfInt8)
+	case RPM_INT16_TYPE:
+		value.Value, err = parseUInt16Array(entry.Data, count*sizeOfUInt16)
+	case RPM_INT32_TYPE:
+		value.Value, err = parseInt32Array(entry.Data, count*sizeOfInt32)
+	case RPM_INT64_TYPE:
+		value.Value, err = parseInt64Array(entry.Data, count*sizeOfInt64)
+	case RPM_STRING_TYPE:
+		value.Value = parseString(entry.Data)
+	case RPM_BIN_TYPE:
+		value.Value, err = parseBinary(entry.Data, entry.Info.Count)
+	case RPM_STRING_ARRAY_TYPE, RPM_I18NSTRING_TYPE:
+		value.Value = parseStringArray(entry.Data, entry.Info.Count)
+	}
+	if err != nil {
+		return TagValue{}, err
+	}
+	return value, nil
+}
@@ -0,0 +1,67 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHeader_ExtraTags(t *testing.T) {
+	header := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_REQUIRENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar"}},
+		testEntry{Tag: RPMTAG_REQUIREFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+		// RPMTAG_ARCHIVESIZE
+		testEntry{Tag: 1046, Type: RPM_INT32_TYPE, Value: []int32{1234}},
+		testEntry{Tag: 20003, Type: RPM_INT32_TYPE, Value: []int32{-1, 7}},
+		// RPMTAG_BUGURL
+		testEntry{Tag: 5012, Type: RPM_STRING_TYPE, Value: "https://bugs.example.com"},
+		testEntry{Tag: 20004, Type: RPM_INT64_TYPE, Value: []int64{1 << 33, -1}},
+		testEntry{Tag: RPMTAG_PUBKEYS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"a2V5"}},
+		testEntry{Tag: 20000, Type: RPM_INT16_TYPE, Value: []uint16{1, 2}},
+		testEntry{Tag: 20001, Type: RPM_BIN_TYPE, Value: []byte{0xde, 0xad}},
+		testEntry{Tag: 20002, Type: RPM_I18NSTRING_TYPE, Value: []string{"C text", "de text"}},
+		// vendor specific tags
+		testEntry{Tag: RPMTAG_EXTERNAL_TAG + 1, Type: RPM_STRING_TYPE, Value: "appliance-1.2"},
+		testEntry{Tag: RPMTAG_EXTERNAL_TAG + 2, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"a", "b"}},
+	)
+
+	pkg, err := ParseHeader(header)
+	require.NoError(t, err)
+	assert.Nil(t, pkg.ExtraTags)
+
+	pkg, err = ParseHeader(header, WithExtraTags())
+	require.NoError(t, err)
+	assert.Equal(t, map[Tag]TagValue{
+		5012:                    {Type: RPM_STRING_TYPE, Value: "https://bugs.example.com"},
+		RPMTAG_PUBKEYS:          {Type: RPM_STRING_ARRAY_TYPE, Value: []string{"a2V5"}},
+		20000:                   {Type: RPM_INT16_TYPE, Value: []uint16{1, 2}},
+		20001:                   {Type: RPM_BIN_TYPE, Value: []byte{0xde, 0xad}},
+		20002:                   {Type: RPM_I18NSTRING_TYPE, Value: []string{"C text", "de text"}},
+		20003:                   {Type: RPM_INT32_TYPE, Value: []int32{-1, 7}},
+		20004:                   {Type: RPM_INT64_TYPE, Value: []int64{1 << 33, -1}},
+		RPMTAG_EXTERNAL_TAG + 1: {Type: RPM_STRING_TYPE, Value: "appliance-1.2"},
+		RPMTAG_EXTERNAL_TAG + 2: {Type: RPM_STRING_ARRAY_TYPE, Value: []string{"a", "b"}},
+	}, pkg.ExtraTags)
+	assert.Equal(t, "foo", pkg.Name)
+	assert.Equal(t, int64(1234), pkg.ArchiveSize)
+	assert.Len(t, pkg.Requires, 1)
+	assert.Len(t, pkg.Files, 1)
+}
+
+func TestPackageExtraTags(t *testing.T) {
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-plain/Packages", WithExtraTags()) {
+		if pkg.Name != "bash" {
+			continue
+		}
+		// every tag of the package is mapped to a field
+		assert.Nil(t, pkg.ExtraTags)
+		assert.Equal(t, int64(3683588), pkg.ArchiveSize)
+		return
+	}
+	t.Fatal("bash not found")
+}
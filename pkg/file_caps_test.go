@@ -0,0 +1,35 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileInfo_Caps(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			var iputils *PackageInfo
+			for _, pkg := range listFixturePackages(t, fixture) {
+				if pkg.Name == "iputils" {
+					iputils = pkg
+				}
+			}
+			require.NotNil(t, iputils)
+
+			caps := map[string]string{}
+			for _, f := range iputils.Files {
+				if f.Caps != "" {
+					caps[f.Path] = f.Caps
+				}
+			}
+			// ping is not setuid, it is granted the capabilities it needs instead
+			assert.Equal(t, map[string]string{
+				"/usr/bin/ping":       "= cap_net_admin,cap_net_raw+p",
+				"/usr/sbin/arping":    "= cap_net_raw+p",
+				"/usr/sbin/clockdiff": "= cap_net_raw+p",
+			}, caps)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileInfo_Class(t *testing.T) {
+	files := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 1}},
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "foo.sh", "foo.conf"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", "/etc/"}},
+	}
+
+	t.Run("resolved", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_FILECLASS, Type: RPM_INT32_TYPE, Value: []int32{1, 2, 0}},
+			testEntry{Tag: RPMTAG_CLASSDICT, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"", "ELF 64-bit LSB executable", "POSIX shell script, ASCII text executable"}},
+		)...))
+		require.NoError(t, err)
+		var classes []string
+		for _, f := range pkg.Files {
+			classes = append(classes, f.Class)
+		}
+		assert.Equal(t, []string{"ELF 64-bit LSB executable", "POSIX shell script, ASCII text executable", ""}, classes)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_FILECLASS, Type: RPM_INT32_TYPE, Value: []int32{0, 3, 0}},
+			testEntry{Tag: RPMTAG_CLASSDICT, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"ASCII text"}},
+		)...))
+		require.NoError(t, err)
+		assert.Equal(t, "ASCII text", pkg.Files[0].Class)
+		assert.Equal(t, "", pkg.Files[1].Class)
+		assert.Equal(t, []string{`invalid class index 3 for file "/usr/bin/foo.sh"`}, pkg.Warnings)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_CLASSDICT, Type: RPM_STRING_TYPE, Value: "ASCII text"},
+		)...))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "class-dict", typeErr.Name)
+	})
+}
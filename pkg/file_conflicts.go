@@ -0,0 +1,93 @@
+package rpmdb
+
+// Conflict describes a single path that is owned by two packages in a way that rpm would refuse to install together.
+type Conflict struct {
+	Path string
+	A    FileInfo
+	B    FileInfo
+}
+
+// FileConflicts compares the file lists of two packages and returns every path that rpm would consider a file
+// conflict. The rules applied mirror rpm's transaction checks:
+//   - %ghost entries on either side are ignored
+//   - directories never conflict with other directories
+//   - files with differing (non-zero) colors are resolved by the multilib color preference and do not conflict
+//   - otherwise the files are shared (and do not conflict) when they are identical: the same mode and, depending on
+//     the file type, the same owner, symlink target, size and digest, or device number
+//
+// Packages read with WithFiles(false) have their file lists decoded first (packages whose files cannot be decoded
+// report no conflicts).
+//
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/transaction.c
+func FileConflicts(a, b *PackageInfo) []Conflict {
+	if a == nil || b == nil {
+		return nil
+	}
+	aFiles, err := a.FileList()
+	if err != nil {
+		return nil
+	}
+	bFiles, err := b.FileList()
+	if err != nil {
+		return nil
+	}
+
+	others := make(map[string]FileInfo, len(bFiles))
+	for _, f := range bFiles {
+		others[b.FilePath(f)] = f
+	}
+
+	var conflicts []Conflict
+	for _, f := range aFiles {
+		path := a.FilePath(f)
+		other, ok := others[path]
+		if !ok {
+			continue
+		}
+		if filesConflict(f, other) {
+			conflicts = append(conflicts, Conflict{
+				Path: path,
+				A:    f,
+				B:    other,
+			})
+		}
+	}
+	return conflicts
+}
+
+func filesConflict(a, b FileInfo) bool {
+	if a.Flags.IsGhost() || b.Flags.IsGhost() {
+		return false
+	}
+
+	if fileType(a.Mode) == fileTypeDir && fileType(b.Mode) == fileTypeDir {
+		return false
+	}
+
+	// on multilib systems both files are installed and rpm keeps the one matching the preferred color
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/transaction.c
+	if a.Color != 0 && b.Color != 0 && a.Color != b.Color {
+		return false
+	}
+
+	return !filesIdentical(a, b)
+}
+
+// filesIdentical compares two files the way rpm decides whether a file can be shared by two packages.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmfi.c (rpmfiCompare)
+func filesIdentical(a, b FileInfo) bool {
+	if a.Mode != b.Mode {
+		return false
+	}
+
+	switch fileType(a.Mode) {
+	case fileTypeSymlink:
+		return a.Username == b.Username && a.Groupname == b.Groupname && a.LinkTo == b.LinkTo
+	case fileTypeRegular:
+		return a.Username == b.Username && a.Groupname == b.Groupname && a.LongSize == b.LongSize && a.Digest == b.Digest
+	case fileTypeBlock, fileTypeChar:
+		return a.RDev == b.RDev
+	default:
+		return true
+	}
+}
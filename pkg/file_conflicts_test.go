@@ -0,0 +1,246 @@
+package rpmdb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileConflicts(t *testing.T) {
+	const (
+		regular = 0100644
+		dir     = 0040755
+		symlink = 0120777
+		char    = 0020666
+		block   = 0060660
+	)
+
+	tests := []struct {
+		name     string
+		a        FileInfo
+		b        FileInfo
+		conflict bool
+	}{
+		{
+			name:     "identical files are shared",
+			a:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa"},
+			b:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa"},
+			conflict: false,
+		},
+		{
+			name:     "differing digests conflict",
+			a:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa"},
+			b:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "bbbb"},
+			conflict: true,
+		},
+		{
+			name:     "differing modes conflict",
+			a:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa"},
+			b:        FileInfo{Path: "/etc/shared", Mode: 0100755, Digest: "aaaa"},
+			conflict: true,
+		},
+		{
+			name:     "directories never conflict",
+			a:        FileInfo{Path: "/usr/share/doc", Mode: dir},
+			b:        FileInfo{Path: "/usr/share/doc", Mode: 0040700},
+			conflict: false,
+		},
+		{
+			name:     "directory and file conflict",
+			a:        FileInfo{Path: "/usr/share/doc", Mode: dir},
+			b:        FileInfo{Path: "/usr/share/doc", Mode: regular, Digest: "aaaa"},
+			conflict: true,
+		},
+		{
+			name:     "file and symlink conflict",
+			a:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: symlink},
+			b:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: regular, Digest: "aaaa"},
+			conflict: true,
+		},
+		{
+			name:     "identical symlinks are shared",
+			a:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: symlink, LinkTo: "libfoo.so.1"},
+			b:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: symlink, LinkTo: "libfoo.so.1"},
+			conflict: false,
+		},
+		{
+			name:     "symlinks with differing targets conflict",
+			a:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: symlink, LinkTo: "libfoo.so.1"},
+			b:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: symlink, LinkTo: "libfoo.so.2"},
+			conflict: true,
+		},
+		{
+			name:     "symlinks with differing owners conflict",
+			a:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: symlink, LinkTo: "libfoo.so.1", Username: "root"},
+			b:        FileInfo{Path: "/usr/lib/libfoo.so", Mode: symlink, LinkTo: "libfoo.so.1", Username: "foo"},
+			conflict: true,
+		},
+		{
+			name:     "differing sizes conflict",
+			a:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa", LongSize: 4},
+			b:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa", LongSize: 5},
+			conflict: true,
+		},
+		{
+			name:     "differing users conflict",
+			a:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa", Username: "root", Groupname: "root"},
+			b:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa", Username: "foo", Groupname: "root"},
+			conflict: true,
+		},
+		{
+			name:     "differing groups conflict",
+			a:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa", Username: "root", Groupname: "root"},
+			b:        FileInfo{Path: "/etc/shared", Mode: regular, Digest: "aaaa", Username: "root", Groupname: "foo"},
+			conflict: true,
+		},
+		{
+			name:     "identical devices are shared",
+			a:        FileInfo{Path: "/dev/null", Mode: char, RDev: 0x0103},
+			b:        FileInfo{Path: "/dev/null", Mode: char, RDev: 0x0103},
+			conflict: false,
+		},
+		{
+			name:     "character devices with differing device numbers conflict",
+			a:        FileInfo{Path: "/dev/null", Mode: char, RDev: 0x0103},
+			b:        FileInfo{Path: "/dev/null", Mode: char, RDev: 0x0105},
+			conflict: true,
+		},
+		{
+			name:     "block devices with differing device numbers conflict",
+			a:        FileInfo{Path: "/dev/loop0", Mode: block, RDev: 0x0700},
+			b:        FileInfo{Path: "/dev/loop0", Mode: block, RDev: 0x0701},
+			conflict: true,
+		},
+		{
+			name:     "ghost on one side is ignored",
+			a:        FileInfo{Path: "/var/log/foo", Mode: regular, Flags: FileFlags(RPMFILE_GHOST)},
+			b:        FileInfo{Path: "/var/log/foo", Mode: regular, Digest: "bbbb"},
+			conflict: false,
+		},
+		{
+			name:     "ghost on both sides is ignored",
+			a:        FileInfo{Path: "/var/log/foo", Mode: regular, Digest: "aaaa", Flags: FileFlags(RPMFILE_GHOST)},
+			b:        FileInfo{Path: "/var/log/foo", Mode: regular, Digest: "bbbb", Flags: FileFlags(RPMFILE_GHOST)},
+			conflict: false,
+		},
+		{
+			name:     "differing colors are resolved by multilib preference",
+			a:        FileInfo{Path: "/usr/bin/foo", Mode: 0100755, Digest: "aaaa", Color: 2},
+			b:        FileInfo{Path: "/usr/bin/foo", Mode: 0100755, Digest: "bbbb", Color: 1},
+			conflict: false,
+		},
+		{
+			name:     "same color with differing digests conflict",
+			a:        FileInfo{Path: "/usr/bin/foo", Mode: 0100755, Digest: "aaaa", Color: 2},
+			b:        FileInfo{Path: "/usr/bin/foo", Mode: 0100755, Digest: "bbbb", Color: 2},
+			conflict: true,
+		},
+		{
+			name:     "uncolored side does not resolve by color",
+			a:        FileInfo{Path: "/usr/bin/foo", Mode: 0100755, Digest: "aaaa", Color: 2},
+			b:        FileInfo{Path: "/usr/bin/foo", Mode: 0100755, Digest: "bbbb"},
+			conflict: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := &PackageInfo{Name: "a", Files: []FileInfo{test.a, {Path: "/only/in/a", Mode: regular}}}
+			b := &PackageInfo{Name: "b", Files: []FileInfo{{Path: "/only/in/b", Mode: regular}, test.b}}
+
+			var expected []Conflict
+			if test.conflict {
+				expected = []Conflict{{Path: test.a.Path, A: test.a, B: test.b}}
+			}
+			assert.Equal(t, expected, FileConflicts(a, b))
+		})
+	}
+}
+
+func TestFileConflicts_InstalledPackages(t *testing.T) {
+	// every package in an installed database was accepted by rpm together, so there must not be any conflicts
+	db, err := Open("testdata/centos7-plain/Packages")
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	pkgList, err := db.ListPackages()
+	if err != nil {
+		t.Fatalf("ListPackages() error: %v", err)
+	}
+
+	for i, a := range pkgList {
+		for _, b := range pkgList[i+1:] {
+			assert.Empty(t, FileConflicts(a, b), "conflicts between %q and %q", a.Name, b.Name)
+		}
+	}
+}
+
+func TestFileConflicts_LazyFiles(t *testing.T) {
+	header := func(name, digest string) []byte {
+		return newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: name},
+			testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: []uint16{0100644}},
+			testEntry{Tag: RPMTAG_FILEDIGESTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{digest}},
+			testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"shared"}},
+			testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/etc/"}},
+			testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+		)
+	}
+
+	// the file lists are decoded on demand, so the conflict is found without reading the files up front
+	a, err := ParseHeader(header("foo", "aaaa"), WithFiles(false))
+	require.NoError(t, err)
+	b, err := ParseHeader(header("bar", "bbbb"), WithFiles(false))
+	require.NoError(t, err)
+	require.Nil(t, a.Files)
+
+	conflicts := FileConflicts(a, b)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "/etc/shared", conflicts[0].Path)
+	assert.Equal(t, "aaaa", conflicts[0].A.Digest)
+	assert.Equal(t, "bbbb", conflicts[0].B.Digest)
+}
+
+func TestFileConflicts_Multilib(t *testing.T) {
+	// none of the fixtures hold both the i686 and x86_64 builds of a package, so the i686 build is derived from the
+	// real x86_64 glibc header: its ELF files (colored 2, ELF64) are recolored as ELF32 with different contents, while
+	// the remaining files (locales, docs, configs) are identical and shared
+	var x86_64 *PackageInfo
+	for _, p := range listFixturePackages(t, "testdata/centos7-plain/Packages") {
+		if p.Name == "glibc" {
+			x86_64 = p
+		}
+	}
+	require.NotNil(t, x86_64)
+
+	i686 := *x86_64
+	i686.Arch = "i686"
+	i686.Files = make([]FileInfo, len(x86_64.Files))
+	elf := 0
+	for i, f := range x86_64.Files {
+		if f.Color == 2 {
+			f.Color = 1
+			f.Digest = strings.Repeat("0", len(f.Digest))
+			if fileType(f.Mode) == fileTypeRegular {
+				elf++
+			}
+		}
+		i686.Files[i] = f
+	}
+	require.Greater(t, elf, 100)
+
+	// rpm installs the ELF files of the preferred color, so the packages are installed together without conflicts
+	assert.Empty(t, FileConflicts(x86_64, &i686))
+	assert.Empty(t, FileConflicts(&i686, x86_64))
+
+	// without the colors the differing ELF files would conflict
+	uncolored := i686
+	uncolored.Files = make([]FileInfo, len(i686.Files))
+	for i, f := range i686.Files {
+		f.Color = 2
+		uncolored.Files[i] = f
+	}
+	assert.Len(t, FileConflicts(x86_64, &uncolored), elf)
+}
@@ -0,0 +1,87 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileInfo_SELinuxContext(t *testing.T) {
+	files := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 1}},
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar", "foo.conf"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", "/etc/"}},
+	}
+
+	tests := []struct {
+		name             string
+		entries          []testEntry
+		expectedContexts []string
+		expectedPolicies []string
+	}{
+		{
+			name:             "absent",
+			expectedContexts: []string{"", "", ""},
+		},
+		{
+			name: "populated",
+			entries: []testEntry{
+				{Tag: RPMTAG_FILECONTEXTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{
+					"system_u:object_r:bin_t",
+					"system_u:object_r:bin_t",
+					"system_u:object_r:etc_t",
+				}},
+				{Tag: RPMTAG_POLICIES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo.pp"}},
+			},
+			expectedContexts: []string{"system_u:object_r:bin_t", "system_u:object_r:bin_t", "system_u:object_r:etc_t"},
+			expectedPolicies: []string{"foo.pp"},
+		},
+		{
+			name: "fewer contexts than files",
+			entries: []testEntry{
+				{Tag: RPMTAG_FILECONTEXTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"system_u:object_r:bin_t"}},
+			},
+			expectedContexts: []string{"system_u:object_r:bin_t", "", ""},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexEntries, err := headerImport(newTestHeader(append(append([]testEntry{}, files...), test.entries...)...))
+			require.NoError(t, err)
+
+			pkg, err := newPackage(indexEntries, options{})
+			require.NoError(t, err)
+
+			var contexts []string
+			for _, f := range pkg.Files {
+				contexts = append(contexts, f.SELinuxContext)
+			}
+			assert.Equal(t, test.expectedContexts, contexts)
+			assert.Equal(t, test.expectedPolicies, pkg.SELinuxPolicies)
+		})
+	}
+
+	t.Run("wrong type", func(t *testing.T) {
+		indexEntries, err := headerImport(newTestHeader(append(append([]testEntry{}, files...),
+			testEntry{Tag: RPMTAG_FILECONTEXTS, Type: RPM_INT32_TYPE, Value: []int32{1, 2, 3}},
+		)...))
+		require.NoError(t, err)
+
+		_, err = newPackage(indexEntries, options{})
+		assert.Error(t, err)
+	})
+}
+
+func TestFileInfo_SELinuxContext_RpmFile(t *testing.T) {
+	// rpm 4.4 (EL5) records a (here empty) context for every file, as the last entry of the immutable region
+	pkg, err := ParseHeader(rpmHeaderSection(t, "testdata/rpm/centos-release-5-0.0.el5.centos.2.x86_64.rpm"))
+	require.NoError(t, err)
+	require.Len(t, pkg.Files, 19)
+
+	for _, f := range pkg.Files {
+		assert.Empty(t, f.SELinuxContext, f.Path)
+	}
+}
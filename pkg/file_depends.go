@@ -0,0 +1,36 @@
+package rpmdb
+
+// FileDependency references the entry of a dependency list of the package that rpmbuild generated for a file, e.g.
+// the soname provided by a shared library or the interpreter required by a script.
+type FileDependency struct {
+	// Type is the dependency list the entry belongs to: 'P' for PackageInfo.Provides and 'R' for
+	// PackageInfo.Requires.
+	Type byte
+	// Index is the position of the entry in the dependency list.
+	Index int
+}
+
+// newFileDependency decodes an entry of the dependency dictionary, which packs the type in the top byte and the index
+// in the lower 24 bits.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmfi.c (rpmfiFDepends)
+func newFileDependency(dep uint32) FileDependency {
+	return FileDependency{Type: byte(dep >> 24), Index: int(dep & 0x00ffffff)}
+}
+
+// FileDependencies resolves the dependencies generated for a file of the package, as "rpm -q --fileprovide" and
+// "rpm -q --filerequire" list them. References to entries that do not exist are skipped.
+func (p *PackageInfo) FileDependencies(f FileInfo) (requires, provides []Dependency) {
+	for _, dep := range f.Depends {
+		switch dep.Type {
+		case 'R':
+			if dep.Index < len(p.Requires) {
+				requires = append(requires, p.Requires[dep.Index])
+			}
+		case 'P':
+			if dep.Index < len(p.Provides) {
+				provides = append(provides, p.Provides[dep.Index])
+			}
+		}
+	}
+	return requires, provides
+}
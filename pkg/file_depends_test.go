@@ -0,0 +1,128 @@
+package rpmdb
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_FileDependencies(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			var libffi *PackageInfo
+			for _, pkg := range listFixturePackages(t, fixture) {
+				if pkg.Name == "libffi" {
+					libffi = pkg
+				}
+			}
+			require.NotNil(t, libffi)
+
+			provides := map[string][]string{}
+			requires := map[string][]string{}
+			for _, f := range libffi.Files {
+				fileRequires, fileProvides := libffi.FileDependencies(f)
+				for _, dep := range fileRequires {
+					requires[f.Path] = append(requires[f.Path], dep.String())
+				}
+				for _, dep := range fileProvides {
+					provides[f.Path] = append(provides[f.Path], dep.String())
+				}
+			}
+
+			// the soname is provided by the library itself, not by the symlink pointing to it
+			assert.Equal(t, map[string][]string{"/usr/lib64/libffi.so.6.0.1": {"libffi.so.6()(64bit)"}}, provides)
+			assert.Equal(t, []string{
+				"libc.so.6(GLIBC_2.14)(64bit)",
+				"libc.so.6(GLIBC_2.7)(64bit)",
+				"libc.so.6(GLIBC_2.4)(64bit)",
+				"libc.so.6(GLIBC_2.2.5)(64bit)",
+				"libc.so.6()(64bit)",
+				"rtld(GNU_HASH)",
+			}, requires["/usr/lib64/libffi.so.6.0.1"])
+		})
+	}
+}
+
+func TestPackageInfo_FileDependencies_Synthetic(t *testing.T) {
+	entries := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_REQUIRENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/bin/sh", "libc.so.6()(64bit)"}},
+		{Tag: RPMTAG_PROVIDENAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "libfoo.so.1()(64bit)"}},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 1}},
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"libfoo.so.1", "foo.sh", "README"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/lib64/", "/usr/share/doc/foo/"}},
+	}
+
+	t.Run("resolved", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(entries,
+			testEntry{Tag: RPMTAG_FILEDEPENDSX, Type: RPM_INT32_TYPE, Value: []int32{0, 2, 0}},
+			testEntry{Tag: RPMTAG_FILEDEPENDSN, Type: RPM_INT32_TYPE, Value: []int32{2, 2, 0}},
+			// the second file references a require that does not exist
+			testEntry{Tag: RPMTAG_DEPENDSDICT, Type: RPM_INT32_TYPE, Value: []int32{'P'<<24 | 1, 'R'<<24 | 1, 'R'<<24 | 0, 'R'<<24 | 5}},
+		)...))
+		require.NoError(t, err)
+		assert.Equal(t, []FileDependency{{Type: 'P', Index: 1}, {Type: 'R', Index: 1}}, pkg.Files[0].Depends)
+		assert.Nil(t, pkg.Files[2].Depends)
+
+		requires, provides := pkg.FileDependencies(pkg.Files[0])
+		assert.Equal(t, []Dependency{{Name: "libc.so.6()(64bit)"}}, requires)
+		assert.Equal(t, []Dependency{{Name: "libfoo.so.1()(64bit)"}}, provides)
+
+		requires, provides = pkg.FileDependencies(pkg.Files[1])
+		assert.Equal(t, []Dependency{{Name: "/bin/sh"}}, requires)
+		assert.Nil(t, provides)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(entries,
+			testEntry{Tag: RPMTAG_FILEDEPENDSX, Type: RPM_INT32_TYPE, Value: []int32{0, 1, 0}},
+			testEntry{Tag: RPMTAG_FILEDEPENDSN, Type: RPM_INT32_TYPE, Value: []int32{1, 2, 0}},
+			testEntry{Tag: RPMTAG_DEPENDSDICT, Type: RPM_INT32_TYPE, Value: []int32{'P'<<24 | 1, 'R'<<24 | 0}},
+		)...))
+		require.NoError(t, err)
+		assert.Equal(t, []FileDependency{{Type: 'R', Index: 0}}, pkg.Files[1].Depends)
+		assert.Equal(t, []string{`invalid dependency index 2 for file "/usr/lib64/foo.sh"`}, pkg.Warnings)
+	})
+
+	t.Run("negative index", func(t *testing.T) {
+		pkg, err := ParseHeader(negativeDependsIndexHeader)
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Files[0].Depends)
+		assert.Equal(t, []string{`invalid dependency index -1 for file "/usr/bin/foo"`}, pkg.Warnings)
+	})
+
+	t.Run("overflowing index", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(entries,
+			testEntry{Tag: RPMTAG_FILEDEPENDSX, Type: RPM_INT32_TYPE, Value: []int32{math.MaxInt32, 0, 0}},
+			testEntry{Tag: RPMTAG_FILEDEPENDSN, Type: RPM_INT32_TYPE, Value: []int32{2, 0, 0}},
+			testEntry{Tag: RPMTAG_DEPENDSDICT, Type: RPM_INT32_TYPE, Value: []int32{'P'<<24 | 1}},
+		)...))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Files[0].Depends)
+		assert.Equal(t, []string{`invalid dependency index 2147483647 for file "/usr/lib64/libfoo.so.1"`}, pkg.Warnings)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(append(entries,
+			testEntry{Tag: RPMTAG_DEPENDSDICT, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"R"}},
+		)...))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "depends-dict", typeErr.Name)
+	})
+}
+
+// negativeDependsIndexHeader references the dependency dictionary with a negative index, which used to panic.
+var negativeDependsIndexHeader = newTestHeader(
+	testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+	testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+	testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+	testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+	testEntry{Tag: RPMTAG_FILEDEPENDSX, Type: RPM_INT32_TYPE, Value: []int32{-1}},
+	testEntry{Tag: RPMTAG_FILEDEPENDSN, Type: RPM_INT32_TYPE, Value: []int32{1}},
+	testEntry{Tag: RPMTAG_DEPENDSDICT, Type: RPM_INT32_TYPE, Value: []int32{5}},
+)
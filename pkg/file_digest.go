@@ -1,5 +1,13 @@
 package rpmdb
 
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+)
+
 // source: https://github.com/rpm-software-management/rpm/blob/0b75075a8d006c8f792d33a57eae7da6b66a4591/rpmio/rpmpgp.h#L241-L275
 
 type DigestAlgorithm int32
@@ -44,3 +52,25 @@ func (d DigestAlgorithm) String() string {
 		return "unknown-digest-algorithm"
 	}
 }
+
+// New returns a hash for the algorithm, so that file contents can be verified against FileInfo.Digest (which is not
+// necessarily a sha256 digest, older packages use md5). Algorithms that are not implemented by the standard library
+// (e.g. ripemd160) are not supported.
+func (d DigestAlgorithm) New() (hash.Hash, bool) {
+	switch d {
+	case PGPHASHALGO_MD5:
+		return md5.New(), true
+	case PGPHASHALGO_SHA1:
+		return sha1.New(), true
+	case PGPHASHALGO_SHA224:
+		return sha256.New224(), true
+	case PGPHASHALGO_SHA256:
+		return sha256.New(), true
+	case PGPHASHALGO_SHA384:
+		return sha512.New384(), true
+	case PGPHASHALGO_SHA512:
+		return sha512.New(), true
+	default:
+		return nil, false
+	}
+}
@@ -1,8 +1,14 @@
 package rpmdb
 
 import (
-	"github.com/stretchr/testify/assert"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFileDigest(t *testing.T) {
@@ -80,3 +86,144 @@ func TestFileDigest(t *testing.T) {
 		})
 	}
 }
+
+func TestDigestAlgorithm_New(t *testing.T) {
+	tests := []struct {
+		algorithm DigestAlgorithm
+		expected  string // the digest of "foo"
+	}{
+		{algorithm: PGPHASHALGO_MD5, expected: "acbd18db4cc2f85cedef654fccc4a4d8"},
+		{algorithm: PGPHASHALGO_SHA1, expected: "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"},
+		{algorithm: PGPHASHALGO_SHA224, expected: "0808f64e60d58979fcb676c96ec938270dea42445aeefcd3a4e6f8db"},
+		{algorithm: PGPHASHALGO_SHA256, expected: "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"},
+		{algorithm: PGPHASHALGO_SHA384, expected: "98c11ffdfdd540676b1a137cb1a22b2a70350c9a44171d6b1180c6be5cbb2ee3f79d532c8a1dd9ef2e8e08e752a3babb"},
+		{algorithm: PGPHASHALGO_SHA512, expected: "f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.algorithm.String(), func(t *testing.T) {
+			h, ok := test.algorithm.New()
+			require.True(t, ok)
+			h.Write([]byte("foo"))
+			assert.Equal(t, test.expected, hex.EncodeToString(h.Sum(nil)))
+		})
+	}
+
+	for _, algorithm := range []DigestAlgorithm{0, PGPHASHALGO_RIPEMD160, PGPHASHALGO_MD2, 12} {
+		h, ok := algorithm.New()
+		assert.False(t, ok, algorithm.String())
+		assert.Nil(t, h)
+	}
+}
+
+func TestFileInfo_DigestBytes(t *testing.T) {
+	tests := []struct {
+		name          string
+		digest        string
+		algorithm     []int32
+		expected      []byte
+		expectedAlgo  DigestAlgorithm
+		expectWarning bool
+	}{
+		{
+			name:         "sha256",
+			digest:       "68353b0b989463d9e202362c843ee42c408dd1e08dd5e8e93733753749a96208",
+			algorithm:    []int32{PGPHASHALGO_SHA256},
+			expected:     []byte{0x68, 0x35, 0x3b, 0x0b, 0x98, 0x94, 0x63, 0xd9, 0xe2, 0x02, 0x36, 0x2c, 0x84, 0x3e, 0xe4, 0x2c, 0x40, 0x8d, 0xd1, 0xe0, 0x8d, 0xd5, 0xe8, 0xe9, 0x37, 0x33, 0x75, 0x37, 0x49, 0xa9, 0x62, 0x08},
+			expectedAlgo: PGPHASHALGO_SHA256,
+		},
+		{
+			name:         "md5 is assumed without a digest algorithm",
+			digest:       "d41d8cd98f00b204e9800998ecf8427e",
+			expected:     []byte{0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e},
+			expectedAlgo: PGPHASHALGO_MD5,
+		},
+		{
+			name:      "no digest",
+			digest:    "",
+			algorithm: []int32{PGPHASHALGO_SHA256},
+		},
+		{
+			name:          "odd length",
+			digest:        "68353b0b9",
+			algorithm:     []int32{PGPHASHALGO_SHA256},
+			expectedAlgo:  PGPHASHALGO_SHA256,
+			expectWarning: true,
+		},
+		{
+			name:          "invalid characters",
+			digest:        "zz353b0b989463d9",
+			algorithm:     []int32{PGPHASHALGO_SHA256},
+			expectedAlgo:  PGPHASHALGO_SHA256,
+			expectWarning: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries := []testEntry{
+				{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+				{Tag: RPMTAG_FILEDIGESTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{test.digest}},
+				{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+				{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+				{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+			}
+			if test.algorithm != nil {
+				entries = append(entries, testEntry{Tag: RPMTAG_FILEDIGESTALGO, Type: RPM_INT32_TYPE, Value: test.algorithm})
+			}
+
+			indexEntries, err := headerImport(newTestHeader(entries...))
+			require.NoError(t, err)
+
+			// an invalid digest must not fail the package
+			pkg, err := newPackage(indexEntries, options{})
+			require.NoError(t, err)
+			require.Len(t, pkg.Files, 1)
+
+			file := pkg.Files[0]
+			assert.Equal(t, test.digest, file.Digest)
+			assert.Equal(t, test.expected, file.DigestBytes)
+			assert.Equal(t, test.expectedAlgo, file.DigestAlgorithm)
+			if test.expectWarning {
+				require.Len(t, pkg.Warnings, 1)
+				assert.Contains(t, pkg.Warnings[0], "/usr/bin/foo")
+			} else {
+				assert.Empty(t, pkg.Warnings)
+			}
+		})
+	}
+}
+
+func BenchmarkVerifyDigest(b *testing.B) {
+	// a verification loop compares computed hashes against the recorded digests of many files
+	var files []FileInfo
+	var sums [][]byte
+	for i := 0; i < 10000; i++ {
+		sum := sha256.Sum256([]byte(fmt.Sprintf("file-%d", i)))
+		files = append(files, FileInfo{Digest: hex.EncodeToString(sum[:]), DigestBytes: sum[:]})
+		sums = append(sums, sum[:])
+	}
+
+	b.Run("hex string", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for i, f := range files {
+				expected, err := hex.DecodeString(f.Digest)
+				if err != nil || !bytes.Equal(expected, sums[i]) {
+					b.Fatal("digest mismatch")
+				}
+			}
+		}
+	})
+
+	b.Run("bytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for i, f := range files {
+				if !bytes.Equal(f.DigestBytes, sums[i]) {
+					b.Fatal("digest mismatch")
+				}
+			}
+		}
+	})
+}
@@ -56,3 +56,29 @@ func (flags FileFlags) String() (result string) {
 	}
 	return
 }
+
+// Has indicates if the given RPMFILE_* flag is set.
+func (flags FileFlags) Has(flag int32) bool {
+	return int32(flags)&flag != 0
+}
+
+// IsConfig indicates a %config file, which may have been modified since it was installed.
+func (flags FileFlags) IsConfig() bool {
+	return flags.Has(RPMFILE_CONFIG)
+}
+
+// IsDoc indicates a %doc file.
+func (flags FileFlags) IsDoc() bool {
+	return flags.Has(RPMFILE_DOC)
+}
+
+// IsGhost indicates a %ghost file, which is owned by the package but not part of its payload (so it may not exist on
+// disk).
+func (flags FileFlags) IsGhost() bool {
+	return flags.Has(RPMFILE_GHOST)
+}
+
+// IsLicense indicates a %license file.
+func (flags FileFlags) IsLicense() bool {
+	return flags.Has(RPMFILE_LICENSE)
+}
@@ -89,3 +89,19 @@ func TestFormatFileFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestFileFlags_Is(t *testing.T) {
+	// %config(missingok,noreplace) %ghost
+	flags := FileFlags(89)
+	assert.True(t, flags.IsConfig())
+	assert.True(t, flags.IsGhost())
+	assert.True(t, flags.Has(RPMFILE_NOREPLACE))
+	assert.False(t, flags.IsDoc())
+	assert.False(t, flags.IsLicense())
+
+	flags = FileFlags(RPMFILE_DOC | RPMFILE_LICENSE)
+	assert.True(t, flags.IsDoc())
+	assert.True(t, flags.IsLicense())
+	assert.False(t, flags.IsConfig())
+	assert.False(t, flags.IsGhost())
+}
@@ -0,0 +1,39 @@
+package rpmdb
+
+// Hardlinks returns the groups of files of the package that are hardlinks of each other, each group in file list
+// order. Like rpm, only regular files that are not %ghost are linked, and files that do not share their device and
+// inode with any other file are not returned.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.0-release/lib/rpmfi.c (rpmfilesBuildNLink)
+func (p *PackageInfo) Hardlinks() ([][]FileInfo, error) {
+	files, err := p.FileList()
+	if err != nil {
+		return nil, err
+	}
+
+	type fileID struct {
+		device, inode int32
+	}
+	groups := map[fileID]int{}
+	var links [][]FileInfo
+	for _, f := range files {
+		if fileType(f.Mode) != fileTypeRegular || f.Flags.IsGhost() {
+			continue
+		}
+		id := fileID{device: f.Device, inode: f.Inode}
+		i, ok := groups[id]
+		if !ok {
+			i = len(links)
+			groups[id] = i
+			links = append(links, nil)
+		}
+		links[i] = append(links[i], f)
+	}
+
+	var hardlinks [][]FileInfo
+	for _, group := range links {
+		if len(group) > 1 {
+			hardlinks = append(hardlinks, group)
+		}
+	}
+	return hardlinks, nil
+}
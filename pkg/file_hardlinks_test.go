@@ -0,0 +1,60 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_Hardlinks(t *testing.T) {
+	for _, opts := range [][]Option{nil, {WithFiles(false)}} {
+		var rpmPython *PackageInfo
+		for _, pkg := range listFixturePackages(t, "testdata/centos7-many/Packages", opts...) {
+			if pkg.Name == "rpm-python" {
+				rpmPython = pkg
+			}
+		}
+		require.NotNil(t, rpmPython)
+
+		hardlinks, err := rpmPython.Hardlinks()
+		require.NoError(t, err)
+		// the optimized byte code is identical to the regular one and packaged as a hardlink of it
+		var paths [][]string
+		for _, group := range hardlinks {
+			var groupPaths []string
+			for _, f := range group {
+				groupPaths = append(groupPaths, rpmPython.FilePath(f))
+			}
+			paths = append(paths, groupPaths)
+		}
+		assert.Equal(t, [][]string{
+			{"/usr/lib64/python2.7/site-packages/rpm/__init__.pyc", "/usr/lib64/python2.7/site-packages/rpm/__init__.pyo"},
+			{"/usr/lib64/python2.7/site-packages/rpm/transaction.pyc", "/usr/lib64/python2.7/site-packages/rpm/transaction.pyo"},
+		}, paths)
+	}
+}
+
+func TestPackageInfo_Hardlinks_Synthetic(t *testing.T) {
+	pkg, err := ParseHeader(newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: []uint16{0100755, 0100755, 0100755, 040755, 040755, 0100644, 020620}},
+		testEntry{Tag: RPMTAG_FILEFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 0, 0, 0, RPMFILE_GHOST, 0}},
+		testEntry{Tag: RPMTAG_FILEDEVICES, Type: RPM_INT32_TYPE, Value: []int32{1, 1, 2, 1, 1, 1, 1}},
+		testEntry{Tag: RPMTAG_FILEINODES, Type: RPM_INT32_TYPE, Value: []int32{1, 1, 1, 2, 2, 1, 3}},
+		testEntry{Tag: RPMTAG_FILERDEVS, Type: RPM_INT16_TYPE, Value: []uint16{0, 0, 0, 0, 0, 0, 0x401}},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 0, 0, 0, 0, 1}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar", "baz", "a", "b", "ghost", "tty1"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", "/dev/"}},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, uint16(0x401), pkg.Files[6].RDev)
+
+	hardlinks, err := pkg.Hardlinks()
+	require.NoError(t, err)
+	// a different device, directories and %ghost files are not linked
+	require.Len(t, hardlinks, 1)
+	require.Len(t, hardlinks[0], 2)
+	assert.Equal(t, "/usr/bin/foo", hardlinks[0][0].Path)
+	assert.Equal(t, "/usr/bin/bar", hardlinks[0][1].Path)
+}
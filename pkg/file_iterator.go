@@ -0,0 +1,530 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// FileIterator yields the files of a package one at a time (see PackageInfo.FilesIter). Usage mirrors sql.Rows:
+//
+//	it := pkg.FilesIter()
+//	for it.Next() {
+//		f := it.File()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type FileIterator struct {
+	// files is set when the file list has already been decoded
+	files   []FileInfo
+	decoder *fileDecoder
+
+	onlyInstalled bool
+	index         int
+	file          FileInfo
+	err           error
+}
+
+// FilesIter returns an iterator over the files owned by the package, producing the same records in the same order as
+// FileList(). For packages read with WithFiles(false) the files are decoded from the header one at a time
+// without materializing the file list, so memory use does not grow with the number of files.
+func (p *PackageInfo) FilesIter() *FileIterator {
+	if p.lazyFiles == nil {
+		return &FileIterator{files: p.Files}
+	}
+
+	entries, files := p.lazyFiles.snapshot()
+	if entries == nil {
+		return &FileIterator{files: files}
+	}
+
+	decoder, err := newFileDecoder(entries, p.lazyFiles.compressed)
+	if err != nil {
+		return &FileIterator{err: fmt.Errorf("failed to read package files: %w", err)}
+	}
+	return &FileIterator{decoder: decoder, onlyInstalled: p.lazyFiles.onlyInstalled}
+}
+
+// Next advances to the next file, returning false when there are no more files or an error occurred.
+func (it *FileIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.decoder == nil {
+		if it.index >= len(it.files) {
+			return false
+		}
+		it.file = it.files[it.index]
+		it.index++
+		return true
+	}
+
+	for it.decoder.next() {
+		if it.onlyInstalled && !it.decoder.file.State.IsInstalled() {
+			continue
+		}
+		it.file = it.decoder.file
+		return true
+	}
+	if it.decoder.err != nil {
+		it.err = fmt.Errorf("failed to read package files: %w", it.decoder.err)
+	}
+	return false
+}
+
+// File returns the current file.
+func (it *FileIterator) File() FileInfo {
+	return it.file
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *FileIterator) Err() error {
+	return it.err
+}
+
+// fileDecoder walks the per-file tag arrays in lockstep, decoding a single FileInfo per step straight from the header
+// data. Each array is cut to its count and only read up to the values present, as with the parse* helpers.
+type fileDecoder struct {
+	compressed      bool
+	digestAlgorithm DigestAlgorithm
+	dirs            []string
+	hasDirIndexes   bool
+	hasBasenames    bool
+	origDirs        []string
+	classDict       []string
+
+	basenames, digests, linkTos, userNames, groupNames, contexts    stringArrayCursor
+	langs, caps, signatures, veritySignatures, oldFileNames         stringArrayCursor
+	origBasenames                                                   stringArrayCursor
+	modes, sizes, longSizes, flags, colors, mtimes, states, indexes []byte
+	devices, inodes, rdevs, classes                                 []byte
+	dependsX, dependsN, dependsDict, verifyFlags, origIndexes       []byte
+
+	index    int
+	file     FileInfo
+	warnings []string
+	err      error
+}
+
+func newFileDecoder(indexEntries []indexEntry, compressed bool) (*fileDecoder, error) {
+	d := &fileDecoder{
+		compressed: compressed,
+		// note: rpm assumes md5 when the digest algorithm is not recorded
+		// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmfi.c
+		digestAlgorithm: DigestAlgorithm(PGPHASHALGO_MD5),
+	}
+
+	for _, indexEntry := range indexEntries {
+		// note: there is no distinction between signed and unsigned (or scalar and array) integer types
+		switch indexEntry.Info.Tag {
+		case RPMTAG_FILESIZES:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-sizes", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.sizes = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_LONGFILESIZES:
+			if indexEntry.Info.Type != RPM_INT64_TYPE {
+				return nil, newTagTypeError("long-file-sizes", indexEntry.Info, RPM_INT64_TYPE)
+			}
+			d.longSizes = countedData(indexEntry, sizeOfInt64)
+		case RPMTAG_FILEFLAGS:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-flags", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.flags = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILEVERIFYFLAGS:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-verify-flags", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.verifyFlags = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILEDIGESTS:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("file-digests", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.digests = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILELINKTOS:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("file-linktos", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.linkTos = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILEMODES:
+			if indexEntry.Info.Type != RPM_INT16_TYPE {
+				return nil, newTagTypeError("file-modes", indexEntry.Info, RPM_INT16_TYPE)
+			}
+			d.modes = countedData(indexEntry, sizeOfUInt16)
+		case RPMTAG_BASENAMES:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("basenames", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.basenames = newStringArrayCursor(indexEntry)
+			d.hasBasenames = true
+		case RPMTAG_ORIGBASENAMES:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("orig-basenames", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.origBasenames = newStringArrayCursor(indexEntry)
+		case RPMTAG_ORIGDIRNAMES:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("orig-dir-names", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.origDirs = parseStringArray(indexEntry.Data, indexEntry.Info.Count)
+		case RPMTAG_ORIGDIRINDEXES:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("orig-dir-indexes", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.origIndexes = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_OLDFILENAMES:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("old-file-names", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.oldFileNames = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILEUSERNAME:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("usernames", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.userNames = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILEGROUPNAME:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("groupnames", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.groupNames = newStringArrayCursor(indexEntry)
+		case RPMTAG_DIRNAMES:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("dir-names", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			// note: the directory list is typically small, it is decoded up front for random access
+			d.dirs = parseStringArray(indexEntry.Data, indexEntry.Info.Count)
+		case RPMTAG_FILECOLORS:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-colors", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.colors = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILECLASS:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-classes", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.classes = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_CLASSDICT:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("class-dict", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			// note: like the directories, the dictionary of distinct classes is decoded up front for random access
+			d.classDict = parseStringArray(indexEntry.Data, indexEntry.Info.Count)
+		case RPMTAG_FILEDEPENDSX:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-depends-x", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.dependsX = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILEDEPENDSN:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-depends-n", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.dependsN = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_DEPENDSDICT:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("depends-dict", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.dependsDict = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILEMTIMES:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-mtimes", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.mtimes = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILEDEVICES:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-devices", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.devices = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILEINODES:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("file-inodes", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.inodes = countedData(indexEntry, sizeOfInt32)
+		case RPMTAG_FILERDEVS:
+			if indexEntry.Info.Type != RPM_INT16_TYPE {
+				return nil, newTagTypeError("file-rdevs", indexEntry.Info, RPM_INT16_TYPE)
+			}
+			d.rdevs = countedData(indexEntry, sizeOfUInt16)
+		case RPMTAG_FILELANGS:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("file-langs", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.langs = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILESTATES:
+			// note: there is no distinction between char and int8
+			if indexEntry.Info.Type != RPM_CHAR_TYPE {
+				return nil, newTagTypeError("file-states", indexEntry.Info, RPM_CHAR_TYPE)
+			}
+			d.states = countedData(indexEntry, sizeOfInt8)
+		case RPMTAG_FILECONTEXTS:
+			// note: only packages built by older rpm versions record contexts, otherwise they come from the policy
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("file-contexts", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.contexts = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILECAPS:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("file-caps", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.caps = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILESIGNATURES:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("file-signatures", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.signatures = newStringArrayCursor(indexEntry)
+		case RPMTAG_VERITYSIGNATURES:
+			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("verity-signatures", indexEntry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			d.veritySignatures = newStringArrayCursor(indexEntry)
+		case RPMTAG_FILEDIGESTALGO:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("digest algo", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			value, err := parseInt32(indexEntry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse digest algo: %w", err)
+			}
+			d.digestAlgorithm = DigestAlgorithm(value)
+		case RPMTAG_DIRINDEXES:
+			if indexEntry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("dir-indexes", indexEntry.Info, RPM_INT32_TYPE)
+			}
+			d.indexes = countedData(indexEntry, sizeOfInt32)
+			d.hasDirIndexes = true
+		}
+	}
+
+	return d, nil
+}
+
+// next decodes the next file into d.file, returning false once all files were decoded or an error occurred.
+func (d *fileDecoder) next() bool {
+	// note: source package headers may record bare file names without any directory tags, the files are named by
+	// their basename alone (as rpm does when querying a source package)
+	bare := d.dirs == nil && !d.hasDirIndexes
+	names := &d.basenames
+	if !d.hasBasenames {
+		// note: v3 package headers (rpm < 4.0) record the full paths instead of the basenames and dirnames, rpm
+		// converts them when reading the header
+		names, bare = &d.oldFileNames, true
+	}
+	if d.err != nil || (!bare && (d.dirs == nil || !d.hasDirIndexes)) {
+		return false
+	}
+
+	i := d.index
+	file, ok := names.next()
+	if !ok {
+		return false
+	}
+	d.index++
+
+	path, dirIndex := file, int32(-1)
+	if !bare {
+		dirIndex, ok = int32At(d.indexes, i)
+		if !ok || dirIndex < 0 || int(dirIndex) >= len(d.dirs) {
+			d.err = fmt.Errorf("file %q has no valid dir index: %w", file, ErrHeaderInvalid)
+			return false
+		}
+		path = d.dirs[dirIndex] + file
+	}
+
+	// note: the original paths are only recorded when the package was installed relocated
+	var origPath string
+	if origFile, ok := d.origBasenames.next(); ok {
+		origIndex, ok := int32At(d.origIndexes, i)
+		if ok && origIndex >= 0 && int(origIndex) < len(d.origDirs) {
+			origPath = d.origDirs[origIndex] + origFile
+		} else {
+			d.warnings = append(d.warnings, fmt.Sprintf("file %q has no valid original dir index", path))
+		}
+	}
+
+	digest, hasDigest := d.digests.next()
+	linkTo, _ := d.linkTos.next()
+	userName, _ := d.userNames.next()
+	groupName, _ := d.groupNames.next()
+	context, _ := d.contexts.next()
+	lang, _ := d.langs.next()
+	caps, _ := d.caps.next()
+	signature, _ := d.signatures.next()
+	veritySignature, _ := d.veritySignatures.next()
+	mode, _ := uint16At(d.modes, i)
+	size, _ := int32At(d.sizes, i)
+	longSize, ok := int64At(d.longSizes, i)
+	if !ok {
+		longSize = int64(uint32(size))
+	}
+	flags, _ := int32At(d.flags, i)
+	verifyFlags, _ := int32At(d.verifyFlags, i)
+	color, _ := int32At(d.colors, i)
+	mtime, _ := int32At(d.mtimes, i)
+	device, _ := int32At(d.devices, i)
+	inode, _ := int32At(d.inodes, i)
+	rdev, _ := uint16At(d.rdevs, i)
+	var state int8
+	if i < len(d.states) {
+		state = int8(d.states[i])
+	}
+
+	var class string
+	if classIndex, ok := int32At(d.classes, i); ok {
+		if classIndex >= 0 && int(classIndex) < len(d.classDict) {
+			class = d.classDict[classIndex]
+		} else {
+			d.warnings = append(d.warnings, fmt.Sprintf("invalid class index %d for file %q", classIndex, path))
+		}
+	}
+
+	var depends []FileDependency
+	dependsX, _ := int32At(d.dependsX, i)
+	dependsN, _ := int32At(d.dependsN, i)
+	for j := int32(0); j < dependsN; j++ {
+		// note: the index is computed in 64 bits so that it cannot overflow
+		index := int64(dependsX) + int64(j)
+		var dep int32
+		var ok bool
+		if dependsX >= 0 {
+			dep, ok = int32At(d.dependsDict, int(index))
+		}
+		if !ok {
+			d.warnings = append(d.warnings, fmt.Sprintf("invalid dependency index %d for file %q", index, path))
+			break
+		}
+		depends = append(depends, newFileDependency(uint32(dep)))
+	}
+
+	var digestBytes []byte
+	var algorithm DigestAlgorithm
+	if digest != "" {
+		algorithm = d.digestAlgorithm
+		var err error
+		digestBytes, err = hex.DecodeString(digest)
+		if err != nil {
+			digestBytes = nil
+			d.warnings = append(d.warnings, fmt.Sprintf("invalid digest for file %q: %v", path, err))
+		}
+	}
+
+	var signatureBytes []byte
+	if signature != "" {
+		var err error
+		signatureBytes, err = hex.DecodeString(signature)
+		if err != nil {
+			signatureBytes = nil
+			d.warnings = append(d.warnings, fmt.Sprintf("invalid IMA signature for file %q: %v", path, err))
+		}
+	}
+
+	// note: unlike the IMA signatures, the fs-verity signatures are base64 encoded
+	var veritySignatureBytes []byte
+	if veritySignature != "" {
+		var err error
+		veritySignatureBytes, err = base64.StdEncoding.DecodeString(veritySignature)
+		if err != nil {
+			veritySignatureBytes = nil
+			d.warnings = append(d.warnings, fmt.Sprintf("invalid verity signature for file %q: %v", path, err))
+		}
+	}
+
+	d.file = FileInfo{
+		Path:            path,
+		OrigPath:        origPath,
+		Mode:            mode,
+		LinkTo:          linkTo,
+		Digest:          digest,
+		DigestBytes:     digestBytes,
+		DigestAlgorithm: algorithm,
+		DigestAbsent:    !hasDigest,
+		Size:            size,
+		LongSize:        longSize,
+		Username:        userName,
+		Groupname:       groupName,
+		Flags:           FileFlags(flags),
+		VerifyFlags:     VerifyFlags(verifyFlags),
+		Color:           color,
+		Class:           class,
+		Depends:         depends,
+		MTime:           mtime,
+		Device:          device,
+		Inode:           inode,
+		RDev:            rdev,
+		Lang:            lang,
+		State:           FileState(state),
+		SELinuxContext:  context,
+		Caps:            caps,
+		IMASignature:    signatureBytes,
+		VeritySignature: veritySignatureBytes,
+	}
+	if d.compressed {
+		d.file.Path = ""
+		d.file.DirIndex = dirIndex
+		d.file.Basename = file
+	}
+	return true
+}
+
+// stringArrayCursor reads the strings of a string array tag one at a time, yielding the same values as
+// parseStringArray.
+type stringArrayCursor struct {
+	data      []byte
+	remaining uint32
+}
+
+func newStringArrayCursor(entry indexEntry) stringArrayCursor {
+	return stringArrayCursor{data: entry.Data, remaining: entry.Info.Count}
+}
+
+func (c *stringArrayCursor) next() (string, bool) {
+	if c.remaining == 0 || len(c.data) == 0 {
+		return "", false
+	}
+	c.remaining--
+
+	idx := bytes.IndexByte(c.data, 0)
+	if idx < 0 {
+		// an unterminated string at the end of the data
+		value := string(c.data)
+		c.data = nil
+		return value, true
+	}
+	value := string(c.data[:idx])
+	c.data = c.data[idx+1:]
+	return value, true
+}
+
+// countedData returns the data of a fixed-width array tag cut to its count. The data of a tag runs up to the next tag,
+// so it may be followed by alignment padding or the values of the next tag, which must not be read as further values.
+func countedData(entry indexEntry, size int) []byte {
+	if n := int(entry.Info.Count) * size; n < len(entry.Data) {
+		return entry.Data[:n]
+	}
+	return entry.Data
+}
+
+func int32At(data []byte, i int) (int32, bool) {
+	if i < 0 || i >= len(data)/sizeOfInt32 {
+		return 0, false
+	}
+	return int32(binary.BigEndian.Uint32(data[i*sizeOfInt32:])), true
+}
+
+func int64At(data []byte, i int) (int64, bool) {
+	if i < 0 || i >= len(data)/sizeOfInt64 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(data[i*sizeOfInt64:])), true
+}
+
+func uint16At(data []byte, i int) (uint16, bool) {
+	if i < 0 || i >= len(data)/sizeOfUInt16 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint16(data[i*sizeOfUInt16:]), true
+}
@@ -0,0 +1,149 @@
+package rpmdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesIter(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{
+			name: "all files",
+		},
+		{
+			name: "only installed files",
+			opts: []Option{WithOnlyInstalledFiles()},
+		},
+		{
+			name: "compressed paths",
+			opts: []Option{WithCompressedPaths()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// the largest fixture available
+			eager := listFixturePackages(t, "testdata/centos7-many/Packages", test.opts...)
+			lazy := listFixturePackages(t, "testdata/centos7-many/Packages", append(test.opts, WithFiles(false))...)
+			require.Len(t, lazy, len(eager))
+
+			for i := range eager {
+				for name, pkg := range map[string]*PackageInfo{"eager": eager[i], "lazy": lazy[i]} {
+					var actual []FileInfo
+					it := pkg.FilesIter()
+					for it.Next() {
+						actual = append(actual, it.File())
+					}
+					require.NoError(t, it.Err())
+
+					for _, d := range deep.Equal(eager[i].Files, actual) {
+						t.Errorf("%s (%s): %s", eager[i].Name, name, d)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFilesIter_AfterLoad(t *testing.T) {
+	pkgList := listFixturePackages(t, "testdata/centos7-plain/Packages", WithFiles(false))
+
+	for _, pkg := range pkgList {
+		expected, err := pkg.FileList()
+		require.NoError(t, err)
+
+		var actual []FileInfo
+		it := pkg.FilesIter()
+		for it.Next() {
+			actual = append(actual, it.File())
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, expected, actual, pkg.Name)
+	}
+}
+
+func TestFilesIter_InvalidDirIndex(t *testing.T) {
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 1}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+	)
+	pkg, err := ParseHeader(blob, WithFiles(false))
+	require.NoError(t, err)
+
+	it := pkg.FilesIter()
+	require.True(t, it.Next())
+	assert.Equal(t, "/usr/bin/foo", it.File().Path)
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestFilesIter_ShortArrays(t *testing.T) {
+	// the arrays only record a value for the first file, and the data of the last tag runs up to the end of the data
+	// segment (over the region trailer), which must not be read as the value of the second file
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+		testEntry{Tag: RPMTAG_FILESTATES, Type: RPM_CHAR_TYPE, Value: []byte{byte(RPMFILE_STATE_NOTINSTALLED)}},
+		testEntry{Tag: RPMTAG_FILEMTIMES, Type: RPM_INT32_TYPE, Value: []int32{1672531200}},
+	)
+
+	for _, opts := range [][]Option{nil, {WithFiles(false)}} {
+		pkg, err := ParseHeader(blob, opts...)
+		require.NoError(t, err)
+		files, err := pkg.FileList()
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+
+		assert.Equal(t, FileState(RPMFILE_STATE_NOTINSTALLED), files[0].State)
+		assert.Equal(t, int32(1672531200), files[0].MTime)
+		assert.Equal(t, FileState(0), files[1].State)
+		assert.Zero(t, files[1].MTime)
+	}
+}
+
+func BenchmarkFilesIter(b *testing.B) {
+	for _, files := range []int{1000, 10000, 100000} {
+		pkg, err := ParseHeader(syntheticHeaders(1, files)[0], WithFiles(false))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(fmt.Sprintf("files=%d/slice", files), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				// decode the whole list without memoizing it in the package
+				files, _, err := getFileInfo(pkg.lazyFiles.entries, false)
+				if err != nil {
+					b.Fatal(err)
+				}
+				for _, f := range files {
+					_ = f.Size
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("files=%d/iter", files), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				it := pkg.FilesIter()
+				for it.Next() {
+					_ = it.File().Size
+				}
+				if err := it.Err(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileInfo_Lang(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			var popt *PackageInfo
+			for _, pkg := range listFixturePackages(t, fixture) {
+				if pkg.Name == "popt" {
+					popt = pkg
+				}
+			}
+			require.NotNil(t, popt)
+
+			langs := map[string]string{}
+			var localized int
+			for _, f := range popt.Files {
+				langs[f.Path] = f.Lang
+				if f.Lang != "" {
+					localized++
+				}
+			}
+			// the translation catalogs are packaged with %lang, everything else is not
+			assert.Equal(t, 26, localized)
+			assert.Equal(t, "cs", langs["/usr/share/locale/cs/LC_MESSAGES/popt.mo"])
+			assert.Contains(t, langs, "/lib64/libpopt.so.0")
+			assert.Equal(t, "", langs["/lib64/libpopt.so.0"])
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package rpmdb
+
+// file type bits of RPMTAG_FILEMODES, these are the POSIX S_IF* values (see stat(2))
+const (
+	fileTypeMask    uint16 = 0170000
+	fileTypeSocket  uint16 = 0140000
+	fileTypeSymlink uint16 = 0120000
+	fileTypeRegular uint16 = 0100000
+	fileTypeBlock   uint16 = 0060000
+	fileTypeDir     uint16 = 0040000
+	fileTypeChar    uint16 = 0020000
+	fileTypeFIFO    uint16 = 0010000
+)
+
+func fileType(mode uint16) uint16 {
+	return mode & fileTypeMask
+}
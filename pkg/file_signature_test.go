@@ -0,0 +1,104 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileInfo_IMASignature(t *testing.T) {
+	files := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 1}},
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar", "foo.conf"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", "/etc/"}},
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		// only regular files are signed, the signature of every other file is empty
+		pkg, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_FILESIGNATURES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"030204a1b2c3d4", "", "030204deadbeef"}},
+		)...))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x03, 0x02, 0x04, 0xa1, 0xb2, 0xc3, 0xd4}, pkg.Files[0].IMASignature)
+		assert.Nil(t, pkg.Files[1].IMASignature)
+		assert.Equal(t, []byte{0x03, 0x02, 0x04, 0xde, 0xad, 0xbe, 0xef}, pkg.Files[2].IMASignature)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(files...))
+		require.NoError(t, err)
+		for _, f := range pkg.Files {
+			assert.Nil(t, f.IMASignature)
+		}
+	})
+
+	t.Run("invalid hex", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_FILESIGNATURES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"0302zz", "", ""}},
+		)...))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Files[0].IMASignature)
+		require.Len(t, pkg.Warnings, 1)
+		assert.Contains(t, pkg.Warnings[0], `invalid IMA signature for file "/usr/bin/foo"`)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_FILESIGNATURES, Type: RPM_BIN_TYPE, Value: []byte{0x03, 0x02}},
+		)...))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "file-signatures", typeErr.Name)
+	})
+}
+
+func TestFileInfo_VeritySignature(t *testing.T) {
+	files := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0}},
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_VERITYSIGNATURES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"3q2+7w==", ""}},
+			testEntry{Tag: RPMTAG_VERITYSIGNATUREALGO, Type: RPM_INT32_TYPE, Value: int32(FS_VERITY_HASH_ALG_SHA256)},
+		)...))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, pkg.Files[0].VeritySignature)
+		assert.Nil(t, pkg.Files[1].VeritySignature)
+		assert.Equal(t, FS_VERITY_HASH_ALG_SHA256, pkg.Signatures.VerityAlgorithm)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(files...))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Files[0].VeritySignature)
+		assert.Equal(t, 0, pkg.Signatures.VerityAlgorithm)
+	})
+
+	t.Run("invalid base64", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_VERITYSIGNATURES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"", "not base64!"}},
+		)...))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Files[1].VeritySignature)
+		require.Len(t, pkg.Warnings, 1)
+		assert.Contains(t, pkg.Warnings[0], `invalid verity signature for file "/usr/bin/bar"`)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(append(files,
+			testEntry{Tag: RPMTAG_VERITYSIGNATUREALGO, Type: RPM_STRING_TYPE, Value: "rsa"},
+		)...))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "verity signature algo", typeErr.Name)
+	})
+}
@@ -0,0 +1,38 @@
+package rpmdb
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.14.0-release/lib/rpmfiles.h
+const (
+	RPMFILE_STATE_MISSING      FileState = -1 /* used for unavailable data */
+	RPMFILE_STATE_NORMAL       FileState = 0
+	RPMFILE_STATE_REPLACED     FileState = 1
+	RPMFILE_STATE_NOTINSTALLED FileState = 2
+	RPMFILE_STATE_NETSHARED    FileState = 3
+	RPMFILE_STATE_WRONGCOLOR   FileState = 4
+)
+
+type FileState int8
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.14.0-release/lib/formats.c
+func (s FileState) String() string {
+	switch s {
+	case RPMFILE_STATE_NORMAL:
+		return "normal"
+	case RPMFILE_STATE_REPLACED:
+		return "replaced"
+	case RPMFILE_STATE_NOTINSTALLED:
+		return "not installed"
+	case RPMFILE_STATE_NETSHARED:
+		return "net shared"
+	case RPMFILE_STATE_WRONGCOLOR:
+		return "wrong color"
+	case RPMFILE_STATE_MISSING:
+		return "missing"
+	default:
+		return "(unknown)"
+	}
+}
+
+// IsInstalled indicates if the file was written to disk by rpm.
+func (s FileState) IsInstalled() bool {
+	return s == RPMFILE_STATE_NORMAL || s == RPMFILE_STATE_REPLACED
+}
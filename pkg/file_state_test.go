@@ -0,0 +1,53 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileState(t *testing.T) {
+	tests := []struct {
+		state     FileState
+		expected  string
+		installed bool
+	}{
+		{
+			state:     RPMFILE_STATE_NORMAL,
+			expected:  "normal",
+			installed: true,
+		},
+		{
+			state:     RPMFILE_STATE_REPLACED,
+			expected:  "replaced",
+			installed: true,
+		},
+		{
+			state:    RPMFILE_STATE_NOTINSTALLED,
+			expected: "not installed",
+		},
+		{
+			state:    RPMFILE_STATE_NETSHARED,
+			expected: "net shared",
+		},
+		{
+			state:    RPMFILE_STATE_WRONGCOLOR,
+			expected: "wrong color",
+		},
+		{
+			state:    RPMFILE_STATE_MISSING,
+			expected: "missing",
+		},
+		{
+			state:    42,
+			expected: "(unknown)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.state.String())
+			assert.Equal(t, test.installed, test.state.IsInstalled())
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package rpmdb
+
+import "encoding/json"
+
+// FileStats are aggregate counts over the files owned by a package (see PackageInfo.FileStats).
+type FileStats struct {
+	// Files is the total number of files, including directories and other special files.
+	Files int
+	// counts by the file type of the mode
+	Regular     int
+	Directories int
+	Symlinks    int
+	Devices     int // block and character devices
+	Other       int // sockets, FIFOs and unknown types
+	// ELF is the number of files rpm classified as ELF objects when building the package (a non-zero file color).
+	ELF int
+	// counts by file flag, a file may be counted by several flags (e.g. a %config %doc file)
+	Config  int
+	Doc     int
+	Ghost   int
+	License int
+	// Size is the total declared size of all files.
+	Size int64
+	// WithDigest is the number of files that have a digest recorded.
+	WithDigest int
+}
+
+// FileStats returns aggregate counts over PackageInfo.Files. When the package was read with WithFiles(false) the
+// file list is not available and a zero value is returned with ok set to false (see FileList to decode it).
+func (p *PackageInfo) FileStats() (stats FileStats, ok bool) {
+	if p.lazyFiles != nil {
+		return FileStats{}, false
+	}
+
+	for _, f := range p.Files {
+		stats.Files++
+		switch fileType(f.Mode) {
+		case fileTypeRegular:
+			stats.Regular++
+		case fileTypeDir:
+			stats.Directories++
+		case fileTypeSymlink:
+			stats.Symlinks++
+		case fileTypeBlock, fileTypeChar:
+			stats.Devices++
+		default:
+			stats.Other++
+		}
+
+		// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/build/rpmfc.h (RPMFC_ELF32, RPMFC_ELF64, RPMFC_ELFMIPSN32)
+		if f.Color != 0 {
+			stats.ELF++
+		}
+
+		if f.Flags.IsConfig() {
+			stats.Config++
+		}
+		if f.Flags.IsDoc() {
+			stats.Doc++
+		}
+		if f.Flags.IsGhost() {
+			stats.Ghost++
+		}
+		if f.Flags.IsLicense() {
+			stats.License++
+		}
+
+		// note: the 32-bit size is stored unsigned and files of 4GiB and more only record a 64-bit size
+		stats.Size += f.LongSize
+		if f.Digest != "" {
+			stats.WithDigest++
+		}
+	}
+	return stats, true
+}
+
+// MarshalJSON encodes the package fields along with its FileStats, which are computed rather than stored. The
+// statistics are left out when the file list was not read (see WithFiles).
+func (p PackageInfo) MarshalJSON() ([]byte, error) {
+	// packageInfo has the fields of PackageInfo but none of its methods, so encoding it does not recurse
+	type packageInfo PackageInfo
+	out := struct {
+		packageInfo
+		FileStats *FileStats `json:",omitempty"`
+	}{packageInfo: packageInfo(p)}
+	if stats, ok := p.FileStats(); ok {
+		out.FileStats = &stats
+	}
+	return json.Marshal(out)
+}
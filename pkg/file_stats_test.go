@@ -0,0 +1,148 @@
+package rpmdb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_FileStats(t *testing.T) {
+	tests := []struct {
+		file     string
+		name     string
+		expected FileStats
+	}{
+		{
+			// clear, infocmp, tabs, tic, toe, tput and tset are ELF, captoinfo, infotocap, reset and reset.1.gz are
+			// symlinks, the docs and man pages are flagged %doc
+			file: "testdata/centos7-plain/Packages",
+			name: "ncurses",
+			expected: FileStats{
+				Files: 29, Regular: 24, Directories: 1, Symlinks: 4, ELF: 7, Doc: 18, Size: 439472, WithDigest: 24,
+			},
+		},
+		{
+			file: "testdata/centos7-plain/Packages",
+			name: "setup",
+			expected: FileStats{
+				Files: 33, Regular: 31, Directories: 2, Config: 28, Doc: 2, Ghost: 2, Size: 705117, WithDigest: 31,
+			},
+		},
+		{
+			file: "testdata/centos6-plain/Packages",
+			name: "setup",
+			expected: FileStats{
+				Files: 30, Regular: 28, Directories: 2, Config: 25, Doc: 2, Ghost: 3, Size: 666708, WithDigest: 28,
+			},
+		},
+		{
+			file: "testdata/centos6-plain/Packages",
+			name: "ncurses-base",
+			expected: FileStats{
+				Files: 154, Regular: 116, Directories: 28, Symlinks: 10, Size: 364580, WithDigest: 116,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file+" "+test.name, func(t *testing.T) {
+			var pkg *PackageInfo
+			for _, p := range listFixturePackages(t, test.file) {
+				if p.Name == test.name {
+					pkg = p
+				}
+			}
+			require.NotNil(t, pkg, "package %q not found", test.name)
+
+			stats, ok := pkg.FileStats()
+			assert.True(t, ok)
+			assert.Equal(t, test.expected, stats)
+		})
+	}
+}
+
+func TestPackageInfo_FileStats_Synthetic(t *testing.T) {
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_FILESIZES, Type: RPM_INT32_TYPE, Value: []int32{-268435456, 10, 0, 0, 0}},
+		testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: []uint16{0100755, 0100644, 0020620, 0010644, 0140755}},
+		testEntry{Tag: RPMTAG_FILEFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0, RPMFILE_LICENSE | RPMFILE_DOC, 0, RPMFILE_GHOST, 0}},
+		testEntry{Tag: RPMTAG_FILECOLORS, Type: RPM_INT32_TYPE, Value: []int32{2, 0, 0, 0, 0}},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 1, 2, 0, 0}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "LICENSE", "foo", "fifo", "socket"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", "/usr/share/licenses/foo/", "/dev/"}},
+	)
+
+	pkg, err := ParseHeader(blob)
+	require.NoError(t, err)
+	stats, ok := pkg.FileStats()
+	assert.True(t, ok)
+	assert.Equal(t, FileStats{
+		Files: 5, Regular: 2, Devices: 1, Other: 2, ELF: 1, Doc: 1, Ghost: 1, License: 1, Size: 0xf0000000 + 10,
+	}, stats)
+
+	pkg, err = ParseHeader(blob, WithFiles(false))
+	require.NoError(t, err)
+	stats, ok = pkg.FileStats()
+	assert.False(t, ok)
+	assert.Zero(t, stats)
+
+	// packages without files have stats all the same
+	pkg, err = ParseHeader(newTestHeader(testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "bar"}))
+	require.NoError(t, err)
+	stats, ok = pkg.FileStats()
+	assert.True(t, ok)
+	assert.Zero(t, stats)
+}
+
+func TestPackageInfo_MarshalJSON(t *testing.T) {
+	const fixture = "testdata/centos7-plain/Packages"
+
+	for _, test := range []struct {
+		name          string
+		opts          []Option
+		expectedStats *FileStats
+	}{
+		{
+			name: "with files",
+			expectedStats: &FileStats{
+				Files: 29, Regular: 24, Directories: 1, Symlinks: 4, ELF: 7, Doc: 18, Size: 439472, WithDigest: 24,
+			},
+		},
+		{
+			// the statistics are not available without the file list
+			name: "without files",
+			opts: []Option{WithFiles(false)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var pkg *PackageInfo
+			for _, p := range listFixturePackages(t, fixture, test.opts...) {
+				if p.Name == "ncurses" {
+					pkg = p
+				}
+			}
+			require.NotNil(t, pkg)
+
+			data, err := json.Marshal(pkg)
+			require.NoError(t, err)
+
+			var decoded struct {
+				PackageInfo
+				FileStats *FileStats
+			}
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, test.expectedStats, decoded.FileStats)
+			assert.Equal(t, pkg.Name, decoded.Name)
+			assert.Equal(t, pkg.Version, decoded.Version)
+			assert.Equal(t, pkg.Files, decoded.Files)
+
+			// a package value is encoded the same way as a pointer to it
+			value, err := json.Marshal(*pkg)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(data), string(value))
+		})
+	}
+}
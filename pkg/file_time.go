@@ -0,0 +1,9 @@
+package rpmdb
+
+import "time"
+
+// ModTime returns the modification time of the file as recorded at build time, to be compared against the file on
+// disk. The recorded value is an unsigned 32-bit number of seconds, so it is only valid until 2106.
+func (f FileInfo) ModTime() time.Time {
+	return time.Unix(int64(uint32(f.MTime)), 0)
+}
@@ -0,0 +1,28 @@
+package rpmdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileInfo_ModTime(t *testing.T) {
+	var clear *FileInfo
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-plain/Packages") {
+		if pkg.Name != "ncurses" {
+			continue
+		}
+		for i, f := range pkg.Files {
+			if f.Path == "/usr/bin/clear" {
+				clear = &pkg.Files[i]
+			}
+		}
+	}
+	require.NotNil(t, clear)
+	assert.Equal(t, time.Date(2017, time.September, 6, 22, 8, 20, 0, time.UTC), clear.ModTime().UTC())
+
+	// times past 2038 overflow the signed field
+	assert.Equal(t, time.Date(2040, time.January, 1, 0, 0, 0, 0, time.UTC), FileInfo{MTime: -2085978496}.ModTime().UTC())
+}
@@ -0,0 +1,25 @@
+package rpmdb
+
+// VerifyFlags are the attributes of a file that "rpm --verify" checks, set from the %verify directive of the spec
+// file (all attributes by default).
+type VerifyFlags uint32
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmvf.h#L20-L38
+const (
+	RPMVERIFY_NONE       VerifyFlags = 0
+	RPMVERIFY_FILEDIGEST VerifyFlags = 1 << 0 /*!< from %verify(filedigest) */
+	RPMVERIFY_FILESIZE   VerifyFlags = 1 << 1 /*!< from %verify(size) */
+	RPMVERIFY_LINKTO     VerifyFlags = 1 << 2 /*!< from %verify(link) */
+	RPMVERIFY_USER       VerifyFlags = 1 << 3 /*!< from %verify(user) */
+	RPMVERIFY_GROUP      VerifyFlags = 1 << 4 /*!< from %verify(group) */
+	RPMVERIFY_MTIME      VerifyFlags = 1 << 5 /*!< from %verify(mtime) */
+	RPMVERIFY_MODE       VerifyFlags = 1 << 6 /*!< from %verify(mode) */
+	RPMVERIFY_RDEV       VerifyFlags = 1 << 7 /*!< from %verify(rdev) */
+	RPMVERIFY_CAPS       VerifyFlags = 1 << 8 /*!< from %verify(caps) */
+	RPMVERIFY_ALL        VerifyFlags = ^VerifyFlags(0)
+)
+
+// Has indicates if the given attribute is verified.
+func (f VerifyFlags) Has(flag VerifyFlags) bool {
+	return f&flag != 0
+}
@@ -0,0 +1,31 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileInfo_VerifyFlags(t *testing.T) {
+	var setup *PackageInfo
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-plain/Packages") {
+		if pkg.Name == "setup" {
+			setup = pkg
+		}
+	}
+	require.NotNil(t, setup)
+
+	flags := map[string]VerifyFlags{}
+	for _, f := range setup.Files {
+		flags[f.Path] = f.VerifyFlags
+	}
+
+	assert.Equal(t, RPMVERIFY_ALL, flags["/etc/bashrc"])
+	// %verify(not md5 size mtime): the content is expected to change
+	gshadow := flags["/etc/gshadow"]
+	assert.Equal(t, RPMVERIFY_ALL&^(RPMVERIFY_FILEDIGEST|RPMVERIFY_FILESIZE|RPMVERIFY_MTIME), gshadow)
+	assert.False(t, gshadow.Has(RPMVERIFY_FILEDIGEST))
+	assert.True(t, gshadow.Has(RPMVERIFY_MODE))
+	assert.True(t, gshadow.Has(RPMVERIFY_USER|RPMVERIFY_GROUP))
+}
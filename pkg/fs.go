@@ -0,0 +1,147 @@
+package rpmdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
+)
+
+// sqliteSidecars are the files sqlite keeps next to the database, which hold committed transactions (the write-ahead
+// log) or must be rolled back (a hot journal) before the database is consistent.
+var sqliteSidecars = []string{"-wal", "-journal"}
+
+// OpenFS opens the rpm database in the given directory of an abstract filesystem (e.g. the contents of an image
+// layer), looking for the same database files as Open does for a directory. Berkeley DB and ndb databases are read in
+// place when the file implements io.ReaderAt (and are read into memory otherwise). SQLite databases are copied to a
+// temporary directory, which is removed by Close.
+func OpenFS(fsys fs.FS, dir string, opts ...Option) (*RpmDB, error) {
+	o := newOptions(opts...)
+	if o.sqliteDB != nil {
+		return nil, fmt.Errorf("a sqlite connection cannot be combined with a filesystem: %w", ErrUnsupported)
+	}
+
+	name, err := findFS(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, size, closer, err := openFSReader(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	db := &RpmDB{opts: o}
+	if closer != nil {
+		db.closers = append(db.closers, closer)
+	}
+
+	if size == 0 {
+		_ = db.Close()
+		return nil, fmt.Errorf("%q is a zero byte file: %w", name, ErrEmptyDatabase)
+	}
+
+	backend, err := detectBackend(reader)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to read %q: %w", name, err)
+	}
+	if backend == BackendSQLite {
+		err = db.openFSSQLite(fsys, name, reader, size)
+	} else {
+		db.db, err = openReaderDBI(reader, size)
+	}
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to open %q: %w", name, err)
+	}
+	return db, nil
+}
+
+// findFS returns the first database file found in the directory of the filesystem.
+func findFS(fsys fs.FS, dir string) (string, error) {
+	for _, name := range dbFileNames {
+		name = path.Join(dir, name)
+		if info, err := fs.Stat(fsys, name); err == nil && info.Mode().IsRegular() {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("no rpm database found in %q: %w", dir, fs.ErrNotExist)
+}
+
+// openFSReader opens the file for random access, reading it into memory when the filesystem does not support that.
+// The returned closer (if any) must be closed once the file is no longer read.
+func openFSReader(fsys fs.FS, name string) (io.ReaderAt, int64, io.Closer, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	if reader, ok := file.(io.ReaderAt); ok {
+		info, err := file.Stat()
+		if err != nil {
+			_ = file.Close()
+			return nil, 0, nil, err
+		}
+		return reader, info.Size(), file, nil
+	}
+
+	defer file.Close()
+	contents, err := io.ReadAll(file)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to read %q: %w", name, err)
+	}
+	return bytes.NewReader(contents), int64(len(contents)), nil, nil
+}
+
+// openFSSQLite copies the sqlite database (and its sidecar files) to a temporary directory, since sqlite can only open
+// databases from a path.
+func (d *RpmDB) openFSSQLite(fsys fs.FS, name string, reader io.ReaderAt, size int64) error {
+	tempDir, err := os.MkdirTemp("", "rpmdb-")
+	if err != nil {
+		return err
+	}
+	d.closers = append(d.closers, removeAllCloser(tempDir))
+
+	tempPath := filepath.Join(tempDir, path.Base(name))
+	if err := writeTempFile(tempPath, io.NewSectionReader(reader, 0, size)); err != nil {
+		return err
+	}
+	for _, suffix := range sqliteSidecars {
+		sidecar, err := fsys.Open(name + suffix)
+		if err != nil {
+			continue
+		}
+		err = writeTempFile(tempPath+suffix, sidecar)
+		_ = sidecar.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	d.db, err = sqlite.Open(tempPath)
+	return err
+}
+
+func writeTempFile(path string, contents io.Reader) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, contents); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to copy %q: %w", filepath.Base(path), err)
+	}
+	return file.Close()
+}
+
+// removeAllCloser removes the directory when closed.
+type removeAllCloser string
+
+func (r removeAllCloser) Close() error {
+	return os.RemoveAll(string(r))
+}
@@ -0,0 +1,137 @@
+package rpmdb
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenFS(t *testing.T) {
+	expected := listFixturePackages(t, "testdata/centos7-plain/Packages")
+	require.Len(t, expected, 144)
+
+	ndbFS := fstest.MapFS{
+		"usr/lib/sysimage/rpm/Packages.db": {Data: buildNDB(fixtureBlobs(t, "testdata/centos7-plain/Packages"))},
+	}
+
+	// files in a zip archive do not support random access, so they are read into memory
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	contents, err := os.ReadFile("testdata/centos7-plain/Packages")
+	require.NoError(t, err)
+	entry, err := writer.Create("var/lib/rpm/Packages")
+	require.NoError(t, err)
+	_, err = entry.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	zipFS, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		fsys    fs.FS
+		dir     string
+		backend string
+	}{
+		{
+			name:    "bdb",
+			fsys:    os.DirFS("testdata"),
+			dir:     "centos7-plain",
+			backend: BackendBerkeleyDB,
+		},
+		{
+			name:    "sqlite",
+			fsys:    os.DirFS("testdata"),
+			dir:     "centos7-plain-sqlite",
+			backend: BackendSQLite,
+		},
+		{
+			name:    "ndb",
+			fsys:    ndbFS,
+			dir:     "usr/lib/sysimage/rpm",
+			backend: BackendNDB,
+		},
+		{
+			name:    "bdb without random access",
+			fsys:    zipFS,
+			dir:     "var/lib/rpm",
+			backend: BackendBerkeleyDB,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := OpenFS(test.fsys, test.dir)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.backend, db.backend())
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			require.Len(t, pkgList, len(expected))
+			for i := range expected {
+				for _, d := range deep.Equal(expected[i], pkgList[i]) {
+					t.Errorf("%s: %s", expected[i].Name, d)
+				}
+			}
+
+			require.NoError(t, db.Close())
+			// the temporary copy of a sqlite database is removed
+			for _, closer := range db.closers {
+				if dir, ok := closer.(removeAllCloser); ok {
+					_, err := os.Stat(string(dir))
+					assert.True(t, errors.Is(err, fs.ErrNotExist), "temporary directory %q was not removed", dir)
+				}
+			}
+		})
+	}
+}
+
+func TestOpenFS_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		fsys        fs.FS
+		expectedErr error
+	}{
+		{
+			name:        "no database",
+			fsys:        fstest.MapFS{"var/lib/rpm/Other": {Data: []byte("other")}},
+			expectedErr: fs.ErrNotExist,
+		},
+		{
+			name:        "zero byte file",
+			fsys:        fstest.MapFS{"var/lib/rpm/Packages": {}},
+			expectedErr: ErrEmptyDatabase,
+		},
+		{
+			name:        "not a database",
+			fsys:        fstest.MapFS{"var/lib/rpm/Packages": {Data: bytes.Repeat([]byte{1}, 512)}},
+			expectedErr: ErrUnsupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := OpenFS(test.fsys, "var/lib/rpm")
+			assert.True(t, errors.Is(err, test.expectedErr), "unexpected error: %v", err)
+		})
+	}
+
+	t.Run("sqlite connection", func(t *testing.T) {
+		conn, err := sql.Open(sqlite.DriverName, sqliteFixture)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = OpenFS(os.DirFS("testdata/centos7-plain"), ".", WithSQLiteDB(conn))
+		assert.True(t, errors.Is(err, ErrUnsupported), "unexpected error: %v", err)
+	})
+}
@@ -0,0 +1,89 @@
+package rpmdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// headerMagic precedes headers stored outside of the database (e.g. within .rpm files): three magic bytes, the
+// header version and four reserved bytes.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header.c (rpm_header_magic)
+var headerMagic = []byte{0x8e, 0xad, 0xe8, 0x01, 0x00, 0x00, 0x00, 0x00}
+
+// HeaderEntry is a single tag read from a header blob.
+type HeaderEntry struct {
+	Tag   int32
+	Type  uint32
+	Count uint32
+	Data  []byte
+}
+
+// Binary returns the data of an RPM_BIN_TYPE entry (e.g. RPMTAG_SIGMD5 or RPMTAG_RSAHEADER), which is exactly Count
+// bytes long. Data itself may run past the value (e.g. into alignment padding for the following entry).
+func (e HeaderEntry) Binary() ([]byte, error) {
+	if e.Type != RPM_BIN_TYPE {
+		return nil, &TagTypeError{Name: "binary", Tag: e.Tag, Type: e.Type, Expected: RPM_BIN_TYPE}
+	}
+	return parseBinary(e.Data, e.Count)
+}
+
+// ParseHeaderEntries validates the given header blob and returns its entries (without the immutable region entry).
+// The blob may optionally start with the header magic (as found in .rpm files).
+func ParseHeaderEntries(blob []byte) ([]HeaderEntry, error) {
+	indexEntries, err := headerImport(trimHeaderMagic(blob))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]HeaderEntry, len(indexEntries))
+	for i, entry := range indexEntries {
+		entries[i] = HeaderEntry{
+			Tag:   entry.Info.Tag,
+			Type:  entry.Info.Type,
+			Count: entry.Info.Count,
+			Data:  entry.Data,
+		}
+	}
+	return entries, nil
+}
+
+// ParseHeader reads the package described by the given header blob, without the need for a database. The blob may
+// optionally start with the header magic (as found in .rpm files).
+func ParseHeader(blob []byte, opts ...Option) (*PackageInfo, error) {
+	return parseHeader(trimHeaderMagic(blob), newOptions(opts...))
+}
+
+func parseHeader(blob []byte, opts options) (*PackageInfo, error) {
+	indexEntries, err := headerImport(blob)
+	if err != nil {
+		return nil, fmt.Errorf("error during importing header: %w", err)
+	}
+	return parseIndexEntries(blob, indexEntries, opts)
+}
+
+// parseIndexEntries reads the package from the entries imported from the header blob.
+func parseIndexEntries(blob []byte, indexEntries []indexEntry, opts options) (*PackageInfo, error) {
+	var warnings []string
+	if err := verifyHeaderDigest(blob, indexEntries); err != nil {
+		if !opts.lenientChecksums || !errors.Is(err, ErrBlobChecksum) {
+			return nil, fmt.Errorf("failed to verify header: %w", err)
+		}
+		warnings = append(warnings, err.Error())
+	}
+
+	pkg, err := newPackage(indexEntries, opts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid package info: %w", err)
+	}
+	pkg.Warnings = append(warnings, pkg.Warnings...)
+	return pkg, nil
+}
+
+func trimHeaderMagic(blob []byte) []byte {
+	// only the magic and version are fixed, the reserved bytes are not checked (as with rpm)
+	if len(blob) >= len(headerMagic) && bytes.Equal(blob[:4], headerMagic[:4]) {
+		return blob[len(headerMagic):]
+	}
+	return blob
+}
@@ -0,0 +1,74 @@
+package rpmdb
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+)
+
+// verifyHeaderDigest checks the header blob against the digests rpm stores with every header (RPMTAG_SHA256HEADER
+// and RPMTAG_SHA1HEADER), which cover the immutable region of the header. Headers without a recorded digest or
+// without an immutable region (e.g. written by very old rpm versions) cannot be verified and are accepted.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.0-release/lib/header.c (hdrblobVerifyRegion)
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.0-release/lib/rpmvs.c
+func verifyHeaderDigest(blob []byte, indexEntries []indexEntry) error {
+	var sha1Digest, sha256Digest string
+	for _, entry := range indexEntries {
+		switch entry.Info.Tag {
+		case RPMTAG_SHA1HEADER:
+			if entry.Info.Type == RPM_STRING_TYPE {
+				sha1Digest = parseString(entry.Data)
+			}
+		case RPMTAG_SHA256HEADER:
+			if entry.Info.Type == RPM_STRING_TYPE {
+				sha256Digest = parseString(entry.Data)
+			}
+		}
+	}
+
+	var h hash.Hash
+	var expected string
+	switch {
+	case sha256Digest != "":
+		h, expected = sha256.New(), sha256Digest
+	case sha1Digest != "":
+		h, expected = sha1.New(), sha1Digest
+	default:
+		return nil
+	}
+
+	region, ok, err := immutableRegion(blob)
+	if err != nil || !ok {
+		return err
+	}
+
+	h.Write(region)
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("header digest is %s, expected %s: %w", actual, expected, ErrBlobChecksum)
+	}
+	return nil
+}
+
+// immutableRegion returns the bytes that the header digests are calculated over: the header magic, the region
+// index and data lengths, the index entries within the region, and the data within the region.
+func immutableRegion(blob []byte) ([]byte, bool, error) {
+	region, err := headerRegion(blob)
+	if err != nil || region == nil || region.Tag != RPMTAG_HEADERIMMUTABLE {
+		return nil, false, err
+	}
+
+	il := int(binary.BigEndian.Uint32(blob[0:]))
+	dataStart := headerPreambleSize + il*entryInfoSize
+
+	var buf bytes.Buffer
+	buf.Write(headerMagic)
+	_ = binary.Write(&buf, binary.BigEndian, int32(region.IndexLength))
+	_ = binary.Write(&buf, binary.BigEndian, int32(region.DataLength))
+	buf.Write(blob[headerPreambleSize : headerPreambleSize+region.IndexLength*entryInfoSize])
+	buf.Write(blob[dataStart : dataStart+region.DataLength])
+	return buf.Bytes(), true, nil
+}
@@ -0,0 +1,144 @@
+package rpmdb
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPackages_BlobChecksum(t *testing.T) {
+	// the bdb and sqlite fixtures hold three packages, the summary of the second (nss-softokn-freebl) has a single bit
+	// flipped. The ndb fixture is the sle15 database with a bit of the filesystem summary flipped, which fails both the
+	// blob checksum and the header digest.
+	tests := []struct {
+		name             string
+		fixture          string
+		expectedInstance string
+		expectedCount    int
+		broken           string
+		expectedWarnings int
+	}{
+		{
+			name:             "bdb",
+			fixture:          "testdata/checksum/bdb/Packages",
+			expectedInstance: "package instance 4",
+			expectedCount:    3,
+			broken:           "nss-softokn-freebl",
+			expectedWarnings: 1,
+		},
+		{
+			name:             "sqlite",
+			fixture:          "testdata/checksum/sqlite/rpmdb.sqlite",
+			expectedInstance: "package instance 2",
+			expectedCount:    3,
+			broken:           "nss-softokn-freebl",
+			expectedWarnings: 1,
+		},
+		{
+			name:             "ndb",
+			fixture:          flipSummaryBit(t, "testdata/sle15-bci/Packages.db", "Basic Directory Layout"),
+			expectedInstance: "blob of package 2",
+			expectedCount:    35,
+			broken:           "filesystem",
+			expectedWarnings: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("strict", func(t *testing.T) {
+				db, err := Open(test.fixture)
+				require.NoError(t, err)
+				defer db.Close()
+
+				_, err = db.ListPackages()
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, ErrBlobChecksum), "unexpected error: %v", err)
+				assert.Contains(t, err.Error(), test.expectedInstance)
+			})
+
+			t.Run("lenient", func(t *testing.T) {
+				db, err := Open(test.fixture, WithLenientChecksums())
+				require.NoError(t, err)
+				defer db.Close()
+
+				pkgs, err := db.ListPackages()
+				require.NoError(t, err)
+				require.Len(t, pkgs, test.expectedCount)
+
+				for _, pkg := range pkgs {
+					if pkg.Name != test.broken {
+						assert.Empty(t, pkg.Warnings, pkg.Name)
+						continue
+					}
+					require.Len(t, pkg.Warnings, test.expectedWarnings)
+					for _, warning := range pkg.Warnings {
+						assert.True(t, strings.Contains(warning, ErrBlobChecksum.Error()), warning)
+					}
+				}
+			})
+		})
+	}
+}
+
+// flipSummaryBit copies the fixture with the case of the first letter of the given summary flipped (a single bit).
+func flipSummaryBit(t *testing.T, fixture, summary string) string {
+	t.Helper()
+
+	data, err := ioutil.ReadFile(fixture)
+	require.NoError(t, err)
+	idx := bytes.Index(data, []byte(summary))
+	require.True(t, idx >= 0, "summary %q not found", summary)
+	require.Equal(t, -1, bytes.Index(data[idx+1:], []byte(summary)), "summary %q is not unique", summary)
+	data[idx] ^= 0x20
+
+	path := filepath.Join(t.TempDir(), filepath.Base(fixture))
+	writeFile(t, path, data)
+	return path
+}
+
+func TestVerifyHeaderDigest(t *testing.T) {
+	for _, fixture := range []string{
+		"testdata/centos6-plain/Packages",
+		"testdata/centos7-many/Packages",
+		"testdata/centos7-plain-sqlite/rpmdb.sqlite",
+	} {
+		t.Run(fixture, func(t *testing.T) {
+			db, err := Open(fixture)
+			require.NoError(t, err)
+			defer db.Close()
+
+			for entry := range db.db.Read() {
+				require.NoError(t, entry.Err)
+				indexEntries, err := headerImport(entry.Value)
+				require.NoError(t, err)
+				assert.NoError(t, verifyHeaderDigest(entry.Value, indexEntries), "instance %d", entry.Instance)
+			}
+		})
+	}
+}
+
+func TestVerifyHeaderDigest_Synthetic(t *testing.T) {
+	// headers without a recorded digest are accepted as-is
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+	)
+	indexEntries, err := headerImport(blob)
+	require.NoError(t, err)
+	assert.NoError(t, verifyHeaderDigest(blob, indexEntries))
+
+	blob = newTestHeader(
+		testEntry{Tag: RPMTAG_SHA1HEADER, Type: RPM_STRING_TYPE, Value: "0000000000000000000000000000000000000000"},
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+	)
+	indexEntries, err = headerImport(blob)
+	require.NoError(t, err)
+	err = verifyHeaderDigest(blob, indexEntries)
+	assert.True(t, errors.Is(err, ErrBlobChecksum), "unexpected error: %v", err)
+}
@@ -0,0 +1,77 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// HeaderRegion describes the region of a header: the leading index entries and data that were written as a whole
+// (when the package was built, or signed for signature headers), as opposed to the entries rpm adds on install. The
+// header digests and signatures are calculated over the region only.
+type HeaderRegion struct {
+	// Tag is the region tag: RPMTAG_HEADERIMMUTABLE for package headers, RPMTAG_HEADERSIGNATURES for signature headers.
+	Tag int32
+	// IndexLength is the number of index entries within the region, including the region entry itself.
+	IndexLength int
+	// DataLength is the number of data bytes within the region, including the region trailer.
+	DataLength int
+}
+
+// isRegionTag indicates that the tag of an index entry describes a region rather than a tag of the package.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.0-release/lib/header.c (ENTRY_IS_REGION)
+func isRegionTag(tag int32) bool {
+	return tag >= RPMTAG_HEADERIMAGE && tag <= RPMTAG_HEADERIMMUTABLE
+}
+
+// ParseHeaderRegion validates the region of the given header blob and returns its boundaries, or nil when the header
+// has no region (e.g. headers written by very old rpm versions). The blob may optionally start with the header magic.
+func ParseHeaderRegion(blob []byte) (*HeaderRegion, error) {
+	blob = trimHeaderMagic(blob)
+	if _, err := headerImport(blob); err != nil {
+		return nil, err
+	}
+	return headerRegion(blob)
+}
+
+// headerRegion validates the region entry and the region trailer at the end of the region data, which points back
+// to the start of the index. The blob must have been imported (so the preamble and the index are in bounds).
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.0-release/lib/header.c (hdrblobVerifyRegion)
+func headerRegion(blob []byte) (*HeaderRegion, error) {
+	il := int32(binary.BigEndian.Uint32(blob[0:]))
+	dl := int32(binary.BigEndian.Uint32(blob[4:]))
+	dataStart := headerPreambleSize + il*entryInfoSize
+
+	var region entryInfo
+	if err := binary.Read(bytes.NewReader(blob[headerPreambleSize:]), binary.BigEndian, &region); err != nil {
+		return nil, fmt.Errorf("failed to read region entry: %w: %w", ErrHeaderInvalid, err)
+	}
+	if !isRegionTag(region.Tag) {
+		return nil, nil
+	}
+	if region.Type != RPM_BIN_TYPE || region.Count != entryInfoSize {
+		return nil, fmt.Errorf("region tag %d has type %d and count %d: %w", region.Tag, region.Type, region.Count, ErrHeaderInvalid)
+	}
+	if region.Offset < 0 || region.Offset > dl-entryInfoSize {
+		return nil, fmt.Errorf("region trailer offset %d out of range: %w", region.Offset, ErrHeaderInvalid)
+	}
+
+	var trailer entryInfo
+	if err := binary.Read(bytes.NewReader(blob[dataStart+region.Offset:]), binary.BigEndian, &trailer); err != nil {
+		return nil, fmt.Errorf("failed to read region trailer: %w: %w", ErrHeaderInvalid, err)
+	}
+	// note: some old packages have HEADERIMAGE in the trailer of the signature region
+	if region.Tag == RPMTAG_HEADERSIGNATURES && trailer.Tag == RPMTAG_HEADERIMAGE {
+		trailer.Tag = RPMTAG_HEADERSIGNATURES
+	}
+	if trailer.Tag != region.Tag || trailer.Type != RPM_BIN_TYPE || trailer.Count != entryInfoSize {
+		return nil, fmt.Errorf("region trailer has tag %d, type %d and count %d: %w", trailer.Tag, trailer.Type, trailer.Count, ErrHeaderInvalid)
+	}
+
+	// the trailer offset is the negated size of the region index
+	ril := -trailer.Offset / entryInfoSize
+	if trailer.Offset%entryInfoSize != 0 || ril < 1 || ril > il {
+		return nil, fmt.Errorf("region index length %d out of range: %w", ril, ErrHeaderInvalid)
+	}
+	return &HeaderRegion{Tag: region.Tag, IndexLength: int(ril), DataLength: int(region.Offset + entryInfoSize)}, nil
+}
@@ -0,0 +1,116 @@
+package rpmdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHeaderRegion(t *testing.T) {
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_SIZE, Type: RPM_INT32_TYPE, Value: int32(10)},
+	)
+
+	region, err := ParseHeaderRegion(blob)
+	require.NoError(t, err)
+	// "foo\x00", the int32 and the trailer
+	assert.Equal(t, &HeaderRegion{Tag: RPMTAG_HEADERIMMUTABLE, IndexLength: 3, DataLength: 4 + 4 + entryInfoSize}, region)
+
+	region, err = ParseHeaderRegion(rpmSignatureSection(t, "testdata/rpm/epel-release-7-5.noarch.rpm"))
+	require.NoError(t, err)
+	require.NotNil(t, region)
+	assert.Equal(t, int32(RPMTAG_HEADERSIGNATURES), region.Tag)
+
+	region, err = ParseHeaderRegion(rpmHeaderSection(t, "testdata/rpm/epel-release-7-5.noarch.rpm"))
+	require.NoError(t, err)
+	require.NotNil(t, region)
+	assert.Equal(t, int32(RPMTAG_HEADERIMMUTABLE), region.Tag)
+}
+
+func TestParseHeaderRegion_Fixture(t *testing.T) {
+	db, err := Open("testdata/centos7-plain/Packages")
+	require.NoError(t, err)
+
+	for entry := range db.db.Read() {
+		require.NoError(t, entry.Err)
+		region, err := ParseHeaderRegion(entry.Value)
+		require.NoError(t, err)
+		require.NotNil(t, region)
+
+		// the install-time tags (e.g. RPMTAG_INSTALLTIME) are appended after the region
+		il := int(binary.BigEndian.Uint32(entry.Value))
+		dl := int(binary.BigEndian.Uint32(entry.Value[4:]))
+		assert.Less(t, region.IndexLength, il)
+		assert.Less(t, region.DataLength, dl)
+	}
+}
+
+func TestParseHeaderRegion_WithoutRegion(t *testing.T) {
+	blob := withoutRegion(newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_VERSION, Type: RPM_STRING_TYPE, Value: "1.0"},
+	))
+
+	region, err := ParseHeaderRegion(blob)
+	require.NoError(t, err)
+	assert.Nil(t, region)
+
+	// the first entry is a tag of the package like any other
+	pkg, err := ParseHeader(blob)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", pkg.Name)
+	assert.Equal(t, "1.0", pkg.Version)
+}
+
+func TestParseHeaderRegion_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(blob []byte)
+	}{
+		{
+			name: "region type",
+			corrupt: func(blob []byte) {
+				binary.BigEndian.PutUint32(blob[headerPreambleSize+4:], RPM_INT32_TYPE)
+			},
+		},
+		{
+			name: "trailer tag",
+			corrupt: func(blob []byte) {
+				binary.BigEndian.PutUint32(blob[len(blob)-entryInfoSize:], RPMTAG_HEADERSIGNATURES)
+			},
+		},
+		{
+			name: "trailer offset",
+			corrupt: func(blob []byte) {
+				// the region would span more entries than the header has
+				offset := int32(-100 * entryInfoSize)
+				binary.BigEndian.PutUint32(blob[len(blob)-entryInfoSize+8:], uint32(offset))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blob := newTestHeader(testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"})
+			test.corrupt(blob)
+
+			_, err := ParseHeaderRegion(blob)
+			assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+			_, err = ParseHeader(blob)
+			assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+		})
+	}
+}
+
+// withoutRegion removes the region entry of a header built by newTestHeader, leaving the trailer as unused data.
+func withoutRegion(blob []byte) []byte {
+	il := binary.BigEndian.Uint32(blob)
+	stripped := make([]byte, 0, len(blob)-entryInfoSize)
+	stripped = binary.BigEndian.AppendUint32(stripped, il-1)
+	stripped = append(stripped, blob[4:headerPreambleSize]...)
+	return append(stripped, blob[headerPreambleSize+entryInfoSize:]...)
+}
@@ -0,0 +1,853 @@
+package rpmdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHeader_Fixture(t *testing.T) {
+	const fixture = "testdata/centos7-plain/Packages"
+	expected := listFixturePackages(t, fixture)
+
+	db, err := Open(fixture)
+	require.NoError(t, err)
+
+	i := 0
+	for entry := range db.db.Read() {
+		require.NoError(t, entry.Err)
+		require.Less(t, i, len(expected))
+
+		for name, blob := range map[string][]byte{
+			"without magic": entry.Value,
+			"with magic":    append(append([]byte{}, headerMagic...), entry.Value...),
+		} {
+			pkg, err := ParseHeader(blob)
+			require.NoError(t, err, name)
+			for _, d := range deep.Equal(expected[i], pkg) {
+				t.Errorf("%s (%s): %s", expected[i].Name, name, d)
+			}
+
+			entries, err := ParseHeaderEntries(blob)
+			require.NoError(t, err, name)
+			assert.Equal(t, int(binary.BigEndian.Uint32(entry.Value))-1, len(entries))
+		}
+		i++
+	}
+	assert.Equal(t, len(expected), i)
+}
+
+func TestParseHeader_FixtureFields(t *testing.T) {
+	const (
+		centos7 = "testdata/centos7-plain/Packages"
+		centos6 = "testdata/centos6-plain/Packages"
+		httpd24 = "testdata/centos7-httpd24/Packages"
+	)
+	// every fixture is parsed only once, however many fields are read from it
+	fixtures := map[string][]*PackageInfo{}
+	packages := func(t *testing.T, fixture string) []*PackageInfo {
+		if _, ok := fixtures[fixture]; !ok {
+			fixtures[fixture] = listFixturePackages(t, fixture)
+		}
+		return fixtures[fixture]
+	}
+
+	tests := []struct {
+		name     string
+		fixtures []string
+		// pkg is the name of the package to check, every package (but the imported keys) is checked when empty
+		pkg      string
+		field    func(pkg *PackageInfo) interface{}
+		expected interface{}
+	}{
+		{
+			name:     "summary",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Summary },
+			expected: "The GNU Bourne Again shell",
+		},
+		{
+			name:  "description",
+			pkg:   "bash",
+			field: func(pkg *PackageInfo) interface{} { return pkg.Description },
+			expected: "The GNU Bourne Again shell (Bash) is a shell or command language\n" +
+				"interpreter that is compatible with the Bourne shell (sh). Bash\n" +
+				"incorporates useful features from the Korn shell (ksh) and the C shell\n" +
+				"(csh). Most sh scripts can be run by bash without modification.",
+		},
+		{
+			name:     "url",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.URL },
+			expected: "http://www.gnu.org/software/bash",
+		},
+		{
+			name:     "url",
+			pkg:      "ncurses",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.URL },
+			expected: "http://invisible-island.net/ncurses/ncurses.html",
+		},
+		{
+			name:     "packager",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Packager },
+			expected: "CentOS BuildSystem <http://bugs.centos.org>",
+		},
+		{
+			name:     "packager",
+			pkg:      "ncurses",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Packager },
+			expected: "CentOS BuildSystem <http://bugs.centos.org>",
+		},
+		{
+			name:     "distribution",
+			fixtures: []string{httpd24},
+			pkg:      "httpd24-httpd",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Distribution },
+			expected: "CentOS",
+		},
+		{
+			name:     "distribution",
+			fixtures: []string{httpd24},
+			pkg:      "epel-release",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Distribution },
+			expected: "Fedora Project",
+		},
+		{
+			// not every package records a distribution
+			name:     "distribution",
+			fixtures: []string{httpd24},
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Distribution },
+			expected: "",
+		},
+		{
+			// modularity was introduced with RHEL 8, the CentOS 7 packages do not carry a label
+			name:     "modularitylabel",
+			fixtures: []string{centos7},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Modularitylabel },
+			expected: "",
+		},
+		{
+			name:     "os",
+			fixtures: []string{centos7, sqliteFixture, centos6},
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.OS },
+			expected: "linux",
+		},
+		{
+			name:     "platform",
+			fixtures: []string{centos7, sqliteFixture, centos6},
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Platform },
+			expected: "x86_64-redhat-linux-gnu",
+		},
+		{
+			name:     "os",
+			fixtures: []string{centos7, sqliteFixture, centos6},
+			pkg:      "tzdata",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.OS },
+			expected: "linux",
+		},
+		{
+			name:     "platform",
+			fixtures: []string{centos7, sqliteFixture, centos6},
+			pkg:      "tzdata",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Platform },
+			expected: "noarch-redhat-linux-gnu",
+		},
+		{
+			name:     "build time",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.BuildTime },
+			expected: 1523408122,
+		},
+		{
+			name:     "build host",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.BuildHost },
+			expected: "x86-01.bsys.centos.org",
+		},
+		{
+			name:     "build time",
+			pkg:      "ncurses",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.BuildTime },
+			expected: 1504735709,
+		},
+		{
+			name:     "build host",
+			pkg:      "ncurses",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.BuildHost },
+			expected: "c1bm.rdu2.centos.org",
+		},
+		{
+			name:     "rpm version",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.RPMVersion },
+			expected: "4.11.3",
+		},
+		{
+			// the binary packages were rebuilt from the source package, which leaves out the cookie
+			name:     "cookie",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Cookie },
+			expected: "",
+		},
+		{
+			name:  "optflags",
+			pkg:   "bash",
+			field: func(pkg *PackageInfo) interface{} { return pkg.OptFlags },
+			expected: "-O2 -g -pipe -Wall -Wp,-D_FORTIFY_SOURCE=2 -fexceptions -fstack-protector-strong " +
+				"--param=ssp-buffer-size=4 -grecord-gcc-switches   -m64 -mtune=generic",
+		},
+		{
+			name:     "install time",
+			pkg:      "bash",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.InstallTime },
+			expected: 1538853263,
+		},
+		{
+			name:     "install time",
+			pkg:      "ncurses",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.InstallTime },
+			expected: 1538853263,
+		},
+		{
+			name:     "install time",
+			pkg:      "glibc",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.InstallTime },
+			expected: 1538853267,
+		},
+		{
+			// every package of the base image was installed by a single transaction
+			name:     "install tid",
+			fixtures: []string{centos7},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.InstallTID },
+			expected: 1538853262,
+		},
+		{
+			name:     "remove tid",
+			fixtures: []string{centos7},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.RemoveTID },
+			expected: 0,
+		},
+		{
+			// x86_64 is a multilib architecture, so the transaction accepted both 32-bit and 64-bit files
+			name:     "install color",
+			fixtures: []string{centos7},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.InstallColor },
+			expected: 3,
+		},
+		{
+			name:     "payload format",
+			fixtures: []string{centos7, sqliteFixture, centos6},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.PayloadFormat },
+			expected: "cpio",
+		},
+		{
+			name:     "payload compressor",
+			fixtures: []string{centos7, sqliteFixture, centos6},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.PayloadCompressor },
+			expected: "xz",
+		},
+		{
+			name:     "payload flags",
+			fixtures: []string{centos7, sqliteFixture, centos6},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.PayloadFlags },
+			expected: "2",
+		},
+		{
+			// payload digests are only recorded by rpm 4.14 and later
+			name:     "payload digest",
+			fixtures: []string{centos7},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.PayloadDigest },
+			expected: "",
+		},
+		{
+			name:     "payload digest algorithm",
+			fixtures: []string{centos7},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.PayloadDigestAlgorithm },
+			expected: DigestAlgorithm(0),
+		},
+		{
+			// like the cookie, the source package ID is only recorded when built along with the source package
+			name:     "source pkgid",
+			fixtures: []string{centos7},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.SourcePkgID },
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		if test.fixtures == nil {
+			test.fixtures = []string{centos7, sqliteFixture}
+		}
+		for _, fixture := range test.fixtures {
+			t.Run(test.name+"/"+test.pkg+"/"+fixture, func(t *testing.T) {
+				found := false
+				for _, pkg := range packages(t, fixture) {
+					if pkg.Name == test.pkg || test.pkg == "" && pkg.Name != "gpg-pubkey" {
+						found = true
+						assert.Equal(t, test.expected, test.field(pkg), pkg.Name)
+					}
+				}
+				assert.True(t, found, "package %q not found", test.pkg)
+			})
+		}
+	}
+}
+
+func TestParseHeader_TagFields(t *testing.T) {
+	digest := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	tests := []struct {
+		name     string
+		entries  []testEntry
+		field    func(pkg *PackageInfo) interface{}
+		expected interface{}
+	}{
+		{
+			name: "translated summary",
+			entries: []testEntry{
+				{Tag: RPMTAG_SUMMARY, Type: RPM_I18NSTRING_TYPE, Value: []string{"A foo", "Ein Foo"}},
+				{Tag: RPMTAG_DESCRIPTION, Type: RPM_I18NSTRING_TYPE, Value: []string{"Foo does things.", "Foo macht Dinge."}},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return []string{pkg.Summary, pkg.Description} },
+			expected: []string{"A foo", "Foo does things."},
+		},
+		{
+			name: "plain string summary",
+			entries: []testEntry{
+				{Tag: RPMTAG_SUMMARY, Type: RPM_STRING_TYPE, Value: "A foo"},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return []string{pkg.Summary, pkg.Description} },
+			expected: []string{"A foo", ""},
+		},
+		{
+			name: "url without value",
+			entries: []testEntry{
+				{Tag: RPMTAG_URL, Type: RPM_STRING_TYPE, Value: "(none)"},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.URL },
+			expected: "",
+		},
+		{
+			name: "packager",
+			entries: []testEntry{
+				{Tag: RPMTAG_PACKAGER, Type: RPM_STRING_TYPE, Value: "Fedora Project"},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Packager },
+			expected: "Fedora Project",
+		},
+		{
+			name: "packager without value",
+			entries: []testEntry{
+				{Tag: RPMTAG_PACKAGER, Type: RPM_STRING_TYPE, Value: "(none)"},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Packager },
+			expected: "",
+		},
+		{
+			name: "empty packager",
+			entries: []testEntry{
+				{Tag: RPMTAG_PACKAGER, Type: RPM_STRING_TYPE, Value: ""},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Packager },
+			expected: "",
+		},
+		{
+			name: "distribution",
+			entries: []testEntry{
+				{Tag: RPMTAG_DISTRIBUTION, Type: RPM_STRING_TYPE, Value: "Fedora Project"},
+				{Tag: RPMTAG_DISTTAG, Type: RPM_STRING_TYPE, Value: "fc38"},
+				{Tag: RPMTAG_DISTURL, Type: RPM_STRING_TYPE, Value: "obs://build.opensuse.org/openSUSE:Leap:15.5/standard/0123456789abcdef-foo"},
+			},
+			field: func(pkg *PackageInfo) interface{} {
+				return []string{pkg.Distribution, pkg.DistTag, pkg.DistURL}
+			},
+			expected: []string{"Fedora Project", "fc38", "obs://build.opensuse.org/openSUSE:Leap:15.5/standard/0123456789abcdef-foo"},
+		},
+		{
+			name: "distribution without value",
+			entries: []testEntry{
+				{Tag: RPMTAG_DISTRIBUTION, Type: RPM_STRING_TYPE, Value: "(none)"},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Distribution },
+			expected: "",
+		},
+		{
+			name: "modularitylabel",
+			entries: []testEntry{
+				{Tag: RPMTAG_MODULARITYLABEL, Type: RPM_STRING_TYPE, Value: "nodejs:14:8040020210817081431:9f9e2e7e"},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.Modularitylabel },
+			expected: "nodejs:14:8040020210817081431:9f9e2e7e",
+		},
+		{
+			name: "cookie and optflags",
+			entries: []testEntry{
+				{Tag: RPMTAG_COOKIE, Type: RPM_STRING_TYPE, Value: "buildhost 1523408122"},
+				{Tag: RPMTAG_OPTFLAGS, Type: RPM_STRING_TYPE, Value: "-O2 -g"},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return []string{pkg.Cookie, pkg.OptFlags} },
+			expected: []string{"buildhost 1523408122", "-O2 -g"},
+		},
+		{
+			name:     "no install time",
+			field:    func(pkg *PackageInfo) interface{} { return pkg.InstallTime },
+			expected: 0,
+		},
+		{
+			name: "transaction ids",
+			entries: []testEntry{
+				{Tag: RPMTAG_INSTALLTID, Type: RPM_INT32_TYPE, Value: int32(1538853262)},
+				{Tag: RPMTAG_REMOVETID, Type: RPM_INT32_TYPE, Value: int32(1556442593)},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return []int{pkg.InstallTID, pkg.RemoveTID} },
+			expected: []int{1538853262, 1556442593},
+		},
+		{
+			name: "payload digest",
+			entries: []testEntry{
+				{Tag: RPMTAG_PAYLOADDIGEST, Type: RPM_STRING_ARRAY_TYPE, Value: []string{digest}},
+				{Tag: RPMTAG_PAYLOADDIGESTALGO, Type: RPM_INT32_TYPE, Value: []int32{PGPHASHALGO_SHA256}},
+			},
+			field: func(pkg *PackageInfo) interface{} {
+				return []string{pkg.PayloadDigest, pkg.PayloadDigestAlgorithm.String()}
+			},
+			expected: []string{digest, "sha256"},
+		},
+		{
+			name: "source pkgid",
+			entries: []testEntry{
+				{Tag: RPMTAG_SOURCEPKGID, Type: RPM_BIN_TYPE, Value: []byte{0x8c, 0xa9, 0x3e, 0x28, 0x31, 0x10, 0x28, 0x18, 0x75, 0x9a, 0x22, 0xe2, 0x2e, 0x87, 0x12, 0x68}},
+			},
+			field:    func(pkg *PackageInfo) interface{} { return pkg.SourcePkgID },
+			expected: "8ca93e2831102818759a22e22e871268",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries := append([]testEntry{{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"}}, test.entries...)
+			pkg, err := ParseHeader(newTestHeader(entries...))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, test.field(pkg))
+		})
+	}
+}
+
+func TestParseHeader_TagTypeErrors(t *testing.T) {
+	tests := []struct {
+		entry        testEntry
+		expectedName string
+	}{
+		{entry: testEntry{Tag: RPMTAG_SUMMARY, Type: RPM_INT32_TYPE, Value: []int32{1}}, expectedName: "summary"},
+		{entry: testEntry{Tag: RPMTAG_DISTTAG, Type: RPM_INT32_TYPE, Value: []int32{38}}, expectedName: "disttag"},
+		{entry: testEntry{Tag: RPMTAG_MODULARITYLABEL, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"nodejs:14"}}, expectedName: "modularitylabel"},
+		{entry: testEntry{Tag: RPMTAG_PLATFORM, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"x86_64-redhat-linux-gnu"}}, expectedName: "platform"},
+		{entry: testEntry{Tag: RPMTAG_BUILDTIME, Type: RPM_STRING_TYPE, Value: "1523408122"}, expectedName: "buildtime"},
+		{entry: testEntry{Tag: RPMTAG_COOKIE, Type: RPM_INT32_TYPE, Value: []int32{1}}, expectedName: "cookie"},
+		{entry: testEntry{Tag: RPMTAG_INSTALLTID, Type: RPM_STRING_TYPE, Value: "1538853262"}, expectedName: "installtid"},
+		{entry: testEntry{Tag: RPMTAG_INSTALLCOLOR, Type: RPM_STRING_TYPE, Value: "3"}, expectedName: "installcolor"},
+		{entry: testEntry{Tag: RPMTAG_LONGSIZE, Type: RPM_INT32_TYPE, Value: int32(10)}, expectedName: "longsize"},
+		{entry: testEntry{Tag: RPMTAG_PAYLOADCOMPRESSOR, Type: RPM_BIN_TYPE, Value: []byte("zstd")}, expectedName: "payloadcompressor"},
+		{entry: testEntry{Tag: RPMTAG_PAYLOADDIGESTALGO, Type: RPM_STRING_TYPE, Value: "sha256"}, expectedName: "payload digest algo"},
+		{entry: testEntry{Tag: RPMTAG_SOURCEPKGID, Type: RPM_STRING_TYPE, Value: "8ca93e2831102818759a22e22e871268"}, expectedName: "sourcepkgid"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expectedName, func(t *testing.T) {
+			_, err := ParseHeader(newTestHeader(
+				testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+				test.entry,
+			))
+			assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+			var typeErr *TagTypeError
+			require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+			assert.Equal(t, test.expectedName, typeErr.Name)
+		})
+	}
+}
+
+func TestParseHeader_NoneNormalization(t *testing.T) {
+	entries := []testEntry{{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"}}
+	for _, tag := range []int32{RPMTAG_SOURCERPM, RPMTAG_LICENSE, RPMTAG_VENDOR, RPMTAG_URL, RPMTAG_PACKAGER,
+		RPMTAG_DISTRIBUTION, RPMTAG_DISTTAG, RPMTAG_DISTURL, RPMTAG_MODULARITYLABEL, RPMTAG_BUILDHOST, RPMTAG_PLATFORM,
+		RPMTAG_COOKIE, RPMTAG_OPTFLAGS, RPMTAG_OS, RPMTAG_ENCODING, RPMTAG_RPMVERSION, RPMTAG_PAYLOADFORMAT,
+		RPMTAG_PAYLOADCOMPRESSOR, RPMTAG_PAYLOADFLAGS} {
+		entries = append(entries, testEntry{Tag: tag, Type: RPM_STRING_TYPE, Value: "(none)"})
+	}
+	for _, tag := range []int32{RPMTAG_SUMMARY, RPMTAG_DESCRIPTION, RPMTAG_GROUP} {
+		entries = append(entries, testEntry{Tag: tag, Type: RPM_I18NSTRING_TYPE, Value: []string{"(none)"}})
+	}
+	blob := newTestHeader(entries...)
+
+	values := func(pkg *PackageInfo) []string {
+		return []string{pkg.SourceRpm, pkg.License, pkg.Vendor, pkg.URL, pkg.Packager, pkg.Distribution, pkg.DistTag,
+			pkg.DistURL, pkg.Modularitylabel, pkg.BuildHost, pkg.Platform, pkg.Cookie, pkg.OptFlags, pkg.OS, pkg.Encoding,
+			pkg.RPMVersion, pkg.PayloadFormat, pkg.PayloadCompressor, pkg.PayloadFlags, pkg.Summary, pkg.Description,
+			pkg.Group}
+	}
+
+	pkg, err := ParseHeader(blob)
+	require.NoError(t, err)
+	for i, value := range values(pkg) {
+		assert.Empty(t, value, "value %d", i)
+	}
+
+	pkg, err = ParseHeader(blob, WithNoneNormalization(false))
+	require.NoError(t, err)
+	for i, value := range values(pkg) {
+		assert.Equal(t, "(none)", value, "value %d", i)
+	}
+	assert.Equal(t, "foo", pkg.Name)
+}
+
+func TestParseHeader_TransactionIDs(t *testing.T) {
+	transactions := map[int][]string{}
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-many/Packages") {
+		transactions[pkg.InstallTID] = append(transactions[pkg.InstallTID], pkg.Name)
+	}
+	assert.Len(t, transactions, 7)
+	assert.Len(t, transactions[1538853262], 125)
+	assert.Contains(t, transactions[1556442593], "glibc")
+}
+
+func TestParseHeader_FileColors(t *testing.T) {
+	colors := map[string]int32{}
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-plain/Packages") {
+		if pkg.Name != "bash" {
+			continue
+		}
+		for _, f := range pkg.Files {
+			colors[f.Path] = f.Color
+		}
+	}
+	assert.Equal(t, int32(2), colors["/usr/bin/bash"])
+	assert.Equal(t, int32(0), colors["/etc/skel/.bashrc"])
+}
+
+func TestParseHeader_Prefixes(t *testing.T) {
+	var pkg *PackageInfo
+	for _, p := range listFixturePackages(t, "testdata/centos6-many/Packages") {
+		if p.Name == "libxslt" {
+			pkg = p
+			continue
+		}
+		assert.Empty(t, p.Prefixes, p.Name)
+		assert.Empty(t, p.InstPrefixes, p.Name)
+	}
+	require.NotNil(t, pkg)
+	assert.Equal(t, []string{"/usr"}, pkg.Prefixes)
+	assert.Equal(t, []string{"/usr"}, pkg.InstPrefixes)
+	for _, f := range pkg.Files {
+		assert.True(t, strings.HasPrefix(f.Path, "/usr/"), f.Path)
+	}
+
+	// rpm rewrites the file paths of relocated packages before storing the header, they are reported as-is
+	pkg, err := ParseHeader(newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_PREFIXES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr"}},
+		testEntry{Tag: RPMTAG_INSTPREFIXES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/opt/foo"}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/opt/foo/bin/"}},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/usr"}, pkg.Prefixes)
+	assert.Equal(t, []string{"/opt/foo"}, pkg.InstPrefixes)
+	require.Len(t, pkg.Files, 1)
+	assert.Equal(t, "/opt/foo/bin/foo", pkg.Files[0].Path)
+}
+
+func TestParseHeader_LongSizes(t *testing.T) {
+	// the 32-bit sizes are unsigned, so they are widened without sign extension
+	pkg, err := ParseHeader(newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_SIZE, Type: RPM_INT32_TYPE, Value: int32(-268435456)},
+		testEntry{Tag: RPMTAG_ARCHIVESIZE, Type: RPM_INT32_TYPE, Value: int32(-268435456)},
+		testEntry{Tag: RPMTAG_SIGSIZE, Type: RPM_INT32_TYPE, Value: int32(1 << 20)},
+		testEntry{Tag: RPMTAG_FILESIZES, Type: RPM_INT32_TYPE, Value: []int32{-268435456}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/share/foo/"}},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0xf0000000), pkg.LongSize)
+	assert.Equal(t, int64(0xf0000000), pkg.ArchiveSize)
+	assert.Equal(t, 1<<20, pkg.Signatures.Size)
+	require.Len(t, pkg.Files, 1)
+	assert.Equal(t, int64(0xf0000000), pkg.Files[0].LongSize)
+
+	// packages of 4GiB and more record the 64-bit tags instead
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_LONGSIZE, Type: RPM_INT64_TYPE, Value: []int64{5 << 30}},
+		testEntry{Tag: RPMTAG_LONGARCHIVESIZE, Type: RPM_INT64_TYPE, Value: []int64{5<<30 + 512}},
+		testEntry{Tag: RPMTAG_LONGSIGSIZE, Type: RPM_INT64_TYPE, Value: []int64{6 << 30}},
+		testEntry{Tag: RPMTAG_LONGFILESIZES, Type: RPM_INT64_TYPE, Value: []int64{5<<30 - 10, 10}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"model.bin", "README"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/share/foo/"}},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0}},
+	)
+	for _, opts := range [][]Option{nil, {WithFiles(false)}} {
+		pkg, err = ParseHeader(blob, opts...)
+		require.NoError(t, err)
+		assert.Zero(t, pkg.Size)
+		assert.Equal(t, int64(5<<30), pkg.LongSize)
+		assert.Equal(t, int64(5<<30+512), pkg.ArchiveSize)
+		assert.Equal(t, 6<<30, pkg.Signatures.Size)
+
+		files, err := pkg.FileList()
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+		assert.Equal(t, int64(5<<30-10), files[0].LongSize)
+		assert.Equal(t, int64(10), files[1].LongSize)
+	}
+}
+
+func TestParseHeader_OldFileNames(t *testing.T) {
+	entries := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_OLDFILENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/foo", "/usr/share/doc/foo-1.0/README"}},
+		{Tag: RPMTAG_FILESIZES, Type: RPM_INT32_TYPE, Value: []int32{1024, 42}},
+		{Tag: RPMTAG_FILEFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0, RPMFILE_DOC}},
+	}
+
+	pkg, err := ParseHeader(newTestHeader(entries...))
+	require.NoError(t, err)
+	require.Len(t, pkg.Files, 2)
+	assert.Equal(t, "/usr/bin/foo", pkg.Files[0].Path)
+	assert.Equal(t, int32(1024), pkg.Files[0].Size)
+	assert.Equal(t, "/usr/share/doc/foo-1.0/README", pkg.Files[1].Path)
+	assert.True(t, pkg.Files[1].Flags.IsDoc())
+
+	// the full path is kept as the basename, without a directory
+	pkg, err = ParseHeader(newTestHeader(entries...), WithCompressedPaths())
+	require.NoError(t, err)
+	require.Len(t, pkg.Files, 2)
+	assert.Equal(t, int32(-1), pkg.Files[0].DirIndex)
+	assert.Equal(t, "/usr/bin/foo", pkg.FilePath(pkg.Files[0]))
+
+	// the compressed file names take precedence
+	pkg, err = ParseHeader(newTestHeader(append(entries,
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/bin/"}},
+	)...))
+	require.NoError(t, err)
+	require.Len(t, pkg.Files, 2)
+	assert.Equal(t, "/bin/foo", pkg.Files[0].Path)
+	assert.Equal(t, "/bin/bar", pkg.Files[1].Path)
+}
+
+func TestParseHeader_OrigPaths(t *testing.T) {
+	// installed with --relocate /usr=/opt/foo
+	entries := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_PREFIXES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr"}},
+		{Tag: RPMTAG_INSTPREFIXES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/opt/foo"}},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 1}},
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "README"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/opt/foo/bin/", "/opt/foo/share/doc/foo/"}},
+	}
+
+	pkg, err := ParseHeader(newTestHeader(append(entries,
+		testEntry{Tag: RPMTAG_ORIGDIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 1}},
+		testEntry{Tag: RPMTAG_ORIGBASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "README"}},
+		testEntry{Tag: RPMTAG_ORIGDIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", "/usr/share/doc/foo/"}},
+	)...))
+	require.NoError(t, err)
+	require.Len(t, pkg.Files, 2)
+	assert.Equal(t, "/opt/foo/bin/foo", pkg.Files[0].Path)
+	assert.Equal(t, "/usr/bin/foo", pkg.Files[0].OrigPath)
+	assert.Equal(t, "/opt/foo/share/doc/foo/README", pkg.Files[1].Path)
+	assert.Equal(t, "/usr/share/doc/foo/README", pkg.Files[1].OrigPath)
+	assert.Empty(t, pkg.Warnings)
+
+	// packages that were not relocated have no original paths
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-plain/Packages") {
+		for _, f := range pkg.Files {
+			require.Empty(t, f.OrigPath, f.Path)
+		}
+	}
+
+	pkg, err = ParseHeader(newTestHeader(append(entries,
+		testEntry{Tag: RPMTAG_ORIGDIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 2}},
+		testEntry{Tag: RPMTAG_ORIGBASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "README"}},
+		testEntry{Tag: RPMTAG_ORIGDIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", "/usr/share/doc/foo/"}},
+	)...))
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/bin/foo", pkg.Files[0].OrigPath)
+	assert.Empty(t, pkg.Files[1].OrigPath)
+	assert.Equal(t, []string{`file "/opt/foo/share/doc/foo/README" has no valid original dir index`}, pkg.Warnings)
+}
+
+func TestParseHeader_FilesMissing(t *testing.T) {
+	files := []testEntry{
+		{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+		{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+		{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+	}
+	tests := []struct {
+		name     string
+		entries  []testEntry
+		expected bool
+	}{
+		{
+			name:     "files",
+			entries:  append([]testEntry{{Tag: RPMTAG_SIZE, Type: RPM_INT32_TYPE, Value: int32(100)}}, files...),
+			expected: false,
+		},
+		{
+			name:     "no files",
+			entries:  []testEntry{{Tag: RPMTAG_SIZE, Type: RPM_INT32_TYPE, Value: int32(0)}},
+			expected: false,
+		},
+		{
+			name:     "stripped file list",
+			entries:  []testEntry{{Tag: RPMTAG_SIZE, Type: RPM_INT32_TYPE, Value: int32(100)}},
+			expected: true,
+		},
+		{
+			name:     "stripped file list of a large package",
+			entries:  []testEntry{{Tag: RPMTAG_LONGSIZE, Type: RPM_INT64_TYPE, Value: []int64{5 << 30}}},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries := append([]testEntry{{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"}}, test.entries...)
+			pkg, err := ParseHeader(newTestHeader(entries...))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, pkg.FilesMissing)
+		})
+	}
+
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-many/Packages") {
+		assert.False(t, pkg.FilesMissing, pkg.Name)
+	}
+}
+
+func TestParseHeader_RpmFile(t *testing.T) {
+	blob := rpmHeaderSection(t, "testdata/rpm/epel-release-7-5.noarch.rpm")
+
+	pkg, err := ParseHeader(blob)
+	require.NoError(t, err)
+
+	assert.Equal(t, "epel-release", pkg.Name)
+	assert.Equal(t, "7", pkg.Version)
+	assert.Equal(t, "5", pkg.Release)
+	assert.Equal(t, "noarch", pkg.Arch)
+	assert.Equal(t, "epel-release-7-5.src.rpm", pkg.SourceRpm)
+	assert.Equal(t, PackageKindBinary, pkg.Kind)
+
+	var paths []string
+	for _, f := range pkg.Files {
+		paths = append(paths, f.Path)
+	}
+	assert.Contains(t, paths, "/etc/yum.repos.d/epel.repo")
+
+	entries, err := ParseHeaderEntries(blob)
+	require.NoError(t, err)
+	var found bool
+	for _, entry := range entries {
+		if entry.Tag == RPMTAG_NAME {
+			found = true
+			assert.Equal(t, HeaderEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Count: 1, Data: []byte("epel-release\x00")}, entry)
+		}
+	}
+	assert.True(t, found, "missing name entry")
+}
+
+func TestParseHeader_Invalid(t *testing.T) {
+	_, err := ParseHeader([]byte{0x8e, 0xad, 0xe8, 0x01})
+	assert.Error(t, err)
+
+	_, err = ParseHeaderEntries(nil)
+	assert.Error(t, err)
+}
+
+func TestHeaderEntry_Binary(t *testing.T) {
+	entries, err := ParseHeaderEntries(rpmSignatureSection(t, "testdata/rpm/epel-release-7-5.noarch.rpm"))
+	require.NoError(t, err)
+
+	values := map[int32][]byte{}
+	for _, entry := range entries {
+		if entry.Type == RPM_BIN_TYPE {
+			values[entry.Tag], err = entry.Binary()
+			require.NoError(t, err)
+			assert.Len(t, values[entry.Tag], int(entry.Count))
+		}
+	}
+	assert.Len(t, values[RPMSIGTAG_MD5], 16)
+	assert.NotEmpty(t, values[RPMTAG_RSAHEADER])
+
+	_, err = HeaderEntry{Tag: RPMTAG_SIGMD5, Type: RPM_BIN_TYPE, Count: 16, Data: make([]byte, 8)}.Binary()
+	assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+
+	_, err = HeaderEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Count: 1, Data: []byte("foo\x00")}.Binary()
+	var typeErr *TagTypeError
+	require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+	assert.Equal(t, int32(RPMTAG_NAME), typeErr.Tag)
+}
+
+// rpmHeaderSection returns the main header of the given .rpm file (starting with the header magic), skipping the lead
+// and signature header.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/package.c
+func rpmHeaderSection(t *testing.T, path string) []byte {
+	t.Helper()
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	sigSize := len(rpmSignatureSection(t, path))
+	// the signature header is padded to an 8 byte boundary
+	sigSize += (8 - sigSize%8) % 8
+
+	return contents[rpmLeadSize+sigSize:]
+}
+
+// rpmSignatureSection returns the signature header (including the header magic) of the given .rpm file.
+func rpmSignatureSection(t *testing.T, path string) []byte {
+	t.Helper()
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	sig := contents[rpmLeadSize:]
+	require.Equal(t, headerMagic[:4], sig[:4], "missing signature header magic")
+
+	il := binary.BigEndian.Uint32(sig[8:])
+	dl := binary.BigEndian.Uint32(sig[12:])
+	return sig[:len(headerMagic)+headerPreambleSize+int(il)*entryInfoSize+int(dl)]
+}
+
+func FuzzParseHeader(f *testing.F) {
+	f.Add(newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: []uint16{0100644}},
+		testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+		testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/"}},
+	))
+	f.Add(negativeDependsIndexHeader)
+	for _, name := range []string{"negative-count.hdr", "negative-index-length.hdr"} {
+		blob, err := ioutil.ReadFile("testdata/invalid-headers/" + name)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(blob)
+	}
+
+	f.Fuzz(func(t *testing.T, blob []byte) {
+		// any input must either parse or return an error, never panic
+		_, _ = ParseHeader(blob)
+	})
+}
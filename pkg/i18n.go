@@ -0,0 +1,85 @@
+package rpmdb
+
+import "strings"
+
+// i18nDefaultLocale is the locale of the untranslated text within RPM_I18NSTRING_TYPE entries.
+const i18nDefaultLocale = "C"
+
+// i18nTable returns the locales listed in RPMTAG_HEADERI18NTABLE, which name the translations of every
+// RPM_I18NSTRING_TYPE entry by position (nil when the header carries no table).
+func i18nTable(indexEntries []indexEntry) []string {
+	for _, entry := range indexEntries {
+		if entry.Info.Tag == RPMTAG_HEADERI18NTABLE && entry.Info.Type == RPM_STRING_ARRAY_TYPE {
+			return parseStringArray(entry.Data, entry.Info.Count)
+		}
+	}
+	return nil
+}
+
+// parseI18NString returns the text of an RPM_I18NSTRING_TYPE entry in the first of the preferred locales that has a
+// translation, otherwise the untranslated (C locale) text, falling back to the first translation when the locale
+// table does not list the C locale. Plain RPM_STRING_TYPE entries are returned as-is.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header.c (headerFindI18NString)
+func parseI18NString(entry indexEntry, locales []string, preferred []string) string {
+	if entry.Info.Type != RPM_I18NSTRING_TYPE {
+		return parseString(entry.Data)
+	}
+
+	translations := parseStringArray(entry.Data, entry.Info.Count)
+	if len(translations) == 0 {
+		return ""
+	}
+	if len(locales) > len(translations) {
+		locales = locales[:len(translations)]
+	}
+
+	for _, locale := range preferred {
+		weak := -1
+		for i, candidate := range locales {
+			switch i18nMatchLocale(candidate, locale) {
+			case i18nMatchExact:
+				return translations[i]
+			case i18nMatchLanguage:
+				if weak < 0 {
+					weak = i
+				}
+			}
+		}
+		if weak >= 0 {
+			return translations[weak]
+		}
+	}
+
+	for i, locale := range locales {
+		if locale == i18nDefaultLocale {
+			return translations[i]
+		}
+	}
+	return translations[0]
+}
+
+const (
+	i18nNoMatch = iota
+	i18nMatchExact
+	i18nMatchLanguage
+)
+
+// i18nMatchLocale compares a locale of the i18n table with a requested locale of the form
+// language[_territory][.codeset][@modifier]. Like rpm, the modifier and the codeset of the requested locale are
+// optional, while a match on the language alone is only used when there is no better one.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header.c (headerMatchLocale)
+func i18nMatchLocale(candidate, locale string) int {
+	if candidate == locale {
+		return i18nMatchExact
+	}
+	if i := strings.IndexByte(locale, '@'); i >= 0 && candidate == locale[:i] {
+		return i18nMatchExact
+	}
+	if i := strings.IndexByte(locale, '.'); i >= 0 && candidate == locale[:i] {
+		return i18nMatchExact
+	}
+	if i := strings.IndexByte(locale, '_'); i >= 0 && candidate == locale[:i] {
+		return i18nMatchLanguage
+	}
+	return i18nNoMatch
+}
@@ -0,0 +1,123 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseI18NString(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  []testEntry
+		locales  []string
+		expected string
+	}{
+		{
+			name: "C locale first",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"C", "de"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Applications/System", "Anwendungen/System"}},
+			},
+			expected: "Applications/System",
+		},
+		{
+			name: "C locale not first",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"de", "C"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Anwendungen/System", "Applications/System"}},
+			},
+			expected: "Applications/System",
+		},
+		{
+			name: "C locale without a translation",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"de", "C"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Anwendungen/System"}},
+			},
+			expected: "Anwendungen/System",
+		},
+		{
+			name: "without locale table",
+			entries: []testEntry{
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Applications/System", "Anwendungen/System"}},
+			},
+			expected: "Applications/System",
+		},
+		{
+			name: "preferred locale",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"C", "de"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Applications/System", "Anwendungen/System"}},
+			},
+			locales:  []string{"de"},
+			expected: "Anwendungen/System",
+		},
+		{
+			name: "preferred locale with codeset and modifier",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"C", "de_DE", "de"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Applications/System", "Anwendungen/System (DE)", "Anwendungen/System"}},
+			},
+			locales:  []string{"de_DE.UTF-8@euro"},
+			expected: "Anwendungen/System (DE)",
+		},
+		{
+			name: "preferred locale matching the language only",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"C", "de"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Applications/System", "Anwendungen/System"}},
+			},
+			locales:  []string{"de_AT"},
+			expected: "Anwendungen/System",
+		},
+		{
+			name: "first preferred locale with a translation",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"C", "de", "fr"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Applications/System", "Anwendungen/System", "Applications/Système"}},
+			},
+			locales:  []string{"es_ES", "fr_FR", "de_DE"},
+			expected: "Applications/Système",
+		},
+		{
+			name: "preferred locale without a translation",
+			entries: []testEntry{
+				{Tag: RPMTAG_HEADERI18NTABLE, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"C", "de"}},
+				{Tag: RPMTAG_GROUP, Type: RPM_I18NSTRING_TYPE, Value: []string{"Applications/System"}},
+			},
+			locales:  []string{"de"},
+			expected: "Applications/System",
+		},
+		{
+			name: "plain string",
+			entries: []testEntry{
+				{Tag: RPMTAG_GROUP, Type: RPM_STRING_TYPE, Value: "Applications/System"},
+			},
+			expected: "Applications/System",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries := append([]testEntry{{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"}}, test.entries...)
+			pkg, err := ParseHeader(newTestHeader(entries...), WithLocale(test.locales...))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, pkg.Group)
+		})
+	}
+}
+
+func TestPackageGroup(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", "testdata/centos6-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			groups := map[string]string{}
+			for _, pkg := range listFixturePackages(t, fixture) {
+				groups[pkg.Name] = pkg.Group
+			}
+			assert.Equal(t, "System Environment/Shells", groups["bash"])
+			assert.Equal(t, "System Environment/Base", groups["ncurses"])
+		})
+	}
+}
@@ -0,0 +1,421 @@
+// Package image reads the rpm database of a container image, either a docker save tarball or an OCI image layout (a
+// directory or a tarball of one), without extracting the image.
+package image
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	rpmdb "github.com/anchore/go-rpmdb/pkg"
+)
+
+// RPMDirs are the directories an rpm database is looked for in, the database written by the topmost layer wins.
+var RPMDirs = []string{
+	"var/lib/rpm",
+	// the default since rpm 4.16 on Fedora and openSUSE, /var/lib/rpm is a symlink to it
+	"usr/lib/sysimage/rpm",
+}
+
+// dbFiles are the files of an rpm database that are read (the index files of each backend are not needed).
+var dbFiles = map[string]struct{}{
+	"Packages":             {},
+	"Packages.db":          {},
+	"rpmdb.sqlite":         {},
+	"rpmdb.sqlite-wal":     {},
+	"rpmdb.sqlite-journal": {},
+}
+
+// the whiteout markers of a layer, which delete a file (or directory) of the layers below
+// ref. https://github.com/opencontainers/image-spec/blob/v1.0.2/layer.md#whiteouts
+const (
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = ".wh..wh..opq"
+)
+
+const (
+	mediaTypeOCIIndex    = "application/vnd.oci.image.index.v1+json"
+	mediaTypeDockerIndex = "application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+// Open opens the rpm database of the image at the given path. The database files are read into memory (SQLite
+// databases are then copied to a temporary directory, see rpmdb.OpenFS), the image itself is never extracted.
+func Open(imagePath string, opts ...rpmdb.Option) (*rpmdb.RpmDB, error) {
+	img, err := openImage(imagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := img.layers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image %q: %w", imagePath, err)
+	}
+
+	files := make(map[string]layerFile)
+	for i, layer := range layers {
+		if err := readLayer(img, layer, i, files); err != nil {
+			return nil, fmt.Errorf("failed to read layer %q: %w", layer, err)
+		}
+	}
+
+	db, err := openFiles(files, opts)
+	if err != nil {
+		return nil, fmt.Errorf("image %q: %w", imagePath, err)
+	}
+	return db, nil
+}
+
+// OpenTar opens the rpm database in a tar stream of a filesystem (e.g. a single, optionally gzip compressed, layer).
+// The stream is read in a single pass, only the database files are buffered.
+func OpenTar(reader io.Reader, opts ...rpmdb.Option) (*rpmdb.RpmDB, error) {
+	files := make(map[string]layerFile)
+	if err := applyLayer(reader, 0, files); err != nil {
+		return nil, fmt.Errorf("failed to read tar stream: %w", err)
+	}
+	return openFiles(files, opts)
+}
+
+// openFiles opens the database of the topmost layer among the database files.
+func openFiles(files map[string]layerFile, opts []rpmdb.Option) (*rpmdb.RpmDB, error) {
+	dir, ok := topmostDB(files)
+	if !ok {
+		return nil, fmt.Errorf("no rpm database found: %w", fs.ErrNotExist)
+	}
+
+	fsys := memFS{}
+	for name, file := range files {
+		fsys[name] = file.data
+	}
+	return rpmdb.OpenFS(fsys, dir, opts...)
+}
+
+// ListPackages returns the packages of the rpm database of the image at the given path (see Open).
+func ListPackages(imagePath string, opts ...rpmdb.Option) ([]*rpmdb.PackageInfo, error) {
+	db, err := Open(imagePath, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return db.ListPackages()
+}
+
+// layerFile is a database file, along with the index of the layer it was last written by.
+type layerFile struct {
+	data  []byte
+	layer int
+}
+
+// topmostDB returns the rpm directory holding the database written by the highest layer.
+func topmostDB(files map[string]layerFile) (string, bool) {
+	dir, layer := "", -1
+	for _, candidate := range RPMDirs {
+		for _, name := range []string{"rpmdb.sqlite", "Packages.db", "Packages"} {
+			if file, ok := files[path.Join(candidate, name)]; ok && file.layer > layer {
+				dir, layer = candidate, file.layer
+			}
+		}
+	}
+	return dir, layer >= 0
+}
+
+// readLayer applies the changes of a layer to the database files of the layers below.
+func readLayer(img *layout, name string, index int, files map[string]layerFile) error {
+	blob, err := img.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	return applyLayer(blob, index, files)
+}
+
+// applyLayer reads the (optionally gzip compressed) tar stream of a layer, applying its changes to the database files.
+func applyLayer(blob io.Reader, index int, files map[string]layerFile) error {
+	reader, err := decompress(blob)
+	if err != nil {
+		return err
+	}
+
+	// note: whiteouts only apply to the layers below, regardless of where they appear in the layer
+	var deleted []string
+	written := make(map[string]layerFile)
+
+	layer := tar.NewReader(reader)
+	for {
+		header, err := layer.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		name := cleanPath(header.Name)
+		dir, base := path.Split(name)
+		switch {
+		case base == whiteoutOpaque:
+			deleted = append(deleted, strings.TrimSuffix(dir, "/"))
+			continue
+		case strings.HasPrefix(base, whiteoutPrefix):
+			deleted = append(deleted, path.Join(dir, strings.TrimPrefix(base, whiteoutPrefix)))
+			continue
+		}
+
+		if !isDBFile(name) {
+			continue
+		}
+		if header.Typeflag != tar.TypeReg {
+			// e.g. replaced by a symlink, which is followed by looking in each of the rpm directories
+			deleted = append(deleted, name)
+			continue
+		}
+		data, err := io.ReadAll(layer)
+		if err != nil {
+			return fmt.Errorf("failed to read %q: %w", name, err)
+		}
+		written[name] = layerFile{data: data, layer: index}
+	}
+
+	for _, prefix := range deleted {
+		for name := range files {
+			if name == prefix || strings.HasPrefix(name, prefix+"/") {
+				delete(files, name)
+			}
+		}
+	}
+	for name, file := range written {
+		files[name] = file
+	}
+	return nil
+}
+
+func isDBFile(name string) bool {
+	dir, base := path.Split(name)
+	if _, ok := dbFiles[base]; !ok {
+		return false
+	}
+	dir = strings.TrimSuffix(dir, "/")
+	for _, candidate := range RPMDirs {
+		if dir == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanPath normalizes a tar entry name (e.g. "./var/lib/rpm/") to a relative path without a trailing slash.
+func cleanPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+// decompress transparently decompresses gzip compressed layers, uncompressed layers are read as they are.
+func decompress(reader io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(reader)
+	magic, err := buffered.Peek(2)
+	if err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		return gzip.NewReader(buffered)
+	}
+	return buffered, nil
+}
+
+func openImage(imagePath string) (*layout, error) {
+	info, err := os.Stat(imagePath)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &layout{fsys: os.DirFS(imagePath)}, nil
+	}
+	return &layout{fsys: tarFS(imagePath)}, nil
+}
+
+// layout is a docker save or OCI image layout (a directory or a tarball), the layers of which are listed by its
+// manifest.
+type layout struct {
+	fsys fs.FS
+}
+
+// layers lists the layer blobs of the (first) image of the layout, lowest layer first. The docker save manifest is
+// preferred, since docker also writes an OCI index next to it.
+func (l *layout) layers() ([]string, error) {
+	var dockerManifest []struct {
+		Layers []string
+	}
+	err := l.readJSON("manifest.json", &dockerManifest)
+	switch {
+	case err == nil:
+		if len(dockerManifest) == 0 {
+			return nil, fmt.Errorf("manifest.json lists no images: %w", rpmdb.ErrUnsupported)
+		}
+		return dockerManifest[0].Layers, nil
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	return l.ociLayers("index.json")
+}
+
+// ociDescriptor references a blob of an OCI image layout by digest.
+// ref. https://github.com/opencontainers/image-spec/blob/v1.0.2/descriptor.md
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+func (d ociDescriptor) path() (string, error) {
+	algorithm, encoded, ok := strings.Cut(d.Digest, ":")
+	if !ok || algorithm == "" || encoded == "" || strings.ContainsAny(d.Digest, "/.") {
+		return "", fmt.Errorf("invalid digest %q: %w", d.Digest, rpmdb.ErrUnsupported)
+	}
+	return path.Join("blobs", algorithm, encoded), nil
+}
+
+// ociLayers follows the first manifest of the index (descending into nested indexes, e.g. of a multi-platform image)
+// to its layers.
+// ref. https://github.com/opencontainers/image-spec/blob/v1.0.2/image-layout.md
+func (l *layout) ociLayers(indexPath string) ([]string, error) {
+	var index struct {
+		Manifests []ociDescriptor `json:"manifests"`
+	}
+	if err := l.readJSON(indexPath, &index); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("neither a docker save nor an OCI image layout: %w", rpmdb.ErrUnsupported)
+		}
+		return nil, err
+	}
+	if len(index.Manifests) == 0 {
+		return nil, fmt.Errorf("%s lists no manifests: %w", indexPath, rpmdb.ErrUnsupported)
+	}
+
+	descriptor := index.Manifests[0]
+	manifestPath, err := descriptor.path()
+	if err != nil {
+		return nil, err
+	}
+	if descriptor.MediaType == mediaTypeOCIIndex || descriptor.MediaType == mediaTypeDockerIndex {
+		return l.ociLayers(manifestPath)
+	}
+
+	var manifest struct {
+		Layers []ociDescriptor `json:"layers"`
+	}
+	if err := l.readJSON(manifestPath, &manifest); err != nil {
+		return nil, err
+	}
+
+	var layers []string
+	for _, layer := range manifest.Layers {
+		layerPath, err := layer.path()
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, layerPath)
+	}
+	return layers, nil
+}
+
+func (l *layout) readJSON(name string, v interface{}) error {
+	file, err := l.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return nil
+}
+
+// tarFS reads the files of an image layout archived in a tarball. Every file is located by scanning the entry headers
+// of the tarball, the contents of the other entries are skipped over.
+type tarFS string
+
+func (t tarFS) Open(name string) (fs.File, error) {
+	file, err := os.Open(string(t))
+	if err != nil {
+		return nil, err
+	}
+
+	archive := tar.NewReader(file)
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("failed to read %q: %w", string(t), err)
+		}
+		if cleanPath(header.Name) == name && header.Typeflag == tar.TypeReg {
+			return &tarFile{Reader: archive, file: file, info: header.FileInfo()}, nil
+		}
+	}
+
+	_ = file.Close()
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// tarFile is an entry of a tarball, which is read until the tarball is closed.
+type tarFile struct {
+	*tar.Reader
+	file *os.File
+	info fs.FileInfo
+}
+
+func (f *tarFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *tarFile) Close() error {
+	return f.file.Close()
+}
+
+// memFS holds the database files extracted from the layers, by path. Only the files can be opened (the directories
+// holding them cannot), which is all that rpmdb.OpenFS needs.
+type memFS map[string][]byte
+
+func (m memFS) Open(name string) (fs.File, error) {
+	data, ok := m[name]
+	if !ok || !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &memFile{Reader: bytes.NewReader(data), name: path.Base(name)}, nil
+}
+
+// memFile is an open file of a memFS, which supports random access.
+type memFile struct {
+	*bytes.Reader
+	name string
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) {
+	return memFileInfo{name: f.name, size: f.Reader.Size()}, nil
+}
+
+func (f *memFile) Close() error {
+	return nil
+}
+
+type memFileInfo struct {
+	name string
+	size int64
+}
+
+func (i memFileInfo) Name() string       { return i.name }
+func (i memFileInfo) Size() int64        { return i.size }
+func (i memFileInfo) Mode() fs.FileMode  { return 0o444 }
+func (i memFileInfo) ModTime() time.Time { return time.Time{} }
+func (i memFileInfo) IsDir() bool        { return false }
+func (i memFileInfo) Sys() interface{}   { return nil }
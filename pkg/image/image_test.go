@@ -0,0 +1,279 @@
+package image
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	rpmdb "github.com/anchore/go-rpmdb/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	centos6Fixture = "../testdata/centos6-plain/Packages"
+	centos7Fixture = "../testdata/centos7-plain/Packages"
+	sqliteFixture  = "../testdata/centos7-plain-sqlite/rpmdb.sqlite"
+)
+
+func readFixture(t *testing.T, path string) []byte {
+	t.Helper()
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return contents
+}
+
+// archive writes the files (in name order) as a tarball, a nil entry is written as an empty file.
+func archive(t *testing.T, files map[string][]byte, compress bool) []byte {
+	t.Helper()
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buff bytes.Buffer
+	var gz *gzip.Writer
+	writer := tar.NewWriter(&buff)
+	if compress {
+		gz = gzip.NewWriter(&buff)
+		writer = tar.NewWriter(gz)
+	}
+	for _, name := range names {
+		require.NoError(t, writer.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(files[name]))}))
+		_, err := writer.Write(files[name])
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	if gz != nil {
+		require.NoError(t, gz.Close())
+	}
+	return buff.Bytes()
+}
+
+// dockerSave lays out the layers (lowest first) the way docker save does.
+func dockerSave(t *testing.T, layers ...map[string][]byte) []byte {
+	files := make(map[string][]byte)
+	var manifest [1]struct{ Layers []string }
+	for i, layer := range layers {
+		name := string(rune('a'+i)) + "/layer.tar"
+		files[name] = archive(t, layer, false)
+		manifest[0].Layers = append(manifest[0].Layers, name)
+	}
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	files["manifest.json"] = data
+	return archive(t, files, false)
+}
+
+// ociLayout lays out the layers (lowest first) as an OCI image layout, with the manifest behind an image index.
+func ociLayout(t *testing.T, layers ...map[string][]byte) map[string][]byte {
+	files := make(map[string][]byte)
+	addBlob := func(mediaType string, data []byte) ociDescriptor {
+		digest := sha256.Sum256(data)
+		files["blobs/sha256/"+hex.EncodeToString(digest[:])] = data
+		return ociDescriptor{MediaType: mediaType, Digest: "sha256:" + hex.EncodeToString(digest[:])}
+	}
+	addJSON := func(mediaType string, v interface{}) ociDescriptor {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return addBlob(mediaType, data)
+	}
+
+	var manifest struct {
+		Layers []ociDescriptor `json:"layers"`
+	}
+	for _, layer := range layers {
+		manifest.Layers = append(manifest.Layers, addBlob("application/vnd.oci.image.layer.v1.tar+gzip", archive(t, layer, true)))
+	}
+	type index struct {
+		Manifests []ociDescriptor `json:"manifests"`
+	}
+	nested := addJSON(mediaTypeOCIIndex, index{Manifests: []ociDescriptor{addJSON("application/vnd.oci.image.manifest.v1+json", manifest)}})
+	data, err := json.Marshal(index{Manifests: []ociDescriptor{nested}})
+	require.NoError(t, err)
+	files["index.json"] = data
+	files["oci-layout"] = []byte(`{"imageLayoutVersion":"1.0.0"}`)
+	return files
+}
+
+func writeFile(t *testing.T, path string, contents []byte) string {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, contents, 0o600))
+	return path
+}
+
+func countPackages(t *testing.T, fixture string) int {
+	t.Helper()
+	db, err := rpmdb.Open(fixture)
+	require.NoError(t, err)
+	defer db.Close()
+	pkgList, err := db.ListPackages()
+	require.NoError(t, err)
+	return len(pkgList)
+}
+
+func TestListPackages(t *testing.T) {
+	centos6, centos7 := countPackages(t, centos6Fixture), countPackages(t, centos7Fixture)
+	require.NotEqual(t, centos6, centos7)
+
+	base := map[string][]byte{
+		"etc/os-release":       []byte("ID=centos"),
+		"var/lib/rpm/Packages": readFixture(t, centos6Fixture),
+	}
+
+	tests := []struct {
+		name     string
+		layers   []map[string][]byte
+		expected int
+	}{
+		{
+			name:     "single layer",
+			layers:   []map[string][]byte{base},
+			expected: centos6,
+		},
+		{
+			name: "topmost database wins",
+			layers: []map[string][]byte{base, {
+				"./var/lib/rpm/Packages": readFixture(t, centos7Fixture),
+			}},
+			expected: centos7,
+		},
+		{
+			name:     "unrelated layer on top",
+			layers:   []map[string][]byte{base, {"etc/motd": []byte("hello")}},
+			expected: centos6,
+		},
+		{
+			name: "migrated to the sysimage directory",
+			layers: []map[string][]byte{base, {
+				"var/lib/.wh.rpm":                   nil,
+				"usr/lib/sysimage/rpm/rpmdb.sqlite": readFixture(t, sqliteFixture),
+			}},
+			expected: centos7,
+		},
+		{
+			name: "newer database in another directory",
+			layers: []map[string][]byte{base, {
+				"usr/lib/sysimage/rpm/rpmdb.sqlite": readFixture(t, sqliteFixture),
+			}},
+			expected: centos7,
+		},
+		{
+			name: "opaque directory",
+			layers: []map[string][]byte{
+				base,
+				{"usr/lib/sysimage/rpm/rpmdb.sqlite": readFixture(t, sqliteFixture)},
+				{"usr/lib/sysimage/rpm/.wh..wh..opq": nil},
+			},
+			expected: centos6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			images := map[string]string{
+				"docker save":      writeFile(t, filepath.Join(dir, "docker.tar"), dockerSave(t, test.layers...)),
+				"oci layout":       filepath.Join(dir, "oci"),
+				"oci layout (tar)": writeFile(t, filepath.Join(dir, "oci.tar"), archive(t, ociLayout(t, test.layers...), false)),
+			}
+			for name, contents := range ociLayout(t, test.layers...) {
+				writeFile(t, filepath.Join(dir, "oci", name), contents)
+			}
+
+			for name, path := range images {
+				pkgList, err := ListPackages(path)
+				require.NoError(t, err, name)
+				assert.Len(t, pkgList, test.expected, name)
+			}
+		})
+	}
+}
+
+func TestListPackages_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name        string
+		image       string
+		expectedErr error
+	}{
+		{
+			name: "database removed by a whiteout",
+			image: writeFile(t, filepath.Join(dir, "removed.tar"), dockerSave(t,
+				map[string][]byte{"var/lib/rpm/Packages": readFixture(t, centos6Fixture)},
+				map[string][]byte{"var/lib/rpm/.wh.Packages": nil},
+			)),
+			expectedErr: fs.ErrNotExist,
+		},
+		{
+			name:        "not an image",
+			image:       writeFile(t, filepath.Join(dir, "other.tar"), archive(t, map[string][]byte{"README": []byte("hello")}, false)),
+			expectedErr: rpmdb.ErrUnsupported,
+		},
+		{
+			name:        "missing image",
+			image:       filepath.Join(dir, "missing.tar"),
+			expectedErr: fs.ErrNotExist,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ListPackages(test.image)
+			assert.True(t, errors.Is(err, test.expectedErr), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestOpenTar(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string][]byte
+		compress bool
+		expected int
+	}{
+		{
+			name:     "bdb",
+			files:    map[string][]byte{"etc/os-release": []byte("ID=centos"), "var/lib/rpm/Packages": readFixture(t, centos6Fixture)},
+			expected: countPackages(t, centos6Fixture),
+		},
+		{
+			name:     "gzip compressed sqlite",
+			files:    map[string][]byte{"usr/lib/sysimage/rpm/rpmdb.sqlite": readFixture(t, sqliteFixture)},
+			compress: true,
+			expected: countPackages(t, sqliteFixture),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// hide everything but Read, the stream cannot seek
+			stream := struct{ io.Reader }{bytes.NewReader(archive(t, test.files, test.compress))}
+
+			db, err := OpenTar(stream)
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			assert.Len(t, pkgList, test.expected)
+		})
+	}
+
+	_, err := OpenTar(bytes.NewReader(archive(t, map[string][]byte{"etc/os-release": []byte("ID=centos")}, false)))
+	assert.True(t, errors.Is(err, fs.ErrNotExist), "unexpected error: %v", err)
+}
@@ -0,0 +1,47 @@
+package rpmdb
+
+import (
+	"path"
+	"sort"
+)
+
+// ImplicitDirs returns the ancestor directories of every path owned by the package that the package does not own
+// itself (sorted, deduplicated and without trailing slashes). These are the directories that must already exist
+// (or be created, e.g. with "mkdir -p") for the package files to be laid down. The root directory is never included.
+// When the package was read with WithFiles(false) the file list is decoded first (a package whose files cannot be
+// decoded reports no directories).
+func ImplicitDirs(pkg *PackageInfo) []string {
+	if pkg == nil {
+		return nil
+	}
+	files, err := pkg.FileList()
+	if err != nil {
+		return nil
+	}
+
+	owned := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		owned[path.Clean(pkg.FilePath(f))] = struct{}{}
+	}
+
+	implied := make(map[string]struct{})
+	for p := range owned {
+		for dir := path.Dir(p); dir != "/" && dir != "."; dir = path.Dir(dir) {
+			if _, ok := implied[dir]; ok {
+				// all further ancestors have already been visited
+				break
+			}
+			implied[dir] = struct{}{}
+		}
+	}
+
+	var dirs []string
+	for dir := range implied {
+		if _, ok := owned[dir]; ok {
+			continue
+		}
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
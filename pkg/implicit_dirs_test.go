@@ -0,0 +1,110 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImplicitDirs(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		expected []string
+	}{
+		{
+			name:     "no files",
+			files:    nil,
+			expected: nil,
+		},
+		{
+			name:     "file at the root",
+			files:    []string{"/.autorelabel"},
+			expected: nil,
+		},
+		{
+			name:     "root owned by the package",
+			files:    []string{"/", "/bin"},
+			expected: nil,
+		},
+		{
+			name:  "deeply nested file",
+			files: []string{"/usr/share/texlive/texmf-dist/tex/latex/foo/bar/baz.sty"},
+			expected: []string{
+				"/usr",
+				"/usr/share",
+				"/usr/share/texlive",
+				"/usr/share/texlive/texmf-dist",
+				"/usr/share/texlive/texmf-dist/tex",
+				"/usr/share/texlive/texmf-dist/tex/latex",
+				"/usr/share/texlive/texmf-dist/tex/latex/foo",
+				"/usr/share/texlive/texmf-dist/tex/latex/foo/bar",
+			},
+		},
+		{
+			name: "owned directories are excluded",
+			files: []string{
+				"/usr/share/doc/foo",
+				"/usr/share/doc/foo/README",
+				"/usr/share/doc/foo/examples/a/b/c.txt",
+			},
+			expected: []string{
+				"/usr",
+				"/usr/share",
+				"/usr/share/doc",
+				"/usr/share/doc/foo/examples",
+				"/usr/share/doc/foo/examples/a",
+				"/usr/share/doc/foo/examples/a/b",
+			},
+		},
+		{
+			name: "trailing and duplicate slashes are normalized",
+			files: []string{
+				"/etc//foo/",
+				"/etc/foo/bar.conf",
+				"/etc/foo/baz/",
+			},
+			expected: []string{
+				"/etc",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkg := &PackageInfo{}
+			for _, f := range test.files {
+				pkg.Files = append(pkg.Files, FileInfo{Path: f})
+			}
+			assert.Equal(t, test.expected, ImplicitDirs(pkg))
+		})
+	}
+}
+
+func TestImplicitDirs_Fixture(t *testing.T) {
+	for name, opts := range map[string][]Option{"eager": nil, "lazy": {WithFiles(false)}} {
+		t.Run(name, func(t *testing.T) {
+			var pkg *PackageInfo
+			for _, p := range listFixturePackages(t, "testdata/centos7-plain/Packages", opts...) {
+				if p.Name == "ncurses" {
+					pkg = p
+				}
+			}
+			if pkg == nil {
+				t.Fatal("package not found")
+			}
+
+			// note: /usr/share/doc/ncurses-5.9 is owned by the package, so it is not implicit
+			assert.Equal(t, []string{
+				"/usr",
+				"/usr/bin",
+				"/usr/share",
+				"/usr/share/doc",
+				"/usr/share/man",
+				"/usr/share/man/man1",
+				"/usr/share/man/man5",
+				"/usr/share/man/man7",
+			}, ImplicitDirs(pkg))
+		})
+	}
+}
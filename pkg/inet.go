@@ -6,6 +6,9 @@ import (
 	"log"
 )
 
+// Htonl swaps the byte order of the given value.
+//
+// Deprecated: header values are decoded directly as big-endian and no longer need to be swapped after the fact.
 func Htonl(val int32) int32 {
 	buf := new(bytes.Buffer)
 	if err := binary.Write(buf, binary.LittleEndian, val); err != nil {
@@ -20,6 +23,9 @@ func Htonl(val int32) int32 {
 	return val
 }
 
+// HtonlU swaps the byte order of the given value.
+//
+// Deprecated: header values are decoded directly as big-endian and no longer need to be swapped after the fact.
 func HtonlU(val uint32) uint32 {
 	buf := new(bytes.Buffer)
 	if err := binary.Write(buf, binary.LittleEndian, val); err != nil {
@@ -0,0 +1,87 @@
+package rpmdb
+
+import (
+	"fmt"
+	"sync"
+)
+
+// lazyFiles retains the raw file tags of a package header so the file list can be decoded on first use.
+type lazyFiles struct {
+	once sync.Once
+	// mu guards entries being released once decoded (see snapshot)
+	mu            sync.Mutex
+	entries       []indexEntry
+	onlyInstalled bool
+	compressed    bool
+
+	files []FileInfo
+	err   error
+}
+
+func newLazyFiles(indexEntries []indexEntry, opts options) *lazyFiles {
+	lazy := &lazyFiles{onlyInstalled: opts.onlyInstalledFiles, compressed: opts.compressedPaths}
+	for _, entry := range indexEntries {
+		if !isFileTag(entry.Info.Tag) {
+			continue
+		}
+		// copy the tag data so the rest of the header blob can be released
+		entry.Data = append([]byte(nil), entry.Data...)
+		lazy.entries = append(lazy.entries, entry)
+	}
+	return lazy
+}
+
+func (l *lazyFiles) load() ([]FileInfo, error) {
+	l.once.Do(func() {
+		// note: warnings are not reported for lazily decoded files (FileInfo.DigestBytes is still nil for invalid digests)
+		files, _, err := getFileInfo(l.entries, l.compressed)
+		if err != nil {
+			l.err = fmt.Errorf("failed to read package files: %w", err)
+			return
+		}
+		if l.onlyInstalled {
+			files = installedFiles(files)
+		}
+		l.files = files
+		// the raw data is no longer needed once decoded
+		l.mu.Lock()
+		l.entries = nil
+		l.mu.Unlock()
+	})
+	return l.files, l.err
+}
+
+// snapshot returns the raw file tags, or the decoded files when load has already released the raw tags.
+func (l *lazyFiles) snapshot() ([]indexEntry, []FileInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.entries == nil {
+		return nil, l.files
+	}
+	return l.entries, nil
+}
+
+// isFileTag indicates if the given tag is one of the per-file arrays consumed by getFileInfo.
+func isFileTag(tag int32) bool {
+	switch tag {
+	case RPMTAG_FILESIZES, RPMTAG_FILEFLAGS, RPMTAG_FILEDIGESTALGO, RPMTAG_FILEDIGESTS, RPMTAG_FILELINKTOS, RPMTAG_FILEMODES,
+		RPMTAG_BASENAMES, RPMTAG_FILEUSERNAME, RPMTAG_FILEGROUPNAME, RPMTAG_DIRNAMES, RPMTAG_FILECOLORS,
+		RPMTAG_FILEMTIMES, RPMTAG_FILESTATES, RPMTAG_FILECONTEXTS, RPMTAG_DIRINDEXES, RPMTAG_LONGFILESIZES,
+		RPMTAG_FILEDEVICES, RPMTAG_FILEINODES, RPMTAG_FILERDEVS, RPMTAG_FILELANGS,
+		RPMTAG_FILECLASS, RPMTAG_CLASSDICT, RPMTAG_FILEDEPENDSX, RPMTAG_FILEDEPENDSN, RPMTAG_DEPENDSDICT,
+		RPMTAG_FILEVERIFYFLAGS, RPMTAG_FILECAPS, RPMTAG_FILESIGNATURES,
+		RPMTAG_VERITYSIGNATURES, RPMTAG_OLDFILENAMES, RPMTAG_ORIGBASENAMES, RPMTAG_ORIGDIRNAMES, RPMTAG_ORIGDIRINDEXES:
+		return true
+	}
+	return false
+}
+
+// FileList returns the files owned by the package. When the package was read with WithFiles(false) the file
+// list is decoded on the first call and memoized (this is safe for concurrent use), otherwise PackageInfo.Files is
+// returned as-is.
+func (p *PackageInfo) FileList() ([]FileInfo, error) {
+	if p.lazyFiles == nil {
+		return p.Files, nil
+	}
+	return p.lazyFiles.load()
+}
@@ -0,0 +1,182 @@
+package rpmdb
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileList_Lazy(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{
+			name: "all files",
+		},
+		{
+			name: "only installed files",
+			opts: []Option{WithOnlyInstalledFiles()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			eager := listFixturePackages(t, "testdata/centos7-plain/Packages", test.opts...)
+			lazy := listFixturePackages(t, "testdata/centos7-plain/Packages", append(test.opts, WithFiles(false))...)
+			require.Len(t, lazy, len(eager))
+
+			for i := range eager {
+				assert.Nil(t, lazy[i].Files, "files should not be decoded eagerly")
+
+				expected, err := eager[i].FileList()
+				require.NoError(t, err)
+				assert.Equal(t, eager[i].Files, expected)
+
+				actual, err := lazy[i].FileList()
+				require.NoError(t, err)
+				for _, d := range deep.Equal(expected, actual) {
+					t.Errorf("%s: %s", eager[i].Name, d)
+				}
+			}
+		})
+	}
+}
+
+func TestFileList_Concurrent(t *testing.T) {
+	pkgList := listFixturePackages(t, "testdata/centos7-plain/Packages", WithFiles(false))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, p := range pkgList {
+				_, err := p.FileList()
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	first, err := pkgList[0].FileList()
+	require.NoError(t, err)
+	second, err := pkgList[0].FileList()
+	require.NoError(t, err)
+	if len(first) > 0 {
+		assert.True(t, &first[0] == &second[0], "the decoded file list should be memoized")
+	}
+}
+
+func listFixturePackages(t *testing.T, path string, opts ...Option) []*PackageInfo {
+	t.Helper()
+	db, err := Open(path, opts...)
+	require.NoError(t, err)
+	pkgList, err := db.ListPackages()
+	require.NoError(t, err)
+	return pkgList
+}
+
+// syntheticHeaders builds package headers with a realistic amount of file tags, standing in for a large database.
+func syntheticHeaders(packages, filesPerPackage int) [][]byte {
+	var blobs [][]byte
+	for p := 0; p < packages; p++ {
+		var basenames, digests, users []string
+		var dirIndexes, sizes, flags []int32
+		var modes []uint16
+		for f := 0; f < filesPerPackage; f++ {
+			basenames = append(basenames, fmt.Sprintf("file-%d", f))
+			digests = append(digests, fmt.Sprintf("%064x", p*filesPerPackage+f))
+			users = append(users, "root")
+			dirIndexes = append(dirIndexes, int32(f%2))
+			sizes = append(sizes, int32(f*100))
+			flags = append(flags, 0)
+			modes = append(modes, 0100644)
+		}
+		blobs = append(blobs, newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: fmt.Sprintf("package-%d", p)},
+			testEntry{Tag: RPMTAG_VERSION, Type: RPM_STRING_TYPE, Value: "1.0"},
+			testEntry{Tag: RPMTAG_RELEASE, Type: RPM_STRING_TYPE, Value: "1.el8"},
+			testEntry{Tag: RPMTAG_FILESIZES, Type: RPM_INT32_TYPE, Value: sizes},
+			testEntry{Tag: RPMTAG_FILEMODES, Type: RPM_INT16_TYPE, Value: modes},
+			testEntry{Tag: RPMTAG_FILEDIGESTS, Type: RPM_STRING_ARRAY_TYPE, Value: digests},
+			testEntry{Tag: RPMTAG_FILEFLAGS, Type: RPM_INT32_TYPE, Value: flags},
+			testEntry{Tag: RPMTAG_FILEUSERNAME, Type: RPM_STRING_ARRAY_TYPE, Value: users},
+			testEntry{Tag: RPMTAG_FILEGROUPNAME, Type: RPM_STRING_ARRAY_TYPE, Value: users},
+			testEntry{Tag: RPMTAG_DIRINDEXES, Type: RPM_INT32_TYPE, Value: dirIndexes},
+			testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: basenames},
+			testEntry{Tag: RPMTAG_DIRNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/", fmt.Sprintf("/usr/share/package-%d/", p)}},
+		))
+	}
+	return blobs
+}
+
+// BenchmarkListPackages_Files compares listing 10k packages with and without WithFiles(false) when only a few file
+// lists are read, reporting both the allocations and the heap retained by the listed packages.
+func BenchmarkListPackages_Files(b *testing.B) {
+	blobs := syntheticHeaders(10000, 50)
+
+	benchmarks := []struct {
+		name string
+		opts []Option
+	}{
+		{
+			name: "eager",
+		},
+		{
+			name: "lazy",
+			opts: []Option{WithFiles(false)},
+		},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			opts := newOptions(bm.opts...)
+			b.ReportAllocs()
+			var retained uint64
+			for n := 0; n < b.N; n++ {
+				var before runtime.MemStats
+				b.StopTimer()
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+				b.StartTimer()
+
+				var pkgList []*PackageInfo
+				for _, blob := range blobs {
+					indexEntries, err := headerImport(blob)
+					if err != nil {
+						b.Fatal(err)
+					}
+					pkg, err := newPackage(indexEntries, opts)
+					if err != nil {
+						b.Fatal(err)
+					}
+					pkgList = append(pkgList, pkg)
+				}
+				// only drill into a handful of packages
+				for _, pkg := range pkgList[:3] {
+					if _, err := pkg.FileList(); err != nil {
+						b.Fatal(err)
+					}
+				}
+
+				// the heap still held by the package list, which is what a caller keeping the packages around pays for
+				var after runtime.MemStats
+				b.StopTimer()
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+				if after.HeapAlloc > before.HeapAlloc {
+					retained += after.HeapAlloc - before.HeapAlloc
+				}
+				runtime.KeepAlive(pkgList)
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(retained)/float64(b.N), "retained-B/op")
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package rpmdb
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// Source identifies the database a package was read from.
+type Source struct {
+	Path    string
+	Backend string // BackendBerkeleyDB, BackendSQLite or BackendNDB
+}
+
+// MultiDB reads several rpm databases as one, e.g. both sides of a Berkeley DB to SQLite migration or a --dbpath
+// overlay next to the system database.
+type MultiDB struct {
+	dbs     []*RpmDB
+	sources []Source
+	opts    options
+}
+
+// OpenMulti opens every database at the given paths (the backend of each is detected as with Open). The options are
+// applied to each database, WithSQLiteDB is not supported.
+func OpenMulti(paths []string, opts ...Option) (*MultiDB, error) {
+	o := newOptions(opts...)
+	if o.sqliteDB != nil {
+		return nil, fmt.Errorf("a sqlite connection cannot be shared by multiple databases: %w", ErrUnsupported)
+	}
+
+	m := &MultiDB{opts: o}
+	for _, path := range paths {
+		db, err := openDBI(path, o)
+		if err != nil {
+			_ = m.Close()
+			return nil, fmt.Errorf("failed to open %q: %w", path, err)
+		}
+		rpmDB := &RpmDB{db: db, opts: o}
+		m.dbs = append(m.dbs, rpmDB)
+		m.sources = append(m.sources, Source{Path: path, Backend: rpmDB.backend()})
+	}
+	return m, nil
+}
+
+// ListPackages returns the packages of all databases in the order the databases were given, with
+// PackageInfo.Sources naming the database each package was read from. With WithDeduplication a package whose header
+// is identical across databases is returned once, listing every database it was found in. Packages with the same
+// NEVRA but a different header are never collapsed, instead each of them carries a warning naming the other source.
+func (m *MultiDB) ListPackages() ([]*PackageInfo, error) {
+	type seen struct {
+		pkg         *PackageInfo
+		fingerprint [sha256.Size]byte
+	}
+
+	var pkgList []*PackageInfo
+	byNEVRA := make(map[string][]seen)
+
+	for i, db := range m.dbs {
+		source := m.sources[i]
+		_, err := db.listPackages(func(pkg *PackageInfo, blob []byte) {
+			fingerprint := sha256.Sum256(blob)
+			key := nevraKey(pkg)
+
+			for _, other := range byNEVRA[key] {
+				if other.fingerprint == fingerprint {
+					if m.opts.deduplicate {
+						other.pkg.Sources = append(other.pkg.Sources, source)
+						return
+					}
+					continue
+				}
+				pkg.Warnings = append(pkg.Warnings, fmt.Sprintf("conflicting header for %s in %s", key, other.pkg.Sources[0].Path))
+				other.pkg.Warnings = append(other.pkg.Warnings, fmt.Sprintf("conflicting header for %s in %s", key, source.Path))
+			}
+
+			pkg.Sources = []Source{source}
+			byNEVRA[key] = append(byNEVRA[key], seen{pkg: pkg, fingerprint: fingerprint})
+			pkgList = append(pkgList, pkg)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list packages of %q: %w", source.Path, err)
+		}
+	}
+
+	return pkgList, nil
+}
+
+// Close closes every database, returning the first error encountered.
+func (m *MultiDB) Close() error {
+	var firstErr error
+	for _, db := range m.dbs {
+		if err := db.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// nevraKey formats the package name, epoch, version, release and arch (with the epoch always present).
+func nevraKey(p *PackageInfo) string {
+	epoch := 0
+	if p.Epoch != nil {
+		epoch = *p.Epoch
+	}
+	return fmt.Sprintf("%s-%d:%s-%s.%s", p.Name, epoch, p.Version, p.Release, p.Arch)
+}
@@ -0,0 +1,95 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the bdb fixture holds tzdata, nss-softokn-freebl and ncurses, while the sqlite fixture holds the same
+// nss-softokn-freebl header, an ncurses header with a different install time, and nss-pem
+var multiFixtures = []string{"testdata/multi/bdb/Packages", "testdata/multi/sqlite/rpmdb.sqlite"}
+
+func TestMultiDB_ListPackages(t *testing.T) {
+	bdbSource := Source{Path: multiFixtures[0], Backend: "bdb"}
+	sqliteSource := Source{Path: multiFixtures[1], Backend: "sqlite"}
+
+	type expectedPackage struct {
+		name     string
+		sources  []Source
+		warnings int
+	}
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected []expectedPackage
+	}{
+		{
+			name: "all packages",
+			expected: []expectedPackage{
+				{name: "tzdata", sources: []Source{bdbSource}},
+				{name: "nss-softokn-freebl", sources: []Source{bdbSource}},
+				{name: "ncurses", sources: []Source{bdbSource}, warnings: 1},
+				{name: "nss-softokn-freebl", sources: []Source{sqliteSource}},
+				{name: "ncurses", sources: []Source{sqliteSource}, warnings: 1},
+				{name: "nss-pem", sources: []Source{sqliteSource}},
+			},
+		},
+		{
+			name: "deduplicated",
+			opts: []Option{WithDeduplication()},
+			expected: []expectedPackage{
+				{name: "tzdata", sources: []Source{bdbSource}},
+				{name: "nss-softokn-freebl", sources: []Source{bdbSource, sqliteSource}},
+				{name: "ncurses", sources: []Source{bdbSource}, warnings: 1},
+				{name: "ncurses", sources: []Source{sqliteSource}, warnings: 1},
+				{name: "nss-pem", sources: []Source{sqliteSource}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := OpenMulti(multiFixtures, test.opts...)
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkgs, err := db.ListPackages()
+			require.NoError(t, err)
+			require.Len(t, pkgs, len(test.expected))
+
+			for i, expected := range test.expected {
+				assert.Equal(t, expected.name, pkgs[i].Name)
+				assert.Equal(t, expected.sources, pkgs[i].Sources, expected.name)
+				assert.Len(t, pkgs[i].Warnings, expected.warnings, expected.name)
+			}
+		})
+	}
+}
+
+func TestMultiDB_ConflictWarning(t *testing.T) {
+	db, err := OpenMulti(multiFixtures)
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkgs, err := db.ListPackages()
+	require.NoError(t, err)
+
+	var warnings []string
+	for _, pkg := range pkgs {
+		if pkg.Name == "ncurses" {
+			warnings = append(warnings, pkg.Warnings...)
+		}
+	}
+	assert.Equal(t, []string{
+		"conflicting header for ncurses-0:5.9-14.20130511.el7_4.x86_64 in " + multiFixtures[1],
+		"conflicting header for ncurses-0:5.9-14.20130511.el7_4.x86_64 in " + multiFixtures[0],
+	}, warnings)
+}
+
+func TestOpenMulti_MissingDatabase(t *testing.T) {
+	_, err := OpenMulti([]string{multiFixtures[0], "testdata/multi/missing/Packages"})
+	assert.Error(t, err)
+}
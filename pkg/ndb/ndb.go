@@ -0,0 +1,232 @@
+package ndb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/adler32"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/anchore/go-rpmdb/pkg/dbi"
+)
+
+// the on-disk layout of the ndb package database (Packages.db), all integers are little-endian
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/ndb/rpmpkg.c
+const (
+	// HeaderMagic is the magic number at the start of the database ("RpmP")
+	HeaderMagic = 'R' | 'p'<<8 | 'm'<<16 | 'P'<<24
+	// SlotMagic is the magic number of every slot ("Slot")
+	SlotMagic = 'S' | 'l'<<8 | 'o'<<16 | 't'<<24
+	// BlobHeadMagic is the magic number at the start of every blob ("BlbS")
+	BlobHeadMagic = 'B' | 'l'<<8 | 'b'<<16 | 'S'<<24
+	// BlobTailMagic is the magic number at the end of every blob ("BlbE")
+	BlobTailMagic = 'B' | 'l'<<8 | 'b'<<16 | 'E'<<24
+
+	// DBVersion is the only database version written by rpm
+	DBVersion = 0
+
+	PageSize = 4096
+	// HeaderSize is the size (in bytes) of the database header, which occupies the first slots of the first page
+	HeaderSize = 32
+	// SlotSize is the size (in bytes) of a slot: magic, package index, block offset and block count
+	SlotSize = 16
+	// BlockSize is the unit (in bytes) of the block offsets and counts of the slots
+	BlockSize = 16
+	// BlobHeadSize is the size (in bytes) of the blob header: magic, package index, generation and blob length
+	BlobHeadSize = 16
+	// BlobTailSize is the size (in bytes) of the blob trailer: checksum, blob length and magic
+	BlobTailSize = 12
+)
+
+// Header is the database header at the start of the first slot page.
+type Header struct {
+	Magic      uint32
+	Version    uint32
+	Generation uint32
+	// SlotPages is the number of pages holding the slots, the blobs are stored after them
+	SlotPages  uint32
+	NextPkgIdx uint32
+}
+
+// Slot locates the header blob of one package, a zero package index marks an unused slot.
+type Slot struct {
+	Magic      uint32
+	PkgIdx     uint32
+	BlockOff   uint32
+	BlockCount uint32
+}
+
+// NDB reads package headers from an rpm database using the ndb backend (rpm 4.15+, the default on openSUSE/SLES).
+type NDB struct {
+	file *io.SectionReader
+	// closer releases the file opened by Open, it is nil for databases read with NewReader
+	closer io.Closer
+	Header Header
+	// Slots are the used slots, ordered by package index
+	Slots []Slot
+}
+
+type Entry = dbi.Entry
+
+// Open reads the database header and the slots of the Packages.db file at the given path.
+func Open(path string) (*NDB, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to stat db file: %w", err)
+	}
+
+	db, err := NewReader(file, info.Size())
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	db.closer = file
+	return db, nil
+}
+
+// NewReader reads the database from the given reader (e.g. a database held in memory) of the given size.
+func NewReader(reader io.ReaderAt, size int64) (*NDB, error) {
+	file := io.NewSectionReader(reader, 0, size)
+
+	headerBuff := make([]byte, HeaderSize)
+	if _, err := file.ReadAt(headerBuff, 0); err != nil {
+		return nil, fmt.Errorf("failed to read ndb header: %v: %w", err, dbi.ErrCorruptDatabase)
+	}
+
+	var header Header
+	if err := binary.Read(bytes.NewReader(headerBuff), binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse ndb header: %w", err)
+	}
+	if header.Magic != HeaderMagic {
+		return nil, fmt.Errorf("unexpected ndb magic: %#x: %w", header.Magic, dbi.ErrUnsupported)
+	}
+	if header.Version != DBVersion {
+		return nil, fmt.Errorf("unsupported ndb version %d: %w", header.Version, dbi.ErrUnsupportedSchema)
+	}
+	if header.SlotPages == 0 {
+		return nil, fmt.Errorf("ndb header has no slot pages: %w", dbi.ErrCorruptDatabase)
+	}
+
+	slotsSize := int64(header.SlotPages) * PageSize
+	if size < slotsSize {
+		return nil, fmt.Errorf("ndb file is truncated (%d bytes, %d slot pages): %w", size, header.SlotPages, dbi.ErrCorruptDatabase)
+	}
+
+	// the slots start right after the database header
+	slotsBuff := make([]byte, slotsSize-HeaderSize)
+	if _, err := file.ReadAt(slotsBuff, HeaderSize); err != nil {
+		return nil, fmt.Errorf("failed to read ndb slots: %w", err)
+	}
+
+	var slots []Slot
+	blobsOff := uint32(slotsSize / BlockSize)
+	for offset := 0; offset+SlotSize <= len(slotsBuff); offset += SlotSize {
+		slot := Slot{
+			Magic:      binary.LittleEndian.Uint32(slotsBuff[offset:]),
+			PkgIdx:     binary.LittleEndian.Uint32(slotsBuff[offset+4:]),
+			BlockOff:   binary.LittleEndian.Uint32(slotsBuff[offset+8:]),
+			BlockCount: binary.LittleEndian.Uint32(slotsBuff[offset+12:]),
+		}
+		if slot.Magic != SlotMagic {
+			return nil, fmt.Errorf("unexpected magic of slot %d: %#x: %w", offset/SlotSize, slot.Magic, dbi.ErrCorruptDatabase)
+		}
+		if slot.PkgIdx == 0 {
+			continue
+		}
+		if slot.BlockOff < blobsOff || slot.BlockCount == 0 {
+			return nil, fmt.Errorf("slot of package %d references blocks %d+%d within the slot pages: %w", slot.PkgIdx, slot.BlockOff, slot.BlockCount, dbi.ErrCorruptDatabase)
+		}
+		slots = append(slots, slot)
+	}
+
+	// rpm lists the packages by index rather than in slot order
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].PkgIdx < slots[j].PkgIdx
+	})
+
+	return &NDB{
+		file:   file,
+		Header: header,
+		Slots:  slots,
+	}, nil
+}
+
+func (db *NDB) Read() <-chan Entry {
+	entries := make(chan Entry)
+
+	go func() {
+		defer close(entries)
+
+		for _, slot := range db.Slots {
+			blob, err := db.readBlob(slot)
+			entries <- Entry{
+				Instance: slot.PkgIdx,
+				Value:    blob,
+				Err:      err,
+			}
+			// note: a checksum mismatch leaves the rest of the database readable
+			if err != nil && !errors.Is(err, dbi.ErrBlobChecksum) {
+				return
+			}
+		}
+	}()
+
+	return entries
+}
+
+// readBlob reads the header blob a slot references, verifying the blob header and trailer (including the adler32
+// checksum over everything but the trailer). The blob is returned along with a dbi.ErrBlobChecksum error when only the
+// checksum does not match.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/ndb/rpmpkg.c (rpmpkgReadBlob)
+func (db *NDB) readBlob(slot Slot) ([]byte, error) {
+	size := int64(slot.BlockCount) * BlockSize
+	if size < BlobHeadSize+BlobTailSize {
+		return nil, fmt.Errorf("blob of package %d is too small (%d blocks): %w", slot.PkgIdx, slot.BlockCount, dbi.ErrCorruptDatabase)
+	}
+
+	buff := make([]byte, size)
+	if _, err := db.file.ReadAt(buff, int64(slot.BlockOff)*BlockSize); err != nil {
+		return nil, fmt.Errorf("failed to read blob of package %d: %v: %w", slot.PkgIdx, err, dbi.ErrCorruptDatabase)
+	}
+
+	head, tail := buff[:BlobHeadSize], buff[size-BlobTailSize:]
+	if magic := binary.LittleEndian.Uint32(head); magic != BlobHeadMagic {
+		return nil, fmt.Errorf("unexpected blob magic of package %d: %#x: %w", slot.PkgIdx, magic, dbi.ErrCorruptDatabase)
+	}
+	if pkgIdx := binary.LittleEndian.Uint32(head[4:]); pkgIdx != slot.PkgIdx {
+		return nil, fmt.Errorf("blob of package %d belongs to package %d: %w", slot.PkgIdx, pkgIdx, dbi.ErrCorruptDatabase)
+	}
+	blobLen := binary.LittleEndian.Uint32(head[12:])
+	if int64(blobLen) > size-BlobHeadSize-BlobTailSize {
+		return nil, fmt.Errorf("blob of package %d overflows its blocks (%d bytes in %d blocks): %w", slot.PkgIdx, blobLen, slot.BlockCount, dbi.ErrCorruptDatabase)
+	}
+
+	if magic := binary.LittleEndian.Uint32(tail[8:]); magic != BlobTailMagic {
+		return nil, fmt.Errorf("unexpected blob trailer magic of package %d: %#x: %w", slot.PkgIdx, magic, dbi.ErrCorruptDatabase)
+	}
+	if tailLen := binary.LittleEndian.Uint32(tail[4:]); tailLen != blobLen {
+		return nil, fmt.Errorf("blob trailer of package %d has length %d, expected %d: %w", slot.PkgIdx, tailLen, blobLen, dbi.ErrCorruptDatabase)
+	}
+	blob := buff[BlobHeadSize : BlobHeadSize+blobLen]
+	if checksum, actual := binary.LittleEndian.Uint32(tail), adler32.Checksum(buff[:size-BlobTailSize]); checksum != actual {
+		return blob, fmt.Errorf("blob of package %d has adler32 checksum %#08x, expected %#08x: %w", slot.PkgIdx, actual, checksum, dbi.ErrBlobChecksum)
+	}
+
+	return blob, nil
+}
+
+func (db *NDB) Close() error {
+	if db.closer == nil {
+		return nil
+	}
+	return db.closer.Close()
+}
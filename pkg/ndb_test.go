@@ -0,0 +1,154 @@
+package rpmdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/adler32"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/go-rpmdb/pkg/ndb"
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureBlobs returns the raw header blobs of a fixture database, in database order.
+func fixtureBlobs(t *testing.T, path string) [][]byte {
+	t.Helper()
+
+	db, err := Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var blobs [][]byte
+	_, err = db.listPackages(func(_ *PackageInfo, blob []byte) {
+		blobs = append(blobs, blob)
+	})
+	require.NoError(t, err)
+	return blobs
+}
+
+// buildNDB lays out the blobs the way the rpm ndb backend does: a single slot page (the database header followed by
+// the slots) and the blobs after it. The slots are written in reverse order to verify that packages are listed by
+// package index.
+func buildNDB(blobs [][]byte) []byte {
+	le := binary.LittleEndian
+	data := make([]byte, ndb.PageSize)
+	le.PutUint32(data[0:], ndb.HeaderMagic)
+	le.PutUint32(data[4:], ndb.DBVersion)
+	le.PutUint32(data[8:], 1)
+	le.PutUint32(data[12:], 1)
+	le.PutUint32(data[16:], uint32(len(blobs)+1))
+
+	for slot := ndb.HeaderSize / ndb.SlotSize; slot < ndb.PageSize/ndb.SlotSize; slot++ {
+		le.PutUint32(data[slot*ndb.SlotSize:], ndb.SlotMagic)
+	}
+
+	for i := len(blobs) - 1; i >= 0; i-- {
+		blob, pkgIdx := blobs[i], uint32(i+1)
+		blocks := (ndb.BlobHeadSize + len(blob) + ndb.BlobTailSize + ndb.BlockSize - 1) / ndb.BlockSize
+
+		slot := data[ndb.HeaderSize+(len(blobs)-1-i)*ndb.SlotSize:]
+		le.PutUint32(slot[4:], pkgIdx)
+		le.PutUint32(slot[8:], uint32(len(data)/ndb.BlockSize))
+		le.PutUint32(slot[12:], uint32(blocks))
+
+		record := make([]byte, blocks*ndb.BlockSize)
+		le.PutUint32(record[0:], ndb.BlobHeadMagic)
+		le.PutUint32(record[4:], pkgIdx)
+		le.PutUint32(record[8:], 1)
+		le.PutUint32(record[12:], uint32(len(blob)))
+		copy(record[ndb.BlobHeadSize:], blob)
+		tail := record[len(record)-ndb.BlobTailSize:]
+		le.PutUint32(tail[0:], adler32.Checksum(record[:len(record)-ndb.BlobTailSize]))
+		le.PutUint32(tail[4:], uint32(len(blob)))
+		le.PutUint32(tail[8:], ndb.BlobTailMagic)
+
+		data = append(data, record...)
+	}
+	return data
+}
+
+func TestPackageList_NDB(t *testing.T) {
+	expected := listFixturePackages(t, "testdata/centos7-plain/Packages")
+	require.Len(t, expected, 144)
+
+	path := filepath.Join(t.TempDir(), "Packages.db")
+	writeFile(t, path, buildNDB(fixtureBlobs(t, "testdata/centos7-plain/Packages")))
+
+	db, err := Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, ok := db.db.(*ndb.NDB)
+	require.True(t, ok, "expected the ndb backend, got %T", db.db)
+	assert.Equal(t, "ndb", db.backend())
+
+	pkgList, err := db.ListPackages()
+	require.NoError(t, err)
+	require.Len(t, pkgList, len(expected))
+	for i := range expected {
+		for _, d := range deep.Equal(expected[i], pkgList[i]) {
+			t.Errorf("%s: %s", expected[i].Name, d)
+		}
+	}
+}
+
+func TestPackageList_NDBCorrupt(t *testing.T) {
+	blobs := fixtureBlobs(t, "testdata/centos7-plain/Packages")[:2]
+
+	tests := []struct {
+		name    string
+		corrupt func(data []byte) []byte
+		openErr bool
+	}{
+		{
+			name: "unknown version",
+			corrupt: func(data []byte) []byte {
+				binary.LittleEndian.PutUint32(data[4:], 1)
+				return data
+			},
+			openErr: true,
+		},
+		{
+			name: "truncated slot page",
+			corrupt: func(data []byte) []byte {
+				return data[:ndb.PageSize/2]
+			},
+			openErr: true,
+		},
+		{
+			name: "bad slot magic",
+			corrupt: func(data []byte) []byte {
+				data[ndb.HeaderSize] = 'X'
+				return data
+			},
+			openErr: true,
+		},
+		{
+			name: "truncated blob",
+			corrupt: func(data []byte) []byte {
+				return data[:len(data)-1]
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "Packages.db")
+			writeFile(t, path, test.corrupt(buildNDB(blobs)))
+
+			db, err := Open(path)
+			if test.openErr {
+				assert.True(t, errors.Is(err, ErrCorruptDatabase) || errors.Is(err, ErrUnsupported), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			defer db.Close()
+
+			_, err = db.ListPackages()
+			assert.True(t, errors.Is(err, ErrCorruptDatabase), "unexpected error: %v", err)
+		})
+	}
+}
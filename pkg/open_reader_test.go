@@ -0,0 +1,103 @@
+package rpmdb
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenReader(t *testing.T) {
+	expected := listFixturePackages(t, "testdata/centos7-plain/Packages")
+	require.Len(t, expected, 144)
+
+	bdbContents, err := os.ReadFile("testdata/centos7-plain/Packages")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		contents []byte
+		backend  string
+	}{
+		{
+			name:     "bdb",
+			contents: bdbContents,
+			backend:  BackendBerkeleyDB,
+		},
+		{
+			name:     "ndb",
+			contents: buildNDB(fixtureBlobs(t, "testdata/centos7-plain/Packages")),
+			backend:  BackendNDB,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := OpenReader(bytes.NewReader(test.contents), int64(len(test.contents)))
+			require.NoError(t, err)
+			defer db.Close()
+
+			assert.Equal(t, test.backend, db.backend())
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			require.Len(t, pkgList, len(expected))
+			for i := range expected {
+				for _, d := range deep.Equal(expected[i], pkgList[i]) {
+					t.Errorf("%s: %s", expected[i].Name, d)
+				}
+			}
+		})
+	}
+}
+
+func TestOpenReader_Unsupported(t *testing.T) {
+	sqliteContents, err := os.ReadFile(sqliteFixture)
+	require.NoError(t, err)
+	rpmContents, err := os.ReadFile("testdata/rpm/epel-release-7-5.noarch.rpm")
+	require.NoError(t, err)
+
+	conn, err := sql.Open(sqlite.DriverName, sqliteFixture)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tests := []struct {
+		name        string
+		contents    []byte
+		opts        []Option
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			expectedErr: ErrEmptyDatabase,
+		},
+		{
+			name:        "sqlite connection",
+			contents:    rpmContents,
+			opts:        []Option{WithSQLiteDB(conn)},
+			expectedErr: ErrUnsupported,
+		},
+		{
+			name:        "sqlite",
+			contents:    sqliteContents,
+			expectedErr: ErrUnsupported,
+		},
+		{
+			name:        "not a database",
+			contents:    rpmContents,
+			expectedErr: ErrUnsupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := OpenReader(bytes.NewReader(test.contents), int64(len(test.contents)), test.opts...)
+			assert.True(t, errors.Is(err, test.expectedErr), "unexpected error: %v", err)
+		})
+	}
+}
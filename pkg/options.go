@@ -0,0 +1,121 @@
+package rpmdb
+
+import "database/sql"
+
+type Option func(*options)
+
+type options struct {
+	onlyInstalledFiles bool
+	withoutFiles       bool
+	compressedPaths    bool
+	lenientChecksums   bool
+	deduplicate        bool
+	locales            []string
+	invalidUTF8        InvalidUTF8Policy
+	extraTags          bool
+	keepNone           bool
+	sqliteDB           *sql.DB
+}
+
+// WithOnlyInstalledFiles restricts PackageInfo.Files to entries that rpm actually wrote to disk (file states
+// "normal" and "replaced"), dropping files that were excluded at install time (e.g. --excludedocs, netshared paths,
+// or the losing side of a multilib color conflict).
+func WithOnlyInstalledFiles() Option {
+	return func(o *options) {
+		o.onlyInstalledFiles = true
+	}
+}
+
+// WithFiles controls if PackageInfo.Files is populated while listing packages (the default). When disabled, the file
+// list is left nil and only decoded on demand via PackageInfo.FileList(), which avoids building file lists for
+// every package when only a few are inspected.
+func WithFiles(enabled bool) Option {
+	return func(o *options) {
+		o.withoutFiles = !enabled
+	}
+}
+
+// WithCompressedPaths keeps file paths in rpm's own dirnames/basenames form: PackageInfo.DirNames holds each directory
+// once and every FileInfo carries a DirIndex and Basename instead of a joined Path. This avoids building a string per
+// file for consumers that deduplicate paths themselves; use PackageInfo.FilePath to get the full path of a file.
+func WithCompressedPaths() Option {
+	return func(o *options) {
+		o.compressedPaths = true
+	}
+}
+
+// WithLenientChecksums reads package headers that do not match their stored digest (or, for ndb databases, the blob
+// checksum) instead of failing with ErrBlobChecksum, recording the mismatch in PackageInfo.Warnings. Such headers cannot
+// be trusted to be what rpm wrote.
+func WithLenientChecksums() Option {
+	return func(o *options) {
+		o.lenientChecksums = true
+	}
+}
+
+// WithDeduplication collapses packages with identical headers found in more than one database opened with OpenMulti
+// into a single PackageInfo listing every source. It has no effect on a single database.
+func WithDeduplication() Option {
+	return func(o *options) {
+		o.deduplicate = true
+	}
+}
+
+// WithSQLiteDB reads packages over the given connection instead of opening the database path, allowing callers to
+// supply their own database/sql driver (e.g. mattn/go-sqlite3) or VFS. The connection is not closed by RpmDB.Close.
+func WithSQLiteDB(db *sql.DB) Option {
+	return func(o *options) {
+		o.sqliteDB = db
+	}
+}
+
+// WithLocale selects the translation returned for the translatable tags (PackageInfo.Summary, Description and Group)
+// when the package carries one, e.g. WithLocale("de_DE.UTF-8"). Locales are tried in the given order, the same way
+// rpm matches its LANGUAGE and LANG settings, before falling back to the untranslated text (the default).
+func WithLocale(locales ...string) Option {
+	return func(o *options) {
+		o.locales = locales
+	}
+}
+
+// WithInvalidUTF8 sets how strings that are not valid UTF-8 (e.g. Latin-1 changelog entries of older packages) are
+// returned, see InvalidUTF8Policy. By default they are kept as stored in the header.
+func WithInvalidUTF8(policy InvalidUTF8Policy) Option {
+	return func(o *options) {
+		o.invalidUTF8 = policy
+	}
+}
+
+// WithExtraTags keeps every header tag that is not mapped to a field of PackageInfo (or FileInfo) in
+// PackageInfo.ExtraTags, decoded according to its type. This includes vendor specific tags (RPMTAG_EXTERNAL_TAG and
+// above), which are otherwise ignored.
+func WithExtraTags() Option {
+	return func(o *options) {
+		o.extraTags = true
+	}
+}
+
+// WithNoneNormalization controls if the "(none)" placeholder that rpmbuild records for unset optional tags (e.g. a
+// package without a vendor or URL) is returned as an empty string (the default). When disabled, every string is
+// returned exactly as stored in the header; use PackageInfo.HasTag to tell an absent tag from an empty one.
+func WithNoneNormalization(enabled bool) Option {
+	return func(o *options) {
+		o.keepNone = !enabled
+	}
+}
+
+func newOptions(opts ...Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// normalizeNone returns an empty string for the "(none)" placeholder, unless disabled with WithNoneNormalization.
+func (o options) normalizeNone(value string) string {
+	if value == "(none)" && !o.keepNone {
+		return ""
+	}
+	return value
+}
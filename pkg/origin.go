@@ -0,0 +1,249 @@
+package rpmdb
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// OriginHint is a best guess at the distribution (or repository) a package was installed from. The rpmdb does not
+// record repository IDs, but the signing key and the vendor data within the header usually identify the origin.
+type OriginHint struct {
+	// Labels are the likely origins, strongest signal first (e.g. "epel", "epel-7"). A package signed by a key
+	// that is not well-known and without any other recognizable signal is labeled "third-party".
+	Labels []string
+	// KeyID is the 16 hex character ID of the key that signed the package (for gpg-pubkey entries, the 8 hex
+	// character ID of the imported key), empty for unsigned packages.
+	KeyID string
+	// Evidence describes each signal that contributed to the labels.
+	Evidence []string
+}
+
+// wellKnownKey describes a distribution signing key, see wellKnownKeys.
+type wellKnownKey struct {
+	Origin  string
+	Release string
+	Name    string
+}
+
+// wellKnownKeys maps the short (32 bit) ID of distribution signing keys, which is also the version of the
+// corresponding gpg-pubkey entry, to the origin of the packages they sign.
+var wellKnownKeys = map[string]wellKnownKey{
+	// CentOS
+	"16ff0e46": {Origin: "centos", Release: "2", Name: "CentOS 2 Official Signing Key"},
+	"025e513b": {Origin: "centos", Release: "3", Name: "CentOS 3 Official Signing Key"},
+	"443e1821": {Origin: "centos", Release: "4", Name: "CentOS 4 Official Signing Key"},
+	"e8562897": {Origin: "centos", Release: "5", Name: "CentOS 5 Official Signing Key"},
+	"c105b9de": {Origin: "centos", Release: "6", Name: "CentOS 6 Official Signing Key"},
+	"f4a80eb5": {Origin: "centos", Release: "7", Name: "CentOS 7 Official Signing Key"},
+	"8483c65d": {Origin: "centos", Release: "8", Name: "CentOS Official Signing Key"},
+	"f2ee9d55": {Origin: "centos-sclo", Name: "CentOS SoftwareCollections SIG Key"},
+
+	// EPEL
+	"0608b895": {Origin: "epel", Release: "6", Name: "Fedora EPEL (6) Key"},
+	"352c64e5": {Origin: "epel", Release: "7", Name: "Fedora EPEL (7) Key"},
+	"2f86d6a1": {Origin: "epel", Release: "8", Name: "Fedora EPEL (8) Key"},
+	"3228467c": {Origin: "epel", Release: "9", Name: "Fedora EPEL (9) Key"},
+
+	// RHEL
+	"fd431d51": {Origin: "rhel", Name: "Red Hat Release Key 2"},
+	"37017186": {Origin: "rhel", Name: "Red Hat Release Key"},
+
+	// Fedora
+	"45719a39": {Origin: "fedora", Release: "34", Name: "Fedora 34 Key"},
+	"9867c58f": {Origin: "fedora", Release: "35", Name: "Fedora 35 Key"},
+	"38ab71f4": {Origin: "fedora", Release: "36", Name: "Fedora 36 Key"},
+	"5323552a": {Origin: "fedora", Release: "37", Name: "Fedora 37 Key"},
+	"eb10b464": {Origin: "fedora", Release: "38", Name: "Fedora 38 Key"},
+	"18b8e74c": {Origin: "fedora", Release: "39", Name: "Fedora 39 Key"},
+	"a15b79cc": {Origin: "fedora", Release: "40", Name: "Fedora 40 Key"},
+	"e99d6ad1": {Origin: "fedora", Release: "41", Name: "Fedora 41 Key"},
+
+	// SUSE
+	"39db7c82": {Origin: "suse", Name: "SuSE Package Signing Key"},
+	"3dbdc284": {Origin: "opensuse", Name: "openSUSE Project Signing Key"},
+}
+
+// originVendors maps (lower case) RPMTAG_VENDOR and RPMTAG_PACKAGER prefixes to an origin.
+var originVendors = []struct {
+	prefix string
+	origin string
+}{
+	{prefix: "centos", origin: "centos"},
+	{prefix: "red hat", origin: "rhel"},
+	{prefix: "fedora project", origin: "fedora"},
+	{prefix: "opensuse", origin: "opensuse"},
+	{prefix: "suse", origin: "suse"},
+}
+
+// originDomains maps the domains found within RPMTAG_PACKAGER, RPMTAG_BUILDHOST and RPMTAG_URL to an origin.
+var originDomains = []struct {
+	domain string
+	origin string
+}{
+	{domain: "centos.org", origin: "centos"},
+	{domain: "redhat.com", origin: "rhel"},
+	{domain: "fedoraproject.org", origin: "fedora"},
+	{domain: "opensuse.org", origin: "opensuse"},
+	{domain: "suse.de", origin: "suse"},
+	{domain: "suse.com", origin: "suse"},
+}
+
+// distTagPattern matches the dist tag within a release (e.g. "3.el7", "1.el8_4", "2.fc38", "3.el7.centos").
+var distTagPattern = regexp.MustCompile(`\.(el|fc)(\d+)(?:_\d+)*(\.centos)?(?:\.|$)`)
+
+// OriginHints extracts the signals within the package header that hint at where the package came from: the
+// signing key (looked up in a table of well-known distribution keys), the vendor, the packager, the build host,
+// the URL and the dist tag of the release. The labels are a best guess, packages are regularly rebuilt or
+// re-signed by other parties, so the evidence is returned alongside them.
+func OriginHints(pkg *PackageInfo) OriginHint {
+	var hint OriginHint
+	addLabel := func(labels ...string) {
+		for _, label := range labels {
+			if !containsString(hint.Labels, label) {
+				hint.Labels = append(hint.Labels, label)
+			}
+		}
+	}
+
+	// the signing key is the strongest signal, the versioned label is only known from the key table
+	if pkg.Name == "gpg-pubkey" || pkg.Kind == PackageKindGPGPubkey {
+		hint.KeyID = strings.ToLower(pkg.Version)
+		if key, ok := wellKnownKeys[hint.KeyID]; ok {
+			// the key itself is more specific than the user ID it was imported with
+			addLabel(key.labels()...)
+			hint.Evidence = append(hint.Evidence, fmt.Sprintf("imports key %s (%s)", hint.KeyID, key.Name))
+			return hint
+		}
+	} else if hint.KeyID = pkg.Signatures.KeyID(); hint.KeyID != "" {
+		shortID := hint.KeyID[len(hint.KeyID)-8:]
+		if key, ok := wellKnownKeys[shortID]; ok {
+			addLabel(key.labels()...)
+			hint.Evidence = append(hint.Evidence, fmt.Sprintf("signed by key %s (%s)", shortID, key.Name))
+		} else {
+			hint.Evidence = append(hint.Evidence, fmt.Sprintf("signed by unknown key %s", shortID))
+		}
+	}
+
+	var origins []string
+	addOrigin := func(origin, evidence string) {
+		if !containsString(origins, origin) {
+			origins = append(origins, origin)
+		}
+		hint.Evidence = append(hint.Evidence, evidence)
+	}
+
+	for _, signal := range []struct {
+		name   string
+		value  string
+		host   string
+		vendor bool
+	}{
+		{name: "vendor", value: pkg.Vendor, vendor: true},
+		{name: "packager", value: pkg.Packager, host: emailDomain(pkg.Packager), vendor: true},
+		{name: "build host", value: pkg.BuildHost, host: pkg.BuildHost},
+		{name: "url", value: pkg.URL, host: urlHost(pkg.URL)},
+	} {
+		origin := domainOrigin(signal.host)
+		if origin == "" && signal.vendor {
+			origin = vendorOrigin(signal.value)
+		}
+		if origin != "" {
+			addOrigin(origin, fmt.Sprintf("%s %q", signal.name, signal.value))
+		}
+	}
+
+	// the dist tag narrows the origin down to a release, Fedora packages built for an el release are EPEL
+	distTag := distTagPattern.FindStringSubmatch(pkg.Release)
+	if distTag != nil {
+		hint.Evidence = append(hint.Evidence, fmt.Sprintf("dist tag %q", strings.Trim(distTag[0], ".")))
+		if distTag[3] != "" && !containsString(origins, "centos") {
+			origins = append(origins, "centos")
+		}
+	}
+	for _, origin := range origins {
+		if distTag == nil {
+			addLabel(origin)
+			continue
+		}
+		switch {
+		case distTag[1] == "el" && origin == "fedora":
+			addLabel("epel", "epel-"+distTag[2])
+		case distTag[1] == "el" && (origin == "centos" || origin == "rhel"):
+			addLabel(origin, origin+"-"+distTag[2])
+		case distTag[1] == "fc" && origin == "fedora":
+			addLabel(origin, origin+"-"+distTag[2])
+		default:
+			addLabel(origin)
+		}
+	}
+
+	if len(hint.Labels) == 0 && hint.KeyID != "" {
+		hint.Labels = append(hint.Labels, "third-party")
+	}
+	return hint
+}
+
+// labels returns the origin along with the versioned origin (e.g. "centos", "centos-7") when the key is specific to
+// a single release.
+func (k wellKnownKey) labels() []string {
+	if k.Release == "" {
+		return []string{k.Origin}
+	}
+	return []string{k.Origin, k.Origin + "-" + k.Release}
+}
+
+// vendorOrigin returns the origin of the given vendor (or packager) name, if it is a well-known distribution.
+func vendorOrigin(vendor string) string {
+	vendor = strings.ToLower(vendor)
+	for _, v := range originVendors {
+		if strings.HasPrefix(vendor, v.prefix) {
+			return v.origin
+		}
+	}
+	return ""
+}
+
+// domainOrigin returns the origin of the given host name (or domain), if it is within a well-known domain.
+func domainOrigin(host string) string {
+	host = strings.ToLower(host)
+	for _, d := range originDomains {
+		if host == d.domain || strings.HasSuffix(host, "."+d.domain) {
+			return d.origin
+		}
+	}
+	return ""
+}
+
+// emailDomain returns the domain of the (first) e-mail address or URL within a packager value, such as
+// "CentOS BuildSystem <http://bugs.centos.org>" or "CBS <cbs@centos.org>".
+func emailDomain(packager string) string {
+	start, end := strings.Index(packager, "<"), strings.LastIndex(packager, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	address := packager[start+1 : end]
+	if i := strings.LastIndex(address, "@"); i >= 0 && !strings.Contains(address, "://") {
+		return address[i+1:]
+	}
+	return urlHost(address)
+}
+
+// urlHost returns the host name of the given URL, or an empty string when it cannot be parsed.
+func urlHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginHints_Fixtures(t *testing.T) {
+	tests := []struct {
+		file     string
+		name     string
+		version  string
+		keyID    string
+		expected []string
+	}{
+		{
+			file:     "testdata/centos7-plain/Packages",
+			name:     "ncurses",
+			keyID:    "24c6a8a7f4a80eb5",
+			expected: []string{"centos", "centos-7"},
+		},
+		{
+			file:     "testdata/centos6-plain/Packages",
+			name:     "basesystem",
+			keyID:    "0946fca2c105b9de",
+			expected: []string{"centos", "centos-6"},
+		},
+		{
+			file:     "testdata/centos7-httpd24/Packages",
+			name:     "nss_wrapper",
+			keyID:    "6a2faea2352c64e5",
+			expected: []string{"epel", "epel-7"},
+		},
+		{
+			// built by the CentOS community build system and signed by the SIG
+			file:     "testdata/centos7-httpd24/Packages",
+			name:     "httpd24",
+			keyID:    "4eb84e71f2ee9d55",
+			expected: []string{"centos-sclo", "centos", "centos-7"},
+		},
+		{
+			// built by Fedora (without a dist tag), but shipped and signed by CentOS
+			file:     "testdata/centos7-httpd24/Packages",
+			name:     "epel-release",
+			keyID:    "24c6a8a7f4a80eb5",
+			expected: []string{"centos", "centos-7", "fedora"},
+		},
+		{
+			file:     "testdata/centos7-httpd24/Packages",
+			name:     "gpg-pubkey",
+			version:  "352c64e5",
+			keyID:    "352c64e5",
+			expected: []string{"epel", "epel-7"},
+		},
+		{
+			// imported keys are not signed and carry no vendor data within centos 6 databases
+			file:     "testdata/centos6-many/Packages",
+			name:     "gpg-pubkey",
+			version:  "c105b9de",
+			keyID:    "c105b9de",
+			expected: []string{"centos", "centos-6"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" "+test.file, func(t *testing.T) {
+			var pkg *PackageInfo
+			for _, p := range listFixturePackages(t, test.file) {
+				if p.Name == test.name && (test.version == "" || p.Version == test.version) {
+					pkg = p
+				}
+			}
+			require.NotNil(t, pkg, "package %q not found", test.name)
+
+			hint := OriginHints(pkg)
+			assert.Equal(t, test.keyID, hint.KeyID)
+			assert.Equal(t, test.expected, hint.Labels)
+			assert.NotEmpty(t, hint.Evidence)
+		})
+	}
+}
+
+func TestOriginHints_Evidence(t *testing.T) {
+	pkgList := listFixturePackages(t, "testdata/centos7-httpd24/Packages")
+
+	var pkg *PackageInfo
+	for _, p := range pkgList {
+		if p.Name == "epel-release" {
+			pkg = p
+		}
+	}
+	require.NotNil(t, pkg)
+
+	assert.Equal(t, []string{
+		"signed by key f4a80eb5 (CentOS 7 Official Signing Key)",
+		`vendor "Fedora Project"`,
+		`packager "Fedora Project"`,
+		`build host "buildvm-ppc64le-05.ppc.fedoraproject.org"`,
+		`url "http://download.fedoraproject.org/pub/epel"`,
+	}, OriginHints(pkg).Evidence)
+}
+
+func TestOriginHints(t *testing.T) {
+	unknownKey := oldFormatPacket(pgpTagSignature, []byte{3, 5, 0x00, 0x5f, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 1, 2, 0xde, 0xad})
+
+	tests := []struct {
+		name     string
+		pkg      PackageInfo
+		expected []string
+	}{
+		{
+			name: "fedora",
+			pkg: PackageInfo{
+				Name:      "bash",
+				Release:   "1.fc38",
+				Vendor:    "Fedora Project",
+				Packager:  "Fedora Project",
+				BuildHost: "buildvm-x86-01.iad2.fedoraproject.org",
+			},
+			expected: []string{"fedora", "fedora-38"},
+		},
+		{
+			name: "rhel",
+			pkg: PackageInfo{
+				Name:      "bash",
+				Release:   "2.el8_4",
+				Vendor:    "Red Hat, Inc.",
+				Packager:  "Red Hat, Inc. <http://bugzilla.redhat.com/bugzilla>",
+				BuildHost: "x86-vm-07.build.eng.bos.redhat.com",
+			},
+			expected: []string{"rhel", "rhel-8"},
+		},
+		{
+			name: "centos dist tag",
+			pkg: PackageInfo{
+				Name:    "centos-release",
+				Release: "3.el7.centos",
+			},
+			expected: []string{"centos", "centos-7"},
+		},
+		{
+			name: "opensuse",
+			pkg: PackageInfo{
+				Name:      "bash",
+				Release:   "lp152.1.1",
+				Vendor:    "openSUSE",
+				BuildHost: "lamb21",
+			},
+			expected: []string{"opensuse"},
+		},
+		{
+			name: "third-party",
+			pkg: PackageInfo{
+				Name:       "foo",
+				Release:    "1",
+				Vendor:     "Example, Inc.",
+				Packager:   "Example Packager <packager@example.com>",
+				Signatures: Signatures{DSA: unknownKey},
+			},
+			expected: []string{"third-party"},
+		},
+		{
+			name: "unsigned without signals",
+			pkg: PackageInfo{
+				Name:    "foo",
+				Release: "1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, OriginHints(&test.pkg).Labels)
+		})
+	}
+}
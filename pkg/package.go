@@ -3,56 +3,235 @@ package rpmdb
 import (
 	"bytes"
 	"encoding/binary"
-	"golang.org/x/xerrors"
+	"encoding/hex"
+	"fmt"
 	"strings"
 )
 
 type PackageInfo struct {
-	Epoch           *int
-	Name            string
-	Version         string
-	Release         string
-	Arch            string
-	SourceRpm       string
-	Size            int
-	License         string
-	Vendor          string
-	DigestAlgorithm DigestAlgorithm
-	Files           []FileInfo
+	Epoch                  *int // nil when the package has no epoch, which rpm distinguishes from an explicit epoch of 0 (e.g. in %{EPOCH})
+	Name                   string
+	Version                string
+	Release                string
+	Arch                   string
+	OS                     string // e.g. "linux"
+	Platform               string // the target triple the package was built for (e.g. "x86_64-redhat-linux-gnu")
+	Summary                string
+	Description            string
+	Group                  string
+	SourceRpm              string
+	Size                   int
+	LongSize               int64 // the installed size as a 64-bit value (RPMTAG_LONGSIZE), falling back to Size
+	ArchiveSize            int64 // the size of the uncompressed payload (RPMTAG_LONGARCHIVESIZE, falling back to RPMTAG_ARCHIVESIZE)
+	License                string
+	Vendor                 string
+	URL                    string
+	Packager               string
+	Distribution           string
+	DistTag                string
+	DistURL                string
+	Modularitylabel        string
+	Encoding               string // the encoding of the header strings declared at build time ("utf-8"), empty for older packages
+	BuildHost              string
+	RPMVersion             string // the version of rpm that built the package
+	Cookie                 string // identifies the rpmbuild invocation (the build host and time), only recorded when built along with the source package
+	OptFlags               string // the compiler flags of the build (%{optflags})
+	DigestAlgorithm        DigestAlgorithm
+	BuildTime              int
+	InstallTime            int
+	InstallColor           int // the color of the installing transaction, 3 on multilib systems accepting both 32-bit (1) and 64-bit (2) files
+	InstallTID             int // the ID of the transaction that installed the package (its start time)
+	RemoveTID              int // the ID of the transaction that removed the package, only set for erased headers (e.g. in rollbacks)
+	PayloadDigest          string
+	PayloadDigestAlgorithm DigestAlgorithm // the algorithm of PayloadDigest, zero when there is no payload digest
+	PayloadFormat          string          // the archive format of the payload (e.g. "cpio")
+	PayloadCompressor      string          // e.g. "gzip", "xz" or "zstd"
+	PayloadFlags           string          // the compression level passed to the compressor (e.g. "9")
+	PkgID                  string          // hex encoded RPMTAG_SIGMD5, which rpm uses to identify a package (RPMTAG_PKGID)
+	SourcePkgID            string          // hex encoded package ID of the source package the package was built from
+	Signatures             Signatures
+	Kind                   PackageKind
+	SELinuxPolicies        []string              // the base64 encoded policy modules of the package (see SELinuxPolicyModules)
+	SELinuxPolicyModules   []SELinuxPolicyModule // the policy modules along with their names and types
+	Prefixes               []string              // the relocatable path prefixes of the package, empty unless it is relocatable
+	InstPrefixes           []string              // the prefixes the package was installed to, the file paths already reflect any relocation
+	Requires               []Dependency
+	Provides               []Dependency
+	Conflicts              []Dependency
+	Obsoletes              []Dependency
+	Recommends             []Dependency
+	Suggests               []Dependency
+	Supplements            []Dependency
+	Enhances               []Dependency
+	Changelog              []ChangelogEntry
+	PreIn                  *Scriptlet // %pre, nil when the package has none
+	PostIn                 *Scriptlet // %post
+	PreUn                  *Scriptlet // %preun
+	PostUn                 *Scriptlet // %postun
+	PreTrans               *Scriptlet // %pretrans
+	PostTrans              *Scriptlet // %posttrans
+	Triggers               []Trigger
+	FileTriggers           []Trigger // file and transaction file triggers (rpm 4.13 and later)
+	DirNames               []string  // only populated with WithCompressedPaths, indexed by FileInfo.DirIndex
+	Files                  []FileInfo
+	FilesMissing           bool             // the package has an installed size but the header has no file list (e.g. stripped when building a minimal image)
+	Warnings               []string         // non-fatal problems found while reading the header (e.g. corrupt file digests)
+	ExtraTags              map[Tag]TagValue // the tags not mapped to any other field, only populated with WithExtraTags
+	Sources                []Source         // the databases the package was read from, only populated by OpenMulti
+
+	// tags are all tags present in the header (see HasTag)
+	tags []Tag
+	// lazyFiles is only set when reading packages with WithFiles(false)
+	lazyFiles *lazyFiles
 }
 
 type FileInfo struct {
-	Path      string
-	Mode      uint16
-	Digest    string
-	Size      int32
-	Username  string
-	Groupname string
-	Flags     FileFlags
+	Path            string
+	OrigPath        string // the path the file was packaged under when the package was installed relocated, empty otherwise
+	Mode            uint16
+	LinkTo          string // the target of a symlink, empty for every other type of file
+	Digest          string
+	DigestBytes     []byte // the decoded Digest, nil when there is no digest or it is not valid hex
+	DigestAbsent    bool   // no digest is recorded for the file at all (RPMTAG_FILEDIGESTS is absent), unlike an empty Digest
+	DigestAlgorithm DigestAlgorithm
+	Size            int32
+	LongSize        int64 // the file size as a 64-bit value (RPMTAG_LONGFILESIZES), falling back to Size
+	Username        string
+	Groupname       string
+	Flags           FileFlags
+	VerifyFlags     VerifyFlags      // the attributes "rpm --verify" checks, zero when the package does not record them
+	Color           int32            // 1 for 32-bit and 2 for 64-bit ELF files, 0 for everything else (see PackageInfo.InstallColor)
+	Class           string           // the file type as classified by libmagic at build time (e.g. "directory" or "ELF 64-bit LSB shared object, ...")
+	Depends         []FileDependency // the dependencies generated for the file (see PackageInfo.FileDependencies)
+	MTime           int32
+	Device          int32     // the device the file was on when the package was built, see Inode
+	Inode           int32     // files sharing a Device and Inode are hardlinks of each other (see PackageInfo.Hardlinks)
+	RDev            uint16    // the device number of block and character devices
+	Lang            string    // the language of a %lang file (e.g. "de" for a translation catalog), empty for every other file
+	State           FileState // whether rpm wrote the file to disk on install (see FileState.IsInstalled and WithOnlyInstalledFiles)
+	SELinuxContext  string
+	Caps            string // the file capabilities set on install in the cap_to_text(3) format (e.g. "= cap_net_raw+p"), empty for most files
+	IMASignature    []byte // the IMA signature rpm writes to the security.ima xattr, nil when the file is not signed
+	VeritySignature []byte // the fs-verity signature rpm enables verity with, nil when the file is not signed
+	DirIndex        int32  // only populated with WithCompressedPaths (see PackageInfo.FilePath)
+	Basename        string // only populated with WithCompressedPaths (see PackageInfo.FilePath)
 }
 
 const (
 	// rpmTag_e
 	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmtag.h#L28
-	RPMTAG_NAME           = 1000 /* s */
-	RPMTAG_VERSION        = 1001 /* s */
-	RPMTAG_RELEASE        = 1002 /* s */
-	RPMTAG_EPOCH          = 1003 /* i */
-	RPMTAG_ARCH           = 1022 /* s */
-	RPMTAG_SOURCERPM      = 1044 /* s */
-	RPMTAG_SIZE           = 1009 /* i */
-	RPMTAG_LICENSE        = 1014 /* s */
-	RPMTAG_VENDOR         = 1011 /* s */
-	RPMTAG_DIRINDEXES     = 1116 /* i[] */
-	RPMTAG_BASENAMES      = 1117 /* s[] */
-	RPMTAG_DIRNAMES       = 1118 /* s[] */
-	RPMTAG_FILESIZES      = 1028 /* i[] */
-	RPMTAG_FILEMODES      = 1030 /* h[] , specifically []uint16 (ref https://github.com/rpm-software-management/rpm/blob/2153fa4ae51a84547129b8ebb3bb396e1737020e/lib/rpmtypes.h#L53 )*/
-	RPMTAG_FILEDIGESTS    = 1035 /* s[] */
-	RPMTAG_FILEFLAGS      = 1037 /* i[] */
-	RPMTAG_FILEUSERNAME   = 1039 /* s[] */
-	RPMTAG_FILEGROUPNAME  = 1040 /* s[] */
-	RPMTAG_FILEDIGESTALGO = 5011 /* i  */
+	RPMTAG_HEADERIMAGE       = 61   /* x */
+	RPMTAG_HEADERSIGNATURES  = 62   /* x */
+	RPMTAG_HEADERIMMUTABLE   = 63   /* x */
+	RPMTAG_HEADERI18NTABLE   = 100  /* s[] */
+	RPMTAG_NAME              = 1000 /* s */
+	RPMTAG_VERSION           = 1001 /* s */
+	RPMTAG_RELEASE           = 1002 /* s */
+	RPMTAG_EPOCH             = 1003 /* i */
+	RPMTAG_SUMMARY           = 1004 /* s{} */
+	RPMTAG_DESCRIPTION       = 1005 /* s{} */
+	RPMTAG_BUILDTIME         = 1006 /* i */
+	RPMTAG_BUILDHOST         = 1007 /* s */
+	RPMTAG_INSTALLTIME       = 1008 /* i */
+	RPMTAG_OS                = 1021 /* s */
+	RPMTAG_ARCH              = 1022 /* s */
+	RPMTAG_SOURCERPM         = 1044 /* s */
+	RPMTAG_ARCHIVESIZE       = 1046 /* i */
+	RPMTAG_LONGARCHIVESIZE   = 271  /* l */
+	RPMTAG_FILEVERIFYFLAGS   = 1045 /* i[] */
+	RPMTAG_REQUIREFLAGS      = 1048 /* i[] */
+	RPMTAG_REQUIRENAME       = 1049 /* s[] */
+	RPMTAG_REQUIREVERSION    = 1050 /* s[] */
+	RPMTAG_PROVIDENAME       = 1047 /* s[] */
+	RPMTAG_PROVIDEFLAGS      = 1112 /* i[] */
+	RPMTAG_PROVIDEVERSION    = 1113 /* s[] */
+	RPMTAG_CONFLICTFLAGS     = 1053 /* i[] */
+	RPMTAG_CONFLICTNAME      = 1054 /* s[] */
+	RPMTAG_CONFLICTVERSION   = 1055 /* s[] */
+	RPMTAG_RPMVERSION        = 1064 /* s */
+	RPMTAG_TRIGGERSCRIPTS    = 1065 /* s[] */
+	RPMTAG_TRIGGERNAME       = 1066 /* s[] */
+	RPMTAG_TRIGGERVERSION    = 1067 /* s[] */
+	RPMTAG_TRIGGERFLAGS      = 1068 /* i[] */
+	RPMTAG_TRIGGERINDEX      = 1069 /* i[] */
+	RPMTAG_CHANGELOGTIME     = 1080 /* i[] */
+	RPMTAG_CHANGELOGNAME     = 1081 /* s[] */
+	RPMTAG_CHANGELOGTEXT     = 1082 /* s[] */
+	RPMTAG_PREINPROG         = 1085 /* s[] */
+	RPMTAG_POSTINPROG        = 1086 /* s[] */
+	RPMTAG_PREUNPROG         = 1087 /* s[] */
+	RPMTAG_POSTUNPROG        = 1088 /* s[] */
+	RPMTAG_OBSOLETENAME      = 1090 /* s[] */
+	RPMTAG_TRIGGERSCRIPTPROG = 1092 /* s[] */
+	RPMTAG_COOKIE            = 1094 /* s */
+	RPMTAG_PREFIXES          = 1098 /* s[] */
+	RPMTAG_INSTPREFIXES      = 1099 /* s[] */
+	RPMTAG_OBSOLETEFLAGS     = 1114 /* i[] */
+	RPMTAG_OBSOLETEVERSION   = 1115 /* s[] */
+	RPMTAG_SIZE              = 1009 /* i */
+	RPMTAG_LICENSE           = 1014 /* s */
+	RPMTAG_DISTRIBUTION      = 1010 /* s */
+	RPMTAG_VENDOR            = 1011 /* s */
+	RPMTAG_PACKAGER          = 1015 /* s */
+	RPMTAG_GROUP             = 1016 /* s{} */
+	RPMTAG_URL               = 1020 /* s */
+	RPMTAG_PREIN             = 1023 /* s */
+	RPMTAG_POSTIN            = 1024 /* s */
+	RPMTAG_PREUN             = 1025 /* s */
+	RPMTAG_POSTUN            = 1026 /* s */
+	RPMTAG_DIRINDEXES        = 1116 /* i[] */
+	RPMTAG_BASENAMES         = 1117 /* s[] */
+	RPMTAG_DIRNAMES          = 1118 /* s[] */
+	RPMTAG_ORIGDIRINDEXES    = 1119 /* i[] */
+	RPMTAG_ORIGBASENAMES     = 1120 /* s[] */
+	RPMTAG_ORIGDIRNAMES      = 1121 /* s[] */
+	RPMTAG_OPTFLAGS          = 1122 /* s */
+	RPMTAG_DISTURL           = 1123 /* s */
+	RPMTAG_INSTALLCOLOR      = 1127 /* i */
+	RPMTAG_INSTALLTID        = 1128 /* i */
+	RPMTAG_REMOVETID         = 1129 /* i */
+	RPMTAG_PAYLOADFORMAT     = 1124 /* s */
+	RPMTAG_PAYLOADCOMPRESSOR = 1125 /* s */
+	RPMTAG_PAYLOADFLAGS      = 1126 /* s */
+	RPMTAG_OLDFILENAMES      = 1027 /* s[] */
+	RPMTAG_FILESIZES         = 1028 /* i[] */
+	RPMTAG_FILESTATES        = 1029 /* c[] */
+	RPMTAG_FILEMODES         = 1030 /* h[] , specifically []uint16 (ref https://github.com/rpm-software-management/rpm/blob/2153fa4ae51a84547129b8ebb3bb396e1737020e/lib/rpmtypes.h#L53 )*/
+	RPMTAG_FILERDEVS         = 1033 /* h[] */
+	RPMTAG_FILEMTIMES        = 1034 /* i[] */
+	RPMTAG_FILEDIGESTS       = 1035 /* s[] */
+	RPMTAG_FILELINKTOS       = 1036 /* s[] */
+	RPMTAG_FILEFLAGS         = 1037 /* i[] */
+	RPMTAG_FILEUSERNAME      = 1039 /* s[] */
+	RPMTAG_FILEGROUPNAME     = 1040 /* s[] */
+	RPMTAG_FILEDEVICES       = 1095 /* i[] */
+	RPMTAG_FILEINODES        = 1096 /* i[] */
+	RPMTAG_FILELANGS         = 1097 /* s[] */
+	RPMTAG_FILECOLORS        = 1140 /* i[] */
+	RPMTAG_FILECLASS         = 1141 /* i[] */
+	RPMTAG_CLASSDICT         = 1142 /* s[] */
+	RPMTAG_FILEDEPENDSX      = 1143 /* i[] */
+	RPMTAG_FILEDEPENDSN      = 1144 /* i[] */
+	RPMTAG_DEPENDSDICT       = 1145 /* i[] */
+	RPMTAG_SOURCEPACKAGE     = 1106 /* i */
+	RPMTAG_SOURCEPKGID       = 1146 /* x */
+	RPMTAG_FILECONTEXTS      = 1147 /* s[] */
+	RPMTAG_POLICIES          = 1150 /* s[] */
+	RPMTAG_PRETRANS          = 1151 /* s */
+	RPMTAG_POSTTRANS         = 1152 /* s */
+	RPMTAG_PRETRANSPROG      = 1153 /* s[] */
+	RPMTAG_POSTTRANSPROG     = 1154 /* s[] */
+	RPMTAG_PLATFORM          = 1132 /* s */
+	RPMTAG_DISTTAG           = 1155 /* s */
+	RPMTAG_LONGFILESIZES     = 5008 /* l[] */
+	RPMTAG_LONGSIZE          = 5009 /* l */
+	RPMTAG_FILECAPS          = 5010 /* s[] */
+	RPMTAG_FILEDIGESTALGO    = 5011 /* i  */
+	RPMTAG_ENCODING          = 5062 /* s */
+	RPMTAG_FILESIGNATURES    = 5090 /* s[] */
+	RPMTAG_PAYLOADDIGEST     = 5092 /* s[] */
+	RPMTAG_PAYLOADDIGESTALGO = 5093 /* i */
+	RPMTAG_MODULARITYLABEL   = 5096 /* s */
 
 	//rpmTagType_e
 	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmtag.h#L362
@@ -68,38 +247,132 @@ const (
 	RPM_I18NSTRING_TYPE   = 9
 )
 
+// selinux policy modules, from the %sepolicy section
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmtag.h
 const (
+	RPMTAG_POLICYNAMES        = 5030 /* s[] */
+	RPMTAG_POLICYTYPES        = 5031 /* s[] */
+	RPMTAG_POLICYTYPESINDEXES = 5032 /* i[] */
+	RPMTAG_POLICYFLAGS        = 5033 /* i[] */
+)
+
+// weak dependencies, only recorded by rpm 4.13 and later
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.13.0-release/lib/rpmtag.h
+const (
+	RPMTAG_RECOMMENDNAME     = 5046 /* s[] */
+	RPMTAG_RECOMMENDVERSION  = 5047 /* s[] */
+	RPMTAG_RECOMMENDFLAGS    = 5048 /* i[] */
+	RPMTAG_SUGGESTNAME       = 5049 /* s[] */
+	RPMTAG_SUGGESTVERSION    = 5050 /* s[] */
+	RPMTAG_SUGGESTFLAGS      = 5051 /* i[] */
+	RPMTAG_SUPPLEMENTNAME    = 5052 /* s[] */
+	RPMTAG_SUPPLEMENTVERSION = 5053 /* s[] */
+	RPMTAG_SUPPLEMENTFLAGS   = 5054 /* i[] */
+	RPMTAG_ENHANCENAME       = 5055 /* s[] */
+	RPMTAG_ENHANCEVERSION    = 5056 /* s[] */
+	RPMTAG_ENHANCEFLAGS      = 5057 /* i[] */
+)
+
+// file triggers, only recorded by rpm 4.13 and later
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.13.0-release/lib/rpmtag.h
+const (
+	RPMTAG_FILETRIGGERSCRIPTS         = 5066 /* s[] */
+	RPMTAG_FILETRIGGERSCRIPTPROG      = 5067 /* s[] */
+	RPMTAG_FILETRIGGERNAME            = 5069 /* s[] */
+	RPMTAG_FILETRIGGERINDEX           = 5070 /* i[] */
+	RPMTAG_FILETRIGGERVERSION         = 5071 /* s[] */
+	RPMTAG_FILETRIGGERFLAGS           = 5072 /* i[] */
+	RPMTAG_TRANSFILETRIGGERSCRIPTS    = 5076 /* s[] */
+	RPMTAG_TRANSFILETRIGGERSCRIPTPROG = 5077 /* s[] */
+	RPMTAG_TRANSFILETRIGGERNAME       = 5079 /* s[] */
+	RPMTAG_TRANSFILETRIGGERINDEX      = 5080 /* i[] */
+	RPMTAG_TRANSFILETRIGGERVERSION    = 5081 /* s[] */
+	RPMTAG_TRANSFILETRIGGERFLAGS      = 5082 /* i[] */
+	RPMTAG_FILETRIGGERPRIORITIES      = 5084 /* i[] */
+	RPMTAG_TRANSFILETRIGGERPRIORITIES = 5085 /* i[] */
+)
+
+const (
+	sizeOfInt8   = 1
 	sizeOfInt32  = 4
+	sizeOfInt64  = 8
 	sizeOfUInt16 = 2
 )
 
-func parseStringArray(data []byte) []string {
+func parseStringArray(data []byte, count uint32) []string {
 	elements := strings.Split(string(data), "\x00")
 	if len(elements) > 0 && elements[len(elements)-1] == "" {
-		return elements[:len(elements)-1]
+		elements = elements[:len(elements)-1]
+	}
+	// note: the entry data may run past the last string (e.g. into the region trailer), the count is authoritative
+	if int(count) < len(elements) {
+		elements = elements[:count]
 	}
 	return elements
 }
 
 func parseString(data []byte) string {
-	return string(bytes.TrimRight(data, "\x00"))
+	// note: the entry data may run past the string terminator (e.g. into alignment padding or the region trailer)
+	if idx := bytes.IndexByte(data, 0); idx >= 0 {
+		data = data[:idx]
+	}
+	return string(data)
+}
+
+func parseBinary(data []byte, count uint32) ([]byte, error) {
+	// note: the entry length may include alignment padding for the following entry, the count is authoritative
+	if int(count) > len(data) {
+		return nil, fmt.Errorf("binary of %d bytes is truncated to %d bytes: %w", count, len(data), ErrHeaderInvalid)
+	}
+	value := make([]byte, count)
+	copy(value, data)
+	return value, nil
 }
 
 func parseInt32(data []byte) (int, error) {
 	var value int32
 	reader := bytes.NewReader(data)
 	if err := binary.Read(reader, binary.BigEndian, &value); err != nil {
-		return 0, xerrors.Errorf("failed to read binary: %w", err)
+		return 0, fmt.Errorf("failed to read binary: %w: %w", ErrHeaderInvalid, err)
 	}
 	return int(value), nil
 }
 
+func parseInt64(data []byte) (int64, error) {
+	var value int64
+	reader := bytes.NewReader(data)
+	if err := binary.Read(reader, binary.BigEndian, &value); err != nil {
+		return 0, fmt.Errorf("failed to read binary: %w: %w", ErrHeaderInvalid, err)
+	}
+	return value, nil
+}
+
 func parseInt32Array(data []byte, arraySize int) ([]int32, error) {
 	var length = arraySize / sizeOfInt32
 	values := make([]int32, length)
 	reader := bytes.NewReader(data)
 	if err := binary.Read(reader, binary.BigEndian, &values); err != nil {
-		return nil, xerrors.Errorf("failed to read binary: %w", err)
+		return nil, fmt.Errorf("failed to read binary: %w: %w", ErrHeaderInvalid, err)
+	}
+	return values, nil
+}
+
+func parseInt64Array(data []byte, arraySize int) ([]int64, error) {
+	var length = arraySize / sizeOfInt64
+	values := make([]int64, length)
+	reader := bytes.NewReader(data)
+	if err := binary.Read(reader, binary.BigEndian, &values); err != nil {
+		return nil, fmt.Errorf("failed to read binary: %w: %w", ErrHeaderInvalid, err)
+	}
+	return values, nil
+}
+
+func parseInt8Array(data []byte, arraySize int) ([]int8, error) {
+	var length = arraySize / sizeOfInt8
+	values := make([]int8, length)
+	reader := bytes.NewReader(data)
+	if err := binary.Read(reader, binary.BigEndian, &values); err != nil {
+		return nil, fmt.Errorf("failed to read binary: %w: %w", ErrHeaderInvalid, err)
 	}
 	return values, nil
 }
@@ -109,237 +382,547 @@ func parseUInt16Array(data []byte, arraySize int) ([]uint16, error) {
 	values := make([]uint16, length)
 	reader := bytes.NewReader(data)
 	if err := binary.Read(reader, binary.BigEndian, &values); err != nil {
-		return nil, xerrors.Errorf("failed to read binary: %w", err)
+		return nil, fmt.Errorf("failed to read binary: %w: %w", ErrHeaderInvalid, err)
 	}
 	return values, nil
 }
 
 // ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/tagexts.c#L649
-func newPackage(indexEntries []indexEntry) (*PackageInfo, error) {
-	pkgInfo := &PackageInfo{}
+func newPackage(indexEntries []indexEntry, opts options) (*PackageInfo, error) {
+	pkgInfo := &PackageInfo{
+		tags: headerTags(indexEntries),
+	}
+	pkgInfo.Kind = pkgInfo.kind()
+	indexEntries, pkgInfo.Warnings = sanitizeStrings(indexEntries, opts.invalidUTF8)
+	locales := i18nTable(indexEntries)
+	var archiveSize int
 	var err error
 
 	for _, entry := range indexEntries {
 		switch entry.Info.Tag {
 		case RPMTAG_NAME:
 			if entry.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag name")
+				return nil, newTagTypeError("name", entry.Info, RPM_STRING_TYPE)
 			}
 			pkgInfo.Name = parseString(entry.Data)
 		case RPMTAG_EPOCH:
 			if entry.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag epoch")
+				return nil, newTagTypeError("epoch", entry.Info, RPM_INT32_TYPE)
 			}
 
 			if entry.Data != nil {
 				value, err := parseInt32(entry.Data)
 				if err != nil {
-					return nil, xerrors.Errorf("failed to parse epoch: %w", err)
+					return nil, fmt.Errorf("failed to parse epoch: %w", err)
 				}
 				pkgInfo.Epoch = &value
 			}
 
 		case RPMTAG_VERSION:
 			if entry.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag version")
+				return nil, newTagTypeError("version", entry.Info, RPM_STRING_TYPE)
 			}
 			pkgInfo.Version = parseString(entry.Data)
 		case RPMTAG_RELEASE:
 			if entry.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag release")
+				return nil, newTagTypeError("release", entry.Info, RPM_STRING_TYPE)
 			}
 			pkgInfo.Release = parseString(entry.Data)
 		case RPMTAG_ARCH:
 			if entry.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag arch")
+				return nil, newTagTypeError("arch", entry.Info, RPM_STRING_TYPE)
 			}
 			pkgInfo.Arch = parseString(entry.Data)
-		case RPMTAG_SOURCERPM:
+		case RPMTAG_OS:
 			if entry.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag sourcerpm")
+				return nil, newTagTypeError("os", entry.Info, RPM_STRING_TYPE)
 			}
-			pkgInfo.SourceRpm = parseString(entry.Data)
-			if pkgInfo.SourceRpm == "(none)" {
-				pkgInfo.SourceRpm = ""
+			pkgInfo.OS = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_PLATFORM:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("platform", entry.Info, RPM_STRING_TYPE)
 			}
-		case RPMTAG_LICENSE:
+			pkgInfo.Platform = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_SUMMARY:
+			if entry.Info.Type != RPM_I18NSTRING_TYPE && entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("summary", entry.Info, RPM_I18NSTRING_TYPE)
+			}
+			pkgInfo.Summary = opts.normalizeNone(parseI18NString(entry, locales, opts.locales))
+		case RPMTAG_DESCRIPTION:
+			if entry.Info.Type != RPM_I18NSTRING_TYPE && entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("description", entry.Info, RPM_I18NSTRING_TYPE)
+			}
+			pkgInfo.Description = opts.normalizeNone(parseI18NString(entry, locales, opts.locales))
+		case RPMTAG_GROUP:
+			if entry.Info.Type != RPM_I18NSTRING_TYPE && entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("group", entry.Info, RPM_I18NSTRING_TYPE)
+			}
+			pkgInfo.Group = opts.normalizeNone(parseI18NString(entry, locales, opts.locales))
+		case RPMTAG_SOURCERPM:
 			if entry.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag license")
+				return nil, newTagTypeError("sourcerpm", entry.Info, RPM_STRING_TYPE)
 			}
-			pkgInfo.License = parseString(entry.Data)
-			if pkgInfo.License == "(none)" {
-				pkgInfo.License = ""
+			pkgInfo.SourceRpm = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_LICENSE:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("license", entry.Info, RPM_STRING_TYPE)
 			}
+			pkgInfo.License = opts.normalizeNone(parseString(entry.Data))
 		case RPMTAG_VENDOR:
 			if entry.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag vendor")
+				return nil, newTagTypeError("vendor", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.Vendor = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_URL:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("url", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.URL = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_PACKAGER:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("packager", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.Packager = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_DISTRIBUTION:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("distribution", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.Distribution = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_DISTTAG:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("disttag", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.DistTag = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_DISTURL:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("disturl", entry.Info, RPM_STRING_TYPE)
 			}
-			pkgInfo.Vendor = parseString(entry.Data)
-			if pkgInfo.Vendor == "(none)" {
-				pkgInfo.Vendor = ""
+			pkgInfo.DistURL = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_MODULARITYLABEL:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("modularitylabel", entry.Info, RPM_STRING_TYPE)
 			}
+			pkgInfo.Modularitylabel = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_ENCODING:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("encoding", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.Encoding = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_BUILDHOST:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("buildhost", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.BuildHost = opts.normalizeNone(parseString(entry.Data))
 		case RPMTAG_SIZE:
 			if entry.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag size")
+				return nil, newTagTypeError("size", entry.Info, RPM_INT32_TYPE)
 			}
 
 			pkgInfo.Size, err = parseInt32(entry.Data)
 			if err != nil {
-				return nil, xerrors.Errorf("failed to parse size: %w", err)
+				return nil, fmt.Errorf("failed to parse size: %w", err)
 			}
-		case RPMTAG_FILEDIGESTALGO:
-			// note: all digests within a package entry only supports a single digest algorithm (there may be future support for
-			// algorithm noted for each file entry, but currently unimplemented: https://github.com/rpm-software-management/rpm/blob/0b75075a8d006c8f792d33a57eae7da6b66a4591/lib/rpmtag.h#L256)
+		case RPMTAG_LONGSIZE:
+			if entry.Info.Type != RPM_INT64_TYPE {
+				return nil, newTagTypeError("longsize", entry.Info, RPM_INT64_TYPE)
+			}
+
+			pkgInfo.LongSize, err = parseInt64(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse longsize: %w", err)
+			}
+		case RPMTAG_ARCHIVESIZE:
 			if entry.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag digest algo")
+				return nil, newTagTypeError("archivesize", entry.Info, RPM_INT32_TYPE)
 			}
 
-			digestAlgorithm, err := parseInt32(entry.Data)
+			archiveSize, err = parseInt32(entry.Data)
 			if err != nil {
-				return nil, xerrors.Errorf("failed to parse size: %w", err)
+				return nil, fmt.Errorf("failed to parse archivesize: %w", err)
+			}
+		case RPMTAG_LONGARCHIVESIZE:
+			if entry.Info.Type != RPM_INT64_TYPE {
+				return nil, newTagTypeError("longarchivesize", entry.Info, RPM_INT64_TYPE)
 			}
 
-			pkgInfo.DigestAlgorithm = DigestAlgorithm(digestAlgorithm)
-		}
+			pkgInfo.ArchiveSize, err = parseInt64(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse longarchivesize: %w", err)
+			}
+		case RPMTAG_RPMVERSION:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("rpmversion", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.RPMVersion = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_COOKIE:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("cookie", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.Cookie = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_OPTFLAGS:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("optflags", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.OptFlags = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_BUILDTIME:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("buildtime", entry.Info, RPM_INT32_TYPE)
+			}
 
-	}
+			pkgInfo.BuildTime, err = parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse buildtime: %w", err)
+			}
+		case RPMTAG_INSTALLTIME:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("installtime", entry.Info, RPM_INT32_TYPE)
+			}
 
-	files, err := getFileInfo(indexEntries)
-	if err != nil {
-		return nil, xerrors.Errorf("failed to read package files: %w", err)
-	}
+			pkgInfo.InstallTime, err = parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse installtime: %w", err)
+			}
+		case RPMTAG_INSTALLCOLOR:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("installcolor", entry.Info, RPM_INT32_TYPE)
+			}
 
-	pkgInfo.Files = files
+			pkgInfo.InstallColor, err = parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse installcolor: %w", err)
+			}
+		case RPMTAG_INSTALLTID:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("installtid", entry.Info, RPM_INT32_TYPE)
+			}
 
-	return pkgInfo, nil
-}
+			pkgInfo.InstallTID, err = parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse installtid: %w", err)
+			}
+		case RPMTAG_REMOVETID:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("removetid", entry.Info, RPM_INT32_TYPE)
+			}
 
-func getFileInfo(indexEntries []indexEntry) ([]FileInfo, error) {
-	var err error
+			pkgInfo.RemoveTID, err = parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse removetid: %w", err)
+			}
+		case RPMTAG_PAYLOADDIGEST:
+			// note: this is an array, however, there is only ever a single payload digest recorded
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("payload digest", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			if digests := parseStringArray(entry.Data, entry.Info.Count); len(digests) > 0 {
+				pkgInfo.PayloadDigest = digests[0]
+			}
+		case RPMTAG_PAYLOADDIGESTALGO:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("payload digest algo", entry.Info, RPM_INT32_TYPE)
+			}
+
+			digestAlgorithm, err := parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse payload digest algo: %w", err)
+			}
+			pkgInfo.PayloadDigestAlgorithm = DigestAlgorithm(digestAlgorithm)
+		case RPMTAG_PAYLOADFORMAT:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("payloadformat", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.PayloadFormat = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_PAYLOADCOMPRESSOR:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("payloadcompressor", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.PayloadCompressor = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_PAYLOADFLAGS:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("payloadflags", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.PayloadFlags = opts.normalizeNone(parseString(entry.Data))
+		case RPMTAG_SOURCEPKGID:
+			if entry.Info.Type != RPM_BIN_TYPE {
+				return nil, newTagTypeError("sourcepkgid", entry.Info, RPM_BIN_TYPE)
+			}
+			sourcePkgID, err := parseBinary(entry.Data, entry.Info.Count)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse sourcepkgid: %w", err)
+			}
+			pkgInfo.SourcePkgID = hex.EncodeToString(sourcePkgID)
+		case RPMTAG_SIGSIZE:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("sigsize", entry.Info, RPM_INT32_TYPE)
+			}
+
+			if pkgInfo.HasTag(RPMTAG_LONGSIGSIZE) {
+				continue
+			}
+			pkgInfo.Signatures.Size, err = parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse sigsize: %w", err)
+			}
+		case RPMTAG_LONGSIGSIZE:
+			if entry.Info.Type != RPM_INT64_TYPE {
+				return nil, newTagTypeError("longsigsize", entry.Info, RPM_INT64_TYPE)
+			}
 
-	// each of these fields are arrays of metadata for a single file, where the same index across variables are
-	// for the same file (this is how the information is stored within the RPM DB)
-	var allBasenames []string
-	var allDirs []string
-	var allDirIndexes []int32
-	var allFileDigests []string
-	var allFileModes []uint16
-	var allFileSizes []int32
-	var allFileFlags []int32
-	var allUserNames []string
-	var allGroupNames []string
-
-	for _, indexEntry := range indexEntries {
-		switch indexEntry.Info.Tag {
-
-		case RPMTAG_FILESIZES:
-			// note: there is no distinction between int32, uint32, and []uint32
-			if indexEntry.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag file-sizes")
-			}
-			allFileSizes, err = parseInt32Array(indexEntry.Data, indexEntry.Length)
+			sigSize, err := parseInt64(entry.Data)
 			if err != nil {
-				return nil, xerrors.Errorf("failed to parse file-sizes: %w", err)
+				return nil, fmt.Errorf("failed to parse longsigsize: %w", err)
 			}
-		case RPMTAG_FILEFLAGS:
-			// note: there is no distinction between int32, uint32, and []uint32
-			if indexEntry.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag file-flags")
+			pkgInfo.Signatures.Size = int(sigSize)
+		case RPMTAG_SIGMD5:
+			if entry.Info.Type != RPM_BIN_TYPE {
+				return nil, newTagTypeError("sigmd5", entry.Info, RPM_BIN_TYPE)
 			}
-			allFileFlags, err = parseInt32Array(indexEntry.Data, indexEntry.Length)
+			pkgInfo.Signatures.MD5, err = parseBinary(entry.Data, entry.Info.Count)
 			if err != nil {
-				return nil, xerrors.Errorf("failed to parse file-flags: %w", err)
+				return nil, fmt.Errorf("failed to parse sigmd5: %w", err)
 			}
-		case RPMTAG_FILEDIGESTS:
-			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag file-digests")
+			pkgInfo.PkgID = hex.EncodeToString(pkgInfo.Signatures.MD5)
+		case RPMTAG_SIGPGP:
+			if entry.Info.Type != RPM_BIN_TYPE {
+				return nil, newTagTypeError("sigpgp", entry.Info, RPM_BIN_TYPE)
 			}
-			allFileDigests = parseStringArray(indexEntry.Data)
-		case RPMTAG_FILEMODES:
-			// note: there is no distinction between int16, uint16, and []uint16
-			if indexEntry.Info.Type != RPM_INT16_TYPE {
-				return nil, xerrors.New("invalid tag file-modes")
+			pkgInfo.Signatures.PGP = true
+			pkgInfo.Signatures.SigPGP, err = parseBinary(entry.Data, entry.Info.Count)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse sigpgp: %w", err)
 			}
-			allFileModes, err = parseUInt16Array(indexEntry.Data, indexEntry.Length)
+		case RPMTAG_SIGGPG:
+			if entry.Info.Type != RPM_BIN_TYPE {
+				return nil, newTagTypeError("siggpg", entry.Info, RPM_BIN_TYPE)
+			}
+			pkgInfo.Signatures.PGP = true
+			pkgInfo.Signatures.SigGPG, err = parseBinary(entry.Data, entry.Info.Count)
 			if err != nil {
-				return nil, xerrors.Errorf("failed to parse file-modes: %w", err)
+				return nil, fmt.Errorf("failed to parse siggpg: %w", err)
 			}
-		case RPMTAG_BASENAMES:
-			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag basenames")
+		case RPMTAG_DSAHEADER:
+			if entry.Info.Type != RPM_BIN_TYPE {
+				return nil, newTagTypeError("dsaheader", entry.Info, RPM_BIN_TYPE)
 			}
-			allBasenames = parseStringArray(indexEntry.Data)
-		case RPMTAG_FILEUSERNAME:
-			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag usernames")
+			pkgInfo.Signatures.DSA, err = parseBinary(entry.Data, entry.Info.Count)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse dsaheader: %w", err)
 			}
-			allUserNames = parseStringArray(indexEntry.Data)
-		case RPMTAG_FILEGROUPNAME:
-			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag groupnames")
+		case RPMTAG_RSAHEADER:
+			if entry.Info.Type != RPM_BIN_TYPE {
+				return nil, newTagTypeError("rsaheader", entry.Info, RPM_BIN_TYPE)
 			}
-			allGroupNames = parseStringArray(indexEntry.Data)
+			pkgInfo.Signatures.RSA, err = parseBinary(entry.Data, entry.Info.Count)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse rsaheader: %w", err)
+			}
+		case RPMTAG_SHA1HEADER:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("sha1header", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.Signatures.HeaderSHA1 = parseString(entry.Data)
+		case RPMTAG_SHA256HEADER:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError("sha256header", entry.Info, RPM_STRING_TYPE)
+			}
+			pkgInfo.Signatures.HeaderSHA256 = parseString(entry.Data)
+		case RPMTAG_VERITYSIGNATUREALGO:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("verity signature algo", entry.Info, RPM_INT32_TYPE)
+			}
+
+			pkgInfo.Signatures.VerityAlgorithm, err = parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse verity signature algo: %w", err)
+			}
+		case RPMTAG_PREFIXES:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("prefixes", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			pkgInfo.Prefixes = parseStringArray(entry.Data, entry.Info.Count)
+		case RPMTAG_INSTPREFIXES:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("instprefixes", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			pkgInfo.InstPrefixes = parseStringArray(entry.Data, entry.Info.Count)
+		case RPMTAG_POLICIES:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("policies", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			pkgInfo.SELinuxPolicies = parseStringArray(entry.Data, entry.Info.Count)
 		case RPMTAG_DIRNAMES:
-			if indexEntry.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag dir-names")
+			if !opts.compressedPaths {
+				continue
+			}
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, newTagTypeError("dir-names", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			pkgInfo.DirNames = parseStringArray(entry.Data, entry.Info.Count)
+		case RPMTAG_FILEDIGESTALGO:
+			// note: all digests within a package entry only supports a single digest algorithm (there may be future support for
+			// algorithm noted for each file entry, but currently unimplemented: https://github.com/rpm-software-management/rpm/blob/0b75075a8d006c8f792d33a57eae7da6b66a4591/lib/rpmtag.h#L256)
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, newTagTypeError("digest algo", entry.Info, RPM_INT32_TYPE)
 			}
-			allDirs = parseStringArray(indexEntry.Data)
-		case RPMTAG_DIRINDEXES:
-			// note: there is no distinction between int32, uint32, and []uint32
-			if indexEntry.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag dir-indexes")
+
+			digestAlgorithm, err := parseInt32(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse size: %w", err)
+			}
+
+			pkgInfo.DigestAlgorithm = DigestAlgorithm(digestAlgorithm)
+		default:
+			if !opts.extraTags || isFileTag(entry.Info.Tag) || structuredTags[Tag(entry.Info.Tag)] {
+				continue
 			}
-			allDirIndexes, err = parseInt32Array(indexEntry.Data, indexEntry.Length)
+			value, err := parseTagValue(entry)
 			if err != nil {
-				return nil, xerrors.Errorf("failed to parse dir-indexes: %w", err)
+				return nil, fmt.Errorf("failed to parse tag %d: %w", entry.Info.Tag, err)
 			}
+			if pkgInfo.ExtraTags == nil {
+				pkgInfo.ExtraTags = map[Tag]TagValue{}
+			}
+			pkgInfo.ExtraTags[Tag(entry.Info.Tag)] = value
 		}
+
 	}
 
-	// now that we have all of the available metadata, piece together a list of files and their metadata
-	var files []FileInfo
-	if allDirs != nil && allDirIndexes != nil {
-		for i, file := range allBasenames {
-			var digest, username, groupname string
-			var mode uint16
-			var size, flags int32
+	if !pkgInfo.HasTag(RPMTAG_LONGSIZE) {
+		// note: rpm only records the 64-bit size when the size does not fit into the (unsigned) 32-bit tag
+		pkgInfo.LongSize = int64(uint32(pkgInfo.Size))
+	}
+	if !pkgInfo.HasTag(RPMTAG_LONGARCHIVESIZE) {
+		pkgInfo.ArchiveSize = int64(uint32(archiveSize))
+	}
+	// note: packages without any files (e.g. basesystem) have no installed size, while stripping the file tags from a
+	// header leaves the size behind
+	pkgInfo.FilesMissing = pkgInfo.LongSize > 0 && !pkgInfo.HasTag(RPMTAG_BASENAMES) && !pkgInfo.HasTag(RPMTAG_OLDFILENAMES)
+
+	for _, list := range []struct {
+		tags dependencyTags
+		deps *[]Dependency
+	}{
+		{tags: requireTags, deps: &pkgInfo.Requires},
+		{tags: provideTags, deps: &pkgInfo.Provides},
+		{tags: conflictTags, deps: &pkgInfo.Conflicts},
+		{tags: obsoleteTags, deps: &pkgInfo.Obsoletes},
+		{tags: recommendTags, deps: &pkgInfo.Recommends},
+		{tags: suggestTags, deps: &pkgInfo.Suggests},
+		{tags: supplementTags, deps: &pkgInfo.Supplements},
+		{tags: enhanceTags, deps: &pkgInfo.Enhances},
+	} {
+		deps, warnings, err := parseDependencies(indexEntries, list.tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %ss: %w", list.tags.name, err)
+		}
+		*list.deps = deps
+		pkgInfo.Warnings = append(pkgInfo.Warnings, warnings...)
+	}
 
-			if allFileDigests != nil && len(allFileDigests) > i {
-				digest = allFileDigests[i]
-			}
+	for _, script := range []struct {
+		tags      scriptletTags
+		scriptlet **Scriptlet
+	}{
+		{tags: preInTags, scriptlet: &pkgInfo.PreIn},
+		{tags: postInTags, scriptlet: &pkgInfo.PostIn},
+		{tags: preUnTags, scriptlet: &pkgInfo.PreUn},
+		{tags: postUnTags, scriptlet: &pkgInfo.PostUn},
+		{tags: preTransTags, scriptlet: &pkgInfo.PreTrans},
+		{tags: postTransTags, scriptlet: &pkgInfo.PostTrans},
+	} {
+		*script.scriptlet, err = parseScriptlet(indexEntries, script.tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s scriptlet: %w", script.tags.name, err)
+		}
+	}
 
-			if allFileModes != nil && len(allFileModes) > i {
-				mode = allFileModes[i]
-			}
+	for _, list := range []struct {
+		tags     triggerTags
+		triggers *[]Trigger
+	}{
+		{tags: packageTriggerTags, triggers: &pkgInfo.Triggers},
+		{tags: fileTriggerTags, triggers: &pkgInfo.FileTriggers},
+		{tags: transFileTriggerTags, triggers: &pkgInfo.FileTriggers},
+	} {
+		triggers, warnings, err := parseTriggers(indexEntries, list.tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %ss: %w", list.tags.name, err)
+		}
+		*list.triggers = append(*list.triggers, triggers...)
+		pkgInfo.Warnings = append(pkgInfo.Warnings, warnings...)
+	}
 
-			if allFileSizes != nil && len(allFileSizes) > i {
-				size = allFileSizes[i]
-			}
+	policyModules, warnings, err := parseSELinuxPolicyModules(indexEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read selinux policy modules: %w", err)
+	}
+	pkgInfo.SELinuxPolicyModules = policyModules
+	pkgInfo.Warnings = append(pkgInfo.Warnings, warnings...)
 
-			if allUserNames != nil && len(allUserNames) > i {
-				username = allUserNames[i]
-			}
+	changelog, warnings, err := parseChangelog(indexEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read changelog: %w", err)
+	}
+	pkgInfo.Changelog = changelog
+	pkgInfo.Warnings = append(pkgInfo.Warnings, warnings...)
 
-			if allGroupNames != nil && len(allGroupNames) > i {
-				groupname = allGroupNames[i]
-			}
+	if opts.withoutFiles {
+		pkgInfo.lazyFiles = newLazyFiles(indexEntries, opts)
+		return pkgInfo, nil
+	}
 
-			if allFileFlags != nil && len(allFileFlags) > i {
-				flags = allFileFlags[i]
-			}
+	files, warnings, err := getFileInfo(indexEntries, opts.compressedPaths)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package files: %w", err)
+	}
+	pkgInfo.Warnings = append(pkgInfo.Warnings, warnings...)
 
-			record := FileInfo{
-				Path:      allDirs[allDirIndexes[i]] + file,
-				Mode:      mode,
-				Digest:    digest,
-				Size:      size,
-				Username:  username,
-				Groupname: groupname,
-				Flags:     FileFlags(flags),
-			}
-			files = append(files, record)
+	if opts.onlyInstalledFiles {
+		files = installedFiles(files)
+	}
+
+	pkgInfo.Files = files
+
+	return pkgInfo, nil
+}
+
+// getFileInfo zips the per-file tag arrays into a file list, along with any warnings for (non-fatal) invalid values.
+// When compressed is set the files reference their directory by index instead of carrying the joined path.
+func getFileInfo(indexEntries []indexEntry, compressed bool) ([]FileInfo, []string, error) {
+	decoder, err := newFileDecoder(indexEntries, compressed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var files []FileInfo
+	for decoder.next() {
+		files = append(files, decoder.file)
+	}
+	if decoder.err != nil {
+		return nil, nil, decoder.err
+	}
+	return files, decoder.warnings, nil
+}
+
+// installedFiles filters the (already fully zipped) file list down to the files that rpm wrote to disk.
+func installedFiles(files []FileInfo) []FileInfo {
+	var installed []FileInfo
+	for _, f := range files {
+		if f.State.IsInstalled() {
+			installed = append(installed, f)
 		}
 	}
+	return installed
+}
 
-	return files, nil
+// FilePath returns the full path of a file of the package, regardless of whether the package was read with
+// WithCompressedPaths or not.
+func (p *PackageInfo) FilePath(f FileInfo) string {
+	if f.Path != "" {
+		return f.Path
+	}
+	if f.DirIndex < 0 || int(f.DirIndex) >= len(p.DirNames) {
+		return f.Basename
+	}
+	return p.DirNames[f.DirIndex] + f.Basename
 }
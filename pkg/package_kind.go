@@ -0,0 +1,51 @@
+package rpmdb
+
+// PackageKind classifies a database entry by the tags present within its header, since not every entry is an
+// installed binary package (e.g. imported public keys are stored as pseudo-packages without an architecture).
+type PackageKind int
+
+const (
+	// PackageKindOther is an entry that is neither a package nor a public key (e.g. a header that only carries
+	// NAME/VERSION/RELEASE/DESCRIPTION).
+	PackageKindOther PackageKind = iota
+	// PackageKindBinary is a regular installed package.
+	PackageKindBinary
+	// PackageKindSource is an installed source package.
+	PackageKindSource
+	// PackageKindGPGPubkey is an imported OpenPGP public key ("gpg-pubkey" pseudo-package).
+	PackageKindGPGPubkey
+)
+
+func (k PackageKind) String() string {
+	switch k {
+	case PackageKindBinary:
+		return "binary"
+	case PackageKindSource:
+		return "source"
+	case PackageKindGPGPubkey:
+		return "gpg-pubkey"
+	default:
+		return "other"
+	}
+}
+
+// kind classifies the header by tag presence (not by tag values, which may be missing or empty).
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header.c (headerIsSource)
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmkeyring.c (makePubkeyHeader)
+func (p *PackageInfo) kind() PackageKind {
+	switch {
+	case p.HasTag(RPMTAG_PUBKEYS):
+		return PackageKindGPGPubkey
+	case !p.HasTag(RPMTAG_ARCH):
+		return PackageKindOther
+	case p.HasTag(RPMTAG_SOURCEPACKAGE) || !p.HasTag(RPMTAG_SOURCERPM):
+		return PackageKindSource
+	default:
+		return PackageKindBinary
+	}
+}
+
+// IsSource indicates that the entry is an installed source package rather than a binary package.
+func (p *PackageInfo) IsSource() bool {
+	return p.Kind == PackageKindSource
+}
@@ -0,0 +1,133 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageKind(t *testing.T) {
+	nvr := []testEntry{
+		{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		{Tag: RPMTAG_VERSION, Type: RPM_STRING_TYPE, Value: "1.0"},
+		{Tag: RPMTAG_RELEASE, Type: RPM_STRING_TYPE, Value: "1"},
+	}
+	description := testEntry{Tag: RPMTAG_DESCRIPTION, Type: RPM_I18NSTRING_TYPE, Value: []string{"a description"}}
+
+	tests := []struct {
+		name     string
+		entries  []testEntry
+		expected PackageKind
+	}{
+		{
+			name: "binary",
+			entries: append(nvr,
+				testEntry{Tag: RPMTAG_ARCH, Type: RPM_STRING_TYPE, Value: "x86_64"},
+				testEntry{Tag: RPMTAG_SOURCERPM, Type: RPM_STRING_TYPE, Value: "foo-1.0-1.src.rpm"},
+			),
+			expected: PackageKindBinary,
+		},
+		{
+			name: "source without a source rpm",
+			entries: append(nvr,
+				testEntry{Tag: RPMTAG_ARCH, Type: RPM_STRING_TYPE, Value: "x86_64"},
+			),
+			expected: PackageKindSource,
+		},
+		{
+			name: "source package tag",
+			entries: append(nvr,
+				testEntry{Tag: RPMTAG_ARCH, Type: RPM_STRING_TYPE, Value: "x86_64"},
+				testEntry{Tag: RPMTAG_SOURCERPM, Type: RPM_STRING_TYPE, Value: "(none)"},
+				testEntry{Tag: RPMTAG_SOURCEPACKAGE, Type: RPM_INT32_TYPE, Value: int32(1)},
+			),
+			expected: PackageKindSource,
+		},
+		{
+			name: "public key",
+			entries: append([]testEntry{
+				{Tag: RPMTAG_PUBKEYS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bWFkZSB1cA=="}},
+			}, append(nvr, description)...),
+			expected: PackageKindGPGPubkey,
+		},
+		{
+			name:     "name, version, release and description only",
+			entries:  append(nvr, description),
+			expected: PackageKindOther,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexEntries, err := headerImport(newTestHeader(test.entries...))
+			require.NoError(t, err)
+
+			pkg, err := newPackage(indexEntries, options{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, pkg.Kind)
+			assert.Equal(t, test.expected == PackageKindSource, pkg.IsSource())
+			assert.Equal(t, "foo", pkg.Name)
+		})
+	}
+}
+
+func TestPackageKind_Fixture(t *testing.T) {
+	tests := []struct {
+		fixture         string
+		expectedPubkeys []string
+	}{
+		{
+			fixture:         "testdata/centos7-many/Packages",
+			expectedPubkeys: []string{"gpg-pubkey-f4a80eb5-53a7ff4b"},
+		},
+		{
+			// an rpm 4.17 sqlite database with the Fedora key imported by dnf
+			fixture:         "testdata/fedora35/rpmdb.sqlite",
+			expectedPubkeys: []string{"gpg-pubkey-9867c58f-601c49ca"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			var pubkeys []string
+			for _, p := range listFixturePackages(t, test.fixture) {
+				if p.Name == "gpg-pubkey" {
+					pubkeys = append(pubkeys, p.NEVRA().String())
+					assert.Equal(t, PackageKindGPGPubkey, p.Kind)
+					assert.Empty(t, p.Arch)
+					assert.Zero(t, p.Size)
+					assert.Empty(t, p.Warnings)
+					continue
+				}
+				assert.Equal(t, PackageKindBinary, p.Kind, p.Name)
+			}
+			assert.Equal(t, test.expectedPubkeys, pubkeys)
+		})
+	}
+}
+
+func TestPackageKind_SourceFiles(t *testing.T) {
+	// source packages may list their files without any directory tags
+	blob := newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_ARCH, Type: RPM_STRING_TYPE, Value: "x86_64"},
+		testEntry{Tag: RPMTAG_SOURCEPACKAGE, Type: RPM_INT32_TYPE, Value: int32(1)},
+		testEntry{Tag: RPMTAG_FILESIZES, Type: RPM_INT32_TYPE, Value: []int32{1024, 2048}},
+		testEntry{Tag: RPMTAG_BASENAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo.spec", "foo-1.0.tar.gz"}},
+	)
+
+	for _, opts := range [][]Option{nil, {WithFiles(false)}, {WithCompressedPaths()}} {
+		pkg, err := ParseHeader(blob, opts...)
+		require.NoError(t, err)
+		assert.True(t, pkg.IsSource())
+
+		files, err := pkg.FileList()
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+		assert.Equal(t, "foo.spec", pkg.FilePath(files[0]))
+		assert.Equal(t, int32(1024), files[0].Size)
+		assert.Equal(t, "foo-1.0.tar.gz", pkg.FilePath(files[1]))
+		assert.Equal(t, int32(2048), files[1].Size)
+	}
+}
@@ -0,0 +1,152 @@
+package rpmdb
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+)
+
+const (
+	pgpTagSignature            = 2
+	pgpTagPublicKey            = 6
+	pgpTagUserID               = 13
+	pgpSubpacketIssuer         = 16
+	pgpSubpacketIssuerFprint   = 33
+	pgpIssuerKeyIDSize         = 8
+	pgpV3SignatureKeyIDOffset  = 7
+	pgpV4SignatureHashedOffset = 4
+)
+
+// pgpSignatureKeyID extracts the (64 bit) ID of the signing key from an OpenPGP signature packet, as stored in
+// RPMTAG_RSAHEADER and RPMTAG_DSAHEADER. An empty string is returned when the packet cannot be parsed or does not
+// name the issuer.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-5.2
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/rpmio/rpmpgp.c (pgpPrtSig)
+func pgpSignatureKeyID(packet []byte) string {
+	tag, body, _, ok := pgpPacketBody(packet)
+	if !ok || tag != pgpTagSignature || len(body) == 0 {
+		return ""
+	}
+
+	switch body[0] {
+	case 3:
+		// version, hashed length (5), type, creation time (4), key ID (8), ...
+		if len(body) < pgpV3SignatureKeyIDOffset+pgpIssuerKeyIDSize {
+			return ""
+		}
+		return hex.EncodeToString(body[pgpV3SignatureKeyIDOffset : pgpV3SignatureKeyIDOffset+pgpIssuerKeyIDSize])
+	case 4:
+		// version, type, public key algorithm, hash algorithm, hashed subpackets, unhashed subpackets, ...
+		rest := body[pgpV4SignatureHashedOffset:]
+		for i := 0; i < 2; i++ {
+			if len(rest) < 2 {
+				return ""
+			}
+			size := int(binary.BigEndian.Uint16(rest))
+			if len(rest) < 2+size {
+				return ""
+			}
+			if keyID := pgpSubpacketsKeyID(rest[2 : 2+size]); keyID != "" {
+				return keyID
+			}
+			rest = rest[2+size:]
+		}
+	}
+	return ""
+}
+
+// pgpSubpacketsKeyID looks for the issuer (or issuer fingerprint) within the given v4 signature subpackets.
+func pgpSubpacketsKeyID(data []byte) string {
+	for len(data) > 0 {
+		var size, header int
+		switch first := int(data[0]); {
+		case first < 192:
+			size, header = first, 1
+		case first < 255:
+			if len(data) < 2 {
+				return ""
+			}
+			size, header = (first-192)<<8+int(data[1])+192, 2
+		default:
+			if len(data) < 5 {
+				return ""
+			}
+			size, header = int(binary.BigEndian.Uint32(data[1:])), 5
+		}
+		if size < 1 || size > len(data)-header {
+			return ""
+		}
+
+		subpacket := data[header : header+size]
+		// note: the high bit of the type flags the subpacket as critical
+		value := subpacket[1:]
+		switch subpacket[0] & 0x7f {
+		case pgpSubpacketIssuer:
+			if len(value) == pgpIssuerKeyIDSize {
+				return hex.EncodeToString(value)
+			}
+		case pgpSubpacketIssuerFprint:
+			// a version octet followed by the fingerprint, a v4 key ID is the low 64 bits of the fingerprint
+			if len(value) > pgpIssuerKeyIDSize && value[0] == 4 {
+				return hex.EncodeToString(value[len(value)-pgpIssuerKeyIDSize:])
+			}
+		}
+		data = data[header+size:]
+	}
+	return ""
+}
+
+// pgpPacketBody splits a single OpenPGP packet (in either the old or new packet format) into its tag and body, along
+// with the data following the packet.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-4.2
+func pgpPacketBody(packet []byte) (int, []byte, []byte, bool) {
+	if len(packet) < 2 || packet[0]&0x80 == 0 {
+		return 0, nil, nil, false
+	}
+
+	var tag, size, header int
+	if packet[0]&0x40 == 0 {
+		// old format: the low two bits select the length of the length
+		tag = int(packet[0]>>2) & 0x0f
+		switch packet[0] & 0x03 {
+		case 0:
+			size, header = int(packet[1]), 2
+		case 1:
+			if len(packet) < 3 {
+				return 0, nil, nil, false
+			}
+			size, header = int(binary.BigEndian.Uint16(packet[1:])), 3
+		case 2:
+			if len(packet) < 5 {
+				return 0, nil, nil, false
+			}
+			size, header = int(binary.BigEndian.Uint32(packet[1:])), 5
+		default:
+			// indeterminate length, the packet extends to the end of the data
+			size, header = len(packet)-1, 1
+		}
+	} else {
+		tag = int(packet[0]) & 0x3f
+		switch first := int(packet[1]); {
+		case first < 192:
+			size, header = first, 2
+		case first < 224:
+			if len(packet) < 3 {
+				return 0, nil, nil, false
+			}
+			size, header = (first-192)<<8+int(packet[2])+192, 3
+		case first == 255:
+			if len(packet) < 6 {
+				return 0, nil, nil, false
+			}
+			size, header = int(binary.BigEndian.Uint32(packet[2:])), 6
+		default:
+			// partial body lengths are not used for signatures
+			return 0, nil, nil, false
+		}
+	}
+
+	if size < 0 || size > len(packet)-header {
+		return 0, nil, nil, false
+	}
+	return tag, packet[header : header+size], packet[header+size:], true
+}
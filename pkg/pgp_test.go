@@ -0,0 +1,135 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPGPSignatureKeyID_RPMFiles(t *testing.T) {
+	tests := []struct {
+		file  string
+		tag   int32
+		keyID string
+	}{
+		{
+			file:  "testdata/rpm/centos-release-5-0.0.el5.centos.2.x86_64.rpm",
+			tag:   RPMTAG_DSAHEADER,
+			keyID: "a8a447dce8562897",
+		},
+		{
+			file:  "testdata/rpm/epel-release-7-5.noarch.rpm",
+			tag:   RPMTAG_RSAHEADER,
+			keyID: "24c6a8a7f4a80eb5",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			entries, err := ParseHeaderEntries(rpmSignatureSection(t, test.file))
+			require.NoError(t, err)
+
+			var packet []byte
+			for _, entry := range entries {
+				if entry.Tag == test.tag {
+					packet = entry.Data
+				}
+			}
+			require.NotNil(t, packet, "missing signature tag %d", test.tag)
+			assert.Equal(t, test.keyID, pgpSignatureKeyID(packet))
+		})
+	}
+}
+
+func TestPGPSignatureKeyID(t *testing.T) {
+	keyID := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	fingerprint := append([]byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff, 0x00, 0x11, 0x22, 0x33, 0x44, 0x55}, keyID...)
+
+	// v4 signature: version, type, public key algorithm (RSA), hash algorithm (SHA256), then the subpacket areas
+	v4 := func(hashed, unhashed []byte) []byte {
+		body := []byte{4, 0x00, 1, 8}
+		body = append(body, byte(len(hashed)>>8), byte(len(hashed)))
+		body = append(body, hashed...)
+		body = append(body, byte(len(unhashed)>>8), byte(len(unhashed)))
+		body = append(body, unhashed...)
+		// hash prefix and a (truncated) MPI, which are not inspected
+		return append(body, 0xde, 0xad, 0x00, 0x08, 0xff)
+	}
+	creationTime := []byte{5, 2, 0x5f, 0x00, 0x00, 0x00}
+	issuer := append([]byte{9, pgpSubpacketIssuer}, keyID...)
+	issuerFingerprint := append([]byte{22, pgpSubpacketIssuerFprint, 4}, fingerprint...)
+
+	tests := []struct {
+		name     string
+		packet   []byte
+		expected string
+	}{
+		{
+			name:     "v4 issuer in the unhashed area (old packet format)",
+			packet:   oldFormatPacket(pgpTagSignature, v4(creationTime, issuer)),
+			expected: "0102030405060708",
+		},
+		{
+			name:     "v4 issuer fingerprint in the hashed area (new packet format)",
+			packet:   newFormatPacket(pgpTagSignature, v4(append(creationTime, issuerFingerprint...), nil)),
+			expected: "0102030405060708",
+		},
+		{
+			name:     "v4 critical issuer",
+			packet:   newFormatPacket(pgpTagSignature, v4(append([]byte{9, 0x80 | pgpSubpacketIssuer}, keyID...), nil)),
+			expected: "0102030405060708",
+		},
+		{
+			name:   "v4 without issuer",
+			packet: newFormatPacket(pgpTagSignature, v4(creationTime, nil)),
+		},
+		{
+			name:   "v4 truncated subpackets",
+			packet: newFormatPacket(pgpTagSignature, v4(issuer, nil)[:10]),
+		},
+		{
+			name:     "v3",
+			packet:   oldFormatPacket(pgpTagSignature, append([]byte{3, 5, 0x00, 0x5f, 0x00, 0x00, 0x00}, append(keyID, 1, 2, 0xde, 0xad)...)),
+			expected: "0102030405060708",
+		},
+		{
+			name:   "v3 truncated",
+			packet: oldFormatPacket(pgpTagSignature, []byte{3, 5, 0x00, 0x5f, 0x00, 0x00, 0x00, 0x01}),
+		},
+		{
+			name:   "not a signature packet",
+			packet: oldFormatPacket(6, v4(creationTime, issuer)),
+		},
+		{
+			name:   "packet length exceeds data",
+			packet: oldFormatPacket(pgpTagSignature, v4(creationTime, issuer))[:20],
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, pgpSignatureKeyID(test.packet))
+		})
+	}
+}
+
+// oldFormatPacket wraps the body in an old format OpenPGP packet with a two octet length.
+func oldFormatPacket(tag int, body []byte) []byte {
+	return append([]byte{0x80 | byte(tag)<<2 | 1, byte(len(body) >> 8), byte(len(body))}, body...)
+}
+
+// newFormatPacket wraps the body in a new format OpenPGP packet with a one or two octet length.
+func newFormatPacket(tag int, body []byte) []byte {
+	header := []byte{0xc0 | byte(tag)}
+	if len(body) < 192 {
+		header = append(header, byte(len(body)))
+	} else {
+		size := len(body) - 192
+		header = append(header, byte(size>>8)+192, byte(size))
+	}
+	return append(header, body...)
+}
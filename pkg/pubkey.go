@@ -0,0 +1,176 @@
+package rpmdb
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PubkeyInfo describes an OpenPGP public key imported into the rpmdb (a gpg-pubkey pseudo-package), which rpm trusts
+// to verify the signatures of packages.
+type PubkeyInfo struct {
+	// Fingerprint is the hex encoded fingerprint of the primary key (40 characters for v4 keys).
+	Fingerprint string
+	// KeyID is the hex encoded 64 bit ID of the primary key, the low 32 bits are the version of the gpg-pubkey entry.
+	KeyID string
+	// Algorithm is the OpenPGP public key algorithm of the primary key (e.g. 1 for RSA or 17 for DSA).
+	Algorithm int
+	// Created is the creation time of the primary key, which is also the release of the gpg-pubkey entry.
+	Created time.Time
+	// UserID is the first user ID of the key (e.g. "CentOS-7 Key (CentOS 7 Official Signing Key) <security@centos.org>").
+	UserID string
+}
+
+const (
+	pgpArmorBegin = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	pgpArmorEnd   = "-----END PGP PUBLIC KEY BLOCK-----"
+)
+
+// Pubkey parses the ASCII armored public key that rpm stores in the description of gpg-pubkey entries. It returns nil
+// for every other kind of package.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmts.c (rpmtsImportPubkey)
+func (p *PackageInfo) Pubkey() (*PubkeyInfo, error) {
+	// note: keys imported by older rpm versions do not carry RPMTAG_PUBKEYS, so their kind is not known
+	if p.Name != "gpg-pubkey" && p.Kind != PackageKindGPGPubkey {
+		return nil, nil
+	}
+
+	packets, err := pgpDearmor(p.Description)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key of %s-%s: %w", p.Version, p.Release, err)
+	}
+	key, err := parsePubkey(packets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key of %s-%s: %w", p.Version, p.Release, err)
+	}
+	return key, nil
+}
+
+// ListPubkeys returns the public keys imported into the database, in database order.
+func (d *RpmDB) ListPubkeys() ([]PubkeyInfo, error) {
+	pkgList, err := d.ListPackages()
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []PubkeyInfo
+	for _, pkg := range pkgList {
+		key, err := pkg.Pubkey()
+		if err != nil {
+			return nil, err
+		}
+		if key != nil {
+			keys = append(keys, *key)
+		}
+	}
+	return keys, nil
+}
+
+// pgpDearmor decodes the packets of an ASCII armored public key block. The armor checksum is not verified.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-6.2
+func pgpDearmor(armored string) ([]byte, error) {
+	scanner := bufio.NewScanner(strings.NewReader(armored))
+	inBlock, inHeaders := false, false
+	var encoded strings.Builder
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case !inBlock:
+			inBlock = line == pgpArmorBegin
+			inHeaders = inBlock
+		case line == pgpArmorEnd:
+			packets, err := base64.StdEncoding.DecodeString(encoded.String())
+			if err != nil {
+				return nil, fmt.Errorf("invalid armored public key (%v): %w", err, ErrHeaderInvalid)
+			}
+			return packets, nil
+		case inHeaders && strings.Contains(line, ": "):
+			// an armor header (e.g. "Version: rpm-4.11.3 (NSS-3)")
+		case line == "":
+			inHeaders = false
+		case strings.HasPrefix(line, "="):
+			// the armor checksum
+		default:
+			inHeaders = false
+			encoded.WriteString(line)
+		}
+	}
+	return nil, fmt.Errorf("no armored public key block: %w", ErrHeaderInvalid)
+}
+
+// parsePubkey reads the primary key and the first user ID from the packets of a transferable public key.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-5.5.2
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-12.2
+func parsePubkey(packets []byte) (*PubkeyInfo, error) {
+	tag, body, rest, ok := pgpPacketBody(packets)
+	if !ok || tag != pgpTagPublicKey || len(body) == 0 {
+		return nil, fmt.Errorf("no public key packet: %w", ErrHeaderInvalid)
+	}
+
+	key := &PubkeyInfo{}
+	switch body[0] {
+	case 2, 3:
+		// version, creation time (4), validity days (2), algorithm, RSA modulus and exponent MPIs
+		if len(body) < 8 {
+			return nil, fmt.Errorf("truncated v%d public key packet: %w", body[0], ErrHeaderInvalid)
+		}
+		modulus, next, ok := pgpMPI(body[8:])
+		if !ok || len(modulus) < pgpIssuerKeyIDSize {
+			return nil, fmt.Errorf("invalid v%d public key modulus: %w", body[0], ErrHeaderInvalid)
+		}
+		exponent, _, ok := pgpMPI(next)
+		if !ok {
+			return nil, fmt.Errorf("invalid v%d public key exponent: %w", body[0], ErrHeaderInvalid)
+		}
+		fingerprint := md5.Sum(append(append([]byte{}, modulus...), exponent...))
+		key.Fingerprint = hex.EncodeToString(fingerprint[:])
+		key.KeyID = hex.EncodeToString(modulus[len(modulus)-pgpIssuerKeyIDSize:])
+		key.Algorithm = int(body[7])
+	case 4:
+		// version, creation time (4), algorithm, key material
+		if len(body) < 6 {
+			return nil, fmt.Errorf("truncated v4 public key packet: %w", ErrHeaderInvalid)
+		}
+		hash := sha1.New()
+		hash.Write([]byte{0x99, byte(len(body) >> 8), byte(len(body))})
+		hash.Write(body)
+		fingerprint := hash.Sum(nil)
+		key.Fingerprint = hex.EncodeToString(fingerprint)
+		key.KeyID = hex.EncodeToString(fingerprint[len(fingerprint)-pgpIssuerKeyIDSize:])
+		key.Algorithm = int(body[5])
+	default:
+		return nil, fmt.Errorf("unsupported public key version %d: %w", body[0], ErrHeaderInvalid)
+	}
+	key.Created = time.Unix(int64(binary.BigEndian.Uint32(body[1:5])), 0).UTC()
+
+	// note: the user IDs follow the primary key (and its revocation signatures, if any)
+	for len(rest) > 0 {
+		tag, body, rest, ok = pgpPacketBody(rest)
+		if !ok {
+			break
+		}
+		if tag == pgpTagUserID {
+			key.UserID = string(body)
+			break
+		}
+	}
+	return key, nil
+}
+
+// pgpMPI splits a multiprecision integer (a 16 bit length in bits followed by the big-endian value) from the data.
+func pgpMPI(data []byte) ([]byte, []byte, bool) {
+	if len(data) < 2 {
+		return nil, nil, false
+	}
+	size := (int(binary.BigEndian.Uint16(data)) + 7) / 8
+	if len(data) < 2+size {
+		return nil, nil, false
+	}
+	return data[2 : 2+size], data[2+size:], true
+}
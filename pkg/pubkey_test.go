@@ -0,0 +1,92 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpmDB_ListPubkeys(t *testing.T) {
+	tests := []struct {
+		file     string
+		expected []PubkeyInfo
+	}{
+		{
+			file: "testdata/centos7-httpd24/Packages",
+			expected: []PubkeyInfo{
+				{
+					Fingerprint: "6341ab2753d78a78a7c27bb124c6a8a7f4a80eb5",
+					KeyID:       "24c6a8a7f4a80eb5",
+					Algorithm:   1,
+					Created:     time.Unix(0x53a7ff4b, 0).UTC(),
+					UserID:      "CentOS-7 Key (CentOS 7 Official Signing Key) <security@centos.org>",
+				},
+				{
+					Fingerprint: "91e97d7c4a5e96f17f3e888f6a2faea2352c64e5",
+					KeyID:       "6a2faea2352c64e5",
+					Algorithm:   1,
+					Created:     time.Unix(0x52ae6884, 0).UTC(),
+					UserID:      "Fedora EPEL (7) <epel@fedoraproject.org>",
+				},
+				{
+					Fingerprint: "c4dbd535b1fbba14f8ba64a84eb84e71f2ee9d55",
+					KeyID:       "4eb84e71f2ee9d55",
+					Algorithm:   1,
+					Created:     time.Unix(0x560cfc0a, 0).UTC(),
+					UserID:      "CentOS SoftwareCollections SIG (https://wiki.centos.org/SpecialInterestGroup/SCLo) <security@centos.org>",
+				},
+			},
+		},
+		{
+			// keys imported by rpm 4.8 do not carry RPMTAG_PUBKEYS
+			file: "testdata/centos6-many/Packages",
+			expected: []PubkeyInfo{
+				{
+					Fingerprint: "c1dac52d1664e8a4386dba430946fca2c105b9de",
+					KeyID:       "0946fca2c105b9de",
+					Algorithm:   1,
+					Created:     time.Unix(0x4e0fd3a3, 0).UTC(),
+					UserID:      "CentOS-6 Key (CentOS 6 Official Signing Key) <centos-6-key@centos.org>",
+				},
+			},
+		},
+		{
+			file: "testdata/centos7-plain/Packages",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			db, err := Open(test.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			keys, err := db.ListPubkeys()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, keys)
+		})
+	}
+}
+
+func TestPackageInfo_Pubkey(t *testing.T) {
+	pkg := &PackageInfo{Name: "bash"}
+	key, err := pkg.Pubkey()
+	require.NoError(t, err)
+	assert.Nil(t, key)
+
+	for _, description := range []string{
+		"",
+		"-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nnot base64\n-----END PGP PUBLIC KEY BLOCK-----\n",
+		// a signature packet instead of a public key packet
+		"-----BEGIN PGP PUBLIC KEY BLOCK-----\n\niAMEAA==\n-----END PGP PUBLIC KEY BLOCK-----\n",
+		// a truncated v4 public key packet
+		"-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmQACBFM=\n-----END PGP PUBLIC KEY BLOCK-----\n",
+	} {
+		pkg := &PackageInfo{Name: "gpg-pubkey", Kind: PackageKindGPGPubkey, Description: description}
+		_, err := pkg.Pubkey()
+		assert.True(t, errors.Is(err, ErrHeaderInvalid), "unexpected error: %v", err)
+	}
+}
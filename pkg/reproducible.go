@@ -0,0 +1,30 @@
+package rpmdb
+
+import "fmt"
+
+// AppearsReproducible reports whether the package carries the signature of a reproducible build: a recorded payload
+// digest and every file mtime clamped to the build time (which is what rpmbuild does when SOURCE_DATE_EPOCH is set
+// along with %clamp_mtime_to_source_date_epoch and %use_source_date_epoch_as_buildtime). The reason describes the
+// first signal that did not match, or the evidence for a match. When the package was read with WithFiles(false) the
+// file list is decoded first.
+func (p *PackageInfo) AppearsReproducible() (bool, string) {
+	if p.PayloadDigest == "" {
+		return false, "no payload digest recorded"
+	}
+
+	files, err := p.FileList()
+	if err != nil {
+		return false, err.Error()
+	}
+	if len(files) == 0 {
+		return false, "no files to inspect for mtime clamping"
+	}
+
+	for _, f := range files {
+		if int(f.MTime) != p.BuildTime {
+			return false, fmt.Sprintf("mtime of %q (%d) is not clamped to the build time (%d)", p.FilePath(f), f.MTime, p.BuildTime)
+		}
+	}
+
+	return true, fmt.Sprintf("all %d file mtimes are clamped to the build time (%d)", len(files), p.BuildTime)
+}
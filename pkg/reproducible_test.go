@@ -0,0 +1,142 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppearsReproducible(t *testing.T) {
+	const buildTime = 1672531200
+
+	tests := []struct {
+		name     string
+		pkg      PackageInfo
+		expected bool
+		reason   string
+	}{
+		{
+			name: "clamped",
+			pkg: PackageInfo{
+				BuildTime:     buildTime,
+				PayloadDigest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				Files: []FileInfo{
+					{Path: "/usr/bin/foo", MTime: buildTime},
+					{Path: "/usr/share/doc/foo/README", MTime: buildTime},
+				},
+			},
+			expected: true,
+			reason:   "all 2 file mtimes are clamped to the build time (1672531200)",
+		},
+		{
+			name: "unclamped mtime",
+			pkg: PackageInfo{
+				BuildTime:     buildTime,
+				PayloadDigest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				Files: []FileInfo{
+					{Path: "/usr/bin/foo", MTime: buildTime},
+					{Path: "/usr/share/doc/foo/README", MTime: buildTime + 42},
+				},
+			},
+			expected: false,
+			reason:   `mtime of "/usr/share/doc/foo/README" (1672531242) is not clamped to the build time (1672531200)`,
+		},
+		{
+			name: "missing payload digest",
+			pkg: PackageInfo{
+				BuildTime: buildTime,
+				Files: []FileInfo{
+					{Path: "/usr/bin/foo", MTime: buildTime},
+				},
+			},
+			expected: false,
+			reason:   "no payload digest recorded",
+		},
+		{
+			name: "no files",
+			pkg: PackageInfo{
+				BuildTime:     buildTime,
+				PayloadDigest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+			expected: false,
+			reason:   "no files to inspect for mtime clamping",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, reason := test.pkg.AppearsReproducible()
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
+
+func TestAppearsReproducible_Unclamped(t *testing.T) {
+	for name, opts := range map[string][]Option{"eager": nil, "lazy": {WithFiles(false)}} {
+		t.Run(name, func(t *testing.T) {
+			// centos 7 predates both payload digests and mtime clamping
+			var pkg *PackageInfo
+			for _, p := range listFixturePackages(t, "testdata/centos7-plain/Packages", opts...) {
+				if p.Name == "ncurses" {
+					pkg = p
+				}
+			}
+			if pkg == nil {
+				t.Fatalf("package not found")
+			}
+
+			assert.Equal(t, 1504735709, pkg.BuildTime)
+			assert.Empty(t, pkg.PayloadDigest)
+
+			actual, reason := pkg.AppearsReproducible()
+			assert.False(t, actual)
+			assert.Equal(t, "no payload digest recorded", reason)
+
+			// pretend the payload digest is present to exercise the mtime check against real data
+			pkg.PayloadDigest = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+			actual, reason = pkg.AppearsReproducible()
+			assert.False(t, actual)
+			assert.Contains(t, reason, "is not clamped to the build time")
+		})
+	}
+}
+
+func TestAppearsReproducible_Fixture(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected bool
+		reason   string
+	}{
+		{
+			// built with its file mtimes clamped to the build time
+			name:     "fedora-release-identity-container",
+			expected: true,
+			reason:   "all 2 file mtimes are clamped to the build time (1637412871)",
+		},
+		{
+			// fedora 35 clamps mtimes to SOURCE_DATE_EPOCH (the changelog date), which predates the build time
+			name:     "bash",
+			expected: false,
+			reason:   `mtime of "/etc/skel/.bash_logout" (1626892162) is not clamped to the build time (1626892431)`,
+		},
+	}
+
+	pkgs := map[string]*PackageInfo{}
+	for _, p := range listFixturePackages(t, "testdata/fedora35/rpmdb.sqlite") {
+		pkgs[p.Name] = p
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkg, ok := pkgs[test.name]
+			require.True(t, ok, "package not found")
+			require.NotEmpty(t, pkg.PayloadDigest)
+
+			actual, reason := pkg.AppearsReproducible()
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
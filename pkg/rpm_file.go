@@ -0,0 +1,118 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// rpmLeadMagic starts every .rpm file. The rest of the (obsolete) lead is ignored by rpm, apart from the signature
+// type.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmlead.c
+var rpmLeadMagic = []byte{0xed, 0xab, 0xee, 0xdb}
+
+const (
+	// rpmLeadSize is the size of the lead that starts every .rpm file.
+	rpmLeadSize = 96
+	// rpmLeadSigTypeOffset is the offset of the signature type within the lead.
+	rpmLeadSigTypeOffset = 78
+	// rpmSigTypeHeaderSig is the only signature type rpm supports: a signature header follows the lead.
+	rpmSigTypeHeaderSig = 5
+)
+
+// ParsePackageFile reads the package from a .rpm file: the lead, the signature header and the main header. The
+// payload is not read. As rpm does when installing a package, the tags of the signature header are merged into the
+// main header, so that the result can be compared with the PackageInfo of the installed package.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/package.c (rpmpkgRead)
+func ParsePackageFile(reader io.Reader, opts ...Option) (*PackageInfo, error) {
+	lead := make([]byte, rpmLeadSize)
+	if _, err := io.ReadFull(reader, lead); err != nil {
+		return nil, fmt.Errorf("failed to read lead: %w", err)
+	}
+	if !bytes.Equal(lead[:len(rpmLeadMagic)], rpmLeadMagic) {
+		return nil, fmt.Errorf("not an rpm package: %w", ErrUnsupported)
+	}
+	if sigType := binary.BigEndian.Uint16(lead[rpmLeadSigTypeOffset:]); sigType != rpmSigTypeHeaderSig {
+		return nil, fmt.Errorf("unsupported signature type %d: %w", sigType, ErrUnsupported)
+	}
+
+	sigBlob, err := readHeaderSection(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signature header: %w", err)
+	}
+	// the signature header is padded to an 8 byte boundary
+	if pad := (8 - len(sigBlob)%8) % 8; pad > 0 {
+		if _, err := io.CopyN(io.Discard, reader, int64(pad)); err != nil {
+			return nil, fmt.Errorf("failed to read signature header: %w: %w", ErrHeaderInvalid, err)
+		}
+	}
+	sigEntries, err := headerImport(sigBlob)
+	if err != nil {
+		return nil, fmt.Errorf("error during importing signature header: %w", err)
+	}
+
+	blob, err := readHeaderSection(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	indexEntries, err := headerImport(blob)
+	if err != nil {
+		return nil, fmt.Errorf("error during importing header: %w", err)
+	}
+
+	return parseIndexEntries(blob, mergeSignatures(indexEntries, sigEntries), newOptions(opts...))
+}
+
+// readHeaderSection reads a header preceded by the header magic, returning the header without the magic.
+func readHeaderSection(reader io.Reader) ([]byte, error) {
+	intro := make([]byte, len(headerMagic)+headerPreambleSize)
+	if _, err := io.ReadFull(reader, intro); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrHeaderInvalid, err)
+	}
+	if !bytes.Equal(intro[:4], headerMagic[:4]) {
+		return nil, fmt.Errorf("missing header magic: %w", ErrHeaderInvalid)
+	}
+
+	il := binary.BigEndian.Uint32(intro[len(headerMagic):])
+	dl := binary.BigEndian.Uint32(intro[len(headerMagic)+4:])
+	if il < 1 || il > headerMaxTags {
+		return nil, fmt.Errorf("index length %d out of range: %w", il, ErrHeaderInvalid)
+	}
+	if dl > headerMaxData {
+		return nil, fmt.Errorf("data length %d out of range: %w", dl, ErrHeaderInvalid)
+	}
+
+	blob := make([]byte, headerPreambleSize+int(il)*entryInfoSize+int(dl))
+	copy(blob, intro[len(headerMagic):])
+	if _, err := io.ReadFull(reader, blob[headerPreambleSize:]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrHeaderInvalid, err)
+	}
+	return blob, nil
+}
+
+// mergeSignatures adds the tags of the signature header to the main header (renumbered, see
+// signatureTagToHeaderTag), unless the main header already has them. Only tags within the signature range (and the
+// payload size) are merged, which excludes the region of the signature header.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/package.c (headerMergeLegacySigs)
+func mergeSignatures(indexEntries, sigEntries []indexEntry) []indexEntry {
+	present := make(map[int32]bool, len(indexEntries))
+	for _, entry := range indexEntries {
+		present[entry.Info.Tag] = true
+	}
+
+	merged := indexEntries
+	for _, entry := range sigEntries {
+		tag := signatureTagToHeaderTag(entry.Info.Tag)
+		if tag != RPMTAG_ARCHIVESIZE && (tag < HEADER_SIGBASE || tag >= HEADER_TAGBASE) {
+			continue
+		}
+		if present[tag] {
+			continue
+		}
+		present[tag] = true
+		entry.Info.Tag = tag
+		merged = append(merged, entry)
+	}
+	return merged
+}
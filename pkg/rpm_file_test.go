@@ -0,0 +1,130 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePackageFile(t *testing.T) {
+	tests := []struct {
+		file        string
+		nevra       string
+		signatures  Signatures
+		archiveSize int64
+		keyID       string
+	}{
+		{
+			file:  "testdata/rpm/epel-release-7-5.noarch.rpm",
+			nevra: "epel-release-7-5.noarch",
+			signatures: Signatures{
+				Size:       13140,
+				MD5:        mustDecodeHex(t, "74e3cd3288e69c33fbe475badfac0e7c"),
+				HeaderSHA1: "95ae8c280910e4509f4630268483ba4bd9d040ba",
+			},
+			archiveSize: 26088,
+			keyID:       "24c6a8a7f4a80eb5",
+		},
+		{
+			file:  "testdata/rpm/centos-release-5-0.0.el5.centos.2.x86_64.rpm",
+			nevra: "centos-release-10:5-0.0.el5.centos.2.x86_64",
+			signatures: Signatures{
+				Size:       18433,
+				MD5:        mustDecodeHex(t, "4336410d489588f27136582265d3992c"),
+				HeaderSHA1: "f36d941307229e6cdbf13f9b8694940f09455347",
+			},
+			archiveSize: 38444,
+			keyID:       "a8a447dce8562897",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			file, err := os.Open(test.file)
+			require.NoError(t, err)
+			defer file.Close()
+
+			pkg, err := ParsePackageFile(file)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.nevra, pkg.NEVRA().String())
+			assert.Equal(t, test.signatures.Size, pkg.Signatures.Size)
+			assert.Equal(t, test.signatures.MD5, pkg.Signatures.MD5)
+			assert.Equal(t, test.signatures.HeaderSHA1, pkg.Signatures.HeaderSHA1)
+			assert.Equal(t, test.archiveSize, pkg.ArchiveSize)
+			assert.Equal(t, test.keyID, pkg.Signatures.KeyID())
+			assert.True(t, pkg.HasTag(RPMTAG_SIGMD5))
+
+			// apart from the merged signature tags (and the package ID derived from them), the package is the same as read
+			// from the main header alone
+			headerOnly, err := ParseHeader(rpmHeaderSection(t, test.file))
+			require.NoError(t, err)
+			headerOnly.Signatures, headerOnly.ArchiveSize, headerOnly.PkgID = pkg.Signatures, pkg.ArchiveSize, pkg.PkgID
+			for _, d := range deep.Equal(headerOnly, pkg) {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestParsePackageFile_Errors(t *testing.T) {
+	const fixture = "testdata/rpm/epel-release-7-5.noarch.rpm"
+	contents, err := os.ReadFile(fixture)
+	require.NoError(t, err)
+
+	corrupted := append([]byte{}, contents...)
+	headerStart := len(contents) - len(rpmHeaderSection(t, fixture))
+	il := int(binary.BigEndian.Uint32(corrupted[headerStart+8:]))
+	corrupted[headerStart+len(headerMagic)+headerPreambleSize+il*entryInfoSize+1]++
+
+	database, err := os.ReadFile("testdata/centos7-plain/Packages")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contents    []byte
+		expectedErr error
+	}{
+		{
+			name:        "not a package",
+			contents:    database[:4096],
+			expectedErr: ErrUnsupported,
+		},
+		{
+			name:        "truncated signature header",
+			contents:    contents[:rpmLeadSize+100],
+			expectedErr: ErrHeaderInvalid,
+		},
+		{
+			name:        "truncated header",
+			contents:    contents[:headerStart+100],
+			expectedErr: ErrHeaderInvalid,
+		},
+		{
+			name:        "header digest mismatch",
+			contents:    corrupted,
+			expectedErr: ErrBlobChecksum,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParsePackageFile(bytes.NewReader(test.contents))
+			assert.True(t, errors.Is(err, test.expectedErr), "unexpected error: %v", err)
+		})
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
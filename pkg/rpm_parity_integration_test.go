@@ -0,0 +1,71 @@
+//go:build integration
+
+package rpmdb
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rpmParityDirs are the directories rpm keeps its database in, on older and newer distributions. The database within
+// (Berkeley DB, SQLite or ndb) is found with Detect.
+var rpmParityDirs = []string{
+	"/var/lib/rpm",
+	"/usr/lib/sysimage/rpm",
+}
+
+// TestRPMParity compares every package (and file) read from the host database against the output of rpm itself,
+// it is meant to run within distribution containers (go test -tags integration -run TestRPMParity). The database (or
+// its directory) may be selected with RPMDB_PARITY_PATH, the directory is also passed to rpm via --dbpath.
+func TestRPMParity(t *testing.T) {
+	rpmPath, err := exec.LookPath("rpm")
+	if err != nil {
+		t.Skip("rpm is not installed")
+	}
+
+	var args []string
+	var dbPath string
+	if path := os.Getenv("RPMDB_PARITY_PATH"); path != "" {
+		source, err := Detect(path)
+		require.NoError(t, err)
+		dbPath = source.Path
+		args = append(args, "--dbpath", filepath.Dir(dbPath))
+	} else {
+		for _, dir := range rpmParityDirs {
+			if source, err := Detect(dir); err == nil {
+				dbPath = source.Path
+				break
+			}
+		}
+		// note: rpm is installed, so a missing database means the harness would silently check nothing
+		if dbPath == "" {
+			t.Fatalf("no supported rpm database found (tried %v)", rpmParityDirs)
+		}
+	}
+	t.Logf("comparing %s against %s", dbPath, rpmPath)
+
+	output, err := exec.Command(rpmPath, append(args, "-qa", "--qf", rpmParityQueryFormat)...).Output()
+	require.NoError(t, err)
+	expected, err := parseRPMParityOutput(output)
+	require.NoError(t, err)
+	require.NotEmpty(t, expected)
+
+	db, err := Open(dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+	pkgList, err := db.ListPackages()
+	require.NoError(t, err)
+
+	var actual []parityPackage
+	for _, pkg := range pkgList {
+		actual = append(actual, newParityPackage(pkg))
+	}
+
+	for _, diff := range compareParity(expected, actual) {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,314 @@
+package rpmdb
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rpmParityQueryFormat makes rpm print one "P" line per package followed by one "F" line per file, the fields
+// match parityPackageFields and parityFileFields (the file name is last, so that it may contain tabs).
+const rpmParityQueryFormat = `P\t%{NAME}\t%{EPOCH}\t%{VERSION}\t%{RELEASE}\t%{ARCH}\t%{SUMMARY}\t%{GROUP}\t%{SIZE}\t%{LICENSE}\t%{VENDOR}` +
+	`\t%{SOURCERPM}\t%{URL}\t%{PACKAGER}\t%{DISTRIBUTION}\t%{DISTTAG}\t%{DISTURL}` +
+	`\t%{BUILDHOST}\t%{BUILDTIME}\t%{INSTALLTIME}\t%{SIGMD5}` +
+	`\t%|RSAHEADER?{%{RSAHEADER:pgpsig}}:{%|DSAHEADER?{%{DSAHEADER:pgpsig}}:{(none)}|}|\n` +
+	`[F\t%{FILESIZES}\t%{FILEMODES}\t%{FILEDIGESTS}\t%{FILEFLAGS}\t%{FILEUSERNAME}\t%{FILEGROUPNAME}` +
+	`\t%{FILEMTIMES}\t%{FILELINKTOS}\t%{FILESTATES:fstate}\t%{FILENAMES}\n]`
+
+var (
+	parityPackageFields = []string{
+		"NAME", "EPOCH", "VERSION", "RELEASE", "ARCH", "SUMMARY", "GROUP", "SIZE", "LICENSE", "VENDOR", "SOURCERPM", "URL", "PACKAGER",
+		"DISTRIBUTION", "DISTTAG", "DISTURL", "BUILDHOST", "BUILDTIME", "INSTALLTIME", "SIGMD5", "KEYID",
+	}
+	parityFileFields = []string{
+		"FILESIZES", "FILEMODES", "FILEDIGESTS", "FILEFLAGS", "FILEUSERNAME", "FILEGROUPNAME", "FILEMTIMES",
+		"FILELINKTOS", "FILESTATES",
+	}
+)
+
+// pgpsigKeyIDPattern matches the key ID within the output of rpm's pgpsig format (e.g. "RSA/SHA256, Mon 12 Nov
+// 2018 03:44:28 PM UTC, Key ID 24c6a8a7f4a80eb5").
+var pgpsigKeyIDPattern = regexp.MustCompile(`Key ID ([0-9a-fA-F]+)`)
+
+// parityPackage holds the normalized values of a single package, as printed by rpm or read by this library.
+type parityPackage struct {
+	fields map[string]string
+	files  map[string]map[string]string
+}
+
+// nevra identifies the package within a parity report, an empty epoch is omitted as rpm does.
+func (p parityPackage) nevra() string {
+	evr := p.fields["VERSION"] + "-" + p.fields["RELEASE"]
+	if p.fields["EPOCH"] != "" {
+		evr = p.fields["EPOCH"] + ":" + evr
+	}
+	if p.fields["ARCH"] == "" {
+		return p.fields["NAME"] + "-" + evr
+	}
+	return p.fields["NAME"] + "-" + evr + "." + p.fields["ARCH"]
+}
+
+// normalizeParityValue folds rpm's "(none)" placeholder for missing tags into an empty value, the library reports
+// missing tags as empty strings (or nil for the epoch).
+func normalizeParityValue(value string) string {
+	if value == "(none)" {
+		return ""
+	}
+	return value
+}
+
+// parseRPMParityOutput reads the output of "rpm -qa --qf <rpmParityQueryFormat>".
+func parseRPMParityOutput(output []byte) ([]parityPackage, error) {
+	var pkgs []parityPackage
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		switch {
+		case strings.HasPrefix(text, "P\t"):
+			values := strings.Split(text[2:], "\t")
+			if len(values) != len(parityPackageFields) {
+				return nil, fmt.Errorf("line %d: expected %d package fields, got %d: %q", line, len(parityPackageFields), len(values), text)
+			}
+			pkg := parityPackage{fields: map[string]string{}, files: map[string]map[string]string{}}
+			for i, field := range parityPackageFields {
+				pkg.fields[field] = normalizeParityValue(values[i])
+			}
+			pkg.fields["KEYID"] = ""
+			if match := pgpsigKeyIDPattern.FindStringSubmatch(values[len(values)-1]); match != nil {
+				pkg.fields["KEYID"] = strings.ToLower(match[1])
+			}
+			pkgs = append(pkgs, pkg)
+		case strings.HasPrefix(text, "F\t"):
+			if len(pkgs) == 0 {
+				return nil, fmt.Errorf("line %d: file without a package: %q", line, text)
+			}
+			values := strings.SplitN(text[2:], "\t", len(parityFileFields)+1)
+			if len(values) != len(parityFileFields)+1 {
+				return nil, fmt.Errorf("line %d: expected %d file fields, got %d: %q", line, len(parityFileFields)+1, len(values), text)
+			}
+			path := values[len(values)-1]
+			if path == "(none)" {
+				// packages without files still iterate once over the (missing) file tags
+				continue
+			}
+			file := map[string]string{}
+			for i, field := range parityFileFields {
+				file[field] = normalizeParityValue(values[i])
+			}
+			pkgs[len(pkgs)-1].files[path] = file
+		case text == "":
+		default:
+			return nil, fmt.Errorf("line %d: unexpected output: %q", line, text)
+		}
+	}
+	return pkgs, scanner.Err()
+}
+
+// newParityPackage renders the package read by this library the same way rpm prints it.
+func newParityPackage(pkg *PackageInfo) parityPackage {
+	epoch := ""
+	if pkg.Epoch != nil {
+		epoch = strconv.Itoa(*pkg.Epoch)
+	}
+	p := parityPackage{
+		fields: map[string]string{
+			"NAME":         pkg.Name,
+			"EPOCH":        epoch,
+			"VERSION":      pkg.Version,
+			"RELEASE":      pkg.Release,
+			"ARCH":         pkg.Arch,
+			"SUMMARY":      pkg.Summary,
+			"GROUP":        pkg.Group,
+			"SIZE":         strconv.Itoa(pkg.Size),
+			"LICENSE":      pkg.License,
+			"VENDOR":       pkg.Vendor,
+			"SOURCERPM":    pkg.SourceRpm,
+			"URL":          pkg.URL,
+			"PACKAGER":     pkg.Packager,
+			"DISTRIBUTION": pkg.Distribution,
+			"DISTTAG":      pkg.DistTag,
+			"DISTURL":      pkg.DistURL,
+			"BUILDHOST":    pkg.BuildHost,
+			"BUILDTIME":    strconv.Itoa(pkg.BuildTime),
+			"INSTALLTIME":  strconv.Itoa(pkg.InstallTime),
+			"SIGMD5":       pkg.PkgID,
+			"KEYID":        pkg.Signatures.KeyID(),
+		},
+		files: map[string]map[string]string{},
+	}
+	for field, value := range p.fields {
+		p.fields[field] = normalizeParityValue(value)
+	}
+	for _, f := range pkg.Files {
+		p.files[pkg.FilePath(f)] = map[string]string{
+			"FILESIZES":     strconv.FormatUint(uint64(uint32(f.Size)), 10),
+			"FILEMODES":     strconv.Itoa(int(f.Mode)),
+			"FILEDIGESTS":   f.Digest,
+			"FILEFLAGS":     strconv.Itoa(int(f.Flags)),
+			"FILEUSERNAME":  f.Username,
+			"FILEGROUPNAME": f.Groupname,
+			"FILEMTIMES":    strconv.FormatUint(uint64(uint32(f.MTime)), 10),
+			"FILELINKTOS":   f.LinkTo,
+			"FILESTATES":    f.State.String(),
+		}
+	}
+	return p
+}
+
+// compareParity reports every difference between the packages printed by rpm and the packages read by this
+// library, naming the package, the file (if any) and the field.
+func compareParity(expected, actual []parityPackage) []string {
+	byNEVRA := func(pkgs []parityPackage) map[string][]parityPackage {
+		result := map[string][]parityPackage{}
+		for _, pkg := range pkgs {
+			result[pkg.nevra()] = append(result[pkg.nevra()], pkg)
+		}
+		return result
+	}
+	expectedPkgs, actualPkgs := byNEVRA(expected), byNEVRA(actual)
+
+	var diffs []string
+	for _, nevra := range sortedKeys(expectedPkgs, actualPkgs) {
+		e, a := expectedPkgs[nevra], actualPkgs[nevra]
+		if len(e) != len(a) {
+			diffs = append(diffs, fmt.Sprintf("%s: rpm lists %d package(s), the library %d", nevra, len(e), len(a)))
+			continue
+		}
+		for i := range e {
+			diffs = append(diffs, compareParityFields(nevra, parityPackageFields, e[i].fields, a[i].fields)...)
+
+			for _, path := range sortedKeys(e[i].files, a[i].files) {
+				ef, eok := e[i].files[path]
+				af, aok := a[i].files[path]
+				switch {
+				case !aok:
+					diffs = append(diffs, fmt.Sprintf("%s: file %q is missing from the library", nevra, path))
+				case !eok:
+					diffs = append(diffs, fmt.Sprintf("%s: file %q is not listed by rpm", nevra, path))
+				default:
+					diffs = append(diffs, compareParityFields(nevra+": file "+strconv.Quote(path), parityFileFields, ef, af)...)
+				}
+			}
+		}
+	}
+	return diffs
+}
+
+func compareParityFields(name string, fields []string, expected, actual map[string]string) []string {
+	var diffs []string
+	for _, field := range fields {
+		if expected[field] != actual[field] {
+			diffs = append(diffs, fmt.Sprintf("%s: %s: rpm=%q library=%q", name, field, expected[field], actual[field]))
+		}
+	}
+	return diffs
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestParseRPMParityOutput(t *testing.T) {
+	output := strings.Join([]string{
+		"P\tncurses\t(none)\t5.9\t14.20130511.el7_4\tx86_64\tNcurses support utilities\tSystem Environment/Base\t439378\tMIT\tCentOS\tncurses-5.9-14.20130511.el7_4.src.rpm" +
+			"\thttp://invisible-island.net/ncurses/ncurses.html\tCentOS BuildSystem <http://bugs.centos.org>" +
+			"\tCentOS\t(none)\t(none)\tx86-01.bsys.centos.org\t1507135432\t1560373620\t8ca93e2831102818759a22e22e871268" +
+			"\tRSA/SHA256, Tue 24 Oct 2017 03:46:05 PM UTC, Key ID 24c6a8a7f4a80eb5",
+		"F\t1024\t33261\tabc123\t0\troot\troot\t1507135430\t\tnormal\t/usr/bin/clear",
+		"F\t4096\t16877\t\t0\troot\troot\t1507135430\t\tnot installed\t/usr/share/doc/ncurses 5.9",
+		"F\t3\t41471\t\t0\troot\troot\t1507135430\ttset\tnormal\t/usr/bin/reset",
+		"P\tgpg-pubkey\t(none)\tf4a80eb5\t53a7ff4b\t(none)\tgpg(CentOS-7 Key (CentOS 7 Official Signing Key) <security@centos.org>)\tPublic Keys\t0\tpubkey\t(none)\t(none)\t(none)\t(none)\t(none)\t(none)\t(none)\tlocalhost" +
+			"\t1560373613\t1560373613\t(none)\t(none)",
+		"F\t(none)\t(none)\t(none)\t(none)\t(none)\t(none)\t(none)\t(none)\t(none)\t(none)",
+		"",
+	}, "\n")
+
+	pkgs, err := parseRPMParityOutput([]byte(output))
+	require.NoError(t, err)
+	require.Len(t, pkgs, 2)
+
+	assert.Equal(t, "ncurses-5.9-14.20130511.el7_4.x86_64", pkgs[0].nevra())
+	assert.Equal(t, "", pkgs[0].fields["EPOCH"])
+	assert.Equal(t, "Ncurses support utilities", pkgs[0].fields["SUMMARY"])
+	assert.Equal(t, "24c6a8a7f4a80eb5", pkgs[0].fields["KEYID"])
+	assert.Equal(t, "CentOS BuildSystem <http://bugs.centos.org>", pkgs[0].fields["PACKAGER"])
+	assert.Equal(t, "1560373620", pkgs[0].fields["INSTALLTIME"])
+	assert.Equal(t, "CentOS", pkgs[0].fields["DISTRIBUTION"])
+	assert.Equal(t, "", pkgs[0].fields["DISTTAG"])
+	assert.Equal(t, map[string]map[string]string{
+		"/usr/bin/clear": {
+			"FILESIZES": "1024", "FILEMODES": "33261", "FILEDIGESTS": "abc123", "FILEFLAGS": "0",
+			"FILEUSERNAME": "root", "FILEGROUPNAME": "root", "FILEMTIMES": "1507135430", "FILELINKTOS": "", "FILESTATES": "normal",
+		},
+		"/usr/share/doc/ncurses 5.9": {
+			"FILESIZES": "4096", "FILEMODES": "16877", "FILEDIGESTS": "", "FILEFLAGS": "0",
+			"FILEUSERNAME": "root", "FILEGROUPNAME": "root", "FILEMTIMES": "1507135430", "FILELINKTOS": "", "FILESTATES": "not installed",
+		},
+		"/usr/bin/reset": {
+			"FILESIZES": "3", "FILEMODES": "41471", "FILEDIGESTS": "", "FILEFLAGS": "0",
+			"FILEUSERNAME": "root", "FILEGROUPNAME": "root", "FILEMTIMES": "1507135430", "FILELINKTOS": "tset", "FILESTATES": "normal",
+		},
+	}, pkgs[0].files)
+
+	assert.Equal(t, "gpg-pubkey-f4a80eb5-53a7ff4b", pkgs[1].nevra())
+	assert.Equal(t, "", pkgs[1].fields["KEYID"])
+	assert.Equal(t, "", pkgs[1].fields["VENDOR"])
+	assert.Empty(t, pkgs[1].files)
+
+	for _, invalid := range []string{
+		"P\tncurses\t(none)\n",
+		"F\t1024\t33261\tabc123\t0\troot\troot\t1507135430\t\tnormal\t/usr/bin/clear\n",
+		"error: rpmdb: BDB0113 Thread/process 1/2 failed\n",
+	} {
+		_, err := parseRPMParityOutput([]byte(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestCompareParity(t *testing.T) {
+	var expected, actual []parityPackage
+	for _, pkg := range listFixturePackages(t, "testdata/centos7-plain/Packages") {
+		expected = append(expected, newParityPackage(pkg))
+		actual = append(actual, newParityPackage(pkg))
+	}
+	assert.Empty(t, compareParity(expected, actual))
+
+	var ncurses parityPackage
+	for _, pkg := range actual {
+		if pkg.fields["NAME"] == "ncurses" {
+			ncurses = pkg
+		}
+	}
+	require.NotNil(t, ncurses.fields)
+	ncurses.fields["VENDOR"] = "Example"
+	ncurses.files["/usr/bin/clear"]["FILEMODES"] = "0"
+	delete(ncurses.files, "/usr/bin/reset")
+	actual = actual[1:]
+
+	assert.Equal(t, []string{
+		`ncurses-5.9-14.20130511.el7_4.x86_64: VENDOR: rpm="CentOS" library="Example"`,
+		`ncurses-5.9-14.20130511.el7_4.x86_64: file "/usr/bin/clear": FILEMODES: rpm="33261" library="0"`,
+		`ncurses-5.9-14.20130511.el7_4.x86_64: file "/usr/bin/reset" is missing from the library`,
+		`tzdata-2018e-3.el7.noarch: rpm lists 1 package(s), the library 0`,
+	}, compareParity(expected, actual))
+}
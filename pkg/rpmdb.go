@@ -1,41 +1,165 @@
 package rpmdb
 
 import (
+	"errors"
+	"fmt"
+	"io"
+
 	"github.com/anchore/go-rpmdb/pkg/bdb"
-	"golang.org/x/xerrors"
+	"github.com/anchore/go-rpmdb/pkg/dbi"
+	"github.com/anchore/go-rpmdb/pkg/ndb"
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
 )
 
 type RpmDB struct {
-	db *bdb.BerkeleyDB
+	db   dbi.DBI
+	opts options
+	// closers release what the database was read from (e.g. a file opened by OpenFS), after the backend is closed
+	closers []io.Closer
+}
+
+// Open opens the rpm database at the given path, detecting the backend (Berkeley DB, SQLite or ndb) from the file
+// contents. The path may also be the directory holding the database (see Detect). When a connection is supplied via
+// WithSQLiteDB the path is not used.
+func Open(path string, opts ...Option) (*RpmDB, error) {
+	o := newOptions(opts...)
+
+	db, err := openDBI(path, o)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RpmDB{
+		db:   db,
+		opts: o,
+	}, nil
+
 }
 
-func Open(path string) (*RpmDB, error) {
-	db, err := bdb.Open(path)
+// OpenReader opens an rpm database held in memory (or any other io.ReaderAt) of the given size, detecting the backend
+// from the contents as with Open. Only the Berkeley DB and ndb backends can be read this way, SQLite databases need a
+// path (or a connection supplied to Open via WithSQLiteDB).
+func OpenReader(reader io.ReaderAt, size int64, opts ...Option) (*RpmDB, error) {
+	o := newOptions(opts...)
+	if o.sqliteDB != nil {
+		return nil, fmt.Errorf("a sqlite connection cannot be combined with a reader: %w", ErrUnsupported)
+	}
+	if size == 0 {
+		return nil, fmt.Errorf("zero byte database: %w", ErrEmptyDatabase)
+	}
+
+	db, err := openReaderDBI(reader, size)
 	if err != nil {
 		return nil, err
 	}
 
 	return &RpmDB{
-		db: db,
+		db:   db,
+		opts: o,
 	}, nil
+}
+
+// openReaderDBI opens the backend of the database read from the given reader (see OpenReader).
+func openReaderDBI(reader io.ReaderAt, size int64) (dbi.DBI, error) {
+	backend, err := detectBackend(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database: %w", err)
+	}
+
+	switch backend {
+	case BackendBerkeleyDB:
+		return bdb.NewReader(reader, size)
+	case BackendNDB:
+		return ndb.NewReader(reader, size)
+	case BackendSQLite:
+		return nil, fmt.Errorf("sqlite databases cannot be read from memory: %w", ErrUnsupported)
+	default:
+		return nil, fmt.Errorf("not a recognized rpm database: %w", ErrUnsupported)
+	}
+}
+
+// openDBI opens the backend of the database at the given path (see Detect).
+func openDBI(path string, opts options) (dbi.DBI, error) {
+	if opts.sqliteDB != nil {
+		return sqlite.New(opts.sqliteDB)
+	}
+
+	source, err := Detect(path)
+	if err != nil {
+		return nil, err
+	}
+	switch source.Backend {
+	case BackendSQLite:
+		return sqlite.Open(source.Path)
+	case BackendNDB:
+		return ndb.Open(source.Path)
+	default:
+		return bdb.Open(source.Path)
+	}
+}
+
+// backend names the database backend in use ("bdb", "sqlite" or "ndb").
+func (d *RpmDB) backend() string {
+	switch d.db.(type) {
+	case *bdb.BerkeleyDB:
+		return BackendBerkeleyDB
+	case *sqlite.SQLite:
+		return BackendSQLite
+	case *ndb.NDB:
+		return BackendNDB
+	default:
+		return "unknown"
+	}
+}
 
+// Close releases the resources held by the database backend (a connection supplied via WithSQLiteDB is left open).
+func (d *RpmDB) Close() error {
+	var err error
+	if closer, ok := d.db.(io.Closer); ok {
+		err = closer.Close()
+	}
+	for _, closer := range d.closers {
+		if closeErr := closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
 }
 
 func (d *RpmDB) ListPackages() ([]*PackageInfo, error) {
+	return d.listPackages(nil)
+}
+
+// listPackages parses every header in the database, calling visit (when given) with each package and the raw blob it
+// was parsed from.
+func (d *RpmDB) listPackages(visit func(pkg *PackageInfo, blob []byte)) ([]*PackageInfo, error) {
 	var pkgList []*PackageInfo
 
-	for entry := range d.db.Read() {
+	entries := d.db.Read()
+	// the backend blocks sending the next entry (holding its file or connection) until the channel is drained, which
+	// must also happen when returning early
+	defer func() {
+		for range entries {
+		}
+	}()
+
+	for entry := range entries {
+		var warnings []string
 		if entry.Err != nil {
-			return nil, entry.Err
+			// the backend checksum of a blob can be ignored like the header digest (see WithLenientChecksums)
+			if !d.opts.lenientChecksums || !errors.Is(entry.Err, ErrBlobChecksum) || entry.Value == nil {
+				return nil, entry.Err
+			}
+			warnings = append(warnings, entry.Err.Error())
 		}
 
-		indexEntries, err := headerImport(entry.Value)
+		pkg, err := parseHeader(entry.Value, d.opts)
 		if err != nil {
-			return nil, xerrors.Errorf("error during importing header: %w", err)
+			return nil, fmt.Errorf("package instance %d: %w", entry.Instance, err)
 		}
-		pkg, err := newPackage(indexEntries)
-		if err != nil {
-			return nil, xerrors.Errorf("invalid package info: %w", err)
+		pkg.Warnings = append(warnings, pkg.Warnings...)
+		if visit != nil {
+			visit(pkg, entry.Value)
 		}
 
 		pkgList = append(pkgList, pkg)
@@ -13,269 +13,269 @@ var (
 	// yum groupinstall -y "Development tools"
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
 	CentOS6DevTools = []PackageInfo{
-		{intRef(), "iproute", "2.6.32", "57.el6", "x86_64", "iproute-2.6.32-57.el6.src.rpm", 963477, "GPLv2+ and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setup", "2.8.14", "23.el6", "noarch", "setup-2.8.14-23.el6.src.rpm", 666669, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iputils", "20071127", "24.el6", "x86_64", "iputils-20071127-24.el6.src.rpm", 297243, "BSD with advertising and GPLv2+ and Rdisc", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "4.el6", "noarch", "basesystem-10.0-4.el6.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "util-linux-ng", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 6139437, "GPLv1+ and GPLv2 and GPLv2+ and LGPLv2+ and MIT and BSD with advertising and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata", "2018e", "3.el6", "noarch", "tzdata-2018e-3.el6.src.rpm", 1961609, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "udev", "147", "2.73.el6_8.2", "x86_64", "udev-147-2.73.el6_8.2.src.rpm", 1280842, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh-clients", "5.3p1", "124.el6_10", "x86_64", "openssh-5.3p1-124.el6_10.src.rpm", 1352442, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "git", "1.7.1", "9.el6_9", "x86_64", "git-1.7.1-9.el6_9.src.rpm", 15290753, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.1.2", "48.el6", "x86_64", "bash-4.1.2-48.el6.src.rpm", 3142529, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-devel", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 6327079, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.16", "5.5.el6", "x86_64", "libcap-2.16-5.5.el6.src.rpm", 64437, "LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hicolor-icon-theme", "0.11", "1.1.el6", "noarch", "hicolor-icon-theme-0.11-1.1.el6.src.rpm", 45406, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "4.13a", "8.el6", "x86_64", "texinfo-4.13a-8.el6.src.rpm", 329482, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcj", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 65012480, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.49", "7.el6_9.1", "x86_64", "acl-2.2.49-7.el6_9.1.src.rpm", 31280, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "intltool", "0.41.0", "1.1.el6", "noarch", "intltool-0.41.0-1.1.el6.src.rpm", 167585, "GPLv2 with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el6", "x86_64", "nspr-4.19.0-1.el6.src.rpm", 281968, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "subversion", "1.6.11", "15.el6_7", "x86_64", "subversion-1.6.11-15.el6_7.src.rpm", 12105530, "ASL 1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.41.12", "24.el6", "x86_64", "e2fsprogs-1.41.12-24.el6.src.rpm", 59233, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-gfortran", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 14473805, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.0.41", "4.el6", "x86_64", "libsepol-2.0.41-4.el6.src.rpm", 248680, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 323213, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.3.49.5", "1.el6", "x86_64", "chkconfig-1.3.49.5-1.el6.src.rpm", 670580, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "flex", "2.5.35", "9.el6", "x86_64", "flex-2.5.35-9.el6.src.rpm", 736081, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.4.5", "6.el6", "x86_64", "audit-2.4.5-6.el6.src.rpm", 235282, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cscope", "15.6", "7.el6", "x86_64", "cscope-15.6-7.el6.src.rpm", 466265, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.0", "4.el6", "x86_64", "readline-6.0-4.el6.src.rpm", 433957, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rcs", "5.7", "37.el6", "x86_64", "rcs-5.7-37.el6.src.rpm", 709927, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.04", "30.el6", "x86_64", "file-5.04-30.el6.src.rpm", 2486624, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffstat", "1.51", "2.el6", "x86_64", "diffstat-1.51-2.el6.src.rpm", 45568, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.2.24", "9.el6", "x86_64", "dbus-1.2.24-9.el6.src.rpm", 265736, "GPLv2+ or AFL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "doxygen", "1.6.1", "6.el6", "x86_64", "doxygen-1.6.1-6.el6.src.rpm", 9446190, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.6.20", "1.el6_7.2", "x86_64", "sqlite-3.6.20-1.el6_7.2.src.rpm", 640060, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 16304, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "4.999.9", "0.5.beta.20091007git.el6", "x86_64", "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", 214490, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.7", "4.el6", "x86_64", "libgpg-error-1.7-4.el6.src.rpm", 214321, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "7.8", "7.el6", "x86_64", "pcre-7.8-7.el6.src.rpm", 529027, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.4.2", "9.el6", "x86_64", "findutils-4.4.2-9.el6.src.rpm", 1442912, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.0.1", "13.el6_8", "x86_64", "expat-2.0.1-13.el6_8.src.rpm", 197794, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.18.5", "2.el6_5.2", "x86_64", "p11-kit-0.18.5-2.el6_5.2.src.rpm", 262669, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.4.5", "12.el6_8", "x86_64", "libgcrypt-1.4.5-12.el6_8.src.rpm", 536622, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libusb", "0.1.12", "23.el6", "x86_64", "libusb-0.1.12-23.el6.src.rpm", 54440, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.7.6", "8.el6", "x86_64", "pinentry-0.7.6-8.el6.src.rpm", 147023, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps", "3.2.8", "45.el6_9.3", "x86_64", "procps-3.2.8-45.el6_9.3.src.rpm", 475526, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.23", "15.el6_8", "x86_64", "tar-1.23-15.el6_8.src.rpm", 2616465, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "checkpolicy", "2.0.22", "1.el6", "x86_64", "checkpolicy-2.0.22-1.el6.src.rpm", 870239, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4-utils", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 416398, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "which", "2.19", "6.el6", "x86_64", "which-2.19-6.el6.src.rpm", 73004, "GPLv3", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dash", "0.5.5.1", "4.el6", "x86_64", "dash-0.5.5.1-4.el6.src.rpm", 127277, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "groff", "1.18.1.4", "21.el6", "x86_64", "groff-1.18.1.4-21.el6.src.rpm", 5318766, "GPLv2 and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils-libs", "8.4", "47.el6", "x86_64", "coreutils-8.4-47.el6.src.rpm", 5576, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.8.16", "4.el6", "x86_64", "cracklib-2.8.16-4.el6.src.rpm", 187265, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.4", "47.el6", "x86_64", "coreutils-8.4-47.el6.src.rpm", 12873065, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "module-init-tools", "3.9", "26.el6", "x86_64", "module-init-tools-3.9-26.el6.src.rpm", 1216834, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 2660886, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 2052883, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mingetty", "1.08", "5.el6", "x86_64", "mingetty-1.08-5.el6.src.rpm", 34586, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.10.3", "65.el6", "x86_64", "krb5-1.10.3-65.el6.src.rpm", 1813468, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.2", "2.el6_7.1", "x86_64", "libssh2-1.4.2-2.el6_7.1.src.rpm", 325165, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 777656, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 2034245, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.14", "9.el6_10", "x86_64", "gnupg2-2.0.14-9.el6_10.src.rpm", 6087540, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-libs", "9.8.2", "0.68.rc1.el6_10.1", "x86_64", "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", 2340720, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.0.43", "5.1.el6", "x86_64", "libsemanage-2.0.43-5.1.el6.src.rpm", 204223, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.5", "4.1.el6", "x86_64", "libutempter-1.1.5-4.1.el6.src.rpm", 40785, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth-core-libs", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 177760, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.5", "3.2.el6", "x86_64", "libffi-3.0.5-3.2.el6.src.rpm", 42881, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.6.6", "66.el6_8", "x86_64", "python-2.6.6-66.el6_8.src.rpm", 22979382, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.1", "18.20090824bzr68.el6", "x86_64", "pygpgme-0.1-18.20090824bzr68.el6.src.rpm", 251432, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.9.1", "11.el6", "noarch", "python-urlgrabber-3.9.1-11.el6.src.rpm", 323137, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.23", "9.1.el6", "x86_64", "pkgconfig-0.23-9.1.el6.src.rpm", 140091, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.28.8", "10.el6", "x86_64", "glib2-2.28.8-10.el6.src.rpm", 8061476, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.2", "16.el6", "x86_64", "yum-metadata-parser-1.1.2-16.el6.src.rpm", 58327, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.2.29", "81.el6.centos", "noarch", "yum-3.2.29-81.el6.centos.src.rpm", 4832194, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.77", "7.el6", "x86_64", "passwd-0.77-7.el6.src.rpm", 357699, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-utils", "9.8.2", "0.68.rc1.el6_10.1", "x86_64", "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", 451000, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.629", "5.el6_8.1", "x86_64", "vim-7.4.629-5.el6_8.1.src.rpm", 909230, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "c105b9de", "4e0fd3a3", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 112434659, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fontconfig", "2.8.0", "5.el6", "x86_64", "fontconfig-2.8.0-5.el6.src.rpm", 450597, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libproxy-bin", "0.3.0", "10.el6", "x86_64", "libproxy-0.3.0-10.el6.src.rpm", 6800, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libproxy", "0.3.0", "10.el6", "x86_64", "libproxy-0.3.0-10.el6.src.rpm", 118439, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mpfr", "2.4.1", "6.el6", "x86_64", "mpfr-2.4.1-6.el6.src.rpm", 377376, "LGPLv2+ and GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "libpng", "1.2.49", "2.el6_7", "x86_64", "libpng-1.2.49-2.el6_7.src.rpm", 654803, "zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtiff", "3.9.4", "21.el6_8", "x86_64", "libtiff-3.9.4-21.el6_8.src.rpm", 966899, "libtiff", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zip", "3.0", "1.el6_7.1", "x86_64", "zip-3.0-1.el6_7.1.src.rpm", 823164, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr", "1.3.9", "5.el6_9.1", "x86_64", "apr-1.3.9-5.el6_9.1.src.rpm", 303173, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sysvinit-tools", "2.87", "6.dsf.el6", "x86_64", "sysvinit-2.87-6.dsf.el6.src.rpm", 114115, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "atk", "1.30.0", "1.el6", "x86_64", "atk-1.30.0-1.el6.src.rpm", 957484, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libICE", "1.0.6", "1.el6", "x86_64", "libICE-1.0.6-1.el6.src.rpm", 117691, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hwdata", "0.233", "20.1.el6", "noarch", "hwdata-0.233-20.1.el6.src.rpm", 5894683, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libSM", "1.2.1", "2.el6", "x86_64", "libSM-1.2.1-2.el6.src.rpm", 78264, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-lzma-compat", "4.999.9", "0.5.beta.20091007git.el6", "x86_64", "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", 21146, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 677800, "GPLv3+ and (GPLv2+ or LGPLv3+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "jasper-libs", "1.900.1", "22.el6", "x86_64", "jasper-1.900.1-22.el6.src.rpm", 341232, "JasPer", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "neon", "0.29.3", "3.el6_4", "x86_64", "neon-0.29.3-3.el6_4.src.rpm", 297415, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "alsa-lib", "1.1.0", "4.el6", "x86_64", "alsa-lib-1.1.0-4.el6.src.rpm", 1277969, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libart_lgpl", "2.3.20", "5.1.el6", "x86_64", "libart_lgpl-2.3.20-5.1.el6.src.rpm", 129211, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck", "1.2.0", "7.el6", "x86_64", "fipscheck-1.2.0-7.el6.src.rpm", 28163, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Escapes", "1.04", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 21092, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Simple", "3.13", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 476776, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(3), "perl-version", "0.77", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 51960, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-URI", "1.40", "2.el6", "noarch", "perl-URI-1.40-2.el6.src.rpm", 256097, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "autoconf", "2.63", "5.1.el6", "noarch", "autoconf-2.63-5.1.el6.src.rpm", 2605343, "GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Compress-Raw-Zlib", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 146581, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-IO-Compress-Zlib", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 372202, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "redhat-rpm-config", "9.0.3", "51.el6.centos", "noarch", "redhat-rpm-config-9.0.3-51.el6.centos.src.rpm", 138592, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTML-Parser", "3.64", "2.el6", "x86_64", "perl-HTML-Parser-3.64-2.el6.src.rpm", 226598, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext", "0.17", "18.el6", "x86_64", "gettext-0.17-18.el6.src.rpm", 6369345, "GPLv3 and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxcb", "1.12", "4.el6", "x86_64", "libxcb-1.12-4.el6.src.rpm", 886960, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ppl", "0.10.2", "11.el6", "x86_64", "ppl-0.10.2-11.el6.src.rpm", 4637284, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rsync", "3.0.6", "12.el6", "x86_64", "rsync-3.0.6-12.el6.src.rpm", 698678, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-libs", "0.17", "18.el6", "x86_64", "gettext-0.17-18.el6.src.rpm", 278832, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgfortran", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 988064, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-headers", "2.6.32", "754.12.1.el6", "x86_64", "kernel-2.6.32-754.12.1.el6.src.rpm", 2776718, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-devel", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 990251, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++-devel", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 9728819, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11", "1.6.4", "3.el6", "x86_64", "libX11-1.6.4-3.el6.src.rpm", 1301928, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXext", "1.3.3", "1.el6", "x86_64", "libXext-1.3.3-1.el6.src.rpm", 85590, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXfixes", "5.0.3", "1.el6", "x86_64", "libXfixes-5.0.3-1.el6.src.rpm", 23375, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXi", "1.7.8", "1.el6", "x86_64", "libXi-1.7.8-1.el6.src.rpm", 66523, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXtst", "1.2.3", "1.el6", "x86_64", "libXtst-1.2.3-1.el6.src.rpm", 27778, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXcursor", "1.1.14", "2.1.el6", "x86_64", "libXcursor-1.1.14-2.1.el6.src.rpm", 41934, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXft", "2.3.2", "1.el6", "x86_64", "libXft-2.3.2-1.el6.src.rpm", 120045, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXcomposite", "0.4.3", "4.el6", "x86_64", "libXcomposite-0.4.3-4.el6.src.rpm", 31878, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-libwww-perl", "5.833", "5.el6", "noarch", "perl-libwww-perl-5.833-5.el6.src.rpm", 903654, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "redhat-logos", "60.0.14", "12.el6.centos", "noarch", "redhat-logos-60.0.14-12.el6.centos.src.rpm", 15816517, "Copyright 1999-2010 the CentOS Project.  All rights reserved.", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iptables", "1.4.7", "19.el6", "x86_64", "iptables-1.4.7-19.el6.src.rpm", 861752, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 117352, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdrm", "2.4.65", "2.el6", "x86_64", "libdrm-2.4.65-2.el6.src.rpm", 306787, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "2.4.30", "3.el6", "x86_64", "filesystem-2.4.30-3.el6.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 193924, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "initscripts", "9.03.61", "1.el6.centos", "x86_64", "initscripts-9.03.61-1.el6.centos.src.rpm", 5735427, "GPLv2 and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 193090, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh", "5.3p1", "124.el6_10", "x86_64", "openssh-5.3p1-124.el6_10.src.rpm", 787618, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.14.3", "23.3.el6_8", "x86_64", "nss-softokn-3.14.3-23.3.el6_8.src.rpm", 490290, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Git", "1.7.1", "9.el6_9", "noarch", "git-1.7.1-9.el6_9.src.rpm", 35913, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 752304, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-devel", "2.6.32", "754.12.1.el6", "x86_64", "kernel-2.6.32-754.12.1.el6.src.rpm", 27015877, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.44", "7.el6", "x86_64", "attr-2.4.44-7.el6.src.rpm", 18712, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-client", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 12275931, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.3", "29.el6", "x86_64", "zlib-1.2.3-29.el6.src.rpm", 152305, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gtk2", "2.24.23", "9.el6", "x86_64", "gtk2-2.24.23-9.el6.src.rpm", 12426003, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "7.el6", "x86_64", "popt-1.13-7.el6.src.rpm", 83420, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-devel", "0.17", "18.el6", "x86_64", "gettext-0.17-18.el6.src.rpm", 635713, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 1533095, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 30373, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.el6", "x86_64", "nss-util-3.36.0-1.el6.src.rpm", 191928, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-c++", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 11429003, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.5", "7.el6_0", "x86_64", "bzip2-1.0.5-7.el6_0.src.rpm", 67592, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtool", "2.2.6", "15.5.el6", "x86_64", "libtool-2.2.6-15.5.el6.src.rpm", 1998350, "GPLv2+ and LGPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.0.94", "7.el6", "x86_64", "libselinux-2.0.94-7.el6.src.rpm", 130336, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patchutils", "0.3.1", "3.1.el6", "x86_64", "patchutils-0.3.1-3.1.el6.src.rpm", 241488, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.1", "10.el6", "x86_64", "sed-4.2.1-10.el6.src.rpm", 542324, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bison", "2.4.1", "5.el6", "x86_64", "bison-2.4.1-5.el6.src.rpm", 2081518, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.18", "2.el6", "x86_64", "libidn-1.18-2.el6.src.rpm", 567612, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ctags", "5.8", "2.el6", "x86_64", "ctags-5.8-2.el6.src.rpm", 347508, "GPLv2+ or Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.7.6", "21.el6_8.1", "x86_64", "libxml2-2.7.6-21.el6_8.1.src.rpm", 1779163, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "swig", "1.3.40", "6.el6", "x86_64", "swig-1.3.40-6.el6.src.rpm", 4290828, "GPLv2+ and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 987096, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "indent", "2.2.10", "7.el6", "x86_64", "indent-2.2.10-7.el6.src.rpm", 252872, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "4.1.el6", "x86_64", "lua-5.1.4-4.1.el6.src.rpm", 617799, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "byacc", "1.9.20070509", "7.el6", "x86_64", "byacc-1.9.20070509-7.el6.src.rpm", 87968, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "3.1.7", "10.el6_7.3", "x86_64", "gawk-3.1.7-10.el6_7.3.src.rpm", 2038650, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 136136, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 1011166, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.14.3", "23.3.el6_8", "x86_64", "nss-softokn-3.14.3-23.3.el6_8.src.rpm", 1103062, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "6.el6", "x86_64", "grep-2.20-6.el6.src.rpm", 1197904, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.10", "13.el6", "x86_64", "cpio-2.10-13.el6.src.rpm", 650433, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "9.3.el6", "x86_64", "pth-2.0.7-9.3.el6.src.rpm", 261931, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "2.3", "6.el6_5", "x86_64", "libtasn1-2.3-6.el6_5.src.rpm", 443140, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.18.5", "2.el6_5.2", "x86_64", "p11-kit-0.18.5-2.el6_5.2.src.rpm", 178775, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libnih", "1.0.1", "8.el6", "x86_64", "libnih-1.0.1-8.el6.src.rpm", 490926, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gmp", "4.3.1", "13.el6", "x86_64", "gmp-4.3.1-13.el6.src.rpm", 657883, "LGPLv2+ and  GPLv3+ and LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file", "5.04", "30.el6", "x86_64", "file-5.04-30.el6.src.rpm", 56567, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "net-tools", "1.60", "114.el6", "x86_64", "net-tools-1.60-114.el6.src.rpm", 778260, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "psmisc", "22.6", "24.el6", "x86_64", "psmisc-22.6-24.el6.src.rpm", 222569, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-utils", "2.0.94", "7.el6", "x86_64", "libselinux-2.0.94-7.el6.src.rpm", 62739, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2", "1.0.5", "7.el6_0", "x86_64", "bzip2-1.0.5-7.el6_0.src.rpm", 79087, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.23", "15.el6_6.2", "x86_64", "cyrus-sasl-2.1.23-15.el6_6.2.src.rpm", 357710, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "make", "3.81", "23.el6", "x86_64", "make-3.81-23.el6.src.rpm", 1079569, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "2.8.1", "28.el6", "x86_64", "diffutils-2.8.1-28.el6.src.rpm", 588813, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 386371, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "less", "436", "13.el6", "x86_64", "less-436-13.el6.src.rpm", 201909, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.3.12", "24.el6", "x86_64", "gzip-1.3.12-24.el6.src.rpm", 225924, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.8.16", "4.el6", "x86_64", "cracklib-2.8.16-4.el6.src.rpm", 9327207, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.1", "24.el6", "x86_64", "pam-1.1.1-24.el6.src.rpm", 2419646, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth-scripts", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 11006, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "65.1.el6", "noarch", "ca-certificates-2018.2.22-65.1.el6.src.rpm", 2675229, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 32822, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "ethtool", "3.5", "6.el6", "x86_64", "ethtool-3.5-6.el6.src.rpm", 283910, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.4", "5.el6", "x86_64", "keyutils-1.4-5.el6.src.rpm", 36624, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssl", "1.0.1e", "57.el6", "x86_64", "openssl-1.0.1e-57.el6.src.rpm", 4248338, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.19.7", "53.el6_9", "x86_64", "curl-7.19.7-53.el6_9.src.rpm", 347536, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.19.7", "53.el6_9", "x86_64", "curl-7.19.7-53.el6_9.src.rpm", 357074, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.40", "16.el6", "x86_64", "openldap-2.4.40-16.el6.src.rpm", 859518, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.1.8", "3.el6", "x86_64", "gpgme-1.1.8-3.el6.src.rpm", 729658, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "9.1.el6", "x86_64", "ustr-1.0.4-9.1.el6.src.rpm", 273983, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "5.el6", "x86_64", "shadow-utils-4.1.5.1-5.el6.src.rpm", 3466724, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "MAKEDEV", "3.24", "6.el6", "x86_64", "MAKEDEV-3.24-6.el6.src.rpm", 227290, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.8.0", "39.el6", "x86_64", "gdbm-1.8.0-39.el6.src.rpm", 48770, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.6.6", "66.el6_8", "x86_64", "python-2.6.6-66.el6_8.src.rpm", 79603, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 120906, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "9.el6", "x86_64", "python-pycurl-7.19.0-9.el6.src.rpm", 236939, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.3.1", "2.1.el6", "noarch", "python-iniparse-0.3.1-2.1.el6.src.rpm", 109284, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gamin", "0.1.10", "9.el6", "x86_64", "gamin-0.1.10-9.el6.src.rpm", 416440, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "0.70", "6.el6", "x86_64", "shared-mime-info-0.70-6.el6.src.rpm", 1411396, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.56.13", "8.el6_7", "x86_64", "libuser-0.56.13-8.el6_7.src.rpm", 1882055, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.30", "42.el6_10", "noarch", "yum-utils-1.1.30-42.el6_10.src.rpm", 53961, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "6", "10.el6.centos.12.3", "x86_64", "centos-release-6-10.el6.centos.12.3.src.rpm", 38232, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.30", "42.el6_10", "noarch", "yum-utils-1.1.30-42.el6_10.src.rpm", 22350, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "6.1.el6", "noarch", "rootfiles-8.1-6.1.el6.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 13117447, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "freetype", "2.3.11", "17.el6", "x86_64", "freetype-2.3.11-17.el6.src.rpm", 836907, "FTL or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libjpeg-turbo", "1.2.1", "3.el6_5", "x86_64", "libjpeg-turbo-1.2.1-3.el6_5.src.rpm", 476782, "wxWidgets", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libproxy-python", "0.3.0", "10.el6", "x86_64", "libproxy-0.3.0-10.el6.src.rpm", 8320, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "m4", "1.4.13", "5.el6", "x86_64", "m4-1.4.13-5.el6.src.rpm", 560949, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libs", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 648199, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "avahi-libs", "0.6.25", "17.el6", "x86_64", "avahi-0.6.25-17.el6.src.rpm", 114824, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnutls", "2.12.23", "22.el6", "x86_64", "gnutls-2.12.23-22.el6.src.rpm", 1167530, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz", "4.999.9", "0.5.beta.20091007git.el6", "x86_64", "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", 488160, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.20.51.0.2", "5.48.el6_10.1", "x86_64", "binutils-2.20.51.0.2-5.48.el6_10.1.src.rpm", 9831328, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "unzip", "6.0", "5.el6", "x86_64", "unzip-6.0-5.el6.src.rpm", 331766, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgomp", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 127982, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patch", "2.6", "8.el6_9", "x86_64", "patch-2.6-8.el6_9.src.rpm", 179790, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpciaccess", "0.13.4", "1.el6", "x86_64", "libpciaccess-0.13.4-1.el6.src.rpm", 40777, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util", "1.3.9", "3.el6_0.1", "x86_64", "apr-util-1.3.9-3.el6_0.1.src.rpm", 202360, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "cups-libs", "1.4.2", "81.el6_10", "x86_64", "cups-1.4.2-81.el6_10.src.rpm", 667768, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpp", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 10012603, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pakchois", "0.4", "3.2.el6", "x86_64", "pakchois-0.4-3.2.el6.src.rpm", 50156, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-glib", "0.86", "6.el6", "x86_64", "dbus-glib-0.86-6.el6.src.rpm", 579611, "AFL and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-runtime", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 616098, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck-lib", "1.2.0", "7.el6", "x86_64", "fipscheck-1.2.0-7.el6.src.rpm", 10353, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pixman", "0.32.8", "1.el6", "x86_64", "pixman-0.32.8-1.el6.src.rpm", 721560, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-libs", "5.10.1", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 1485896, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Module-Pluggable", "3.90", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 31033, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl", "5.10.1", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 35282834, "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Error", "0.17015", "4.el6", "noarch", "perl-Error-0.17015-4.el6.src.rpm", 47372, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "automake", "1.11.1", "4.el6", "noarch", "automake-1.11.1-4.el6.src.rpm", 1520636, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-IO-Compress-Base", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 138661, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-Compress-Zlib", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 52519, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTML-Tagset", "3.20", "4.el6", "noarch", "perl-HTML-Tagset-3.20-4.el6.src.rpm", 19692, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cvs", "1.11.23", "16.el6", "x86_64", "cvs-1.11.23-16.el6.src.rpm", 1590583, "GPL+ and GPLv2+ and LGPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXau", "1.0.6", "4.el6", "x86_64", "libXau-1.0.6-4.el6.src.rpm", 40009, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "upstart", "0.6.5", "17.el6", "x86_64", "upstart-0.6.5-17.el6.src.rpm", 567729, "GPLv2 and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cloog-ppl", "0.15.7", "1.2.el6", "x86_64", "cloog-0.15.7-1.2.el6.src.rpm", 185386, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdb", "7.2", "92.el6", "x86_64", "gdb-7.2-92.el6.src.rpm", 5545845, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and GFDL and BSD and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libthai", "0.1.12", "3.el6", "x86_64", "libthai-0.1.12-3.el6.src.rpm", 694712, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libedit", "2.11", "4.20080712cvs.1.el6", "x86_64", "libedit-2.11-4.20080712cvs.1.el6.src.rpm", 185648, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-headers", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 2146171, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 19499265, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11-common", "1.6.4", "3.el6", "noarch", "libX11-1.6.4-3.el6.src.rpm", 1341368, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXrender", "0.9.10", "1.el6", "x86_64", "libXrender-0.9.10-1.el6.src.rpm", 40515, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cairo", "1.8.8", "6.el6_6", "x86_64", "cairo-1.8.8-6.el6_6.src.rpm", 797873, "LGPLv2 or MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXrandr", "1.5.1", "1.el6", "x86_64", "libXrandr-1.5.1-1.el6.src.rpm", 43834, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdk-pixbuf2", "2.24.1", "6.el6_7", "x86_64", "gdk-pixbuf2-2.24.1-6.el6_7.src.rpm", 2637837, "LGPLv2+ and (LGPLv2+ or MPLv1.1) and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXdamage", "1.1.3", "4.el6", "x86_64", "libXdamage-1.1.3-4.el6.src.rpm", 24896, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXinerama", "1.1.3", "2.1.el6", "x86_64", "libXinerama-1.1.3-2.1.el6.src.rpm", 12039, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pango", "1.28.1", "11.el6", "x86_64", "pango-1.28.1-11.el6.src.rpm", 1041857, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mailcap", "2.1.31", "2.el6", "noarch", "mailcap-2.1.31-2.el6.src.rpm", 52877, "Public Domain and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-XML-Parser", "2.36", "7.el6", "x86_64", "perl-XML-Parser-2.36-7.el6.src.rpm", 652307, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "policycoreutils", "2.0.83", "30.1.el6_8", "x86_64", "policycoreutils-2.0.83-30.1.el6_8.src.rpm", 3596110, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(), Name: "iproute", Version: "2.6.32", Release: "57.el6", Arch: "x86_64", SourceRpm: "iproute-2.6.32-57.el6.src.rpm", Size: 963477, License: "GPLv2+ and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.14", Release: "23.el6", Arch: "noarch", SourceRpm: "setup-2.8.14-23.el6.src.rpm", Size: 666669, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iputils", Version: "20071127", Release: "24.el6", Arch: "x86_64", SourceRpm: "iputils-20071127-24.el6.src.rpm", Size: 297243, License: "BSD with advertising and GPLv2+ and Rdisc", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "4.el6", Arch: "noarch", SourceRpm: "basesystem-10.0-4.el6.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "util-linux-ng", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 6139437, License: "GPLv1+ and GPLv2 and GPLv2+ and LGPLv2+ and MIT and BSD with advertising and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018e", Release: "3.el6", Arch: "noarch", SourceRpm: "tzdata-2018e-3.el6.src.rpm", Size: 1961609, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "udev", Version: "147", Release: "2.73.el6_8.2", Arch: "x86_64", SourceRpm: "udev-147-2.73.el6_8.2.src.rpm", Size: 1280842, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh-clients", Version: "5.3p1", Release: "124.el6_10", Arch: "x86_64", SourceRpm: "openssh-5.3p1-124.el6_10.src.rpm", Size: 1352442, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "git", Version: "1.7.1", Release: "9.el6_9", Arch: "x86_64", SourceRpm: "git-1.7.1-9.el6_9.src.rpm", Size: 15290753, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.1.2", Release: "48.el6", Arch: "x86_64", SourceRpm: "bash-4.1.2-48.el6.src.rpm", Size: 3142529, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-devel", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 6327079, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.16", Release: "5.5.el6", Arch: "x86_64", SourceRpm: "libcap-2.16-5.5.el6.src.rpm", Size: 64437, License: "LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hicolor-icon-theme", Version: "0.11", Release: "1.1.el6", Arch: "noarch", SourceRpm: "hicolor-icon-theme-0.11-1.1.el6.src.rpm", Size: 45406, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "4.13a", Release: "8.el6", Arch: "x86_64", SourceRpm: "texinfo-4.13a-8.el6.src.rpm", Size: 329482, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcj", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 65012480, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.49", Release: "7.el6_9.1", Arch: "x86_64", SourceRpm: "acl-2.2.49-7.el6_9.1.src.rpm", Size: 31280, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "intltool", Version: "0.41.0", Release: "1.1.el6", Arch: "noarch", SourceRpm: "intltool-0.41.0-1.1.el6.src.rpm", Size: 167585, License: "GPLv2 with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el6.src.rpm", Size: 281968, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "subversion", Version: "1.6.11", Release: "15.el6_7", Arch: "x86_64", SourceRpm: "subversion-1.6.11-15.el6_7.src.rpm", Size: 12105530, License: "ASL 1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.41.12", Release: "24.el6", Arch: "x86_64", SourceRpm: "e2fsprogs-1.41.12-24.el6.src.rpm", Size: 59233, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-gfortran", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 14473805, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.0.41", Release: "4.el6", Arch: "x86_64", SourceRpm: "libsepol-2.0.41-4.el6.src.rpm", Size: 248680, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 323213, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.3.49.5", Release: "1.el6", Arch: "x86_64", SourceRpm: "chkconfig-1.3.49.5-1.el6.src.rpm", Size: 670580, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "flex", Version: "2.5.35", Release: "9.el6", Arch: "x86_64", SourceRpm: "flex-2.5.35-9.el6.src.rpm", Size: 736081, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.4.5", Release: "6.el6", Arch: "x86_64", SourceRpm: "audit-2.4.5-6.el6.src.rpm", Size: 235282, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cscope", Version: "15.6", Release: "7.el6", Arch: "x86_64", SourceRpm: "cscope-15.6-7.el6.src.rpm", Size: 466265, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.0", Release: "4.el6", Arch: "x86_64", SourceRpm: "readline-6.0-4.el6.src.rpm", Size: 433957, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rcs", Version: "5.7", Release: "37.el6", Arch: "x86_64", SourceRpm: "rcs-5.7-37.el6.src.rpm", Size: 709927, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.04", Release: "30.el6", Arch: "x86_64", SourceRpm: "file-5.04-30.el6.src.rpm", Size: 2486624, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffstat", Version: "1.51", Release: "2.el6", Arch: "x86_64", SourceRpm: "diffstat-1.51-2.el6.src.rpm", Size: 45568, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.2.24", Release: "9.el6", Arch: "x86_64", SourceRpm: "dbus-1.2.24-9.el6.src.rpm", Size: 265736, License: "GPLv2+ or AFL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "doxygen", Version: "1.6.1", Release: "6.el6", Arch: "x86_64", SourceRpm: "doxygen-1.6.1-6.el6.src.rpm", Size: 9446190, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.6.20", Release: "1.el6_7.2", Arch: "x86_64", SourceRpm: "sqlite-3.6.20-1.el6_7.2.src.rpm", Size: 640060, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 16304, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "4.999.9", Release: "0.5.beta.20091007git.el6", Arch: "x86_64", SourceRpm: "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", Size: 214490, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.7", Release: "4.el6", Arch: "x86_64", SourceRpm: "libgpg-error-1.7-4.el6.src.rpm", Size: 214321, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "7.8", Release: "7.el6", Arch: "x86_64", SourceRpm: "pcre-7.8-7.el6.src.rpm", Size: 529027, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.4.2", Release: "9.el6", Arch: "x86_64", SourceRpm: "findutils-4.4.2-9.el6.src.rpm", Size: 1442912, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.0.1", Release: "13.el6_8", Arch: "x86_64", SourceRpm: "expat-2.0.1-13.el6_8.src.rpm", Size: 197794, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.18.5", Release: "2.el6_5.2", Arch: "x86_64", SourceRpm: "p11-kit-0.18.5-2.el6_5.2.src.rpm", Size: 262669, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.4.5", Release: "12.el6_8", Arch: "x86_64", SourceRpm: "libgcrypt-1.4.5-12.el6_8.src.rpm", Size: 536622, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libusb", Version: "0.1.12", Release: "23.el6", Arch: "x86_64", SourceRpm: "libusb-0.1.12-23.el6.src.rpm", Size: 54440, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.7.6", Release: "8.el6", Arch: "x86_64", SourceRpm: "pinentry-0.7.6-8.el6.src.rpm", Size: 147023, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps", Version: "3.2.8", Release: "45.el6_9.3", Arch: "x86_64", SourceRpm: "procps-3.2.8-45.el6_9.3.src.rpm", Size: 475526, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.23", Release: "15.el6_8", Arch: "x86_64", SourceRpm: "tar-1.23-15.el6_8.src.rpm", Size: 2616465, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "checkpolicy", Version: "2.0.22", Release: "1.el6", Arch: "x86_64", SourceRpm: "checkpolicy-2.0.22-1.el6.src.rpm", Size: 870239, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4-utils", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 416398, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "which", Version: "2.19", Release: "6.el6", Arch: "x86_64", SourceRpm: "which-2.19-6.el6.src.rpm", Size: 73004, License: "GPLv3", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dash", Version: "0.5.5.1", Release: "4.el6", Arch: "x86_64", SourceRpm: "dash-0.5.5.1-4.el6.src.rpm", Size: 127277, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "groff", Version: "1.18.1.4", Release: "21.el6", Arch: "x86_64", SourceRpm: "groff-1.18.1.4-21.el6.src.rpm", Size: 5318766, License: "GPLv2 and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils-libs", Version: "8.4", Release: "47.el6", Arch: "x86_64", SourceRpm: "coreutils-8.4-47.el6.src.rpm", Size: 5576, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.8.16", Release: "4.el6", Arch: "x86_64", SourceRpm: "cracklib-2.8.16-4.el6.src.rpm", Size: 187265, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.4", Release: "47.el6", Arch: "x86_64", SourceRpm: "coreutils-8.4-47.el6.src.rpm", Size: 12873065, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "module-init-tools", Version: "3.9", Release: "26.el6", Arch: "x86_64", SourceRpm: "module-init-tools-3.9-26.el6.src.rpm", Size: 1216834, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 2660886, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 2052883, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mingetty", Version: "1.08", Release: "5.el6", Arch: "x86_64", SourceRpm: "mingetty-1.08-5.el6.src.rpm", Size: 34586, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.10.3", Release: "65.el6", Arch: "x86_64", SourceRpm: "krb5-1.10.3-65.el6.src.rpm", Size: 1813468, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.2", Release: "2.el6_7.1", Arch: "x86_64", SourceRpm: "libssh2-1.4.2-2.el6_7.1.src.rpm", Size: 325165, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 777656, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 2034245, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.14", Release: "9.el6_10", Arch: "x86_64", SourceRpm: "gnupg2-2.0.14-9.el6_10.src.rpm", Size: 6087540, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-libs", Version: "9.8.2", Release: "0.68.rc1.el6_10.1", Arch: "x86_64", SourceRpm: "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", Size: 2340720, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.0.43", Release: "5.1.el6", Arch: "x86_64", SourceRpm: "libsemanage-2.0.43-5.1.el6.src.rpm", Size: 204223, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.5", Release: "4.1.el6", Arch: "x86_64", SourceRpm: "libutempter-1.1.5-4.1.el6.src.rpm", Size: 40785, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth-core-libs", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 177760, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.5", Release: "3.2.el6", Arch: "x86_64", SourceRpm: "libffi-3.0.5-3.2.el6.src.rpm", Size: 42881, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.6.6", Release: "66.el6_8", Arch: "x86_64", SourceRpm: "python-2.6.6-66.el6_8.src.rpm", Size: 22979382, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.1", Release: "18.20090824bzr68.el6", Arch: "x86_64", SourceRpm: "pygpgme-0.1-18.20090824bzr68.el6.src.rpm", Size: 251432, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.9.1", Release: "11.el6", Arch: "noarch", SourceRpm: "python-urlgrabber-3.9.1-11.el6.src.rpm", Size: 323137, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.23", Release: "9.1.el6", Arch: "x86_64", SourceRpm: "pkgconfig-0.23-9.1.el6.src.rpm", Size: 140091, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.28.8", Release: "10.el6", Arch: "x86_64", SourceRpm: "glib2-2.28.8-10.el6.src.rpm", Size: 8061476, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.2", Release: "16.el6", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.2-16.el6.src.rpm", Size: 58327, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.2.29", Release: "81.el6.centos", Arch: "noarch", SourceRpm: "yum-3.2.29-81.el6.centos.src.rpm", Size: 4832194, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.77", Release: "7.el6", Arch: "x86_64", SourceRpm: "passwd-0.77-7.el6.src.rpm", Size: 357699, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-utils", Version: "9.8.2", Release: "0.68.rc1.el6_10.1", Arch: "x86_64", SourceRpm: "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", Size: 451000, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.629", Release: "5.el6_8.1", Arch: "x86_64", SourceRpm: "vim-7.4.629-5.el6_8.1.src.rpm", Size: 909230, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "c105b9de", Release: "4e0fd3a3", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 112434659, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fontconfig", Version: "2.8.0", Release: "5.el6", Arch: "x86_64", SourceRpm: "fontconfig-2.8.0-5.el6.src.rpm", Size: 450597, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libproxy-bin", Version: "0.3.0", Release: "10.el6", Arch: "x86_64", SourceRpm: "libproxy-0.3.0-10.el6.src.rpm", Size: 6800, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libproxy", Version: "0.3.0", Release: "10.el6", Arch: "x86_64", SourceRpm: "libproxy-0.3.0-10.el6.src.rpm", Size: 118439, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mpfr", Version: "2.4.1", Release: "6.el6", Arch: "x86_64", SourceRpm: "mpfr-2.4.1-6.el6.src.rpm", Size: 377376, License: "LGPLv2+ and GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "libpng", Version: "1.2.49", Release: "2.el6_7", Arch: "x86_64", SourceRpm: "libpng-1.2.49-2.el6_7.src.rpm", Size: 654803, License: "zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtiff", Version: "3.9.4", Release: "21.el6_8", Arch: "x86_64", SourceRpm: "libtiff-3.9.4-21.el6_8.src.rpm", Size: 966899, License: "libtiff", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zip", Version: "3.0", Release: "1.el6_7.1", Arch: "x86_64", SourceRpm: "zip-3.0-1.el6_7.1.src.rpm", Size: 823164, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr", Version: "1.3.9", Release: "5.el6_9.1", Arch: "x86_64", SourceRpm: "apr-1.3.9-5.el6_9.1.src.rpm", Size: 303173, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sysvinit-tools", Version: "2.87", Release: "6.dsf.el6", Arch: "x86_64", SourceRpm: "sysvinit-2.87-6.dsf.el6.src.rpm", Size: 114115, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "atk", Version: "1.30.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "atk-1.30.0-1.el6.src.rpm", Size: 957484, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libICE", Version: "1.0.6", Release: "1.el6", Arch: "x86_64", SourceRpm: "libICE-1.0.6-1.el6.src.rpm", Size: 117691, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hwdata", Version: "0.233", Release: "20.1.el6", Arch: "noarch", SourceRpm: "hwdata-0.233-20.1.el6.src.rpm", Size: 5894683, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libSM", Version: "1.2.1", Release: "2.el6", Arch: "x86_64", SourceRpm: "libSM-1.2.1-2.el6.src.rpm", Size: 78264, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-lzma-compat", Version: "4.999.9", Release: "0.5.beta.20091007git.el6", Arch: "x86_64", SourceRpm: "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", Size: 21146, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 677800, License: "GPLv3+ and (GPLv2+ or LGPLv3+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "jasper-libs", Version: "1.900.1", Release: "22.el6", Arch: "x86_64", SourceRpm: "jasper-1.900.1-22.el6.src.rpm", Size: 341232, License: "JasPer", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "neon", Version: "0.29.3", Release: "3.el6_4", Arch: "x86_64", SourceRpm: "neon-0.29.3-3.el6_4.src.rpm", Size: 297415, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "alsa-lib", Version: "1.1.0", Release: "4.el6", Arch: "x86_64", SourceRpm: "alsa-lib-1.1.0-4.el6.src.rpm", Size: 1277969, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libart_lgpl", Version: "2.3.20", Release: "5.1.el6", Arch: "x86_64", SourceRpm: "libart_lgpl-2.3.20-5.1.el6.src.rpm", Size: 129211, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck", Version: "1.2.0", Release: "7.el6", Arch: "x86_64", SourceRpm: "fipscheck-1.2.0-7.el6.src.rpm", Size: 28163, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Escapes", Version: "1.04", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 21092, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Simple", Version: "3.13", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 476776, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(3), Name: "perl-version", Version: "0.77", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 51960, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-URI", Version: "1.40", Release: "2.el6", Arch: "noarch", SourceRpm: "perl-URI-1.40-2.el6.src.rpm", Size: 256097, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "autoconf", Version: "2.63", Release: "5.1.el6", Arch: "noarch", SourceRpm: "autoconf-2.63-5.1.el6.src.rpm", Size: 2605343, License: "GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Compress-Raw-Zlib", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 146581, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-IO-Compress-Zlib", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 372202, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "redhat-rpm-config", Version: "9.0.3", Release: "51.el6.centos", Arch: "noarch", SourceRpm: "redhat-rpm-config-9.0.3-51.el6.centos.src.rpm", Size: 138592, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTML-Parser", Version: "3.64", Release: "2.el6", Arch: "x86_64", SourceRpm: "perl-HTML-Parser-3.64-2.el6.src.rpm", Size: 226598, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext", Version: "0.17", Release: "18.el6", Arch: "x86_64", SourceRpm: "gettext-0.17-18.el6.src.rpm", Size: 6369345, License: "GPLv3 and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxcb", Version: "1.12", Release: "4.el6", Arch: "x86_64", SourceRpm: "libxcb-1.12-4.el6.src.rpm", Size: 886960, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ppl", Version: "0.10.2", Release: "11.el6", Arch: "x86_64", SourceRpm: "ppl-0.10.2-11.el6.src.rpm", Size: 4637284, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rsync", Version: "3.0.6", Release: "12.el6", Arch: "x86_64", SourceRpm: "rsync-3.0.6-12.el6.src.rpm", Size: 698678, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-libs", Version: "0.17", Release: "18.el6", Arch: "x86_64", SourceRpm: "gettext-0.17-18.el6.src.rpm", Size: 278832, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgfortran", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 988064, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-headers", Version: "2.6.32", Release: "754.12.1.el6", Arch: "x86_64", SourceRpm: "kernel-2.6.32-754.12.1.el6.src.rpm", Size: 2776718, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-devel", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 990251, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++-devel", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 9728819, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11", Version: "1.6.4", Release: "3.el6", Arch: "x86_64", SourceRpm: "libX11-1.6.4-3.el6.src.rpm", Size: 1301928, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXext", Version: "1.3.3", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXext-1.3.3-1.el6.src.rpm", Size: 85590, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXfixes", Version: "5.0.3", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXfixes-5.0.3-1.el6.src.rpm", Size: 23375, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXi", Version: "1.7.8", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXi-1.7.8-1.el6.src.rpm", Size: 66523, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXtst", Version: "1.2.3", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXtst-1.2.3-1.el6.src.rpm", Size: 27778, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXcursor", Version: "1.1.14", Release: "2.1.el6", Arch: "x86_64", SourceRpm: "libXcursor-1.1.14-2.1.el6.src.rpm", Size: 41934, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXft", Version: "2.3.2", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXft-2.3.2-1.el6.src.rpm", Size: 120045, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXcomposite", Version: "0.4.3", Release: "4.el6", Arch: "x86_64", SourceRpm: "libXcomposite-0.4.3-4.el6.src.rpm", Size: 31878, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-libwww-perl", Version: "5.833", Release: "5.el6", Arch: "noarch", SourceRpm: "perl-libwww-perl-5.833-5.el6.src.rpm", Size: 903654, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "redhat-logos", Version: "60.0.14", Release: "12.el6.centos", Arch: "noarch", SourceRpm: "redhat-logos-60.0.14-12.el6.centos.src.rpm", Size: 15816517, License: "Copyright 1999-2010 the CentOS Project.  All rights reserved.", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iptables", Version: "1.4.7", Release: "19.el6", Arch: "x86_64", SourceRpm: "iptables-1.4.7-19.el6.src.rpm", Size: 861752, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 117352, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdrm", Version: "2.4.65", Release: "2.el6", Arch: "x86_64", SourceRpm: "libdrm-2.4.65-2.el6.src.rpm", Size: 306787, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "2.4.30", Release: "3.el6", Arch: "x86_64", SourceRpm: "filesystem-2.4.30-3.el6.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 193924, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "initscripts", Version: "9.03.61", Release: "1.el6.centos", Arch: "x86_64", SourceRpm: "initscripts-9.03.61-1.el6.centos.src.rpm", Size: 5735427, License: "GPLv2 and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 193090, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh", Version: "5.3p1", Release: "124.el6_10", Arch: "x86_64", SourceRpm: "openssh-5.3p1-124.el6_10.src.rpm", Size: 787618, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.14.3", Release: "23.3.el6_8", Arch: "x86_64", SourceRpm: "nss-softokn-3.14.3-23.3.el6_8.src.rpm", Size: 490290, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Git", Version: "1.7.1", Release: "9.el6_9", Arch: "noarch", SourceRpm: "git-1.7.1-9.el6_9.src.rpm", Size: 35913, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 752304, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-devel", Version: "2.6.32", Release: "754.12.1.el6", Arch: "x86_64", SourceRpm: "kernel-2.6.32-754.12.1.el6.src.rpm", Size: 27015877, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.44", Release: "7.el6", Arch: "x86_64", SourceRpm: "attr-2.4.44-7.el6.src.rpm", Size: 18712, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-client", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 12275931, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.3", Release: "29.el6", Arch: "x86_64", SourceRpm: "zlib-1.2.3-29.el6.src.rpm", Size: 152305, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gtk2", Version: "2.24.23", Release: "9.el6", Arch: "x86_64", SourceRpm: "gtk2-2.24.23-9.el6.src.rpm", Size: 12426003, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "7.el6", Arch: "x86_64", SourceRpm: "popt-1.13-7.el6.src.rpm", Size: 83420, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-devel", Version: "0.17", Release: "18.el6", Arch: "x86_64", SourceRpm: "gettext-0.17-18.el6.src.rpm", Size: 635713, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 1533095, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 30373, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.el6.src.rpm", Size: 191928, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-c++", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 11429003, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.5", Release: "7.el6_0", Arch: "x86_64", SourceRpm: "bzip2-1.0.5-7.el6_0.src.rpm", Size: 67592, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtool", Version: "2.2.6", Release: "15.5.el6", Arch: "x86_64", SourceRpm: "libtool-2.2.6-15.5.el6.src.rpm", Size: 1998350, License: "GPLv2+ and LGPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.0.94", Release: "7.el6", Arch: "x86_64", SourceRpm: "libselinux-2.0.94-7.el6.src.rpm", Size: 130336, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patchutils", Version: "0.3.1", Release: "3.1.el6", Arch: "x86_64", SourceRpm: "patchutils-0.3.1-3.1.el6.src.rpm", Size: 241488, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.1", Release: "10.el6", Arch: "x86_64", SourceRpm: "sed-4.2.1-10.el6.src.rpm", Size: 542324, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bison", Version: "2.4.1", Release: "5.el6", Arch: "x86_64", SourceRpm: "bison-2.4.1-5.el6.src.rpm", Size: 2081518, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.18", Release: "2.el6", Arch: "x86_64", SourceRpm: "libidn-1.18-2.el6.src.rpm", Size: 567612, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ctags", Version: "5.8", Release: "2.el6", Arch: "x86_64", SourceRpm: "ctags-5.8-2.el6.src.rpm", Size: 347508, License: "GPLv2+ or Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.7.6", Release: "21.el6_8.1", Arch: "x86_64", SourceRpm: "libxml2-2.7.6-21.el6_8.1.src.rpm", Size: 1779163, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "swig", Version: "1.3.40", Release: "6.el6", Arch: "x86_64", SourceRpm: "swig-1.3.40-6.el6.src.rpm", Size: 4290828, License: "GPLv2+ and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 987096, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "indent", Version: "2.2.10", Release: "7.el6", Arch: "x86_64", SourceRpm: "indent-2.2.10-7.el6.src.rpm", Size: 252872, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "4.1.el6", Arch: "x86_64", SourceRpm: "lua-5.1.4-4.1.el6.src.rpm", Size: 617799, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "byacc", Version: "1.9.20070509", Release: "7.el6", Arch: "x86_64", SourceRpm: "byacc-1.9.20070509-7.el6.src.rpm", Size: 87968, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "3.1.7", Release: "10.el6_7.3", Arch: "x86_64", SourceRpm: "gawk-3.1.7-10.el6_7.3.src.rpm", Size: 2038650, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 136136, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 1011166, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.14.3", Release: "23.3.el6_8", Arch: "x86_64", SourceRpm: "nss-softokn-3.14.3-23.3.el6_8.src.rpm", Size: 1103062, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "6.el6", Arch: "x86_64", SourceRpm: "grep-2.20-6.el6.src.rpm", Size: 1197904, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.10", Release: "13.el6", Arch: "x86_64", SourceRpm: "cpio-2.10-13.el6.src.rpm", Size: 650433, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "9.3.el6", Arch: "x86_64", SourceRpm: "pth-2.0.7-9.3.el6.src.rpm", Size: 261931, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "2.3", Release: "6.el6_5", Arch: "x86_64", SourceRpm: "libtasn1-2.3-6.el6_5.src.rpm", Size: 443140, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.18.5", Release: "2.el6_5.2", Arch: "x86_64", SourceRpm: "p11-kit-0.18.5-2.el6_5.2.src.rpm", Size: 178775, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libnih", Version: "1.0.1", Release: "8.el6", Arch: "x86_64", SourceRpm: "libnih-1.0.1-8.el6.src.rpm", Size: 490926, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gmp", Version: "4.3.1", Release: "13.el6", Arch: "x86_64", SourceRpm: "gmp-4.3.1-13.el6.src.rpm", Size: 657883, License: "LGPLv2+ and  GPLv3+ and LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file", Version: "5.04", Release: "30.el6", Arch: "x86_64", SourceRpm: "file-5.04-30.el6.src.rpm", Size: 56567, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "net-tools", Version: "1.60", Release: "114.el6", Arch: "x86_64", SourceRpm: "net-tools-1.60-114.el6.src.rpm", Size: 778260, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "psmisc", Version: "22.6", Release: "24.el6", Arch: "x86_64", SourceRpm: "psmisc-22.6-24.el6.src.rpm", Size: 222569, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-utils", Version: "2.0.94", Release: "7.el6", Arch: "x86_64", SourceRpm: "libselinux-2.0.94-7.el6.src.rpm", Size: 62739, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2", Version: "1.0.5", Release: "7.el6_0", Arch: "x86_64", SourceRpm: "bzip2-1.0.5-7.el6_0.src.rpm", Size: 79087, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.23", Release: "15.el6_6.2", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.23-15.el6_6.2.src.rpm", Size: 357710, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "make", Version: "3.81", Release: "23.el6", Arch: "x86_64", SourceRpm: "make-3.81-23.el6.src.rpm", Size: 1079569, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "2.8.1", Release: "28.el6", Arch: "x86_64", SourceRpm: "diffutils-2.8.1-28.el6.src.rpm", Size: 588813, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 386371, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "less", Version: "436", Release: "13.el6", Arch: "x86_64", SourceRpm: "less-436-13.el6.src.rpm", Size: 201909, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.3.12", Release: "24.el6", Arch: "x86_64", SourceRpm: "gzip-1.3.12-24.el6.src.rpm", Size: 225924, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.8.16", Release: "4.el6", Arch: "x86_64", SourceRpm: "cracklib-2.8.16-4.el6.src.rpm", Size: 9327207, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.1", Release: "24.el6", Arch: "x86_64", SourceRpm: "pam-1.1.1-24.el6.src.rpm", Size: 2419646, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth-scripts", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 11006, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "65.1.el6", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-65.1.el6.src.rpm", Size: 2675229, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 32822, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "ethtool", Version: "3.5", Release: "6.el6", Arch: "x86_64", SourceRpm: "ethtool-3.5-6.el6.src.rpm", Size: 283910, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.4", Release: "5.el6", Arch: "x86_64", SourceRpm: "keyutils-1.4-5.el6.src.rpm", Size: 36624, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssl", Version: "1.0.1e", Release: "57.el6", Arch: "x86_64", SourceRpm: "openssl-1.0.1e-57.el6.src.rpm", Size: 4248338, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.19.7", Release: "53.el6_9", Arch: "x86_64", SourceRpm: "curl-7.19.7-53.el6_9.src.rpm", Size: 347536, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.19.7", Release: "53.el6_9", Arch: "x86_64", SourceRpm: "curl-7.19.7-53.el6_9.src.rpm", Size: 357074, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.40", Release: "16.el6", Arch: "x86_64", SourceRpm: "openldap-2.4.40-16.el6.src.rpm", Size: 859518, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.1.8", Release: "3.el6", Arch: "x86_64", SourceRpm: "gpgme-1.1.8-3.el6.src.rpm", Size: 729658, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "9.1.el6", Arch: "x86_64", SourceRpm: "ustr-1.0.4-9.1.el6.src.rpm", Size: 273983, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "5.el6", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-5.el6.src.rpm", Size: 3466724, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "MAKEDEV", Version: "3.24", Release: "6.el6", Arch: "x86_64", SourceRpm: "MAKEDEV-3.24-6.el6.src.rpm", Size: 227290, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.8.0", Release: "39.el6", Arch: "x86_64", SourceRpm: "gdbm-1.8.0-39.el6.src.rpm", Size: 48770, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.6.6", Release: "66.el6_8", Arch: "x86_64", SourceRpm: "python-2.6.6-66.el6_8.src.rpm", Size: 79603, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 120906, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "9.el6", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-9.el6.src.rpm", Size: 236939, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.3.1", Release: "2.1.el6", Arch: "noarch", SourceRpm: "python-iniparse-0.3.1-2.1.el6.src.rpm", Size: 109284, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gamin", Version: "0.1.10", Release: "9.el6", Arch: "x86_64", SourceRpm: "gamin-0.1.10-9.el6.src.rpm", Size: 416440, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "0.70", Release: "6.el6", Arch: "x86_64", SourceRpm: "shared-mime-info-0.70-6.el6.src.rpm", Size: 1411396, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.56.13", Release: "8.el6_7", Arch: "x86_64", SourceRpm: "libuser-0.56.13-8.el6_7.src.rpm", Size: 1882055, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.30", Release: "42.el6_10", Arch: "noarch", SourceRpm: "yum-utils-1.1.30-42.el6_10.src.rpm", Size: 53961, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "6", Release: "10.el6.centos.12.3", Arch: "x86_64", SourceRpm: "centos-release-6-10.el6.centos.12.3.src.rpm", Size: 38232, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.30", Release: "42.el6_10", Arch: "noarch", SourceRpm: "yum-utils-1.1.30-42.el6_10.src.rpm", Size: 22350, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "6.1.el6", Arch: "noarch", SourceRpm: "rootfiles-8.1-6.1.el6.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 13117447, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "freetype", Version: "2.3.11", Release: "17.el6", Arch: "x86_64", SourceRpm: "freetype-2.3.11-17.el6.src.rpm", Size: 836907, License: "FTL or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libjpeg-turbo", Version: "1.2.1", Release: "3.el6_5", Arch: "x86_64", SourceRpm: "libjpeg-turbo-1.2.1-3.el6_5.src.rpm", Size: 476782, License: "wxWidgets", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libproxy-python", Version: "0.3.0", Release: "10.el6", Arch: "x86_64", SourceRpm: "libproxy-0.3.0-10.el6.src.rpm", Size: 8320, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "m4", Version: "1.4.13", Release: "5.el6", Arch: "x86_64", SourceRpm: "m4-1.4.13-5.el6.src.rpm", Size: 560949, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 648199, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "avahi-libs", Version: "0.6.25", Release: "17.el6", Arch: "x86_64", SourceRpm: "avahi-0.6.25-17.el6.src.rpm", Size: 114824, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnutls", Version: "2.12.23", Release: "22.el6", Arch: "x86_64", SourceRpm: "gnutls-2.12.23-22.el6.src.rpm", Size: 1167530, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz", Version: "4.999.9", Release: "0.5.beta.20091007git.el6", Arch: "x86_64", SourceRpm: "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", Size: 488160, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.20.51.0.2", Release: "5.48.el6_10.1", Arch: "x86_64", SourceRpm: "binutils-2.20.51.0.2-5.48.el6_10.1.src.rpm", Size: 9831328, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "unzip", Version: "6.0", Release: "5.el6", Arch: "x86_64", SourceRpm: "unzip-6.0-5.el6.src.rpm", Size: 331766, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgomp", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 127982, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patch", Version: "2.6", Release: "8.el6_9", Arch: "x86_64", SourceRpm: "patch-2.6-8.el6_9.src.rpm", Size: 179790, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpciaccess", Version: "0.13.4", Release: "1.el6", Arch: "x86_64", SourceRpm: "libpciaccess-0.13.4-1.el6.src.rpm", Size: 40777, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util", Version: "1.3.9", Release: "3.el6_0.1", Arch: "x86_64", SourceRpm: "apr-util-1.3.9-3.el6_0.1.src.rpm", Size: 202360, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "cups-libs", Version: "1.4.2", Release: "81.el6_10", Arch: "x86_64", SourceRpm: "cups-1.4.2-81.el6_10.src.rpm", Size: 667768, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpp", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 10012603, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pakchois", Version: "0.4", Release: "3.2.el6", Arch: "x86_64", SourceRpm: "pakchois-0.4-3.2.el6.src.rpm", Size: 50156, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-glib", Version: "0.86", Release: "6.el6", Arch: "x86_64", SourceRpm: "dbus-glib-0.86-6.el6.src.rpm", Size: 579611, License: "AFL and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-runtime", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 616098, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck-lib", Version: "1.2.0", Release: "7.el6", Arch: "x86_64", SourceRpm: "fipscheck-1.2.0-7.el6.src.rpm", Size: 10353, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pixman", Version: "0.32.8", Release: "1.el6", Arch: "x86_64", SourceRpm: "pixman-0.32.8-1.el6.src.rpm", Size: 721560, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-libs", Version: "5.10.1", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 1485896, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Module-Pluggable", Version: "3.90", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 31033, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl", Version: "5.10.1", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 35282834, License: "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Error", Version: "0.17015", Release: "4.el6", Arch: "noarch", SourceRpm: "perl-Error-0.17015-4.el6.src.rpm", Size: 47372, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "automake", Version: "1.11.1", Release: "4.el6", Arch: "noarch", SourceRpm: "automake-1.11.1-4.el6.src.rpm", Size: 1520636, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-IO-Compress-Base", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 138661, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-Compress-Zlib", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 52519, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTML-Tagset", Version: "3.20", Release: "4.el6", Arch: "noarch", SourceRpm: "perl-HTML-Tagset-3.20-4.el6.src.rpm", Size: 19692, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cvs", Version: "1.11.23", Release: "16.el6", Arch: "x86_64", SourceRpm: "cvs-1.11.23-16.el6.src.rpm", Size: 1590583, License: "GPL+ and GPLv2+ and LGPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXau", Version: "1.0.6", Release: "4.el6", Arch: "x86_64", SourceRpm: "libXau-1.0.6-4.el6.src.rpm", Size: 40009, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "upstart", Version: "0.6.5", Release: "17.el6", Arch: "x86_64", SourceRpm: "upstart-0.6.5-17.el6.src.rpm", Size: 567729, License: "GPLv2 and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cloog-ppl", Version: "0.15.7", Release: "1.2.el6", Arch: "x86_64", SourceRpm: "cloog-0.15.7-1.2.el6.src.rpm", Size: 185386, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdb", Version: "7.2", Release: "92.el6", Arch: "x86_64", SourceRpm: "gdb-7.2-92.el6.src.rpm", Size: 5545845, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and GFDL and BSD and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libthai", Version: "0.1.12", Release: "3.el6", Arch: "x86_64", SourceRpm: "libthai-0.1.12-3.el6.src.rpm", Size: 694712, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libedit", Version: "2.11", Release: "4.20080712cvs.1.el6", Arch: "x86_64", SourceRpm: "libedit-2.11-4.20080712cvs.1.el6.src.rpm", Size: 185648, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-headers", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 2146171, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 19499265, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11-common", Version: "1.6.4", Release: "3.el6", Arch: "noarch", SourceRpm: "libX11-1.6.4-3.el6.src.rpm", Size: 1341368, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXrender", Version: "0.9.10", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXrender-0.9.10-1.el6.src.rpm", Size: 40515, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cairo", Version: "1.8.8", Release: "6.el6_6", Arch: "x86_64", SourceRpm: "cairo-1.8.8-6.el6_6.src.rpm", Size: 797873, License: "LGPLv2 or MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXrandr", Version: "1.5.1", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXrandr-1.5.1-1.el6.src.rpm", Size: 43834, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdk-pixbuf2", Version: "2.24.1", Release: "6.el6_7", Arch: "x86_64", SourceRpm: "gdk-pixbuf2-2.24.1-6.el6_7.src.rpm", Size: 2637837, License: "LGPLv2+ and (LGPLv2+ or MPLv1.1) and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXdamage", Version: "1.1.3", Release: "4.el6", Arch: "x86_64", SourceRpm: "libXdamage-1.1.3-4.el6.src.rpm", Size: 24896, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXinerama", Version: "1.1.3", Release: "2.1.el6", Arch: "x86_64", SourceRpm: "libXinerama-1.1.3-2.1.el6.src.rpm", Size: 12039, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pango", Version: "1.28.1", Release: "11.el6", Arch: "x86_64", SourceRpm: "pango-1.28.1-11.el6.src.rpm", Size: 1041857, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mailcap", Version: "2.1.31", Release: "2.el6", Arch: "noarch", SourceRpm: "mailcap-2.1.31-2.el6.src.rpm", Size: 52877, License: "Public Domain and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-XML-Parser", Version: "2.36", Release: "7.el6", Arch: "x86_64", SourceRpm: "perl-XML-Parser-2.36-7.el6.src.rpm", Size: 652307, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "policycoreutils", Version: "2.0.83", Release: "30.1.el6_8", Arch: "x86_64", SourceRpm: "policycoreutils-2.0.83-30.1.el6_8.src.rpm", Size: 3596110, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
 	}
 
 	// docker run --rm -it centos:6 bash
@@ -286,966 +286,966 @@ var (
 	// yum install -y net-tools bc
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
 	CentOS6Many = []PackageInfo{
-		{intRef(), "iproute", "2.6.32", "57.el6", "x86_64", "iproute-2.6.32-57.el6.src.rpm", 963477, "GPLv2+ and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setup", "2.8.14", "23.el6", "noarch", "setup-2.8.14-23.el6.src.rpm", 666669, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iputils", "20071127", "24.el6", "x86_64", "iputils-20071127-24.el6.src.rpm", 297243, "BSD with advertising and GPLv2+ and Rdisc", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "4.el6", "noarch", "basesystem-10.0-4.el6.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "util-linux-ng", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 6139437, "GPLv1+ and GPLv2 and GPLv2+ and LGPLv2+ and MIT and BSD with advertising and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata", "2018e", "3.el6", "noarch", "tzdata-2018e-3.el6.src.rpm", 1961609, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "udev", "147", "2.73.el6_8.2", "x86_64", "udev-147-2.73.el6_8.2.src.rpm", 1280842, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh-clients", "5.3p1", "124.el6_10", "x86_64", "openssh-5.3p1-124.el6_10.src.rpm", 1352442, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "git", "1.7.1", "9.el6_9", "x86_64", "git-1.7.1-9.el6_9.src.rpm", 15290753, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.1.2", "48.el6", "x86_64", "bash-4.1.2-48.el6.src.rpm", 3142529, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-devel", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 6327079, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.16", "5.5.el6", "x86_64", "libcap-2.16-5.5.el6.src.rpm", 64437, "LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hicolor-icon-theme", "0.11", "1.1.el6", "noarch", "hicolor-icon-theme-0.11-1.1.el6.src.rpm", 45406, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "4.13a", "8.el6", "x86_64", "texinfo-4.13a-8.el6.src.rpm", 329482, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcj", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 65012480, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.49", "7.el6_9.1", "x86_64", "acl-2.2.49-7.el6_9.1.src.rpm", 31280, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "intltool", "0.41.0", "1.1.el6", "noarch", "intltool-0.41.0-1.1.el6.src.rpm", 167585, "GPLv2 with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el6", "x86_64", "nspr-4.19.0-1.el6.src.rpm", 281968, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "subversion", "1.6.11", "15.el6_7", "x86_64", "subversion-1.6.11-15.el6_7.src.rpm", 12105530, "ASL 1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.41.12", "24.el6", "x86_64", "e2fsprogs-1.41.12-24.el6.src.rpm", 59233, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-gfortran", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 14473805, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.0.41", "4.el6", "x86_64", "libsepol-2.0.41-4.el6.src.rpm", 248680, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 323213, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.3.49.5", "1.el6", "x86_64", "chkconfig-1.3.49.5-1.el6.src.rpm", 670580, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "flex", "2.5.35", "9.el6", "x86_64", "flex-2.5.35-9.el6.src.rpm", 736081, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.4.5", "6.el6", "x86_64", "audit-2.4.5-6.el6.src.rpm", 235282, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cscope", "15.6", "7.el6", "x86_64", "cscope-15.6-7.el6.src.rpm", 466265, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.0", "4.el6", "x86_64", "readline-6.0-4.el6.src.rpm", 433957, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rcs", "5.7", "37.el6", "x86_64", "rcs-5.7-37.el6.src.rpm", 709927, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.04", "30.el6", "x86_64", "file-5.04-30.el6.src.rpm", 2486624, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffstat", "1.51", "2.el6", "x86_64", "diffstat-1.51-2.el6.src.rpm", 45568, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.2.24", "9.el6", "x86_64", "dbus-1.2.24-9.el6.src.rpm", 265736, "GPLv2+ or AFL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "doxygen", "1.6.1", "6.el6", "x86_64", "doxygen-1.6.1-6.el6.src.rpm", 9446190, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.6.20", "1.el6_7.2", "x86_64", "sqlite-3.6.20-1.el6_7.2.src.rpm", 640060, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxslt", "1.1.26", "2.el6_3.1", "x86_64", "libxslt-1.1.26-2.el6_3.1.src.rpm", 2223955, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 16304, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4-cxx", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 1647216, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "4.999.9", "0.5.beta.20091007git.el6", "x86_64", "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", 214490, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm-devel", "1.8.0", "39.el6", "x86_64", "gdbm-1.8.0-39.el6.src.rpm", 22251, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.7", "4.el6", "x86_64", "libgpg-error-1.7-4.el6.src.rpm", 214321, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-ExtUtils-ParseXS", "2.2003.0", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 62208, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "7.8", "7.el6", "x86_64", "pcre-7.8-7.el6.src.rpm", 529027, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-ExtUtils-MakeMaker", "6.55", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 622462, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.4.2", "9.el6", "x86_64", "findutils-4.4.2-9.el6.src.rpm", 1442912, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "docbook-dtds", "1.0", "51.el6", "noarch", "docbook-dtds-1.0-51.el6.src.rpm", 4571574, "Copyright only", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-indexhtml", "6", "2.el6.centos", "noarch", "centos-indexhtml-6-2.el6.centos.src.rpm", 92560, "Distributable", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.0.1", "13.el6_8", "x86_64", "expat-2.0.1-13.el6_8.src.rpm", 197794, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xmlto", "0.0.23", "3.el6", "x86_64", "xmlto-0.0.23-3.el6.src.rpm", 99317, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.18.5", "2.el6_5.2", "x86_64", "p11-kit-0.18.5-2.el6_5.2.src.rpm", 262669, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-ExtUtils-Embed", "1.28", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 17130, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.4.5", "12.el6_8", "x86_64", "libgcrypt-1.4.5-12.el6_8.src.rpm", 536622, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "libogg", "1.1.4", "2.1.el6", "x86_64", "libogg-1.1.4-2.1.el6.src.rpm", 30454, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXfont", "1.5.1", "2.el6", "x86_64", "libXfont-1.5.1-2.el6.src.rpm", 336333, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libusb", "0.1.12", "23.el6", "x86_64", "libusb-0.1.12-23.el6.src.rpm", 54440, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "libvorbis", "1.2.3", "5.el6_9.1", "x86_64", "libvorbis-1.2.3-5.el6_9.1.src.rpm", 2152680, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.7.6", "8.el6", "x86_64", "pinentry-0.7.6-8.el6.src.rpm", 147023, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsndfile", "1.0.20", "5.el6", "x86_64", "libsndfile-1.0.20-5.el6.src.rpm", 770762, "LGPLv2+ and GPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps", "3.2.8", "45.el6_9.3", "x86_64", "procps-3.2.8-45.el6_9.3.src.rpm", 475526, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "giflib", "4.1.6", "3.1.el6", "x86_64", "giflib-4.1.6-3.1.el6.src.rpm", 81932, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.23", "15.el6_8", "x86_64", "tar-1.23-15.el6_8.src.rpm", 2616465, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap-ng", "0.6.4", "3.el6_0.1", "x86_64", "libcap-ng-0.6.4-3.el6_0.1.src.rpm", 45214, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "checkpolicy", "2.0.22", "1.el6", "x86_64", "checkpolicy-2.0.22-1.el6.src.rpm", 870239, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ConsoleKit-libs", "0.4.1", "6.el6", "x86_64", "ConsoleKit-0.4.1-6.el6.src.rpm", 22061, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libIDL", "0.8.13", "2.1.el6", "x86_64", "libIDL-0.8.13-2.1.el6.src.rpm", 214847, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4-utils", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 416398, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib-devel", "1.2.3", "29.el6", "x86_64", "zlib-1.2.3-29.el6.src.rpm", 117496, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcsc-lite-libs", "1.5.2", "16.el6", "x86_64", "pcsc-lite-1.5.2-16.el6.src.rpm", 44912, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "which", "2.19", "6.el6", "x86_64", "which-2.19-6.el6.src.rpm", 73004, "GPLv3", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ttmkfdir", "3.0.9", "32.1.el6", "x86_64", "ttmkfdir-3.0.9-32.1.el6.src.rpm", 101747, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dash", "0.5.5.1", "4.el6", "x86_64", "dash-0.5.5.1-4.el6.src.rpm", 127277, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "eggdbus", "0.6", "3.el6", "x86_64", "eggdbus-0.6-3.el6.src.rpm", 321013, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "groff", "1.18.1.4", "21.el6", "x86_64", "groff-1.18.1.4-21.el6.src.rpm", 5318766, "GPLv2 and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ConsoleKit", "0.4.1", "6.el6", "x86_64", "ConsoleKit-0.4.1-6.el6.src.rpm", 273094, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils-libs", "8.4", "47.el6", "x86_64", "coreutils-8.4-47.el6.src.rpm", 5576, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libasyncns", "0.8", "1.1.el6", "x86_64", "libasyncns-0.8-1.1.el6.src.rpm", 52778, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.8.16", "4.el6", "x86_64", "cracklib-2.8.16-4.el6.src.rpm", 187265, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata-java", "2019a", "1.el6", "noarch", "tzdata-2019a-1.el6.src.rpm", 374277, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.4", "47.el6", "x86_64", "coreutils-8.4-47.el6.src.rpm", 12873065, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "java-1.7.0-openjdk", "1.7.0.221", "2.6.18.0.el6_10", "x86_64", "java-1.7.0-openjdk-1.7.0.221-2.6.18.0.el6_10.src.rpm", 96821020, "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv1.1 and MPLv2.0 and Public Domain and W3C and zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "module-init-tools", "3.9", "26.el6", "x86_64", "module-init-tools-3.9-26.el6.src.rpm", 1216834, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-devel", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 305553, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "slang", "2.2.1", "1.el6", "x86_64", "slang-2.2.1-1.el6.src.rpm", 1800725, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "slang-devel", "2.2.1", "1.el6", "x86_64", "slang-2.2.1-1.el6.src.rpm", 395444, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 2660886, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "numactl", "2.0.9", "2.el6", "x86_64", "numactl-2.0.9-2.el6.src.rpm", 175404, "LGPLv2/GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 2052883, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pciutils-devel", "3.1.10", "4.el6", "x86_64", "pciutils-3.1.10-4.el6.src.rpm", 74324, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mingetty", "1.08", "5.el6", "x86_64", "mingetty-1.08-5.el6.src.rpm", 34586, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-devel", "2.6.6", "66.el6_8", "x86_64", "python-2.6.6-66.el6_8.src.rpm", 467448, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.10.3", "65.el6", "x86_64", "krb5-1.10.3-65.el6.src.rpm", 1813468, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bc", "1.06.95", "1.el6", "x86_64", "bc-1.06.95-1.el6.src.rpm", 217289, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.2", "2.el6_7.1", "x86_64", "libssh2-1.4.2-2.el6_7.1.src.rpm", 325165, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 777656, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 2034245, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.14", "9.el6_10", "x86_64", "gnupg2-2.0.14-9.el6_10.src.rpm", 6087540, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-libs", "9.8.2", "0.68.rc1.el6_10.1", "x86_64", "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", 2340720, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.0.43", "5.1.el6", "x86_64", "libsemanage-2.0.43-5.1.el6.src.rpm", 204223, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.5", "4.1.el6", "x86_64", "libutempter-1.1.5-4.1.el6.src.rpm", 40785, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth-core-libs", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 177760, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.5", "3.2.el6", "x86_64", "libffi-3.0.5-3.2.el6.src.rpm", 42881, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.6.6", "66.el6_8", "x86_64", "python-2.6.6-66.el6_8.src.rpm", 22979382, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.1", "18.20090824bzr68.el6", "x86_64", "pygpgme-0.1-18.20090824bzr68.el6.src.rpm", 251432, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.9.1", "11.el6", "noarch", "python-urlgrabber-3.9.1-11.el6.src.rpm", 323137, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.23", "9.1.el6", "x86_64", "pkgconfig-0.23-9.1.el6.src.rpm", 140091, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.28.8", "10.el6", "x86_64", "glib2-2.28.8-10.el6.src.rpm", 8061476, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.2", "16.el6", "x86_64", "yum-metadata-parser-1.1.2-16.el6.src.rpm", 58327, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.2.29", "81.el6.centos", "noarch", "yum-3.2.29-81.el6.centos.src.rpm", 4832194, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.77", "7.el6", "x86_64", "passwd-0.77-7.el6.src.rpm", 357699, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-utils", "9.8.2", "0.68.rc1.el6_10.1", "x86_64", "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", 451000, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.629", "5.el6_8.1", "x86_64", "vim-7.4.629-5.el6_8.1.src.rpm", 909230, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "c105b9de", "4e0fd3a3", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 112434659, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fontconfig", "2.8.0", "5.el6", "x86_64", "fontconfig-2.8.0-5.el6.src.rpm", 450597, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libproxy-bin", "0.3.0", "10.el6", "x86_64", "libproxy-0.3.0-10.el6.src.rpm", 6800, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libproxy", "0.3.0", "10.el6", "x86_64", "libproxy-0.3.0-10.el6.src.rpm", 118439, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mpfr", "2.4.1", "6.el6", "x86_64", "mpfr-2.4.1-6.el6.src.rpm", 377376, "LGPLv2+ and GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "libpng", "1.2.49", "2.el6_7", "x86_64", "libpng-1.2.49-2.el6_7.src.rpm", 654803, "zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtiff", "3.9.4", "21.el6_8", "x86_64", "libtiff-3.9.4-21.el6_8.src.rpm", 966899, "libtiff", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zip", "3.0", "1.el6_7.1", "x86_64", "zip-3.0-1.el6_7.1.src.rpm", 823164, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr", "1.3.9", "5.el6_9.1", "x86_64", "apr-1.3.9-5.el6_9.1.src.rpm", 303173, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sysvinit-tools", "2.87", "6.dsf.el6", "x86_64", "sysvinit-2.87-6.dsf.el6.src.rpm", 114115, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "atk", "1.30.0", "1.el6", "x86_64", "atk-1.30.0-1.el6.src.rpm", 957484, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libICE", "1.0.6", "1.el6", "x86_64", "libICE-1.0.6-1.el6.src.rpm", 117691, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hwdata", "0.233", "20.1.el6", "noarch", "hwdata-0.233-20.1.el6.src.rpm", 5894683, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libSM", "1.2.1", "2.el6", "x86_64", "libSM-1.2.1-2.el6.src.rpm", 78264, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-lzma-compat", "4.999.9", "0.5.beta.20091007git.el6", "x86_64", "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", 21146, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 677800, "GPLv3+ and (GPLv2+ or LGPLv3+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "jasper-libs", "1.900.1", "22.el6", "x86_64", "jasper-1.900.1-22.el6.src.rpm", 341232, "JasPer", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "neon", "0.29.3", "3.el6_4", "x86_64", "neon-0.29.3-3.el6_4.src.rpm", 297415, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "alsa-lib", "1.1.0", "4.el6", "x86_64", "alsa-lib-1.1.0-4.el6.src.rpm", 1277969, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libart_lgpl", "2.3.20", "5.1.el6", "x86_64", "libart_lgpl-2.3.20-5.1.el6.src.rpm", 129211, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck", "1.2.0", "7.el6", "x86_64", "fipscheck-1.2.0-7.el6.src.rpm", 28163, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Escapes", "1.04", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 21092, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Simple", "3.13", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 476776, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(3), "perl-version", "0.77", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 51960, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-URI", "1.40", "2.el6", "noarch", "perl-URI-1.40-2.el6.src.rpm", 256097, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "autoconf", "2.63", "5.1.el6", "noarch", "autoconf-2.63-5.1.el6.src.rpm", 2605343, "GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Compress-Raw-Zlib", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 146581, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-IO-Compress-Zlib", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 372202, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "redhat-rpm-config", "9.0.3", "51.el6.centos", "noarch", "redhat-rpm-config-9.0.3-51.el6.centos.src.rpm", 138592, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTML-Parser", "3.64", "2.el6", "x86_64", "perl-HTML-Parser-3.64-2.el6.src.rpm", 226598, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext", "0.17", "18.el6", "x86_64", "gettext-0.17-18.el6.src.rpm", 6369345, "GPLv3 and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxcb", "1.12", "4.el6", "x86_64", "libxcb-1.12-4.el6.src.rpm", 886960, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ppl", "0.10.2", "11.el6", "x86_64", "ppl-0.10.2-11.el6.src.rpm", 4637284, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rsync", "3.0.6", "12.el6", "x86_64", "rsync-3.0.6-12.el6.src.rpm", 698678, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-libs", "0.17", "18.el6", "x86_64", "gettext-0.17-18.el6.src.rpm", 278832, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgfortran", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 988064, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-headers", "2.6.32", "754.12.1.el6", "x86_64", "kernel-2.6.32-754.12.1.el6.src.rpm", 2776718, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-devel", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 990251, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++-devel", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 9728819, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11", "1.6.4", "3.el6", "x86_64", "libX11-1.6.4-3.el6.src.rpm", 1301928, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXext", "1.3.3", "1.el6", "x86_64", "libXext-1.3.3-1.el6.src.rpm", 85590, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXfixes", "5.0.3", "1.el6", "x86_64", "libXfixes-5.0.3-1.el6.src.rpm", 23375, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXi", "1.7.8", "1.el6", "x86_64", "libXi-1.7.8-1.el6.src.rpm", 66523, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXtst", "1.2.3", "1.el6", "x86_64", "libXtst-1.2.3-1.el6.src.rpm", 27778, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXcursor", "1.1.14", "2.1.el6", "x86_64", "libXcursor-1.1.14-2.1.el6.src.rpm", 41934, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXft", "2.3.2", "1.el6", "x86_64", "libXft-2.3.2-1.el6.src.rpm", 120045, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXcomposite", "0.4.3", "4.el6", "x86_64", "libXcomposite-0.4.3-4.el6.src.rpm", 31878, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-libwww-perl", "5.833", "5.el6", "noarch", "perl-libwww-perl-5.833-5.el6.src.rpm", 903654, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "redhat-logos", "60.0.14", "12.el6.centos", "noarch", "redhat-logos-60.0.14-12.el6.centos.src.rpm", 15816517, "Copyright 1999-2010 the CentOS Project.  All rights reserved.", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iptables", "1.4.7", "19.el6", "x86_64", "iptables-1.4.7-19.el6.src.rpm", 861752, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 117352, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdrm", "2.4.65", "2.el6", "x86_64", "libdrm-2.4.65-2.el6.src.rpm", 306787, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "2.4.30", "3.el6", "x86_64", "filesystem-2.4.30-3.el6.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 193924, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "initscripts", "9.03.61", "1.el6.centos", "x86_64", "initscripts-9.03.61-1.el6.centos.src.rpm", 5735427, "GPLv2 and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 193090, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh", "5.3p1", "124.el6_10", "x86_64", "openssh-5.3p1-124.el6_10.src.rpm", 787618, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.14.3", "23.3.el6_8", "x86_64", "nss-softokn-3.14.3-23.3.el6_8.src.rpm", 490290, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Git", "1.7.1", "9.el6_9", "noarch", "git-1.7.1-9.el6_9.src.rpm", 35913, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 752304, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-devel", "2.6.32", "754.12.1.el6", "x86_64", "kernel-2.6.32-754.12.1.el6.src.rpm", 27015877, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.44", "7.el6", "x86_64", "attr-2.4.44-7.el6.src.rpm", 18712, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-client", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 12275931, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.3", "29.el6", "x86_64", "zlib-1.2.3-29.el6.src.rpm", 152305, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gtk2", "2.24.23", "9.el6", "x86_64", "gtk2-2.24.23-9.el6.src.rpm", 12426003, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "7.el6", "x86_64", "popt-1.13-7.el6.src.rpm", 83420, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-devel", "0.17", "18.el6", "x86_64", "gettext-0.17-18.el6.src.rpm", 635713, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 1533095, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 30373, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.el6", "x86_64", "nss-util-3.36.0-1.el6.src.rpm", 191928, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-c++", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 11429003, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.5", "7.el6_0", "x86_64", "bzip2-1.0.5-7.el6_0.src.rpm", 67592, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtool", "2.2.6", "15.5.el6", "x86_64", "libtool-2.2.6-15.5.el6.src.rpm", 1998350, "GPLv2+ and LGPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.0.94", "7.el6", "x86_64", "libselinux-2.0.94-7.el6.src.rpm", 130336, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patchutils", "0.3.1", "3.1.el6", "x86_64", "patchutils-0.3.1-3.1.el6.src.rpm", 241488, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.1", "10.el6", "x86_64", "sed-4.2.1-10.el6.src.rpm", 542324, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bison", "2.4.1", "5.el6", "x86_64", "bison-2.4.1-5.el6.src.rpm", 2081518, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.18", "2.el6", "x86_64", "libidn-1.18-2.el6.src.rpm", 567612, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ctags", "5.8", "2.el6", "x86_64", "ctags-5.8-2.el6.src.rpm", 347508, "GPLv2+ or Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.7.6", "21.el6_8.1", "x86_64", "libxml2-2.7.6-21.el6_8.1.src.rpm", 1779163, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "swig", "1.3.40", "6.el6", "x86_64", "swig-1.3.40-6.el6.src.rpm", 4290828, "GPLv2+ and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 987096, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "indent", "2.2.10", "7.el6", "x86_64", "indent-2.2.10-7.el6.src.rpm", 252872, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "4.1.el6", "x86_64", "lua-5.1.4-4.1.el6.src.rpm", 617799, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "byacc", "1.9.20070509", "7.el6", "x86_64", "byacc-1.9.20070509-7.el6.src.rpm", 87968, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "3.1.7", "10.el6_7.3", "x86_64", "gawk-3.1.7-10.el6_7.3.src.rpm", 2038650, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xml-common", "0.6.3", "33.el6", "noarch", "sgml-common-0.6.3-33.el6.src.rpm", 20624, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 136136, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4-devel", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 25205066, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 1011166, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-Test-Harness", "3.17", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 408795, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.14.3", "23.3.el6_8", "x86_64", "nss-softokn-3.14.3-23.3.el6_8.src.rpm", 1103062, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-devel", "5.10.1", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 1870563, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "6.el6", "x86_64", "grep-2.20-6.el6.src.rpm", 1197904, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sgml-common", "0.6.3", "33.el6", "noarch", "sgml-common-0.6.3-33.el6.src.rpm", 135735, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.10", "13.el6", "x86_64", "cpio-2.10-13.el6.src.rpm", 650433, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "docbook-style-xsl", "1.75.2", "6.el6", "noarch", "docbook-style-xsl-1.75.2-6.el6.src.rpm", 16030288, "Copyright only", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "9.3.el6", "x86_64", "pth-2.0.7-9.3.el6.src.rpm", 261931, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lynx", "2.8.6", "27.el6", "x86_64", "lynx-2.8.6-27.el6.src.rpm", 4934856, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "2.3", "6.el6_5", "x86_64", "libtasn1-2.3-6.el6_5.src.rpm", 443140, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "asciidoc", "8.4.5", "4.1.el6", "noarch", "asciidoc-8.4.5-4.1.el6.src.rpm", 645180, "GPL+ and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.18.5", "2.el6_5.2", "x86_64", "p11-kit-0.18.5-2.el6_5.2.src.rpm", 178775, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hmaccalc", "0.9.12", "2.el6", "x86_64", "hmaccalc-0.9.12-2.el6.src.rpm", 86101, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libnih", "1.0.1", "8.el6", "x86_64", "libnih-1.0.1-8.el6.src.rpm", 490926, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libfontenc", "1.1.2", "3.el6", "x86_64", "libfontenc-1.1.2-3.el6.src.rpm", 50316, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gmp", "4.3.1", "13.el6", "x86_64", "gmp-4.3.1-13.el6.src.rpm", 657883, "LGPLv2+ and  GPLv3+ and LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "xorg-x11-font-utils", "7.2", "11.el6", "x86_64", "xorg-x11-font-utils-7.2-11.el6.src.rpm", 300562, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file", "5.04", "30.el6", "x86_64", "file-5.04-30.el6.src.rpm", 56567, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "flac", "1.2.1", "7.el6_6", "x86_64", "flac-1.2.1-7.el6_6.src.rpm", 722062, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "net-tools", "1.60", "114.el6", "x86_64", "net-tools-1.60-114.el6.src.rpm", 778260, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "jpackage-utils", "1.7.5", "3.16.el6", "noarch", "jpackage-utils-1.7.5-3.16.el6.src.rpm", 168662, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "psmisc", "22.6", "24.el6", "x86_64", "psmisc-22.6-24.el6.src.rpm", 222569, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf-devel", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 26454, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-utils", "2.0.94", "7.el6", "x86_64", "libselinux-2.0.94-7.el6.src.rpm", 62739, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus", "1.2.24", "9.el6", "x86_64", "dbus-1.2.24-9.el6.src.rpm", 498105, "GPLv2+ or AFL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2", "1.0.5", "7.el6_0", "x86_64", "bzip2-1.0.5-7.el6_0.src.rpm", 79087, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tcp_wrappers-libs", "7.6", "58.el6", "x86_64", "tcp_wrappers-7.6-58.el6.src.rpm", 131475, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ORBit2", "2.14.17", "7.el6", "x86_64", "ORBit2-2.14.17-7.el6.src.rpm", 603232, "LGPLv2+ and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.23", "15.el6_6.2", "x86_64", "cyrus-sasl-2.1.23-15.el6_6.2.src.rpm", 357710, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lksctp-tools", "1.0.10", "7.el6", "x86_64", "lksctp-tools-1.0.10-7.el6.src.rpm", 210697, "GPLv2 and GPLv2+ and LGPLv2 and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "make", "3.81", "23.el6", "x86_64", "make-3.81-23.el6.src.rpm", 1079569, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "copy-jdk-configs", "3.3", "9.el6", "noarch", "copy-jdk-configs-3.3-9.el6.src.rpm", 16172, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "2.8.1", "28.el6", "x86_64", "diffutils-2.8.1-28.el6.src.rpm", 588813, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xorg-x11-fonts-Type1", "7.2", "11.el6", "noarch", "xorg-x11-fonts-7.2-11.el6.src.rpm", 883992, "MIT and Lucida and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 386371, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "polkit", "0.96", "11.el6_10.1", "x86_64", "polkit-0.96-11.el6_10.1.src.rpm", 646341, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "less", "436", "13.el6", "x86_64", "less-436-13.el6.src.rpm", 201909, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "GConf2", "2.28.0", "7.el6", "x86_64", "GConf2-2.28.0-7.el6.src.rpm", 6076626, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.3.12", "24.el6", "x86_64", "gzip-1.3.12-24.el6.src.rpm", 225924, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pulseaudio-libs", "0.9.21", "26.el6", "x86_64", "pulseaudio-0.9.21-26.el6.src.rpm", 2700180, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.8.16", "4.el6", "x86_64", "cracklib-2.8.16-4.el6.src.rpm", 9327207, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "java-1.7.0-openjdk-devel", "1.7.0.221", "2.6.18.0.el6_10", "x86_64", "java-1.7.0-openjdk-1.7.0.221-2.6.18.0.el6_10.src.rpm", 37629764, "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv1.1 and MPLv2.0 and Public Domain and W3C and zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.1", "24.el6", "x86_64", "pam-1.1.1-24.el6.src.rpm", 2419646, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils-devel", "2.20.51.0.2", "5.48.el6_10.1", "x86_64", "binutils-2.20.51.0.2-5.48.el6_10.1.src.rpm", 3125929, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs-devel", "2.4.5", "6.el6", "x86_64", "audit-2.4.5-6.el6.src.rpm", 78382, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth-scripts", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 11006, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "newt", "0.52.11", "4.el6", "x86_64", "newt-0.52.11-4.el6.src.rpm", 173237, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "65.1.el6", "noarch", "ca-certificates-2018.2.22-65.1.el6.src.rpm", 2675229, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pciutils-libs", "3.1.10", "4.el6", "x86_64", "pciutils-3.1.10-4.el6.src.rpm", 48992, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 32822, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "numactl-devel", "2.0.9", "2.el6", "x86_64", "numactl-2.0.9-2.el6.src.rpm", 98470, "LGPLv2/GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "ethtool", "3.5", "6.el6", "x86_64", "ethtool-3.5-6.el6.src.rpm", 283910, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "newt-devel", "0.52.11", "4.el6", "x86_64", "newt-0.52.11-4.el6.src.rpm", 127322, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.4", "5.el6", "x86_64", "keyutils-1.4-5.el6.src.rpm", 36624, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-devel", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 1795830, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssl", "1.0.1e", "57.el6", "x86_64", "openssl-1.0.1e-57.el6.src.rpm", 4248338, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.19.7", "53.el6_9", "x86_64", "curl-7.19.7-53.el6_9.src.rpm", 347536, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.19.7", "53.el6_9", "x86_64", "curl-7.19.7-53.el6_9.src.rpm", 357074, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.40", "16.el6", "x86_64", "openldap-2.4.40-16.el6.src.rpm", 859518, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.1.8", "3.el6", "x86_64", "gpgme-1.1.8-3.el6.src.rpm", 729658, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "9.1.el6", "x86_64", "ustr-1.0.4-9.1.el6.src.rpm", 273983, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "5.el6", "x86_64", "shadow-utils-4.1.5.1-5.el6.src.rpm", 3466724, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "MAKEDEV", "3.24", "6.el6", "x86_64", "MAKEDEV-3.24-6.el6.src.rpm", 227290, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.8.0", "39.el6", "x86_64", "gdbm-1.8.0-39.el6.src.rpm", 48770, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.6.6", "66.el6_8", "x86_64", "python-2.6.6-66.el6_8.src.rpm", 79603, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 120906, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "9.el6", "x86_64", "python-pycurl-7.19.0-9.el6.src.rpm", 236939, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.3.1", "2.1.el6", "noarch", "python-iniparse-0.3.1-2.1.el6.src.rpm", 109284, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gamin", "0.1.10", "9.el6", "x86_64", "gamin-0.1.10-9.el6.src.rpm", 416440, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "0.70", "6.el6", "x86_64", "shared-mime-info-0.70-6.el6.src.rpm", 1411396, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.56.13", "8.el6_7", "x86_64", "libuser-0.56.13-8.el6_7.src.rpm", 1882055, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.30", "42.el6_10", "noarch", "yum-utils-1.1.30-42.el6_10.src.rpm", 53961, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "6", "10.el6.centos.12.3", "x86_64", "centos-release-6-10.el6.centos.12.3.src.rpm", 38232, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.30", "42.el6_10", "noarch", "yum-utils-1.1.30-42.el6_10.src.rpm", 22350, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "6.1.el6", "noarch", "rootfiles-8.1-6.1.el6.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 13117447, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "freetype", "2.3.11", "17.el6", "x86_64", "freetype-2.3.11-17.el6.src.rpm", 836907, "FTL or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libjpeg-turbo", "1.2.1", "3.el6_5", "x86_64", "libjpeg-turbo-1.2.1-3.el6_5.src.rpm", 476782, "wxWidgets", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libproxy-python", "0.3.0", "10.el6", "x86_64", "libproxy-0.3.0-10.el6.src.rpm", 8320, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "m4", "1.4.13", "5.el6", "x86_64", "m4-1.4.13-5.el6.src.rpm", 560949, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libs", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 648199, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "avahi-libs", "0.6.25", "17.el6", "x86_64", "avahi-0.6.25-17.el6.src.rpm", 114824, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnutls", "2.12.23", "22.el6", "x86_64", "gnutls-2.12.23-22.el6.src.rpm", 1167530, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz", "4.999.9", "0.5.beta.20091007git.el6", "x86_64", "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", 488160, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.20.51.0.2", "5.48.el6_10.1", "x86_64", "binutils-2.20.51.0.2-5.48.el6_10.1.src.rpm", 9831328, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "unzip", "6.0", "5.el6", "x86_64", "unzip-6.0-5.el6.src.rpm", 331766, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgomp", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 127982, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patch", "2.6", "8.el6_9", "x86_64", "patch-2.6-8.el6_9.src.rpm", 179790, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpciaccess", "0.13.4", "1.el6", "x86_64", "libpciaccess-0.13.4-1.el6.src.rpm", 40777, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util", "1.3.9", "3.el6_0.1", "x86_64", "apr-util-1.3.9-3.el6_0.1.src.rpm", 202360, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "cups-libs", "1.4.2", "81.el6_10", "x86_64", "cups-1.4.2-81.el6_10.src.rpm", 667768, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpp", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 10012603, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pakchois", "0.4", "3.2.el6", "x86_64", "pakchois-0.4-3.2.el6.src.rpm", 50156, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-glib", "0.86", "6.el6", "x86_64", "dbus-glib-0.86-6.el6.src.rpm", 579611, "AFL and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-runtime", "2.9", "9.el6", "x86_64", "systemtap-2.9-9.el6.src.rpm", 616098, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck-lib", "1.2.0", "7.el6", "x86_64", "fipscheck-1.2.0-7.el6.src.rpm", 10353, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pixman", "0.32.8", "1.el6", "x86_64", "pixman-0.32.8-1.el6.src.rpm", 721560, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-libs", "5.10.1", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 1485896, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Module-Pluggable", "3.90", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 31033, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl", "5.10.1", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 35282834, "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Error", "0.17015", "4.el6", "noarch", "perl-Error-0.17015-4.el6.src.rpm", 47372, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "automake", "1.11.1", "4.el6", "noarch", "automake-1.11.1-4.el6.src.rpm", 1520636, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-IO-Compress-Base", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 138661, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-Compress-Zlib", "2.021", "144.el6", "x86_64", "perl-5.10.1-144.el6.src.rpm", 52519, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTML-Tagset", "3.20", "4.el6", "noarch", "perl-HTML-Tagset-3.20-4.el6.src.rpm", 19692, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cvs", "1.11.23", "16.el6", "x86_64", "cvs-1.11.23-16.el6.src.rpm", 1590583, "GPL+ and GPLv2+ and LGPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXau", "1.0.6", "4.el6", "x86_64", "libXau-1.0.6-4.el6.src.rpm", 40009, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "upstart", "0.6.5", "17.el6", "x86_64", "upstart-0.6.5-17.el6.src.rpm", 567729, "GPLv2 and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cloog-ppl", "0.15.7", "1.2.el6", "x86_64", "cloog-0.15.7-1.2.el6.src.rpm", 185386, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdb", "7.2", "92.el6", "x86_64", "gdb-7.2-92.el6.src.rpm", 5545845, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and GFDL and BSD and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libthai", "0.1.12", "3.el6", "x86_64", "libthai-0.1.12-3.el6.src.rpm", 694712, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libedit", "2.11", "4.20080712cvs.1.el6", "x86_64", "libedit-2.11-4.20080712cvs.1.el6.src.rpm", 185648, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-headers", "2.12", "1.212.el6_10.3", "x86_64", "glibc-2.12-1.212.el6_10.3.src.rpm", 2146171, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 19499265, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11-common", "1.6.4", "3.el6", "noarch", "libX11-1.6.4-3.el6.src.rpm", 1341368, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXrender", "0.9.10", "1.el6", "x86_64", "libXrender-0.9.10-1.el6.src.rpm", 40515, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cairo", "1.8.8", "6.el6_6", "x86_64", "cairo-1.8.8-6.el6_6.src.rpm", 797873, "LGPLv2 or MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXrandr", "1.5.1", "1.el6", "x86_64", "libXrandr-1.5.1-1.el6.src.rpm", 43834, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdk-pixbuf2", "2.24.1", "6.el6_7", "x86_64", "gdk-pixbuf2-2.24.1-6.el6_7.src.rpm", 2637837, "LGPLv2+ and (LGPLv2+ or MPLv1.1) and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXdamage", "1.1.3", "4.el6", "x86_64", "libXdamage-1.1.3-4.el6.src.rpm", 24896, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXinerama", "1.1.3", "2.1.el6", "x86_64", "libXinerama-1.1.3-2.1.el6.src.rpm", 12039, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pango", "1.28.1", "11.el6", "x86_64", "pango-1.28.1-11.el6.src.rpm", 1041857, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mailcap", "2.1.31", "2.el6", "noarch", "mailcap-2.1.31-2.el6.src.rpm", 52877, "Public Domain and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-XML-Parser", "2.36", "7.el6", "x86_64", "perl-XML-Parser-2.36-7.el6.src.rpm", 652307, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "policycoreutils", "2.0.83", "30.1.el6_8", "x86_64", "policycoreutils-2.0.83-30.1.el6_8.src.rpm", 3596110, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(), Name: "iproute", Version: "2.6.32", Release: "57.el6", Arch: "x86_64", SourceRpm: "iproute-2.6.32-57.el6.src.rpm", Size: 963477, License: "GPLv2+ and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.14", Release: "23.el6", Arch: "noarch", SourceRpm: "setup-2.8.14-23.el6.src.rpm", Size: 666669, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iputils", Version: "20071127", Release: "24.el6", Arch: "x86_64", SourceRpm: "iputils-20071127-24.el6.src.rpm", Size: 297243, License: "BSD with advertising and GPLv2+ and Rdisc", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "4.el6", Arch: "noarch", SourceRpm: "basesystem-10.0-4.el6.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "util-linux-ng", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 6139437, License: "GPLv1+ and GPLv2 and GPLv2+ and LGPLv2+ and MIT and BSD with advertising and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018e", Release: "3.el6", Arch: "noarch", SourceRpm: "tzdata-2018e-3.el6.src.rpm", Size: 1961609, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "udev", Version: "147", Release: "2.73.el6_8.2", Arch: "x86_64", SourceRpm: "udev-147-2.73.el6_8.2.src.rpm", Size: 1280842, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh-clients", Version: "5.3p1", Release: "124.el6_10", Arch: "x86_64", SourceRpm: "openssh-5.3p1-124.el6_10.src.rpm", Size: 1352442, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "git", Version: "1.7.1", Release: "9.el6_9", Arch: "x86_64", SourceRpm: "git-1.7.1-9.el6_9.src.rpm", Size: 15290753, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.1.2", Release: "48.el6", Arch: "x86_64", SourceRpm: "bash-4.1.2-48.el6.src.rpm", Size: 3142529, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-devel", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 6327079, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.16", Release: "5.5.el6", Arch: "x86_64", SourceRpm: "libcap-2.16-5.5.el6.src.rpm", Size: 64437, License: "LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hicolor-icon-theme", Version: "0.11", Release: "1.1.el6", Arch: "noarch", SourceRpm: "hicolor-icon-theme-0.11-1.1.el6.src.rpm", Size: 45406, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "4.13a", Release: "8.el6", Arch: "x86_64", SourceRpm: "texinfo-4.13a-8.el6.src.rpm", Size: 329482, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcj", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 65012480, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.49", Release: "7.el6_9.1", Arch: "x86_64", SourceRpm: "acl-2.2.49-7.el6_9.1.src.rpm", Size: 31280, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "intltool", Version: "0.41.0", Release: "1.1.el6", Arch: "noarch", SourceRpm: "intltool-0.41.0-1.1.el6.src.rpm", Size: 167585, License: "GPLv2 with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el6.src.rpm", Size: 281968, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "subversion", Version: "1.6.11", Release: "15.el6_7", Arch: "x86_64", SourceRpm: "subversion-1.6.11-15.el6_7.src.rpm", Size: 12105530, License: "ASL 1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.41.12", Release: "24.el6", Arch: "x86_64", SourceRpm: "e2fsprogs-1.41.12-24.el6.src.rpm", Size: 59233, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-gfortran", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 14473805, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.0.41", Release: "4.el6", Arch: "x86_64", SourceRpm: "libsepol-2.0.41-4.el6.src.rpm", Size: 248680, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 323213, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.3.49.5", Release: "1.el6", Arch: "x86_64", SourceRpm: "chkconfig-1.3.49.5-1.el6.src.rpm", Size: 670580, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "flex", Version: "2.5.35", Release: "9.el6", Arch: "x86_64", SourceRpm: "flex-2.5.35-9.el6.src.rpm", Size: 736081, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.4.5", Release: "6.el6", Arch: "x86_64", SourceRpm: "audit-2.4.5-6.el6.src.rpm", Size: 235282, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cscope", Version: "15.6", Release: "7.el6", Arch: "x86_64", SourceRpm: "cscope-15.6-7.el6.src.rpm", Size: 466265, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.0", Release: "4.el6", Arch: "x86_64", SourceRpm: "readline-6.0-4.el6.src.rpm", Size: 433957, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rcs", Version: "5.7", Release: "37.el6", Arch: "x86_64", SourceRpm: "rcs-5.7-37.el6.src.rpm", Size: 709927, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.04", Release: "30.el6", Arch: "x86_64", SourceRpm: "file-5.04-30.el6.src.rpm", Size: 2486624, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffstat", Version: "1.51", Release: "2.el6", Arch: "x86_64", SourceRpm: "diffstat-1.51-2.el6.src.rpm", Size: 45568, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.2.24", Release: "9.el6", Arch: "x86_64", SourceRpm: "dbus-1.2.24-9.el6.src.rpm", Size: 265736, License: "GPLv2+ or AFL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "doxygen", Version: "1.6.1", Release: "6.el6", Arch: "x86_64", SourceRpm: "doxygen-1.6.1-6.el6.src.rpm", Size: 9446190, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.6.20", Release: "1.el6_7.2", Arch: "x86_64", SourceRpm: "sqlite-3.6.20-1.el6_7.2.src.rpm", Size: 640060, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxslt", Version: "1.1.26", Release: "2.el6_3.1", Arch: "x86_64", SourceRpm: "libxslt-1.1.26-2.el6_3.1.src.rpm", Size: 2223955, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 16304, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4-cxx", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 1647216, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "4.999.9", Release: "0.5.beta.20091007git.el6", Arch: "x86_64", SourceRpm: "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", Size: 214490, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm-devel", Version: "1.8.0", Release: "39.el6", Arch: "x86_64", SourceRpm: "gdbm-1.8.0-39.el6.src.rpm", Size: 22251, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.7", Release: "4.el6", Arch: "x86_64", SourceRpm: "libgpg-error-1.7-4.el6.src.rpm", Size: 214321, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-ExtUtils-ParseXS", Version: "2.2003.0", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 62208, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "7.8", Release: "7.el6", Arch: "x86_64", SourceRpm: "pcre-7.8-7.el6.src.rpm", Size: 529027, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-ExtUtils-MakeMaker", Version: "6.55", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 622462, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.4.2", Release: "9.el6", Arch: "x86_64", SourceRpm: "findutils-4.4.2-9.el6.src.rpm", Size: 1442912, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "docbook-dtds", Version: "1.0", Release: "51.el6", Arch: "noarch", SourceRpm: "docbook-dtds-1.0-51.el6.src.rpm", Size: 4571574, License: "Copyright only", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-indexhtml", Version: "6", Release: "2.el6.centos", Arch: "noarch", SourceRpm: "centos-indexhtml-6-2.el6.centos.src.rpm", Size: 92560, License: "Distributable", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.0.1", Release: "13.el6_8", Arch: "x86_64", SourceRpm: "expat-2.0.1-13.el6_8.src.rpm", Size: 197794, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xmlto", Version: "0.0.23", Release: "3.el6", Arch: "x86_64", SourceRpm: "xmlto-0.0.23-3.el6.src.rpm", Size: 99317, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.18.5", Release: "2.el6_5.2", Arch: "x86_64", SourceRpm: "p11-kit-0.18.5-2.el6_5.2.src.rpm", Size: 262669, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-ExtUtils-Embed", Version: "1.28", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 17130, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.4.5", Release: "12.el6_8", Arch: "x86_64", SourceRpm: "libgcrypt-1.4.5-12.el6_8.src.rpm", Size: 536622, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "libogg", Version: "1.1.4", Release: "2.1.el6", Arch: "x86_64", SourceRpm: "libogg-1.1.4-2.1.el6.src.rpm", Size: 30454, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXfont", Version: "1.5.1", Release: "2.el6", Arch: "x86_64", SourceRpm: "libXfont-1.5.1-2.el6.src.rpm", Size: 336333, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libusb", Version: "0.1.12", Release: "23.el6", Arch: "x86_64", SourceRpm: "libusb-0.1.12-23.el6.src.rpm", Size: 54440, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "libvorbis", Version: "1.2.3", Release: "5.el6_9.1", Arch: "x86_64", SourceRpm: "libvorbis-1.2.3-5.el6_9.1.src.rpm", Size: 2152680, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.7.6", Release: "8.el6", Arch: "x86_64", SourceRpm: "pinentry-0.7.6-8.el6.src.rpm", Size: 147023, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsndfile", Version: "1.0.20", Release: "5.el6", Arch: "x86_64", SourceRpm: "libsndfile-1.0.20-5.el6.src.rpm", Size: 770762, License: "LGPLv2+ and GPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps", Version: "3.2.8", Release: "45.el6_9.3", Arch: "x86_64", SourceRpm: "procps-3.2.8-45.el6_9.3.src.rpm", Size: 475526, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "giflib", Version: "4.1.6", Release: "3.1.el6", Arch: "x86_64", SourceRpm: "giflib-4.1.6-3.1.el6.src.rpm", Size: 81932, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.23", Release: "15.el6_8", Arch: "x86_64", SourceRpm: "tar-1.23-15.el6_8.src.rpm", Size: 2616465, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap-ng", Version: "0.6.4", Release: "3.el6_0.1", Arch: "x86_64", SourceRpm: "libcap-ng-0.6.4-3.el6_0.1.src.rpm", Size: 45214, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "checkpolicy", Version: "2.0.22", Release: "1.el6", Arch: "x86_64", SourceRpm: "checkpolicy-2.0.22-1.el6.src.rpm", Size: 870239, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ConsoleKit-libs", Version: "0.4.1", Release: "6.el6", Arch: "x86_64", SourceRpm: "ConsoleKit-0.4.1-6.el6.src.rpm", Size: 22061, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libIDL", Version: "0.8.13", Release: "2.1.el6", Arch: "x86_64", SourceRpm: "libIDL-0.8.13-2.1.el6.src.rpm", Size: 214847, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4-utils", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 416398, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib-devel", Version: "1.2.3", Release: "29.el6", Arch: "x86_64", SourceRpm: "zlib-1.2.3-29.el6.src.rpm", Size: 117496, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcsc-lite-libs", Version: "1.5.2", Release: "16.el6", Arch: "x86_64", SourceRpm: "pcsc-lite-1.5.2-16.el6.src.rpm", Size: 44912, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "which", Version: "2.19", Release: "6.el6", Arch: "x86_64", SourceRpm: "which-2.19-6.el6.src.rpm", Size: 73004, License: "GPLv3", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ttmkfdir", Version: "3.0.9", Release: "32.1.el6", Arch: "x86_64", SourceRpm: "ttmkfdir-3.0.9-32.1.el6.src.rpm", Size: 101747, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dash", Version: "0.5.5.1", Release: "4.el6", Arch: "x86_64", SourceRpm: "dash-0.5.5.1-4.el6.src.rpm", Size: 127277, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "eggdbus", Version: "0.6", Release: "3.el6", Arch: "x86_64", SourceRpm: "eggdbus-0.6-3.el6.src.rpm", Size: 321013, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "groff", Version: "1.18.1.4", Release: "21.el6", Arch: "x86_64", SourceRpm: "groff-1.18.1.4-21.el6.src.rpm", Size: 5318766, License: "GPLv2 and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ConsoleKit", Version: "0.4.1", Release: "6.el6", Arch: "x86_64", SourceRpm: "ConsoleKit-0.4.1-6.el6.src.rpm", Size: 273094, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils-libs", Version: "8.4", Release: "47.el6", Arch: "x86_64", SourceRpm: "coreutils-8.4-47.el6.src.rpm", Size: 5576, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libasyncns", Version: "0.8", Release: "1.1.el6", Arch: "x86_64", SourceRpm: "libasyncns-0.8-1.1.el6.src.rpm", Size: 52778, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.8.16", Release: "4.el6", Arch: "x86_64", SourceRpm: "cracklib-2.8.16-4.el6.src.rpm", Size: 187265, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata-java", Version: "2019a", Release: "1.el6", Arch: "noarch", SourceRpm: "tzdata-2019a-1.el6.src.rpm", Size: 374277, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.4", Release: "47.el6", Arch: "x86_64", SourceRpm: "coreutils-8.4-47.el6.src.rpm", Size: 12873065, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "java-1.7.0-openjdk", Version: "1.7.0.221", Release: "2.6.18.0.el6_10", Arch: "x86_64", SourceRpm: "java-1.7.0-openjdk-1.7.0.221-2.6.18.0.el6_10.src.rpm", Size: 96821020, License: "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv1.1 and MPLv2.0 and Public Domain and W3C and zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "module-init-tools", Version: "3.9", Release: "26.el6", Arch: "x86_64", SourceRpm: "module-init-tools-3.9-26.el6.src.rpm", Size: 1216834, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-devel", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 305553, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "slang", Version: "2.2.1", Release: "1.el6", Arch: "x86_64", SourceRpm: "slang-2.2.1-1.el6.src.rpm", Size: 1800725, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "slang-devel", Version: "2.2.1", Release: "1.el6", Arch: "x86_64", SourceRpm: "slang-2.2.1-1.el6.src.rpm", Size: 395444, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 2660886, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "numactl", Version: "2.0.9", Release: "2.el6", Arch: "x86_64", SourceRpm: "numactl-2.0.9-2.el6.src.rpm", Size: 175404, License: "LGPLv2/GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 2052883, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pciutils-devel", Version: "3.1.10", Release: "4.el6", Arch: "x86_64", SourceRpm: "pciutils-3.1.10-4.el6.src.rpm", Size: 74324, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mingetty", Version: "1.08", Release: "5.el6", Arch: "x86_64", SourceRpm: "mingetty-1.08-5.el6.src.rpm", Size: 34586, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-devel", Version: "2.6.6", Release: "66.el6_8", Arch: "x86_64", SourceRpm: "python-2.6.6-66.el6_8.src.rpm", Size: 467448, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.10.3", Release: "65.el6", Arch: "x86_64", SourceRpm: "krb5-1.10.3-65.el6.src.rpm", Size: 1813468, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bc", Version: "1.06.95", Release: "1.el6", Arch: "x86_64", SourceRpm: "bc-1.06.95-1.el6.src.rpm", Size: 217289, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.2", Release: "2.el6_7.1", Arch: "x86_64", SourceRpm: "libssh2-1.4.2-2.el6_7.1.src.rpm", Size: 325165, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 777656, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 2034245, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.14", Release: "9.el6_10", Arch: "x86_64", SourceRpm: "gnupg2-2.0.14-9.el6_10.src.rpm", Size: 6087540, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-libs", Version: "9.8.2", Release: "0.68.rc1.el6_10.1", Arch: "x86_64", SourceRpm: "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", Size: 2340720, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.0.43", Release: "5.1.el6", Arch: "x86_64", SourceRpm: "libsemanage-2.0.43-5.1.el6.src.rpm", Size: 204223, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.5", Release: "4.1.el6", Arch: "x86_64", SourceRpm: "libutempter-1.1.5-4.1.el6.src.rpm", Size: 40785, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth-core-libs", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 177760, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.5", Release: "3.2.el6", Arch: "x86_64", SourceRpm: "libffi-3.0.5-3.2.el6.src.rpm", Size: 42881, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.6.6", Release: "66.el6_8", Arch: "x86_64", SourceRpm: "python-2.6.6-66.el6_8.src.rpm", Size: 22979382, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.1", Release: "18.20090824bzr68.el6", Arch: "x86_64", SourceRpm: "pygpgme-0.1-18.20090824bzr68.el6.src.rpm", Size: 251432, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.9.1", Release: "11.el6", Arch: "noarch", SourceRpm: "python-urlgrabber-3.9.1-11.el6.src.rpm", Size: 323137, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.23", Release: "9.1.el6", Arch: "x86_64", SourceRpm: "pkgconfig-0.23-9.1.el6.src.rpm", Size: 140091, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.28.8", Release: "10.el6", Arch: "x86_64", SourceRpm: "glib2-2.28.8-10.el6.src.rpm", Size: 8061476, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.2", Release: "16.el6", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.2-16.el6.src.rpm", Size: 58327, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.2.29", Release: "81.el6.centos", Arch: "noarch", SourceRpm: "yum-3.2.29-81.el6.centos.src.rpm", Size: 4832194, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.77", Release: "7.el6", Arch: "x86_64", SourceRpm: "passwd-0.77-7.el6.src.rpm", Size: 357699, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-utils", Version: "9.8.2", Release: "0.68.rc1.el6_10.1", Arch: "x86_64", SourceRpm: "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", Size: 451000, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.629", Release: "5.el6_8.1", Arch: "x86_64", SourceRpm: "vim-7.4.629-5.el6_8.1.src.rpm", Size: 909230, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "c105b9de", Release: "4e0fd3a3", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 112434659, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fontconfig", Version: "2.8.0", Release: "5.el6", Arch: "x86_64", SourceRpm: "fontconfig-2.8.0-5.el6.src.rpm", Size: 450597, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libproxy-bin", Version: "0.3.0", Release: "10.el6", Arch: "x86_64", SourceRpm: "libproxy-0.3.0-10.el6.src.rpm", Size: 6800, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libproxy", Version: "0.3.0", Release: "10.el6", Arch: "x86_64", SourceRpm: "libproxy-0.3.0-10.el6.src.rpm", Size: 118439, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mpfr", Version: "2.4.1", Release: "6.el6", Arch: "x86_64", SourceRpm: "mpfr-2.4.1-6.el6.src.rpm", Size: 377376, License: "LGPLv2+ and GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "libpng", Version: "1.2.49", Release: "2.el6_7", Arch: "x86_64", SourceRpm: "libpng-1.2.49-2.el6_7.src.rpm", Size: 654803, License: "zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtiff", Version: "3.9.4", Release: "21.el6_8", Arch: "x86_64", SourceRpm: "libtiff-3.9.4-21.el6_8.src.rpm", Size: 966899, License: "libtiff", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zip", Version: "3.0", Release: "1.el6_7.1", Arch: "x86_64", SourceRpm: "zip-3.0-1.el6_7.1.src.rpm", Size: 823164, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr", Version: "1.3.9", Release: "5.el6_9.1", Arch: "x86_64", SourceRpm: "apr-1.3.9-5.el6_9.1.src.rpm", Size: 303173, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sysvinit-tools", Version: "2.87", Release: "6.dsf.el6", Arch: "x86_64", SourceRpm: "sysvinit-2.87-6.dsf.el6.src.rpm", Size: 114115, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "atk", Version: "1.30.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "atk-1.30.0-1.el6.src.rpm", Size: 957484, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libICE", Version: "1.0.6", Release: "1.el6", Arch: "x86_64", SourceRpm: "libICE-1.0.6-1.el6.src.rpm", Size: 117691, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hwdata", Version: "0.233", Release: "20.1.el6", Arch: "noarch", SourceRpm: "hwdata-0.233-20.1.el6.src.rpm", Size: 5894683, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libSM", Version: "1.2.1", Release: "2.el6", Arch: "x86_64", SourceRpm: "libSM-1.2.1-2.el6.src.rpm", Size: 78264, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-lzma-compat", Version: "4.999.9", Release: "0.5.beta.20091007git.el6", Arch: "x86_64", SourceRpm: "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", Size: 21146, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 677800, License: "GPLv3+ and (GPLv2+ or LGPLv3+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "jasper-libs", Version: "1.900.1", Release: "22.el6", Arch: "x86_64", SourceRpm: "jasper-1.900.1-22.el6.src.rpm", Size: 341232, License: "JasPer", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "neon", Version: "0.29.3", Release: "3.el6_4", Arch: "x86_64", SourceRpm: "neon-0.29.3-3.el6_4.src.rpm", Size: 297415, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "alsa-lib", Version: "1.1.0", Release: "4.el6", Arch: "x86_64", SourceRpm: "alsa-lib-1.1.0-4.el6.src.rpm", Size: 1277969, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libart_lgpl", Version: "2.3.20", Release: "5.1.el6", Arch: "x86_64", SourceRpm: "libart_lgpl-2.3.20-5.1.el6.src.rpm", Size: 129211, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck", Version: "1.2.0", Release: "7.el6", Arch: "x86_64", SourceRpm: "fipscheck-1.2.0-7.el6.src.rpm", Size: 28163, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Escapes", Version: "1.04", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 21092, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Simple", Version: "3.13", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 476776, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(3), Name: "perl-version", Version: "0.77", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 51960, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-URI", Version: "1.40", Release: "2.el6", Arch: "noarch", SourceRpm: "perl-URI-1.40-2.el6.src.rpm", Size: 256097, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "autoconf", Version: "2.63", Release: "5.1.el6", Arch: "noarch", SourceRpm: "autoconf-2.63-5.1.el6.src.rpm", Size: 2605343, License: "GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Compress-Raw-Zlib", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 146581, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-IO-Compress-Zlib", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 372202, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "redhat-rpm-config", Version: "9.0.3", Release: "51.el6.centos", Arch: "noarch", SourceRpm: "redhat-rpm-config-9.0.3-51.el6.centos.src.rpm", Size: 138592, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTML-Parser", Version: "3.64", Release: "2.el6", Arch: "x86_64", SourceRpm: "perl-HTML-Parser-3.64-2.el6.src.rpm", Size: 226598, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext", Version: "0.17", Release: "18.el6", Arch: "x86_64", SourceRpm: "gettext-0.17-18.el6.src.rpm", Size: 6369345, License: "GPLv3 and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxcb", Version: "1.12", Release: "4.el6", Arch: "x86_64", SourceRpm: "libxcb-1.12-4.el6.src.rpm", Size: 886960, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ppl", Version: "0.10.2", Release: "11.el6", Arch: "x86_64", SourceRpm: "ppl-0.10.2-11.el6.src.rpm", Size: 4637284, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rsync", Version: "3.0.6", Release: "12.el6", Arch: "x86_64", SourceRpm: "rsync-3.0.6-12.el6.src.rpm", Size: 698678, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-libs", Version: "0.17", Release: "18.el6", Arch: "x86_64", SourceRpm: "gettext-0.17-18.el6.src.rpm", Size: 278832, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgfortran", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 988064, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-headers", Version: "2.6.32", Release: "754.12.1.el6", Arch: "x86_64", SourceRpm: "kernel-2.6.32-754.12.1.el6.src.rpm", Size: 2776718, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-devel", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 990251, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++-devel", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 9728819, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11", Version: "1.6.4", Release: "3.el6", Arch: "x86_64", SourceRpm: "libX11-1.6.4-3.el6.src.rpm", Size: 1301928, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXext", Version: "1.3.3", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXext-1.3.3-1.el6.src.rpm", Size: 85590, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXfixes", Version: "5.0.3", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXfixes-5.0.3-1.el6.src.rpm", Size: 23375, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXi", Version: "1.7.8", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXi-1.7.8-1.el6.src.rpm", Size: 66523, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXtst", Version: "1.2.3", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXtst-1.2.3-1.el6.src.rpm", Size: 27778, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXcursor", Version: "1.1.14", Release: "2.1.el6", Arch: "x86_64", SourceRpm: "libXcursor-1.1.14-2.1.el6.src.rpm", Size: 41934, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXft", Version: "2.3.2", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXft-2.3.2-1.el6.src.rpm", Size: 120045, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXcomposite", Version: "0.4.3", Release: "4.el6", Arch: "x86_64", SourceRpm: "libXcomposite-0.4.3-4.el6.src.rpm", Size: 31878, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-libwww-perl", Version: "5.833", Release: "5.el6", Arch: "noarch", SourceRpm: "perl-libwww-perl-5.833-5.el6.src.rpm", Size: 903654, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "redhat-logos", Version: "60.0.14", Release: "12.el6.centos", Arch: "noarch", SourceRpm: "redhat-logos-60.0.14-12.el6.centos.src.rpm", Size: 15816517, License: "Copyright 1999-2010 the CentOS Project.  All rights reserved.", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iptables", Version: "1.4.7", Release: "19.el6", Arch: "x86_64", SourceRpm: "iptables-1.4.7-19.el6.src.rpm", Size: 861752, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 117352, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdrm", Version: "2.4.65", Release: "2.el6", Arch: "x86_64", SourceRpm: "libdrm-2.4.65-2.el6.src.rpm", Size: 306787, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "2.4.30", Release: "3.el6", Arch: "x86_64", SourceRpm: "filesystem-2.4.30-3.el6.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 193924, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "initscripts", Version: "9.03.61", Release: "1.el6.centos", Arch: "x86_64", SourceRpm: "initscripts-9.03.61-1.el6.centos.src.rpm", Size: 5735427, License: "GPLv2 and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 193090, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh", Version: "5.3p1", Release: "124.el6_10", Arch: "x86_64", SourceRpm: "openssh-5.3p1-124.el6_10.src.rpm", Size: 787618, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.14.3", Release: "23.3.el6_8", Arch: "x86_64", SourceRpm: "nss-softokn-3.14.3-23.3.el6_8.src.rpm", Size: 490290, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Git", Version: "1.7.1", Release: "9.el6_9", Arch: "noarch", SourceRpm: "git-1.7.1-9.el6_9.src.rpm", Size: 35913, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 752304, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-devel", Version: "2.6.32", Release: "754.12.1.el6", Arch: "x86_64", SourceRpm: "kernel-2.6.32-754.12.1.el6.src.rpm", Size: 27015877, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.44", Release: "7.el6", Arch: "x86_64", SourceRpm: "attr-2.4.44-7.el6.src.rpm", Size: 18712, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-client", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 12275931, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.3", Release: "29.el6", Arch: "x86_64", SourceRpm: "zlib-1.2.3-29.el6.src.rpm", Size: 152305, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gtk2", Version: "2.24.23", Release: "9.el6", Arch: "x86_64", SourceRpm: "gtk2-2.24.23-9.el6.src.rpm", Size: 12426003, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "7.el6", Arch: "x86_64", SourceRpm: "popt-1.13-7.el6.src.rpm", Size: 83420, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-devel", Version: "0.17", Release: "18.el6", Arch: "x86_64", SourceRpm: "gettext-0.17-18.el6.src.rpm", Size: 635713, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 1533095, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 30373, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.el6.src.rpm", Size: 191928, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-c++", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 11429003, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.5", Release: "7.el6_0", Arch: "x86_64", SourceRpm: "bzip2-1.0.5-7.el6_0.src.rpm", Size: 67592, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtool", Version: "2.2.6", Release: "15.5.el6", Arch: "x86_64", SourceRpm: "libtool-2.2.6-15.5.el6.src.rpm", Size: 1998350, License: "GPLv2+ and LGPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.0.94", Release: "7.el6", Arch: "x86_64", SourceRpm: "libselinux-2.0.94-7.el6.src.rpm", Size: 130336, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patchutils", Version: "0.3.1", Release: "3.1.el6", Arch: "x86_64", SourceRpm: "patchutils-0.3.1-3.1.el6.src.rpm", Size: 241488, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.1", Release: "10.el6", Arch: "x86_64", SourceRpm: "sed-4.2.1-10.el6.src.rpm", Size: 542324, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bison", Version: "2.4.1", Release: "5.el6", Arch: "x86_64", SourceRpm: "bison-2.4.1-5.el6.src.rpm", Size: 2081518, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.18", Release: "2.el6", Arch: "x86_64", SourceRpm: "libidn-1.18-2.el6.src.rpm", Size: 567612, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ctags", Version: "5.8", Release: "2.el6", Arch: "x86_64", SourceRpm: "ctags-5.8-2.el6.src.rpm", Size: 347508, License: "GPLv2+ or Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.7.6", Release: "21.el6_8.1", Arch: "x86_64", SourceRpm: "libxml2-2.7.6-21.el6_8.1.src.rpm", Size: 1779163, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "swig", Version: "1.3.40", Release: "6.el6", Arch: "x86_64", SourceRpm: "swig-1.3.40-6.el6.src.rpm", Size: 4290828, License: "GPLv2+ and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 987096, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "indent", Version: "2.2.10", Release: "7.el6", Arch: "x86_64", SourceRpm: "indent-2.2.10-7.el6.src.rpm", Size: 252872, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "4.1.el6", Arch: "x86_64", SourceRpm: "lua-5.1.4-4.1.el6.src.rpm", Size: 617799, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "byacc", Version: "1.9.20070509", Release: "7.el6", Arch: "x86_64", SourceRpm: "byacc-1.9.20070509-7.el6.src.rpm", Size: 87968, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "3.1.7", Release: "10.el6_7.3", Arch: "x86_64", SourceRpm: "gawk-3.1.7-10.el6_7.3.src.rpm", Size: 2038650, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xml-common", Version: "0.6.3", Release: "33.el6", Arch: "noarch", SourceRpm: "sgml-common-0.6.3-33.el6.src.rpm", Size: 20624, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 136136, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4-devel", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 25205066, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 1011166, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-Test-Harness", Version: "3.17", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 408795, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.14.3", Release: "23.3.el6_8", Arch: "x86_64", SourceRpm: "nss-softokn-3.14.3-23.3.el6_8.src.rpm", Size: 1103062, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-devel", Version: "5.10.1", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 1870563, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "6.el6", Arch: "x86_64", SourceRpm: "grep-2.20-6.el6.src.rpm", Size: 1197904, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sgml-common", Version: "0.6.3", Release: "33.el6", Arch: "noarch", SourceRpm: "sgml-common-0.6.3-33.el6.src.rpm", Size: 135735, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.10", Release: "13.el6", Arch: "x86_64", SourceRpm: "cpio-2.10-13.el6.src.rpm", Size: 650433, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "docbook-style-xsl", Version: "1.75.2", Release: "6.el6", Arch: "noarch", SourceRpm: "docbook-style-xsl-1.75.2-6.el6.src.rpm", Size: 16030288, License: "Copyright only", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "9.3.el6", Arch: "x86_64", SourceRpm: "pth-2.0.7-9.3.el6.src.rpm", Size: 261931, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lynx", Version: "2.8.6", Release: "27.el6", Arch: "x86_64", SourceRpm: "lynx-2.8.6-27.el6.src.rpm", Size: 4934856, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "2.3", Release: "6.el6_5", Arch: "x86_64", SourceRpm: "libtasn1-2.3-6.el6_5.src.rpm", Size: 443140, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "asciidoc", Version: "8.4.5", Release: "4.1.el6", Arch: "noarch", SourceRpm: "asciidoc-8.4.5-4.1.el6.src.rpm", Size: 645180, License: "GPL+ and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.18.5", Release: "2.el6_5.2", Arch: "x86_64", SourceRpm: "p11-kit-0.18.5-2.el6_5.2.src.rpm", Size: 178775, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hmaccalc", Version: "0.9.12", Release: "2.el6", Arch: "x86_64", SourceRpm: "hmaccalc-0.9.12-2.el6.src.rpm", Size: 86101, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libnih", Version: "1.0.1", Release: "8.el6", Arch: "x86_64", SourceRpm: "libnih-1.0.1-8.el6.src.rpm", Size: 490926, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libfontenc", Version: "1.1.2", Release: "3.el6", Arch: "x86_64", SourceRpm: "libfontenc-1.1.2-3.el6.src.rpm", Size: 50316, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gmp", Version: "4.3.1", Release: "13.el6", Arch: "x86_64", SourceRpm: "gmp-4.3.1-13.el6.src.rpm", Size: 657883, License: "LGPLv2+ and  GPLv3+ and LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "xorg-x11-font-utils", Version: "7.2", Release: "11.el6", Arch: "x86_64", SourceRpm: "xorg-x11-font-utils-7.2-11.el6.src.rpm", Size: 300562, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file", Version: "5.04", Release: "30.el6", Arch: "x86_64", SourceRpm: "file-5.04-30.el6.src.rpm", Size: 56567, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "flac", Version: "1.2.1", Release: "7.el6_6", Arch: "x86_64", SourceRpm: "flac-1.2.1-7.el6_6.src.rpm", Size: 722062, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "net-tools", Version: "1.60", Release: "114.el6", Arch: "x86_64", SourceRpm: "net-tools-1.60-114.el6.src.rpm", Size: 778260, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "jpackage-utils", Version: "1.7.5", Release: "3.16.el6", Arch: "noarch", SourceRpm: "jpackage-utils-1.7.5-3.16.el6.src.rpm", Size: 168662, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "psmisc", Version: "22.6", Release: "24.el6", Arch: "x86_64", SourceRpm: "psmisc-22.6-24.el6.src.rpm", Size: 222569, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf-devel", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 26454, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-utils", Version: "2.0.94", Release: "7.el6", Arch: "x86_64", SourceRpm: "libselinux-2.0.94-7.el6.src.rpm", Size: 62739, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus", Version: "1.2.24", Release: "9.el6", Arch: "x86_64", SourceRpm: "dbus-1.2.24-9.el6.src.rpm", Size: 498105, License: "GPLv2+ or AFL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2", Version: "1.0.5", Release: "7.el6_0", Arch: "x86_64", SourceRpm: "bzip2-1.0.5-7.el6_0.src.rpm", Size: 79087, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tcp_wrappers-libs", Version: "7.6", Release: "58.el6", Arch: "x86_64", SourceRpm: "tcp_wrappers-7.6-58.el6.src.rpm", Size: 131475, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ORBit2", Version: "2.14.17", Release: "7.el6", Arch: "x86_64", SourceRpm: "ORBit2-2.14.17-7.el6.src.rpm", Size: 603232, License: "LGPLv2+ and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.23", Release: "15.el6_6.2", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.23-15.el6_6.2.src.rpm", Size: 357710, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lksctp-tools", Version: "1.0.10", Release: "7.el6", Arch: "x86_64", SourceRpm: "lksctp-tools-1.0.10-7.el6.src.rpm", Size: 210697, License: "GPLv2 and GPLv2+ and LGPLv2 and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "make", Version: "3.81", Release: "23.el6", Arch: "x86_64", SourceRpm: "make-3.81-23.el6.src.rpm", Size: 1079569, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "copy-jdk-configs", Version: "3.3", Release: "9.el6", Arch: "noarch", SourceRpm: "copy-jdk-configs-3.3-9.el6.src.rpm", Size: 16172, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "2.8.1", Release: "28.el6", Arch: "x86_64", SourceRpm: "diffutils-2.8.1-28.el6.src.rpm", Size: 588813, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xorg-x11-fonts-Type1", Version: "7.2", Release: "11.el6", Arch: "noarch", SourceRpm: "xorg-x11-fonts-7.2-11.el6.src.rpm", Size: 883992, License: "MIT and Lucida and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 386371, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "polkit", Version: "0.96", Release: "11.el6_10.1", Arch: "x86_64", SourceRpm: "polkit-0.96-11.el6_10.1.src.rpm", Size: 646341, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "less", Version: "436", Release: "13.el6", Arch: "x86_64", SourceRpm: "less-436-13.el6.src.rpm", Size: 201909, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "GConf2", Version: "2.28.0", Release: "7.el6", Arch: "x86_64", SourceRpm: "GConf2-2.28.0-7.el6.src.rpm", Size: 6076626, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.3.12", Release: "24.el6", Arch: "x86_64", SourceRpm: "gzip-1.3.12-24.el6.src.rpm", Size: 225924, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pulseaudio-libs", Version: "0.9.21", Release: "26.el6", Arch: "x86_64", SourceRpm: "pulseaudio-0.9.21-26.el6.src.rpm", Size: 2700180, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.8.16", Release: "4.el6", Arch: "x86_64", SourceRpm: "cracklib-2.8.16-4.el6.src.rpm", Size: 9327207, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "java-1.7.0-openjdk-devel", Version: "1.7.0.221", Release: "2.6.18.0.el6_10", Arch: "x86_64", SourceRpm: "java-1.7.0-openjdk-1.7.0.221-2.6.18.0.el6_10.src.rpm", Size: 37629764, License: "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv1.1 and MPLv2.0 and Public Domain and W3C and zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.1", Release: "24.el6", Arch: "x86_64", SourceRpm: "pam-1.1.1-24.el6.src.rpm", Size: 2419646, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils-devel", Version: "2.20.51.0.2", Release: "5.48.el6_10.1", Arch: "x86_64", SourceRpm: "binutils-2.20.51.0.2-5.48.el6_10.1.src.rpm", Size: 3125929, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs-devel", Version: "2.4.5", Release: "6.el6", Arch: "x86_64", SourceRpm: "audit-2.4.5-6.el6.src.rpm", Size: 78382, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth-scripts", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 11006, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "newt", Version: "0.52.11", Release: "4.el6", Arch: "x86_64", SourceRpm: "newt-0.52.11-4.el6.src.rpm", Size: 173237, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "65.1.el6", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-65.1.el6.src.rpm", Size: 2675229, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pciutils-libs", Version: "3.1.10", Release: "4.el6", Arch: "x86_64", SourceRpm: "pciutils-3.1.10-4.el6.src.rpm", Size: 48992, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 32822, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "numactl-devel", Version: "2.0.9", Release: "2.el6", Arch: "x86_64", SourceRpm: "numactl-2.0.9-2.el6.src.rpm", Size: 98470, License: "LGPLv2/GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "ethtool", Version: "3.5", Release: "6.el6", Arch: "x86_64", SourceRpm: "ethtool-3.5-6.el6.src.rpm", Size: 283910, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "newt-devel", Version: "0.52.11", Release: "4.el6", Arch: "x86_64", SourceRpm: "newt-0.52.11-4.el6.src.rpm", Size: 127322, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.4", Release: "5.el6", Arch: "x86_64", SourceRpm: "keyutils-1.4-5.el6.src.rpm", Size: 36624, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-devel", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 1795830, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssl", Version: "1.0.1e", Release: "57.el6", Arch: "x86_64", SourceRpm: "openssl-1.0.1e-57.el6.src.rpm", Size: 4248338, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.19.7", Release: "53.el6_9", Arch: "x86_64", SourceRpm: "curl-7.19.7-53.el6_9.src.rpm", Size: 347536, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.19.7", Release: "53.el6_9", Arch: "x86_64", SourceRpm: "curl-7.19.7-53.el6_9.src.rpm", Size: 357074, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.40", Release: "16.el6", Arch: "x86_64", SourceRpm: "openldap-2.4.40-16.el6.src.rpm", Size: 859518, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.1.8", Release: "3.el6", Arch: "x86_64", SourceRpm: "gpgme-1.1.8-3.el6.src.rpm", Size: 729658, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "9.1.el6", Arch: "x86_64", SourceRpm: "ustr-1.0.4-9.1.el6.src.rpm", Size: 273983, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "5.el6", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-5.el6.src.rpm", Size: 3466724, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "MAKEDEV", Version: "3.24", Release: "6.el6", Arch: "x86_64", SourceRpm: "MAKEDEV-3.24-6.el6.src.rpm", Size: 227290, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.8.0", Release: "39.el6", Arch: "x86_64", SourceRpm: "gdbm-1.8.0-39.el6.src.rpm", Size: 48770, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.6.6", Release: "66.el6_8", Arch: "x86_64", SourceRpm: "python-2.6.6-66.el6_8.src.rpm", Size: 79603, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 120906, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "9.el6", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-9.el6.src.rpm", Size: 236939, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.3.1", Release: "2.1.el6", Arch: "noarch", SourceRpm: "python-iniparse-0.3.1-2.1.el6.src.rpm", Size: 109284, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gamin", Version: "0.1.10", Release: "9.el6", Arch: "x86_64", SourceRpm: "gamin-0.1.10-9.el6.src.rpm", Size: 416440, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "0.70", Release: "6.el6", Arch: "x86_64", SourceRpm: "shared-mime-info-0.70-6.el6.src.rpm", Size: 1411396, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.56.13", Release: "8.el6_7", Arch: "x86_64", SourceRpm: "libuser-0.56.13-8.el6_7.src.rpm", Size: 1882055, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.30", Release: "42.el6_10", Arch: "noarch", SourceRpm: "yum-utils-1.1.30-42.el6_10.src.rpm", Size: 53961, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "6", Release: "10.el6.centos.12.3", Arch: "x86_64", SourceRpm: "centos-release-6-10.el6.centos.12.3.src.rpm", Size: 38232, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.30", Release: "42.el6_10", Arch: "noarch", SourceRpm: "yum-utils-1.1.30-42.el6_10.src.rpm", Size: 22350, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "6.1.el6", Arch: "noarch", SourceRpm: "rootfiles-8.1-6.1.el6.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 13117447, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "freetype", Version: "2.3.11", Release: "17.el6", Arch: "x86_64", SourceRpm: "freetype-2.3.11-17.el6.src.rpm", Size: 836907, License: "FTL or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libjpeg-turbo", Version: "1.2.1", Release: "3.el6_5", Arch: "x86_64", SourceRpm: "libjpeg-turbo-1.2.1-3.el6_5.src.rpm", Size: 476782, License: "wxWidgets", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libproxy-python", Version: "0.3.0", Release: "10.el6", Arch: "x86_64", SourceRpm: "libproxy-0.3.0-10.el6.src.rpm", Size: 8320, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "m4", Version: "1.4.13", Release: "5.el6", Arch: "x86_64", SourceRpm: "m4-1.4.13-5.el6.src.rpm", Size: 560949, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 648199, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "avahi-libs", Version: "0.6.25", Release: "17.el6", Arch: "x86_64", SourceRpm: "avahi-0.6.25-17.el6.src.rpm", Size: 114824, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnutls", Version: "2.12.23", Release: "22.el6", Arch: "x86_64", SourceRpm: "gnutls-2.12.23-22.el6.src.rpm", Size: 1167530, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz", Version: "4.999.9", Release: "0.5.beta.20091007git.el6", Arch: "x86_64", SourceRpm: "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", Size: 488160, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.20.51.0.2", Release: "5.48.el6_10.1", Arch: "x86_64", SourceRpm: "binutils-2.20.51.0.2-5.48.el6_10.1.src.rpm", Size: 9831328, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "unzip", Version: "6.0", Release: "5.el6", Arch: "x86_64", SourceRpm: "unzip-6.0-5.el6.src.rpm", Size: 331766, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgomp", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 127982, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patch", Version: "2.6", Release: "8.el6_9", Arch: "x86_64", SourceRpm: "patch-2.6-8.el6_9.src.rpm", Size: 179790, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpciaccess", Version: "0.13.4", Release: "1.el6", Arch: "x86_64", SourceRpm: "libpciaccess-0.13.4-1.el6.src.rpm", Size: 40777, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util", Version: "1.3.9", Release: "3.el6_0.1", Arch: "x86_64", SourceRpm: "apr-util-1.3.9-3.el6_0.1.src.rpm", Size: 202360, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "cups-libs", Version: "1.4.2", Release: "81.el6_10", Arch: "x86_64", SourceRpm: "cups-1.4.2-81.el6_10.src.rpm", Size: 667768, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpp", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 10012603, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pakchois", Version: "0.4", Release: "3.2.el6", Arch: "x86_64", SourceRpm: "pakchois-0.4-3.2.el6.src.rpm", Size: 50156, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-glib", Version: "0.86", Release: "6.el6", Arch: "x86_64", SourceRpm: "dbus-glib-0.86-6.el6.src.rpm", Size: 579611, License: "AFL and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-runtime", Version: "2.9", Release: "9.el6", Arch: "x86_64", SourceRpm: "systemtap-2.9-9.el6.src.rpm", Size: 616098, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck-lib", Version: "1.2.0", Release: "7.el6", Arch: "x86_64", SourceRpm: "fipscheck-1.2.0-7.el6.src.rpm", Size: 10353, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pixman", Version: "0.32.8", Release: "1.el6", Arch: "x86_64", SourceRpm: "pixman-0.32.8-1.el6.src.rpm", Size: 721560, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-libs", Version: "5.10.1", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 1485896, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Module-Pluggable", Version: "3.90", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 31033, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl", Version: "5.10.1", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 35282834, License: "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Error", Version: "0.17015", Release: "4.el6", Arch: "noarch", SourceRpm: "perl-Error-0.17015-4.el6.src.rpm", Size: 47372, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "automake", Version: "1.11.1", Release: "4.el6", Arch: "noarch", SourceRpm: "automake-1.11.1-4.el6.src.rpm", Size: 1520636, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-IO-Compress-Base", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 138661, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-Compress-Zlib", Version: "2.021", Release: "144.el6", Arch: "x86_64", SourceRpm: "perl-5.10.1-144.el6.src.rpm", Size: 52519, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTML-Tagset", Version: "3.20", Release: "4.el6", Arch: "noarch", SourceRpm: "perl-HTML-Tagset-3.20-4.el6.src.rpm", Size: 19692, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cvs", Version: "1.11.23", Release: "16.el6", Arch: "x86_64", SourceRpm: "cvs-1.11.23-16.el6.src.rpm", Size: 1590583, License: "GPL+ and GPLv2+ and LGPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXau", Version: "1.0.6", Release: "4.el6", Arch: "x86_64", SourceRpm: "libXau-1.0.6-4.el6.src.rpm", Size: 40009, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "upstart", Version: "0.6.5", Release: "17.el6", Arch: "x86_64", SourceRpm: "upstart-0.6.5-17.el6.src.rpm", Size: 567729, License: "GPLv2 and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cloog-ppl", Version: "0.15.7", Release: "1.2.el6", Arch: "x86_64", SourceRpm: "cloog-0.15.7-1.2.el6.src.rpm", Size: 185386, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdb", Version: "7.2", Release: "92.el6", Arch: "x86_64", SourceRpm: "gdb-7.2-92.el6.src.rpm", Size: 5545845, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and GFDL and BSD and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libthai", Version: "0.1.12", Release: "3.el6", Arch: "x86_64", SourceRpm: "libthai-0.1.12-3.el6.src.rpm", Size: 694712, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libedit", Version: "2.11", Release: "4.20080712cvs.1.el6", Arch: "x86_64", SourceRpm: "libedit-2.11-4.20080712cvs.1.el6.src.rpm", Size: 185648, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-headers", Version: "2.12", Release: "1.212.el6_10.3", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6_10.3.src.rpm", Size: 2146171, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 19499265, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11-common", Version: "1.6.4", Release: "3.el6", Arch: "noarch", SourceRpm: "libX11-1.6.4-3.el6.src.rpm", Size: 1341368, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXrender", Version: "0.9.10", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXrender-0.9.10-1.el6.src.rpm", Size: 40515, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cairo", Version: "1.8.8", Release: "6.el6_6", Arch: "x86_64", SourceRpm: "cairo-1.8.8-6.el6_6.src.rpm", Size: 797873, License: "LGPLv2 or MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXrandr", Version: "1.5.1", Release: "1.el6", Arch: "x86_64", SourceRpm: "libXrandr-1.5.1-1.el6.src.rpm", Size: 43834, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdk-pixbuf2", Version: "2.24.1", Release: "6.el6_7", Arch: "x86_64", SourceRpm: "gdk-pixbuf2-2.24.1-6.el6_7.src.rpm", Size: 2637837, License: "LGPLv2+ and (LGPLv2+ or MPLv1.1) and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXdamage", Version: "1.1.3", Release: "4.el6", Arch: "x86_64", SourceRpm: "libXdamage-1.1.3-4.el6.src.rpm", Size: 24896, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXinerama", Version: "1.1.3", Release: "2.1.el6", Arch: "x86_64", SourceRpm: "libXinerama-1.1.3-2.1.el6.src.rpm", Size: 12039, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pango", Version: "1.28.1", Release: "11.el6", Arch: "x86_64", SourceRpm: "pango-1.28.1-11.el6.src.rpm", Size: 1041857, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mailcap", Version: "2.1.31", Release: "2.el6", Arch: "noarch", SourceRpm: "mailcap-2.1.31-2.el6.src.rpm", Size: 52877, License: "Public Domain and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-XML-Parser", Version: "2.36", Release: "7.el6", Arch: "x86_64", SourceRpm: "perl-XML-Parser-2.36-7.el6.src.rpm", Size: 652307, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "policycoreutils", Version: "2.0.83", Release: "30.1.el6_8", Arch: "x86_64", SourceRpm: "policycoreutils-2.0.83-30.1.el6_8.src.rpm", Size: 3596110, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
 	}
 
 	// docker run --rm -it centos:6 bash
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\"\, \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/(none)/0/g"
 	CentOS6Plain = []PackageInfo{
-		{intRef(), "setup", "2.8.14", "23.el6", "noarch", "setup-2.8.14-23.el6.src.rpm", 666669, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "4.el6", "noarch", "basesystem-10.0-4.el6.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata", "2018e", "3.el6", "noarch", "tzdata-2018e-3.el6.src.rpm", 1961609, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.12", "1.212.el6", "x86_64", "glibc-2.12-1.212.el6.src.rpm", 112436133, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.12", "1.212.el6", "x86_64", "glibc-2.12-1.212.el6.src.rpm", 13117447, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.1.2", "48.el6", "x86_64", "bash-4.1.2-48.el6.src.rpm", 3142529, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.16", "5.5.el6", "x86_64", "libcap-2.16-5.5.el6.src.rpm", 64437, "LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "4.13a", "8.el6", "x86_64", "texinfo-4.13a-8.el6.src.rpm", 329482, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.49", "7.el6_9.1", "x86_64", "acl-2.2.49-7.el6_9.1.src.rpm", 31280, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el6", "x86_64", "nspr-4.19.0-1.el6.src.rpm", 281968, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.41.12", "24.el6", "x86_64", "e2fsprogs-1.41.12-24.el6.src.rpm", 59233, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.0.41", "4.el6", "x86_64", "libsepol-2.0.41-4.el6.src.rpm", 248680, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.3.49.5", "1.el6", "x86_64", "chkconfig-1.3.49.5-1.el6.src.rpm", 670580, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.4.5", "6.el6", "x86_64", "audit-2.4.5-6.el6.src.rpm", 235282, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.0", "4.el6", "x86_64", "readline-6.0-4.el6.src.rpm", 433957, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.04", "30.el6", "x86_64", "file-5.04-30.el6.src.rpm", 2486624, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.2.24", "9.el6", "x86_64", "dbus-1.2.24-9.el6.src.rpm", 265736, "GPLv2+ or AFL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.6.20", "1.el6_7.2", "x86_64", "sqlite-3.6.20-1.el6_7.2.src.rpm", 640060, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 16304, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "4.999.9", "0.5.beta.20091007git.el6", "x86_64", "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", 214490, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.7", "4.el6", "x86_64", "libgpg-error-1.7-4.el6.src.rpm", 214321, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "7.8", "7.el6", "x86_64", "pcre-7.8-7.el6.src.rpm", 529027, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.4.2", "9.el6", "x86_64", "findutils-4.4.2-9.el6.src.rpm", 1442912, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.0.1", "13.el6_8", "x86_64", "expat-2.0.1-13.el6_8.src.rpm", 197794, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.18.5", "2.el6_5.2", "x86_64", "p11-kit-0.18.5-2.el6_5.2.src.rpm", 262669, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.4.5", "12.el6_8", "x86_64", "libgcrypt-1.4.5-12.el6_8.src.rpm", 536622, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libusb", "0.1.12", "23.el6", "x86_64", "libusb-0.1.12-23.el6.src.rpm", 54440, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.7.6", "8.el6", "x86_64", "pinentry-0.7.6-8.el6.src.rpm", 147023, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps", "3.2.8", "45.el6_9.3", "x86_64", "procps-3.2.8-45.el6_9.3.src.rpm", 475526, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.23", "15.el6_8", "x86_64", "tar-1.23-15.el6_8.src.rpm", 2616465, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "checkpolicy", "2.0.22", "1.el6", "x86_64", "checkpolicy-2.0.22-1.el6.src.rpm", 870239, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4-utils", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 416398, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.20.51.0.2", "5.48.el6", "x86_64", "binutils-2.20.51.0.2-5.48.el6.src.rpm", 9831289, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "which", "2.19", "6.el6", "x86_64", "which-2.19-6.el6.src.rpm", 73004, "GPLv3", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dash", "0.5.5.1", "4.el6", "x86_64", "dash-0.5.5.1-4.el6.src.rpm", 127277, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "groff", "1.18.1.4", "21.el6", "x86_64", "groff-1.18.1.4-21.el6.src.rpm", 5318766, "GPLv2 and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils-libs", "8.4", "47.el6", "x86_64", "coreutils-8.4-47.el6.src.rpm", 5576, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.8.16", "4.el6", "x86_64", "cracklib-2.8.16-4.el6.src.rpm", 187265, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.4", "47.el6", "x86_64", "coreutils-8.4-47.el6.src.rpm", 12873065, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "module-init-tools", "3.9", "26.el6", "x86_64", "module-init-tools-3.9-26.el6.src.rpm", 1216834, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 2660886, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 2052883, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mingetty", "1.08", "5.el6", "x86_64", "mingetty-1.08-5.el6.src.rpm", 34586, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.10.3", "65.el6", "x86_64", "krb5-1.10.3-65.el6.src.rpm", 1813468, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.2", "2.el6_7.1", "x86_64", "libssh2-1.4.2-2.el6_7.1.src.rpm", 325165, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 777656, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 2034245, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.14", "9.el6_10", "x86_64", "gnupg2-2.0.14-9.el6_10.src.rpm", 6087540, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-libs", "9.8.2", "0.68.rc1.el6_10.1", "x86_64", "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", 2340720, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.0.43", "5.1.el6", "x86_64", "libsemanage-2.0.43-5.1.el6.src.rpm", 204223, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.5", "4.1.el6", "x86_64", "libutempter-1.1.5-4.1.el6.src.rpm", 40785, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth-core-libs", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 177760, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.5", "3.2.el6", "x86_64", "libffi-3.0.5-3.2.el6.src.rpm", 42881, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.6.6", "66.el6_8", "x86_64", "python-2.6.6-66.el6_8.src.rpm", 22979382, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.1", "18.20090824bzr68.el6", "x86_64", "pygpgme-0.1-18.20090824bzr68.el6.src.rpm", 251432, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.9.1", "11.el6", "noarch", "python-urlgrabber-3.9.1-11.el6.src.rpm", 323137, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.23", "9.1.el6", "x86_64", "pkgconfig-0.23-9.1.el6.src.rpm", 140091, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.28.8", "10.el6", "x86_64", "glib2-2.28.8-10.el6.src.rpm", 8061476, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.2", "16.el6", "x86_64", "yum-metadata-parser-1.1.2-16.el6.src.rpm", 58327, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.2.29", "81.el6.centos", "noarch", "yum-3.2.29-81.el6.centos.src.rpm", 4832194, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.77", "7.el6", "x86_64", "passwd-0.77-7.el6.src.rpm", 357699, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-utils", "9.8.2", "0.68.rc1.el6_10.1", "x86_64", "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", 451000, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.629", "5.el6_8.1", "x86_64", "vim-7.4.629-5.el6_8.1.src.rpm", 909230, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 117352, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "2.4.30", "3.el6", "x86_64", "filesystem-2.4.30-3.el6.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 193090, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.14.3", "23.3.el6_8", "x86_64", "nss-softokn-3.14.3-23.3.el6_8.src.rpm", 490290, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 752304, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.44", "7.el6", "x86_64", "attr-2.4.44-7.el6.src.rpm", 18712, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.3", "29.el6", "x86_64", "zlib-1.2.3-29.el6.src.rpm", 152305, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "7.el6", "x86_64", "popt-1.13-7.el6.src.rpm", 83420, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "db4", "4.7.25", "22.el6", "x86_64", "db4-4.7.25-22.el6.src.rpm", 1533095, "Sleepycat and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.el6", "x86_64", "nss-util-3.36.0-1.el6.src.rpm", 191928, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.5", "7.el6_0", "x86_64", "bzip2-1.0.5-7.el6_0.src.rpm", 67592, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.0.94", "7.el6", "x86_64", "libselinux-2.0.94-7.el6.src.rpm", 130336, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.1", "10.el6", "x86_64", "sed-4.2.1-10.el6.src.rpm", 542324, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.18", "2.el6", "x86_64", "libidn-1.18-2.el6.src.rpm", 567612, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.7.6", "21.el6_8.1", "x86_64", "libxml2-2.7.6-21.el6_8.1.src.rpm", 1779163, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.4.7", "23.el6", "x86_64", "gcc-4.4.7-23.el6.src.rpm", 987096, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "4.1.el6", "x86_64", "lua-5.1.4-4.1.el6.src.rpm", 617799, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "3.1.7", "10.el6_7.3", "x86_64", "gawk-3.1.7-10.el6_7.3.src.rpm", 2038650, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.17.2", "12.28.el6_9.2", "x86_64", "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", 136136, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.164", "2.el6", "x86_64", "elfutils-0.164-2.el6.src.rpm", 1011166, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.14.3", "23.3.el6_8", "x86_64", "nss-softokn-3.14.3-23.3.el6_8.src.rpm", 1103062, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "6.el6", "x86_64", "grep-2.20-6.el6.src.rpm", 1197904, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.10", "13.el6", "x86_64", "cpio-2.10-13.el6.src.rpm", 650433, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "9.3.el6", "x86_64", "pth-2.0.7-9.3.el6.src.rpm", 261931, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "2.3", "6.el6_5", "x86_64", "libtasn1-2.3-6.el6_5.src.rpm", 443140, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.18.5", "2.el6_5.2", "x86_64", "p11-kit-0.18.5-2.el6_5.2.src.rpm", 178775, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libnih", "1.0.1", "8.el6", "x86_64", "libnih-1.0.1-8.el6.src.rpm", 490926, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gmp", "4.3.1", "13.el6", "x86_64", "gmp-4.3.1-13.el6.src.rpm", 657883, "LGPLv2+ and  GPLv3+ and LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file", "5.04", "30.el6", "x86_64", "file-5.04-30.el6.src.rpm", 56567, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "net-tools", "1.60", "114.el6", "x86_64", "net-tools-1.60-114.el6.src.rpm", 778260, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "psmisc", "22.6", "24.el6", "x86_64", "psmisc-22.6-24.el6.src.rpm", 222569, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-utils", "2.0.94", "7.el6", "x86_64", "libselinux-2.0.94-7.el6.src.rpm", 62739, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2", "1.0.5", "7.el6_0", "x86_64", "bzip2-1.0.5-7.el6_0.src.rpm", 79087, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.23", "15.el6_6.2", "x86_64", "cyrus-sasl-2.1.23-15.el6_6.2.src.rpm", 357710, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "make", "3.81", "23.el6", "x86_64", "make-3.81-23.el6.src.rpm", 1079569, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "2.8.1", "28.el6", "x86_64", "diffutils-2.8.1-28.el6.src.rpm", 588813, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.7", "4.20090207.el6", "x86_64", "ncurses-5.7-4.20090207.el6.src.rpm", 386371, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "less", "436", "13.el6", "x86_64", "less-436-13.el6.src.rpm", 201909, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.3.12", "24.el6", "x86_64", "gzip-1.3.12-24.el6.src.rpm", 225924, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.8.16", "4.el6", "x86_64", "cracklib-2.8.16-4.el6.src.rpm", 9327207, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.1", "24.el6", "x86_64", "pam-1.1.1-24.el6.src.rpm", 2419646, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "plymouth-scripts", "0.8.3", "29.el6.centos", "x86_64", "plymouth-0.8.3-29.el6.centos.src.rpm", 11006, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "65.1.el6", "noarch", "ca-certificates-2018.2.22-65.1.el6.src.rpm", 2675229, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "8.el6", "x86_64", "nss-3.36.0-8.el6.src.rpm", 32822, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "ethtool", "3.5", "6.el6", "x86_64", "ethtool-3.5-6.el6.src.rpm", 283910, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.4", "5.el6", "x86_64", "keyutils-1.4-5.el6.src.rpm", 36624, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssl", "1.0.1e", "57.el6", "x86_64", "openssl-1.0.1e-57.el6.src.rpm", 4248338, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.19.7", "53.el6_9", "x86_64", "curl-7.19.7-53.el6_9.src.rpm", 347536, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.19.7", "53.el6_9", "x86_64", "curl-7.19.7-53.el6_9.src.rpm", 357074, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.40", "16.el6", "x86_64", "openldap-2.4.40-16.el6.src.rpm", 859518, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.1.8", "3.el6", "x86_64", "gpgme-1.1.8-3.el6.src.rpm", 729658, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "9.1.el6", "x86_64", "ustr-1.0.4-9.1.el6.src.rpm", 273983, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "5.el6", "x86_64", "shadow-utils-4.1.5.1-5.el6.src.rpm", 3466724, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "MAKEDEV", "3.24", "6.el6", "x86_64", "MAKEDEV-3.24-6.el6.src.rpm", 227290, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.8.0", "39.el6", "x86_64", "gdbm-1.8.0-39.el6.src.rpm", 48770, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.6.6", "66.el6_8", "x86_64", "python-2.6.6-66.el6_8.src.rpm", 79603, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.8.0", "59.el6", "x86_64", "rpm-4.8.0-59.el6.src.rpm", 120906, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "9.el6", "x86_64", "python-pycurl-7.19.0-9.el6.src.rpm", 236939, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.3.1", "2.1.el6", "noarch", "python-iniparse-0.3.1-2.1.el6.src.rpm", 109284, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gamin", "0.1.10", "9.el6", "x86_64", "gamin-0.1.10-9.el6.src.rpm", 416440, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "0.70", "6.el6", "x86_64", "shared-mime-info-0.70-6.el6.src.rpm", 1411396, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.56.13", "8.el6_7", "x86_64", "libuser-0.56.13-8.el6_7.src.rpm", 1882055, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.30", "42.el6_10", "noarch", "yum-utils-1.1.30-42.el6_10.src.rpm", 53961, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "6", "10.el6.centos.12.3", "x86_64", "centos-release-6-10.el6.centos.12.3.src.rpm", 38232, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.30", "42.el6_10", "noarch", "yum-utils-1.1.30-42.el6_10.src.rpm", 22350, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "6.1.el6", "noarch", "rootfiles-8.1-6.1.el6.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.14", Release: "23.el6", Arch: "noarch", SourceRpm: "setup-2.8.14-23.el6.src.rpm", Size: 666669, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "4.el6", Arch: "noarch", SourceRpm: "basesystem-10.0-4.el6.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018e", Release: "3.el6", Arch: "noarch", SourceRpm: "tzdata-2018e-3.el6.src.rpm", Size: 1961609, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.12", Release: "1.212.el6", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6.src.rpm", Size: 112436133, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.12", Release: "1.212.el6", Arch: "x86_64", SourceRpm: "glibc-2.12-1.212.el6.src.rpm", Size: 13117447, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.1.2", Release: "48.el6", Arch: "x86_64", SourceRpm: "bash-4.1.2-48.el6.src.rpm", Size: 3142529, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.16", Release: "5.5.el6", Arch: "x86_64", SourceRpm: "libcap-2.16-5.5.el6.src.rpm", Size: 64437, License: "LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "4.13a", Release: "8.el6", Arch: "x86_64", SourceRpm: "texinfo-4.13a-8.el6.src.rpm", Size: 329482, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.49", Release: "7.el6_9.1", Arch: "x86_64", SourceRpm: "acl-2.2.49-7.el6_9.1.src.rpm", Size: 31280, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el6.src.rpm", Size: 281968, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.41.12", Release: "24.el6", Arch: "x86_64", SourceRpm: "e2fsprogs-1.41.12-24.el6.src.rpm", Size: 59233, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.0.41", Release: "4.el6", Arch: "x86_64", SourceRpm: "libsepol-2.0.41-4.el6.src.rpm", Size: 248680, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.3.49.5", Release: "1.el6", Arch: "x86_64", SourceRpm: "chkconfig-1.3.49.5-1.el6.src.rpm", Size: 670580, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.4.5", Release: "6.el6", Arch: "x86_64", SourceRpm: "audit-2.4.5-6.el6.src.rpm", Size: 235282, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.0", Release: "4.el6", Arch: "x86_64", SourceRpm: "readline-6.0-4.el6.src.rpm", Size: 433957, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.04", Release: "30.el6", Arch: "x86_64", SourceRpm: "file-5.04-30.el6.src.rpm", Size: 2486624, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.2.24", Release: "9.el6", Arch: "x86_64", SourceRpm: "dbus-1.2.24-9.el6.src.rpm", Size: 265736, License: "GPLv2+ or AFL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.6.20", Release: "1.el6_7.2", Arch: "x86_64", SourceRpm: "sqlite-3.6.20-1.el6_7.2.src.rpm", Size: 640060, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 16304, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "4.999.9", Release: "0.5.beta.20091007git.el6", Arch: "x86_64", SourceRpm: "xz-4.999.9-0.5.beta.20091007git.el6.src.rpm", Size: 214490, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.7", Release: "4.el6", Arch: "x86_64", SourceRpm: "libgpg-error-1.7-4.el6.src.rpm", Size: 214321, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "7.8", Release: "7.el6", Arch: "x86_64", SourceRpm: "pcre-7.8-7.el6.src.rpm", Size: 529027, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.4.2", Release: "9.el6", Arch: "x86_64", SourceRpm: "findutils-4.4.2-9.el6.src.rpm", Size: 1442912, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.0.1", Release: "13.el6_8", Arch: "x86_64", SourceRpm: "expat-2.0.1-13.el6_8.src.rpm", Size: 197794, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.18.5", Release: "2.el6_5.2", Arch: "x86_64", SourceRpm: "p11-kit-0.18.5-2.el6_5.2.src.rpm", Size: 262669, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.4.5", Release: "12.el6_8", Arch: "x86_64", SourceRpm: "libgcrypt-1.4.5-12.el6_8.src.rpm", Size: 536622, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libusb", Version: "0.1.12", Release: "23.el6", Arch: "x86_64", SourceRpm: "libusb-0.1.12-23.el6.src.rpm", Size: 54440, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.7.6", Release: "8.el6", Arch: "x86_64", SourceRpm: "pinentry-0.7.6-8.el6.src.rpm", Size: 147023, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps", Version: "3.2.8", Release: "45.el6_9.3", Arch: "x86_64", SourceRpm: "procps-3.2.8-45.el6_9.3.src.rpm", Size: 475526, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.23", Release: "15.el6_8", Arch: "x86_64", SourceRpm: "tar-1.23-15.el6_8.src.rpm", Size: 2616465, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "checkpolicy", Version: "2.0.22", Release: "1.el6", Arch: "x86_64", SourceRpm: "checkpolicy-2.0.22-1.el6.src.rpm", Size: 870239, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4-utils", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 416398, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.20.51.0.2", Release: "5.48.el6", Arch: "x86_64", SourceRpm: "binutils-2.20.51.0.2-5.48.el6.src.rpm", Size: 9831289, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "which", Version: "2.19", Release: "6.el6", Arch: "x86_64", SourceRpm: "which-2.19-6.el6.src.rpm", Size: 73004, License: "GPLv3", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dash", Version: "0.5.5.1", Release: "4.el6", Arch: "x86_64", SourceRpm: "dash-0.5.5.1-4.el6.src.rpm", Size: 127277, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "groff", Version: "1.18.1.4", Release: "21.el6", Arch: "x86_64", SourceRpm: "groff-1.18.1.4-21.el6.src.rpm", Size: 5318766, License: "GPLv2 and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils-libs", Version: "8.4", Release: "47.el6", Arch: "x86_64", SourceRpm: "coreutils-8.4-47.el6.src.rpm", Size: 5576, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.8.16", Release: "4.el6", Arch: "x86_64", SourceRpm: "cracklib-2.8.16-4.el6.src.rpm", Size: 187265, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.4", Release: "47.el6", Arch: "x86_64", SourceRpm: "coreutils-8.4-47.el6.src.rpm", Size: 12873065, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "module-init-tools", Version: "3.9", Release: "26.el6", Arch: "x86_64", SourceRpm: "module-init-tools-3.9-26.el6.src.rpm", Size: 1216834, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 2660886, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 2052883, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mingetty", Version: "1.08", Release: "5.el6", Arch: "x86_64", SourceRpm: "mingetty-1.08-5.el6.src.rpm", Size: 34586, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.10.3", Release: "65.el6", Arch: "x86_64", SourceRpm: "krb5-1.10.3-65.el6.src.rpm", Size: 1813468, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.2", Release: "2.el6_7.1", Arch: "x86_64", SourceRpm: "libssh2-1.4.2-2.el6_7.1.src.rpm", Size: 325165, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 777656, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 2034245, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.14", Release: "9.el6_10", Arch: "x86_64", SourceRpm: "gnupg2-2.0.14-9.el6_10.src.rpm", Size: 6087540, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-libs", Version: "9.8.2", Release: "0.68.rc1.el6_10.1", Arch: "x86_64", SourceRpm: "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", Size: 2340720, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.0.43", Release: "5.1.el6", Arch: "x86_64", SourceRpm: "libsemanage-2.0.43-5.1.el6.src.rpm", Size: 204223, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.5", Release: "4.1.el6", Arch: "x86_64", SourceRpm: "libutempter-1.1.5-4.1.el6.src.rpm", Size: 40785, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth-core-libs", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 177760, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.5", Release: "3.2.el6", Arch: "x86_64", SourceRpm: "libffi-3.0.5-3.2.el6.src.rpm", Size: 42881, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.6.6", Release: "66.el6_8", Arch: "x86_64", SourceRpm: "python-2.6.6-66.el6_8.src.rpm", Size: 22979382, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.1", Release: "18.20090824bzr68.el6", Arch: "x86_64", SourceRpm: "pygpgme-0.1-18.20090824bzr68.el6.src.rpm", Size: 251432, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.9.1", Release: "11.el6", Arch: "noarch", SourceRpm: "python-urlgrabber-3.9.1-11.el6.src.rpm", Size: 323137, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.23", Release: "9.1.el6", Arch: "x86_64", SourceRpm: "pkgconfig-0.23-9.1.el6.src.rpm", Size: 140091, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.28.8", Release: "10.el6", Arch: "x86_64", SourceRpm: "glib2-2.28.8-10.el6.src.rpm", Size: 8061476, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.2", Release: "16.el6", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.2-16.el6.src.rpm", Size: 58327, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.2.29", Release: "81.el6.centos", Arch: "noarch", SourceRpm: "yum-3.2.29-81.el6.centos.src.rpm", Size: 4832194, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.77", Release: "7.el6", Arch: "x86_64", SourceRpm: "passwd-0.77-7.el6.src.rpm", Size: 357699, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-utils", Version: "9.8.2", Release: "0.68.rc1.el6_10.1", Arch: "x86_64", SourceRpm: "bind-9.8.2-0.68.rc1.el6_10.1.src.rpm", Size: 451000, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.629", Release: "5.el6_8.1", Arch: "x86_64", SourceRpm: "vim-7.4.629-5.el6_8.1.src.rpm", Size: 909230, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 117352, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "2.4.30", Release: "3.el6", Arch: "x86_64", SourceRpm: "filesystem-2.4.30-3.el6.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 193090, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.14.3", Release: "23.3.el6_8", Arch: "x86_64", SourceRpm: "nss-softokn-3.14.3-23.3.el6_8.src.rpm", Size: 490290, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 752304, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.44", Release: "7.el6", Arch: "x86_64", SourceRpm: "attr-2.4.44-7.el6.src.rpm", Size: 18712, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.3", Release: "29.el6", Arch: "x86_64", SourceRpm: "zlib-1.2.3-29.el6.src.rpm", Size: 152305, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "7.el6", Arch: "x86_64", SourceRpm: "popt-1.13-7.el6.src.rpm", Size: 83420, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "db4", Version: "4.7.25", Release: "22.el6", Arch: "x86_64", SourceRpm: "db4-4.7.25-22.el6.src.rpm", Size: 1533095, License: "Sleepycat and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.el6", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.el6.src.rpm", Size: 191928, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.5", Release: "7.el6_0", Arch: "x86_64", SourceRpm: "bzip2-1.0.5-7.el6_0.src.rpm", Size: 67592, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.0.94", Release: "7.el6", Arch: "x86_64", SourceRpm: "libselinux-2.0.94-7.el6.src.rpm", Size: 130336, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.1", Release: "10.el6", Arch: "x86_64", SourceRpm: "sed-4.2.1-10.el6.src.rpm", Size: 542324, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.18", Release: "2.el6", Arch: "x86_64", SourceRpm: "libidn-1.18-2.el6.src.rpm", Size: 567612, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.7.6", Release: "21.el6_8.1", Arch: "x86_64", SourceRpm: "libxml2-2.7.6-21.el6_8.1.src.rpm", Size: 1779163, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.4.7", Release: "23.el6", Arch: "x86_64", SourceRpm: "gcc-4.4.7-23.el6.src.rpm", Size: 987096, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "4.1.el6", Arch: "x86_64", SourceRpm: "lua-5.1.4-4.1.el6.src.rpm", Size: 617799, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "3.1.7", Release: "10.el6_7.3", Arch: "x86_64", SourceRpm: "gawk-3.1.7-10.el6_7.3.src.rpm", Size: 2038650, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.17.2", Release: "12.28.el6_9.2", Arch: "x86_64", SourceRpm: "util-linux-ng-2.17.2-12.28.el6_9.2.src.rpm", Size: 136136, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.164", Release: "2.el6", Arch: "x86_64", SourceRpm: "elfutils-0.164-2.el6.src.rpm", Size: 1011166, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.14.3", Release: "23.3.el6_8", Arch: "x86_64", SourceRpm: "nss-softokn-3.14.3-23.3.el6_8.src.rpm", Size: 1103062, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "6.el6", Arch: "x86_64", SourceRpm: "grep-2.20-6.el6.src.rpm", Size: 1197904, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.10", Release: "13.el6", Arch: "x86_64", SourceRpm: "cpio-2.10-13.el6.src.rpm", Size: 650433, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "9.3.el6", Arch: "x86_64", SourceRpm: "pth-2.0.7-9.3.el6.src.rpm", Size: 261931, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "2.3", Release: "6.el6_5", Arch: "x86_64", SourceRpm: "libtasn1-2.3-6.el6_5.src.rpm", Size: 443140, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.18.5", Release: "2.el6_5.2", Arch: "x86_64", SourceRpm: "p11-kit-0.18.5-2.el6_5.2.src.rpm", Size: 178775, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libnih", Version: "1.0.1", Release: "8.el6", Arch: "x86_64", SourceRpm: "libnih-1.0.1-8.el6.src.rpm", Size: 490926, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gmp", Version: "4.3.1", Release: "13.el6", Arch: "x86_64", SourceRpm: "gmp-4.3.1-13.el6.src.rpm", Size: 657883, License: "LGPLv2+ and  GPLv3+ and LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file", Version: "5.04", Release: "30.el6", Arch: "x86_64", SourceRpm: "file-5.04-30.el6.src.rpm", Size: 56567, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "net-tools", Version: "1.60", Release: "114.el6", Arch: "x86_64", SourceRpm: "net-tools-1.60-114.el6.src.rpm", Size: 778260, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "psmisc", Version: "22.6", Release: "24.el6", Arch: "x86_64", SourceRpm: "psmisc-22.6-24.el6.src.rpm", Size: 222569, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-utils", Version: "2.0.94", Release: "7.el6", Arch: "x86_64", SourceRpm: "libselinux-2.0.94-7.el6.src.rpm", Size: 62739, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2", Version: "1.0.5", Release: "7.el6_0", Arch: "x86_64", SourceRpm: "bzip2-1.0.5-7.el6_0.src.rpm", Size: 79087, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.23", Release: "15.el6_6.2", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.23-15.el6_6.2.src.rpm", Size: 357710, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "make", Version: "3.81", Release: "23.el6", Arch: "x86_64", SourceRpm: "make-3.81-23.el6.src.rpm", Size: 1079569, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "2.8.1", Release: "28.el6", Arch: "x86_64", SourceRpm: "diffutils-2.8.1-28.el6.src.rpm", Size: 588813, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.7", Release: "4.20090207.el6", Arch: "x86_64", SourceRpm: "ncurses-5.7-4.20090207.el6.src.rpm", Size: 386371, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "less", Version: "436", Release: "13.el6", Arch: "x86_64", SourceRpm: "less-436-13.el6.src.rpm", Size: 201909, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.3.12", Release: "24.el6", Arch: "x86_64", SourceRpm: "gzip-1.3.12-24.el6.src.rpm", Size: 225924, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.8.16", Release: "4.el6", Arch: "x86_64", SourceRpm: "cracklib-2.8.16-4.el6.src.rpm", Size: 9327207, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.1", Release: "24.el6", Arch: "x86_64", SourceRpm: "pam-1.1.1-24.el6.src.rpm", Size: 2419646, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "plymouth-scripts", Version: "0.8.3", Release: "29.el6.centos", Arch: "x86_64", SourceRpm: "plymouth-0.8.3-29.el6.centos.src.rpm", Size: 11006, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "65.1.el6", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-65.1.el6.src.rpm", Size: 2675229, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "8.el6", Arch: "x86_64", SourceRpm: "nss-3.36.0-8.el6.src.rpm", Size: 32822, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "ethtool", Version: "3.5", Release: "6.el6", Arch: "x86_64", SourceRpm: "ethtool-3.5-6.el6.src.rpm", Size: 283910, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.4", Release: "5.el6", Arch: "x86_64", SourceRpm: "keyutils-1.4-5.el6.src.rpm", Size: 36624, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssl", Version: "1.0.1e", Release: "57.el6", Arch: "x86_64", SourceRpm: "openssl-1.0.1e-57.el6.src.rpm", Size: 4248338, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.19.7", Release: "53.el6_9", Arch: "x86_64", SourceRpm: "curl-7.19.7-53.el6_9.src.rpm", Size: 347536, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.19.7", Release: "53.el6_9", Arch: "x86_64", SourceRpm: "curl-7.19.7-53.el6_9.src.rpm", Size: 357074, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.40", Release: "16.el6", Arch: "x86_64", SourceRpm: "openldap-2.4.40-16.el6.src.rpm", Size: 859518, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.1.8", Release: "3.el6", Arch: "x86_64", SourceRpm: "gpgme-1.1.8-3.el6.src.rpm", Size: 729658, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "9.1.el6", Arch: "x86_64", SourceRpm: "ustr-1.0.4-9.1.el6.src.rpm", Size: 273983, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "5.el6", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-5.el6.src.rpm", Size: 3466724, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "MAKEDEV", Version: "3.24", Release: "6.el6", Arch: "x86_64", SourceRpm: "MAKEDEV-3.24-6.el6.src.rpm", Size: 227290, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.8.0", Release: "39.el6", Arch: "x86_64", SourceRpm: "gdbm-1.8.0-39.el6.src.rpm", Size: 48770, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.6.6", Release: "66.el6_8", Arch: "x86_64", SourceRpm: "python-2.6.6-66.el6_8.src.rpm", Size: 79603, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.8.0", Release: "59.el6", Arch: "x86_64", SourceRpm: "rpm-4.8.0-59.el6.src.rpm", Size: 120906, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "9.el6", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-9.el6.src.rpm", Size: 236939, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.3.1", Release: "2.1.el6", Arch: "noarch", SourceRpm: "python-iniparse-0.3.1-2.1.el6.src.rpm", Size: 109284, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gamin", Version: "0.1.10", Release: "9.el6", Arch: "x86_64", SourceRpm: "gamin-0.1.10-9.el6.src.rpm", Size: 416440, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "0.70", Release: "6.el6", Arch: "x86_64", SourceRpm: "shared-mime-info-0.70-6.el6.src.rpm", Size: 1411396, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.56.13", Release: "8.el6_7", Arch: "x86_64", SourceRpm: "libuser-0.56.13-8.el6_7.src.rpm", Size: 1882055, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.30", Release: "42.el6_10", Arch: "noarch", SourceRpm: "yum-utils-1.1.30-42.el6_10.src.rpm", Size: 53961, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "6", Release: "10.el6.centos.12.3", Arch: "x86_64", SourceRpm: "centos-release-6-10.el6.centos.12.3.src.rpm", Size: 38232, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.30", Release: "42.el6_10", Arch: "noarch", SourceRpm: "yum-utils-1.1.30-42.el6_10.src.rpm", Size: 22350, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "6.1.el6", Arch: "noarch", SourceRpm: "rootfiles-8.1-6.1.el6.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
 	}
 
 	// docker run --rm -it centos:7 bash
 	// yum groupinstall -y "Development tools"
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
 	CentOS7DevTools = []PackageInfo{
-		{intRef(), "libproxy", "0.4.11", "11.el7", "x86_64", "libproxy-0.4.11-11.el7.src.rpm", 164014, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata", "2018e", "3.el7", "noarch", "tzdata-2018e-3.el7.src.rpm", 1966505, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnutls", "3.3.29", "9.el7_6", "x86_64", "gnutls-3.3.29-9.el7_6.src.rpm", 2097819, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 565628, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "make", "3.82", "23.el7", "x86_64", "make-3.82-23.el7.src.rpm", 1160684, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 439378, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh-clients", "7.4p1", "16.el7", "x86_64", "openssh-7.4p1-16.el7.src.rpm", 2651616, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "git", "1.8.3.1", "20.el7", "x86_64", "git-1.8.3.1-20.el7.src.rpm", 23232282, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "3.2", "25.el7", "x86_64", "filesystem-3.2-25.el7.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "neon", "0.30.0", "3.el7", "x86_64", "neon-0.30.0-3.el7.src.rpm", 567772, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 83791, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el7_5", "x86_64", "nspr-4.19.0-1.el7_5.src.rpm", 287728, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mokutil", "15", "2.el7.centos", "x86_64", "shim-signed-15-2.el7.centos.src.rpm", 83521, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "16.el7", "x86_64", "popt-1.13-16.el7.src.rpm", 88516, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "emacs-filesystem", "24.3", "22.el7", "noarch", "emacs-24.3-22.el7.src.rpm", 0, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.13", "18.el7", "x86_64", "libffi-3.0.13-18.el7.src.rpm", 47766, "MIT and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-headers", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 2338328, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.22", "9.el7", "x86_64", "libcap-2.22-9.el7.src.rpm", 111445, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 39238921, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.5", "8.1.el7", "x86_64", "libsepol-2.5-8.1.el7.src.rpm", 686568, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-devel", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 7910669, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 1028216, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "redhat-rpm-config", "9.1.0", "87.el7.centos", "noarch", "redhat-rpm-config-9.1.0-87.el7.centos.src.rpm", 174572, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "4.0.2", "4.el7_3.1", "x86_64", "gawk-4.0.2-4.el7_3.1.src.rpm", 2435978, "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-devel", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 1492653, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.5", "12.el7", "x86_64", "libselinux-2.5-12.el7.src.rpm", 217874, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 328409, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "3.el7", "x86_64", "grep-2.20-3.el7.src.rpm", 1195131, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-gfortran", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 16443248, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.5.8", "3.el7", "x86_64", "keyutils-1.5.8-3.el7.src.rpm", 42138, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-c++", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 17257523, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libverto", "0.2.5", "4.el7", "x86_64", "libverto-0.2.5-4.el7.src.rpm", 23060, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "subversion", "1.7.14", "14.el7", "x86_64", "subversion-1.7.14-14.el7.src.rpm", 4844082, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 437261, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "flex", "2.5.37", "6.el7", "x86_64", "flex-2.5.37-6.el7.src.rpm", 757468, "BSD and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl-libs", "1.0.2k", "12.el7", "x86_64", "openssl-1.0.2k-12.el7.src.rpm", 3200172, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 149898, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "7", "5.1804.4.el7.centos", "x86_64", "centos-release-7-5.1804.4.el7.centos.src.rpm", 40338, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "doxygen", "1.8.5", "3.el7", "x86_64", "doxygen-1.8.5-3.el7.src.rpm", 15389684, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 239967, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffstat", "1.57", "4.el7", "x86_64", "diffstat-1.57-4.el7.src.rpm", 54065, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 1858008, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "byacc", "1.9.20130304", "3.el7", "x86_64", "byacc-1.9.20130304-3.el7.src.rpm", 135161, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.12", "3.el7", "x86_64", "libgpg-error-1.12-3.el7.src.rpm", 350865, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "indent", "2.2.11", "13.el7", "x86_64", "indent-2.2.11-13.el7.src.rpm", 359131, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.5.3", "14.el7", "x86_64", "libgcrypt-1.5.3-14.el7.src.rpm", 597727, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "15.el7", "x86_64", "lua-5.1.4-15.el7.src.rpm", 640319, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 20326, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmount", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 273965, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "1.8", "4.el7", "x86_64", "shared-mime-info-1.8-4.el7.src.rpm", 2379317, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.5", "10.el7", "x86_64", "gzip-1.5-10.el7.src.rpm", 250440, "GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.5.11", "5.el7", "x86_64", "findutils-4.5.11-5.el7.src.rpm", 1855626, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "3.3", "4.el7", "x86_64", "diffutils-3.3-4.el7.src.rpm", 1065157, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.1.0", "10.el7_3", "x86_64", "expat-2.1.0-10.el7_3.src.rpm", 208315, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.8.1", "3.el7_5.1", "x86_64", "audit-2.8.1-3.el7_5.1.src.rpm", 256370, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.8", "22.el7", "x86_64", "pam-1.1.8-22.el7.src.rpm", 2630324, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 1130670, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 2424993, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libassuan", "2.1.0", "3.el7", "x86_64", "libassuan-2.1.0-3.el7.src.rpm", 155391, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.27.1", "4.el7", "x86_64", "pkgconfig-0.27.1-4.el7.src.rpm", 105522, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.26", "23.el7", "x86_64", "cyrus-sasl-2.1.26-23.el7.src.rpm", 396911, "BSD with advertising", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.27", "28.base.el7_5.1", "x86_64", "binutils-2.27-28.base.el7_5.1.src.rpm", 25149789, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.29.0", "46.el7", "x86_64", "curl-7.29.0-46.el7.src.rpm", 435192, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.44", "15.el7_5", "x86_64", "openldap-2.4.44-15.el7_5.src.rpm", 1037299, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.8.1", "17.el7", "x86_64", "pinentry-0.8.1-17.el7.src.rpm", 159929, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.5", "11.el7", "x86_64", "libsemanage-2.5-11.el7.src.rpm", 302369, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.6", "4.el7", "x86_64", "libutempter-1.1.6-4.el7.src.rpm", 49749, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "qrencode-libs", "3.4.1", "3.el7", "x86_64", "qrencode-3.4.1-3.el7.src.rpm", 126732, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper", "1.02.146", "4.el7", "x86_64", "lvm2-2.02.177-4.el7.src.rpm", 338922, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps-ng", "3.3.10", "17.el7_5.2", "x86_64", "procps-ng-3.3.10-17.el7_5.2.src.rpm", 760500, "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod", "20", "21.el7", "x86_64", "kmod-20-21.el7.src.rpm", 243127, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus", "1.10.24", "7.el7", "x86_64", "dbus-1.10.24-7.el7.src.rpm", 595223, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iputils", "20160308", "10.el7", "x86_64", "iputils-20160308-10.el7.src.rpm", 343497, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.10", "8.el7", "x86_64", "gdbm-1.10-8.el7.src.rpm", 184322, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.7.5", "69.el7_5", "x86_64", "python-2.7.5-69.el7_5.src.rpm", 80907, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-python", "1.1.1", "9.el7", "x86_64", "dbus-python-1.1.1-9.el7.src.rpm", 848122, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyliblzma", "0.5.3", "11.el7", "x86_64", "pyliblzma-0.5.3-11.el7.src.rpm", 190112, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.10", "8.el7", "noarch", "python-urlgrabber-3.10-8.el7.src.rpm", 500670, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyxattr", "0.5.1", "5.el7", "x86_64", "pyxattr-0.5.1-5.el7.src.rpm", 63304, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-kitchen", "1.1.1", "5.el7", "noarch", "python-kitchen-1.1.1-5.el7.src.rpm", 1465161, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.22", "5.el7_5", "x86_64", "gnupg2-2.0.22-5.el7_5.src.rpm", 6637796, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.3", "9.el7", "x86_64", "pygpgme-0.3-9.el7.src.rpm", 197501, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.4.3", "158.el7.centos", "noarch", "yum-3.4.3-158.el7.centos.src.rpm", 5814102, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-utils", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 343422, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.160", "4.el7", "x86_64", "vim-7.4.160-4.el7.src.rpm", 917640, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "f4a80eb5", "53a7ff4b", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 120497983, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 1077442, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 2622266, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.11", "35.el7", "x86_64", "file-5.11-35.el7.src.rpm", 3076831, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mpfr", "3.1.1", "4.el7", "x86_64", "mpfr-3.1.1-4.el7.src.rpm", 554279, "LGPLv3+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 166776, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck-lib", "1.4.1", "6.el7", "x86_64", "fipscheck-1.4.1-6.el7.src.rpm", 11466, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr", "1.4.8", "3.el7_4.1", "x86_64", "apr-1.4.8-3.el7_4.1.src.rpm", 226686, "ASL 2.0 and BSD with advertising and ISC and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libquadmath", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 282811, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "boost-system", "1.53.0", "27.el7", "x86_64", "boost-1.53.0-27.el7.src.rpm", 32538, "Boost and MIT and Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "unzip", "6.0", "19.el7", "x86_64", "unzip-6.0-19.el7.src.rpm", 373986, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "avahi-libs", "0.6.31", "19.el7", "x86_64", "avahi-0.6.31-19.el7.src.rpm", 123808, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-libs", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 1546952, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "json-c", "0.11", "4.el7_0", "x86_64", "json-c-0.11-4.el7_0.src.rpm", 65593, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cryptsetup-libs", "2.0.3", "3.el7", "x86_64", "cryptsetup-2.0.3-3.el7.src.rpm", 1219591, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-parent", "0.225", "244.el7", "noarch", "perl-parent-0.225-244.el7.src.rpm", 8141, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-podlators", "2.5.1", "3.el7", "noarch", "perl-podlators-2.5.1-3.el7.src.rpm", 287679, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Escapes", "1.04", "294.el7_6", "noarch", "perl-5.16.3-294.el7_6.src.rpm", 21091, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Encode", "2.51", "7.el7", "x86_64", "perl-Encode-2.51-7.el7.src.rpm", 10176350, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-libs", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 1647344, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Storable", "2.45", "3.el7", "x86_64", "perl-Storable-2.45-3.el7.src.rpm", 181031, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads", "1.87", "4.el7", "x86_64", "perl-threads-1.87-4.el7.src.rpm", 98615, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Filter", "1.49", "3.el7", "x86_64", "perl-Filter-1.49-3.el7.src.rpm", 148475, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-constant", "1.27", "2.el7", "noarch", "perl-constant-1.27-2.el7.src.rpm", 26364, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-macros", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 5134, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Temp", "0.23.01", "3.el7", "noarch", "perl-File-Temp-0.23.01-3.el7.src.rpm", 158781, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-PathTools", "3.40", "5.el7", "x86_64", "perl-PathTools-3.40-5.el7.src.rpm", 174131, "(GPL+ or Artistic) and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Simple", "3.28", "4.el7", "noarch", "perl-Pod-Simple-3.28-4.el7.src.rpm", 538320, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 23552128, "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Error", "0.17020", "2.el7", "noarch", "perl-Error-0.17020-2.el7.src.rpm", 50015, "(GPL+ or Artistic) and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Data-Dumper", "2.145", "3.el7", "x86_64", "perl-Data-Dumper-2.145-3.el7.src.rpm", 99287, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-XML-Parser", "2.41", "10.el7", "x86_64", "perl-XML-Parser-2.41-10.el7.src.rpm", 642973, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "automake", "1.13.4", "3.el7", "noarch", "automake-1.13.4-3.el7.src.rpm", 1731909, "GPLv2+ and GFDL and Public Domain and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "less", "458", "9.el7", "x86_64", "less-458-9.el7.src.rpm", 215376, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgfortran", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 1186168, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpp", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 15644861, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd-libs", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 1263456, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "trousers", "0.3.14", "2.el7", "x86_64", "trousers-0.3.14-2.el7.src.rpm", 836873, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd-sysv", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 3979, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdwarf", "20130207", "4.el7", "x86_64", "libdwarf-20130207-4.el7.src.rpm", 291679, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "boost-date-time", "1.53.0", "27.el7", "x86_64", "boost-1.53.0-27.el7.src.rpm", 139706, "Boost and MIT and Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-runtime", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 1142954, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmodman", "2.0.1", "8.el7", "x86_64", "libmodman-2.0.1-8.el7.src.rpm", 58871, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nettle", "2.7.1", "8.el7", "x86_64", "nettle-2.7.1-8.el7.src.rpm", 765042, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.9", "14.20130511.el7_4", "noarch", "ncurses-5.9-14.20130511.el7_4.src.rpm", 223432, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdb", "7.6.1", "114.el7", "x86_64", "gdb-7.6.1-114.el7.src.rpm", 7371507, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and BSD and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.2.46", "30.el7", "x86_64", "bash-4.2.46-30.el7.src.rpm", 3667709, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libedit", "3.0", "12.20121213cvs.el7", "x86_64", "libedit-3.0-12.20121213cvs.el7.src.rpm", 244257, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.7.4", "1.el7", "x86_64", "chkconfig-1.7.4-1.el7.src.rpm", 779531, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Git", "1.8.3.1", "20.el7", "noarch", "git-1.8.3.1-20.el7.src.rpm", 58567, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setup", "2.8.71", "9.el7", "noarch", "setup-2.8.71-9.el7.src.rpm", 696925, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pakchois", "0.4", "10.el7", "x86_64", "pakchois-0.4-10.el7.src.rpm", 29489, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "7.el7.centos", "noarch", "basesystem-10.0-7.el7.centos.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "subversion-libs", "1.7.14", "14.el7", "x86_64", "subversion-1.7.14-14.el7.src.rpm", 2615823, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.7", "17.el7", "x86_64", "zlib-1.2.7-17.el7.src.rpm", 185710, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "efivar-libs", "36", "11.el7_6.1", "x86_64", "efivar-36-11.el7_6.1.src.rpm", 252096, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.el7_5", "x86_64", "nss-util-3.36.0-1.el7_5.src.rpm", 194944, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-client", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 10146436, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.42.9", "12.el7_5", "x86_64", "e2fsprogs-1.42.9-12.el7_5.src.rpm", 60489, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-headers", "3.10.0", "957.10.1.el7", "x86_64", "kernel-3.10.0-957.10.1.el7.src.rpm", 3823832, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.46", "13.el7", "x86_64", "attr-2.4.46-13.el7.src.rpm", 19896, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-devel", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 1066078, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 37056, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libquadmath-devel", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 18514, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-srpm-macros", "1", "8.el7", "noarch", "perl-srpm-macros-1-8.el7.src.rpm", 794, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "5.1", "5.el7", "x86_64", "texinfo-5.1-5.el7.src.rpm", 494630, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-common-devel", "0.19.8.1", "2.el7", "noarch", "gettext-0.19.8.1-2.el7.src.rpm", 397912, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "8.32", "17.el7", "x86_64", "pcre-8.32-17.el7.src.rpm", 1475532, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "intltool", "0.50.2", "7.el7", "noarch", "intltool-0.50.2-7.el7.src.rpm", 170106, "GPLv2 with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.2", "5.el7", "x86_64", "sed-4.2.2-5.el7.src.rpm", 601208, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 206577, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 1337825, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtool", "2.4.2", "22.el7_3", "x86_64", "libtool-2.4.2-22.el7_3.src.rpm", 2323761, "GPLv2+ and LGPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "gmp", "6.0.0", "15.el7", "x86_64", "gmp-6.0.0-15.el7.src.rpm", 657046, "LGPLv3+ or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cscope", "15.8", "10.el7", "x86_64", "cscope-15.8-10.el7.src.rpm", 939744, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "4.10", "1.el7", "x86_64", "libtasn1-4.10-1.el7.src.rpm", 424486, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patchutils", "0.3.3", "4.el7", "x86_64", "patchutils-0.3.3-4.el7.src.rpm", 260135, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "70.0.el7_5", "noarch", "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", 973960, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bison", "3.0.4", "2.el7", "x86_64", "bison-3.0.4-2.el7.src.rpm", 2154174, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.22", "21.el7", "x86_64", "coreutils-8.22-21.el7.src.rpm", 14588989, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-sign", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 17397, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.15.1", "19.el7", "x86_64", "krb5-1.15.1-19.el7.src.rpm", 1984782, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "swig", "2.0.10", "5.el7", "x86_64", "swig-2.0.10-5.el7.src.rpm", 4926232, "GPLv3+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 70093, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rcs", "5.9.0", "5.el7", "x86_64", "rcs-5.9.0-5.el7.src.rpm", 624710, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ctags", "5.8", "13.el7", "x86_64", "ctags-5.8-13.el7.src.rpm", 359724, "GPLv2+ and LGPLv2+ and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1710062, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.2", "10.el7", "x86_64", "readline-6.2-10.el7.src.rpm", 460464, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.11", "27.el7", "x86_64", "cpio-2.11-27.el7.src.rpm", 689335, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 261837, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.54.2", "2.el7", "x86_64", "glib2-2.54.2-2.el7.src.rpm", 11986873, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.7.17", "8.el7", "x86_64", "sqlite-3.7.17-8.el7.src.rpm", 814231, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 209610, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.28", "4.el7", "x86_64", "libidn-1.28-4.el7.src.rpm", 630407, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap-ng", "0.7.5", "4.el7", "x86_64", "libcap-ng-0.7.5-4.el7.src.rpm", 50510, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 9389116, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpwquality", "1.2.3", "5.el7", "x86_64", "libpwquality-1.2.3-5.el7.src.rpm", 332421, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 14061, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-pem", "1.0.3", "4.el7", "x86_64", "nss-pem-1.0.3-4.el7.src.rpm", 201219, "MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 798130, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lz4", "1.7.5", "2.el7", "x86_64", "lz4-1.7.5-2.el7.src.rpm", 366872, "GPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 2069571, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gobject-introspection", "1.50.0", "1.el7", "x86_64", "gobject-introspection-1.50.0-1.el7.src.rpm", 834149, "GPLv2+, LGPLv2+, MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb-utils", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 326487, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod-libs", "20", "21.el7", "x86_64", "kmod-20-21.el7.src.rpm", 91800, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.3", "10.el7_2.1", "x86_64", "libssh2-1.4.3-10.el7_2.1.src.rpm", 341782, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.29.0", "46.el7", "x86_64", "curl-7.29.0-46.el7.src.rpm", 540259, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.60", "9.el7", "x86_64", "libuser-0.60-9.el7.src.rpm", 1952592, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.26", "34.el7", "x86_64", "tar-1.26-34.el7.src.rpm", 2838271, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "acl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 201225, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "16.el7", "x86_64", "ustr-1.0.4-16.el7.src.rpm", 285943, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "24.el7", "x86_64", "shadow-utils-4.1.5.1-24.el7.src.rpm", 3534490, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "hardlink", "1.0", "19.el7", "x86_64", "hardlink-1.0-19.el7.src.rpm", 16545, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "util-linux", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 8642769, "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kpartx", "0.4.9", "119.el7_5.1", "x86_64", "device-mapper-multipath-0.4.9-119.el7_5.1.src.rpm", 41363, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper-libs", "1.02.146", "4.el7", "x86_64", "lvm2-2.02.177-4.el7.src.rpm", 400551, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dracut", "033", "535.el7_5.1", "x86_64", "dracut-033-535.el7_5.1.src.rpm", 898198, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.10.24", "7.el7", "x86_64", "dbus-1.10.24-7.el7.src.rpm", 362584, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-default-yama-scope", "0.170", "4.el7", "noarch", "elfutils-0.170-4.el7.src.rpm", 1810, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-glib", "0.100", "7.el7", "x86_64", "dbus-glib-0.100-7.el7.src.rpm", 301237, "AFL and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.7.5", "69.el7_5", "x86_64", "python-2.7.5-69.el7_5.src.rpm", 24713084, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2-python", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1503050, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-gobject-base", "3.22.0", "1.el7_4.1", "x86_64", "pygobject3-3.22.0-1.el7_4.1.src.rpm", 1123114, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.4", "10.el7", "x86_64", "yum-metadata-parser-1.1.4-10.el7.src.rpm", 58789, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "19.el7", "x86_64", "python-pycurl-7.19.0-19.el7.src.rpm", 241513, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.4", "9.el7", "noarch", "python-iniparse-0.4-9.el7.src.rpm", 115166, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-chardet", "2.2.1", "1.el7_1", "noarch", "python-chardet-2.2.1-1.el7_1.src.rpm", 1156541, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hostname", "3.13", "3.el7", "x86_64", "hostname-3.13-3.el7.src.rpm", 19449, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "23.el7", "x86_64", "pth-2.0.7-23.el7.src.rpm", 267851, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.3.2", "5.el7", "x86_64", "gpgme-1.3.2-5.el7.src.rpm", 547534, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 53895, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-license", "9.9.4", "61.el7_5.1", "noarch", "bind-9.9.4-61.el7_5.1.src.rpm", 26831, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 22399, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.79", "4.el7", "x86_64", "passwd-0.79-4.el7.src.rpm", 429874, "BSD or GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "11.el7", "noarch", "rootfiles-8.1-11.el7.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 179328, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 14346480, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 913443, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 611536, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libs", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 793087, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmpc", "1.0.1", "3.el7", "x86_64", "libmpc-1.0.1-3.el7.src.rpm", 113833, "LGPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck", "1.4.1", "6.el7", "x86_64", "fipscheck-1.4.1-6.el7.src.rpm", 38839, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgomp", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 212184, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "m4", "1.4.16", "10.el7", "x86_64", "m4-1.4.16-10.el7.src.rpm", 525707, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util", "1.5.2", "6.el7", "x86_64", "apr-util-1.5.2-6.el7.src.rpm", 198751, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "groff-base", "1.22.2", "8.el7", "x86_64", "groff-1.22.2-8.el7.src.rpm", 3453946, "GPLv3+ and GFDL and BSD and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libunistring", "0.9.3", "9.el7", "x86_64", "libunistring-0.9.3-9.el7.src.rpm", 1145761, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcroco", "0.6.12", "4.el7", "x86_64", "libcroco-0.6.12-4.el7.src.rpm", 320955, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patch", "2.7.1", "10.el7_5", "x86_64", "patch-2.7.1-10.el7_5.src.rpm", 215449, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zip", "3.0", "11.el7", "x86_64", "zip-3.0-11.el7.src.rpm", 815173, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 5029340, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTTP-Tiny", "0.033", "3.el7", "noarch", "perl-HTTP-Tiny-0.033-3.el7.src.rpm", 97210, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Perldoc", "3.20", "4.el7", "noarch", "perl-Pod-Perldoc-3.20-4.el7.src.rpm", 166910, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Text-ParseWords", "3.29", "4.el7", "noarch", "perl-Text-ParseWords-3.29-4.el7.src.rpm", 16431, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Usage", "1.63", "3.el7", "noarch", "perl-Pod-Usage-1.63-3.el7.src.rpm", 44671, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Socket", "2.010", "4.el7", "x86_64", "perl-Socket-2.010-4.el7.src.rpm", 114497, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-Time-HiRes", "1.9725", "3.el7", "x86_64", "perl-Time-HiRes-1.9725-3.el7.src.rpm", 94069, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Carp", "1.26", "244.el7", "noarch", "perl-Carp-1.26-244.el7.src.rpm", 28276, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Exporter", "5.68", "3.el7", "noarch", "perl-Exporter-5.68-3.el7.src.rpm", 56612, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Time-Local", "1.2300", "2.el7", "noarch", "perl-Time-Local-1.2300-2.el7.src.rpm", 44062, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads-shared", "1.43", "6.el7", "x86_64", "perl-threads-shared-1.43-6.el7.src.rpm", 73972, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Path", "2.09", "2.el7", "noarch", "perl-File-Path-2.09-2.el7.src.rpm", 50067, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Scalar-List-Utils", "1.27", "248.el7", "x86_64", "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", 67994, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Getopt-Long", "2.40", "3.el7", "noarch", "perl-Getopt-Long-2.40-3.el7.src.rpm", 134846, "GPLv2+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Thread-Queue", "3.02", "2.el7", "noarch", "perl-Thread-Queue-3.02-2.el7.src.rpm", 27642, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-TermReadKey", "2.30", "20.el7", "x86_64", "perl-TermReadKey-2.30-20.el7.src.rpm", 59999, "(Copyright only) and (Artistic or GPL+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "autoconf", "2.69", "11.el7", "noarch", "autoconf-2.69-11.el7.src.rpm", 2320371, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Test-Harness", "3.28", "3.el7", "noarch", "perl-Test-Harness-3.28-3.el7.src.rpm", 607239, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-debug-devel", "3.10.0", "957.10.1.el7", "x86_64", "kernel-3.10.0-957.10.1.el7.src.rpm", 39270753, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "boost-thread", "1.53.0", "27.el7", "x86_64", "boost-1.53.0-27.el7.src.rpm", 95658, "Boost and MIT and Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh", "7.4p1", "16.el7", "x86_64", "openssh-7.4p1-16.el7.src.rpm", 1995364, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 811817, "GPLv3+ and (GPLv2+ or LGPLv3+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 24440903, "LGPLv2+ and MIT and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rsync", "3.1.2", "4.el7", "x86_64", "rsync-3.1.2-4.el7.src.rpm", 834618, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file", "5.11", "35.el7", "x86_64", "file-5.11-35.el7.src.rpm", 67448, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dwz", "0.11", "3.el7", "x86_64", "dwz-0.11-3.el7.src.rpm", 225103, "GPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dyninst", "9.3.1", "2.el7", "x86_64", "dyninst-9.3.1-2.el7.src.rpm", 13049000, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++-devel", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 8436804, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(), Name: "libproxy", Version: "0.4.11", Release: "11.el7", Arch: "x86_64", SourceRpm: "libproxy-0.4.11-11.el7.src.rpm", Size: 164014, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018e", Release: "3.el7", Arch: "noarch", SourceRpm: "tzdata-2018e-3.el7.src.rpm", Size: 1966505, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnutls", Version: "3.3.29", Release: "9.el7_6", Arch: "x86_64", SourceRpm: "gnutls-3.3.29-9.el7_6.src.rpm", Size: 2097819, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 565628, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "make", Version: "3.82", Release: "23.el7", Arch: "x86_64", SourceRpm: "make-3.82-23.el7.src.rpm", Size: 1160684, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 439378, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh-clients", Version: "7.4p1", Release: "16.el7", Arch: "x86_64", SourceRpm: "openssh-7.4p1-16.el7.src.rpm", Size: 2651616, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "git", Version: "1.8.3.1", Release: "20.el7", Arch: "x86_64", SourceRpm: "git-1.8.3.1-20.el7.src.rpm", Size: 23232282, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "3.2", Release: "25.el7", Arch: "x86_64", SourceRpm: "filesystem-3.2-25.el7.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "neon", Version: "0.30.0", Release: "3.el7", Arch: "x86_64", SourceRpm: "neon-0.30.0-3.el7.src.rpm", Size: 567772, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 83791, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el7_5.src.rpm", Size: 287728, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mokutil", Version: "15", Release: "2.el7.centos", Arch: "x86_64", SourceRpm: "shim-signed-15-2.el7.centos.src.rpm", Size: 83521, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "16.el7", Arch: "x86_64", SourceRpm: "popt-1.13-16.el7.src.rpm", Size: 88516, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "emacs-filesystem", Version: "24.3", Release: "22.el7", Arch: "noarch", SourceRpm: "emacs-24.3-22.el7.src.rpm", Size: 0, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.13", Release: "18.el7", Arch: "x86_64", SourceRpm: "libffi-3.0.13-18.el7.src.rpm", Size: 47766, License: "MIT and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-headers", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 2338328, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.22", Release: "9.el7", Arch: "x86_64", SourceRpm: "libcap-2.22-9.el7.src.rpm", Size: 111445, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 39238921, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.5", Release: "8.1.el7", Arch: "x86_64", SourceRpm: "libsepol-2.5-8.1.el7.src.rpm", Size: 686568, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-devel", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 7910669, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 1028216, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "redhat-rpm-config", Version: "9.1.0", Release: "87.el7.centos", Arch: "noarch", SourceRpm: "redhat-rpm-config-9.1.0-87.el7.centos.src.rpm", Size: 174572, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "4.0.2", Release: "4.el7_3.1", Arch: "x86_64", SourceRpm: "gawk-4.0.2-4.el7_3.1.src.rpm", Size: 2435978, License: "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-devel", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 1492653, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.5", Release: "12.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-12.el7.src.rpm", Size: 217874, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 328409, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "3.el7", Arch: "x86_64", SourceRpm: "grep-2.20-3.el7.src.rpm", Size: 1195131, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-gfortran", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 16443248, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.5.8", Release: "3.el7", Arch: "x86_64", SourceRpm: "keyutils-1.5.8-3.el7.src.rpm", Size: 42138, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-c++", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 17257523, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libverto", Version: "0.2.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libverto-0.2.5-4.el7.src.rpm", Size: 23060, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "subversion", Version: "1.7.14", Release: "14.el7", Arch: "x86_64", SourceRpm: "subversion-1.7.14-14.el7.src.rpm", Size: 4844082, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 437261, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "flex", Version: "2.5.37", Release: "6.el7", Arch: "x86_64", SourceRpm: "flex-2.5.37-6.el7.src.rpm", Size: 757468, License: "BSD and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl-libs", Version: "1.0.2k", Release: "12.el7", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-12.el7.src.rpm", Size: 3200172, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 149898, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "7", Release: "5.1804.4.el7.centos", Arch: "x86_64", SourceRpm: "centos-release-7-5.1804.4.el7.centos.src.rpm", Size: 40338, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "doxygen", Version: "1.8.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "doxygen-1.8.5-3.el7.src.rpm", Size: 15389684, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 239967, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffstat", Version: "1.57", Release: "4.el7", Arch: "x86_64", SourceRpm: "diffstat-1.57-4.el7.src.rpm", Size: 54065, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 1858008, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "byacc", Version: "1.9.20130304", Release: "3.el7", Arch: "x86_64", SourceRpm: "byacc-1.9.20130304-3.el7.src.rpm", Size: 135161, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "libgpg-error-1.12-3.el7.src.rpm", Size: 350865, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "indent", Version: "2.2.11", Release: "13.el7", Arch: "x86_64", SourceRpm: "indent-2.2.11-13.el7.src.rpm", Size: 359131, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.5.3", Release: "14.el7", Arch: "x86_64", SourceRpm: "libgcrypt-1.5.3-14.el7.src.rpm", Size: 597727, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "15.el7", Arch: "x86_64", SourceRpm: "lua-5.1.4-15.el7.src.rpm", Size: 640319, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 20326, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmount", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 273965, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "1.8", Release: "4.el7", Arch: "x86_64", SourceRpm: "shared-mime-info-1.8-4.el7.src.rpm", Size: 2379317, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "gzip-1.5-10.el7.src.rpm", Size: 250440, License: "GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.5.11", Release: "5.el7", Arch: "x86_64", SourceRpm: "findutils-4.5.11-5.el7.src.rpm", Size: 1855626, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "3.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "diffutils-3.3-4.el7.src.rpm", Size: 1065157, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.1.0", Release: "10.el7_3", Arch: "x86_64", SourceRpm: "expat-2.1.0-10.el7_3.src.rpm", Size: 208315, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.8.1", Release: "3.el7_5.1", Arch: "x86_64", SourceRpm: "audit-2.8.1-3.el7_5.1.src.rpm", Size: 256370, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.8", Release: "22.el7", Arch: "x86_64", SourceRpm: "pam-1.1.8-22.el7.src.rpm", Size: 2630324, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 1130670, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 2424993, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libassuan", Version: "2.1.0", Release: "3.el7", Arch: "x86_64", SourceRpm: "libassuan-2.1.0-3.el7.src.rpm", Size: 155391, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.27.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "pkgconfig-0.27.1-4.el7.src.rpm", Size: 105522, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.26", Release: "23.el7", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.26-23.el7.src.rpm", Size: 396911, License: "BSD with advertising", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.27", Release: "28.base.el7_5.1", Arch: "x86_64", SourceRpm: "binutils-2.27-28.base.el7_5.1.src.rpm", Size: 25149789, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.29.0", Release: "46.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-46.el7.src.rpm", Size: 435192, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.44", Release: "15.el7_5", Arch: "x86_64", SourceRpm: "openldap-2.4.44-15.el7_5.src.rpm", Size: 1037299, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.8.1", Release: "17.el7", Arch: "x86_64", SourceRpm: "pinentry-0.8.1-17.el7.src.rpm", Size: 159929, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.5", Release: "11.el7", Arch: "x86_64", SourceRpm: "libsemanage-2.5-11.el7.src.rpm", Size: 302369, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.6", Release: "4.el7", Arch: "x86_64", SourceRpm: "libutempter-1.1.6-4.el7.src.rpm", Size: 49749, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "qrencode-libs", Version: "3.4.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "qrencode-3.4.1-3.el7.src.rpm", Size: 126732, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper", Version: "1.02.146", Release: "4.el7", Arch: "x86_64", SourceRpm: "lvm2-2.02.177-4.el7.src.rpm", Size: 338922, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps-ng", Version: "3.3.10", Release: "17.el7_5.2", Arch: "x86_64", SourceRpm: "procps-ng-3.3.10-17.el7_5.2.src.rpm", Size: 760500, License: "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod", Version: "20", Release: "21.el7", Arch: "x86_64", SourceRpm: "kmod-20-21.el7.src.rpm", Size: 243127, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus", Version: "1.10.24", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-7.el7.src.rpm", Size: 595223, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iputils", Version: "20160308", Release: "10.el7", Arch: "x86_64", SourceRpm: "iputils-20160308-10.el7.src.rpm", Size: 343497, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.10", Release: "8.el7", Arch: "x86_64", SourceRpm: "gdbm-1.10-8.el7.src.rpm", Size: 184322, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.7.5", Release: "69.el7_5", Arch: "x86_64", SourceRpm: "python-2.7.5-69.el7_5.src.rpm", Size: 80907, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-python", Version: "1.1.1", Release: "9.el7", Arch: "x86_64", SourceRpm: "dbus-python-1.1.1-9.el7.src.rpm", Size: 848122, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyliblzma", Version: "0.5.3", Release: "11.el7", Arch: "x86_64", SourceRpm: "pyliblzma-0.5.3-11.el7.src.rpm", Size: 190112, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.10", Release: "8.el7", Arch: "noarch", SourceRpm: "python-urlgrabber-3.10-8.el7.src.rpm", Size: 500670, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyxattr", Version: "0.5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "pyxattr-0.5.1-5.el7.src.rpm", Size: 63304, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-kitchen", Version: "1.1.1", Release: "5.el7", Arch: "noarch", SourceRpm: "python-kitchen-1.1.1-5.el7.src.rpm", Size: 1465161, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.22", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "gnupg2-2.0.22-5.el7_5.src.rpm", Size: 6637796, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "pygpgme-0.3-9.el7.src.rpm", Size: 197501, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.4.3", Release: "158.el7.centos", Arch: "noarch", SourceRpm: "yum-3.4.3-158.el7.centos.src.rpm", Size: 5814102, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-utils", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 343422, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.160", Release: "4.el7", Arch: "x86_64", SourceRpm: "vim-7.4.160-4.el7.src.rpm", Size: 917640, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "f4a80eb5", Release: "53a7ff4b", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 120497983, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 1077442, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 2622266, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.11", Release: "35.el7", Arch: "x86_64", SourceRpm: "file-5.11-35.el7.src.rpm", Size: 3076831, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mpfr", Version: "3.1.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "mpfr-3.1.1-4.el7.src.rpm", Size: 554279, License: "LGPLv3+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 166776, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck-lib", Version: "1.4.1", Release: "6.el7", Arch: "x86_64", SourceRpm: "fipscheck-1.4.1-6.el7.src.rpm", Size: 11466, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr", Version: "1.4.8", Release: "3.el7_4.1", Arch: "x86_64", SourceRpm: "apr-1.4.8-3.el7_4.1.src.rpm", Size: 226686, License: "ASL 2.0 and BSD with advertising and ISC and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libquadmath", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 282811, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "boost-system", Version: "1.53.0", Release: "27.el7", Arch: "x86_64", SourceRpm: "boost-1.53.0-27.el7.src.rpm", Size: 32538, License: "Boost and MIT and Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "unzip", Version: "6.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "unzip-6.0-19.el7.src.rpm", Size: 373986, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "avahi-libs", Version: "0.6.31", Release: "19.el7", Arch: "x86_64", SourceRpm: "avahi-0.6.31-19.el7.src.rpm", Size: 123808, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-libs", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 1546952, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "json-c", Version: "0.11", Release: "4.el7_0", Arch: "x86_64", SourceRpm: "json-c-0.11-4.el7_0.src.rpm", Size: 65593, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cryptsetup-libs", Version: "2.0.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "cryptsetup-2.0.3-3.el7.src.rpm", Size: 1219591, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-parent", Version: "0.225", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-parent-0.225-244.el7.src.rpm", Size: 8141, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-podlators", Version: "2.5.1", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-podlators-2.5.1-3.el7.src.rpm", Size: 287679, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Escapes", Version: "1.04", Release: "294.el7_6", Arch: "noarch", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 21091, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Encode", Version: "2.51", Release: "7.el7", Arch: "x86_64", SourceRpm: "perl-Encode-2.51-7.el7.src.rpm", Size: 10176350, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-libs", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 1647344, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Storable", Version: "2.45", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Storable-2.45-3.el7.src.rpm", Size: 181031, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads", Version: "1.87", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-threads-1.87-4.el7.src.rpm", Size: 98615, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Filter", Version: "1.49", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Filter-1.49-3.el7.src.rpm", Size: 148475, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-constant", Version: "1.27", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-constant-1.27-2.el7.src.rpm", Size: 26364, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-macros", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 5134, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Temp", Version: "0.23.01", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-File-Temp-0.23.01-3.el7.src.rpm", Size: 158781, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-PathTools", Version: "3.40", Release: "5.el7", Arch: "x86_64", SourceRpm: "perl-PathTools-3.40-5.el7.src.rpm", Size: 174131, License: "(GPL+ or Artistic) and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Simple", Version: "3.28", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Simple-3.28-4.el7.src.rpm", Size: 538320, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 23552128, License: "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Error", Version: "0.17020", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Error-0.17020-2.el7.src.rpm", Size: 50015, License: "(GPL+ or Artistic) and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Data-Dumper", Version: "2.145", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Data-Dumper-2.145-3.el7.src.rpm", Size: 99287, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-XML-Parser", Version: "2.41", Release: "10.el7", Arch: "x86_64", SourceRpm: "perl-XML-Parser-2.41-10.el7.src.rpm", Size: 642973, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "automake", Version: "1.13.4", Release: "3.el7", Arch: "noarch", SourceRpm: "automake-1.13.4-3.el7.src.rpm", Size: 1731909, License: "GPLv2+ and GFDL and Public Domain and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "less", Version: "458", Release: "9.el7", Arch: "x86_64", SourceRpm: "less-458-9.el7.src.rpm", Size: 215376, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgfortran", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 1186168, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpp", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 15644861, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd-libs", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 1263456, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "trousers", Version: "0.3.14", Release: "2.el7", Arch: "x86_64", SourceRpm: "trousers-0.3.14-2.el7.src.rpm", Size: 836873, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd-sysv", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 3979, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdwarf", Version: "20130207", Release: "4.el7", Arch: "x86_64", SourceRpm: "libdwarf-20130207-4.el7.src.rpm", Size: 291679, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "boost-date-time", Version: "1.53.0", Release: "27.el7", Arch: "x86_64", SourceRpm: "boost-1.53.0-27.el7.src.rpm", Size: 139706, License: "Boost and MIT and Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-runtime", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 1142954, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmodman", Version: "2.0.1", Release: "8.el7", Arch: "x86_64", SourceRpm: "libmodman-2.0.1-8.el7.src.rpm", Size: 58871, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nettle", Version: "2.7.1", Release: "8.el7", Arch: "x86_64", SourceRpm: "nettle-2.7.1-8.el7.src.rpm", Size: 765042, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.9", Release: "14.20130511.el7_4", Arch: "noarch", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 223432, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdb", Version: "7.6.1", Release: "114.el7", Arch: "x86_64", SourceRpm: "gdb-7.6.1-114.el7.src.rpm", Size: 7371507, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and BSD and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.2.46", Release: "30.el7", Arch: "x86_64", SourceRpm: "bash-4.2.46-30.el7.src.rpm", Size: 3667709, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libedit", Version: "3.0", Release: "12.20121213cvs.el7", Arch: "x86_64", SourceRpm: "libedit-3.0-12.20121213cvs.el7.src.rpm", Size: 244257, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.7.4", Release: "1.el7", Arch: "x86_64", SourceRpm: "chkconfig-1.7.4-1.el7.src.rpm", Size: 779531, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Git", Version: "1.8.3.1", Release: "20.el7", Arch: "noarch", SourceRpm: "git-1.8.3.1-20.el7.src.rpm", Size: 58567, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.71", Release: "9.el7", Arch: "noarch", SourceRpm: "setup-2.8.71-9.el7.src.rpm", Size: 696925, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pakchois", Version: "0.4", Release: "10.el7", Arch: "x86_64", SourceRpm: "pakchois-0.4-10.el7.src.rpm", Size: 29489, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "7.el7.centos", Arch: "noarch", SourceRpm: "basesystem-10.0-7.el7.centos.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "subversion-libs", Version: "1.7.14", Release: "14.el7", Arch: "x86_64", SourceRpm: "subversion-1.7.14-14.el7.src.rpm", Size: 2615823, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.7", Release: "17.el7", Arch: "x86_64", SourceRpm: "zlib-1.2.7-17.el7.src.rpm", Size: 185710, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "efivar-libs", Version: "36", Release: "11.el7_6.1", Arch: "x86_64", SourceRpm: "efivar-36-11.el7_6.1.src.rpm", Size: 252096, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.el7_5.src.rpm", Size: 194944, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-client", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 10146436, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.42.9", Release: "12.el7_5", Arch: "x86_64", SourceRpm: "e2fsprogs-1.42.9-12.el7_5.src.rpm", Size: 60489, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-headers", Version: "3.10.0", Release: "957.10.1.el7", Arch: "x86_64", SourceRpm: "kernel-3.10.0-957.10.1.el7.src.rpm", Size: 3823832, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.46", Release: "13.el7", Arch: "x86_64", SourceRpm: "attr-2.4.46-13.el7.src.rpm", Size: 19896, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-devel", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 1066078, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 37056, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libquadmath-devel", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 18514, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-srpm-macros", Version: "1", Release: "8.el7", Arch: "noarch", SourceRpm: "perl-srpm-macros-1-8.el7.src.rpm", Size: 794, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "texinfo-5.1-5.el7.src.rpm", Size: 494630, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-common-devel", Version: "0.19.8.1", Release: "2.el7", Arch: "noarch", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 397912, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "8.32", Release: "17.el7", Arch: "x86_64", SourceRpm: "pcre-8.32-17.el7.src.rpm", Size: 1475532, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "intltool", Version: "0.50.2", Release: "7.el7", Arch: "noarch", SourceRpm: "intltool-0.50.2-7.el7.src.rpm", Size: 170106, License: "GPLv2 with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "sed-4.2.2-5.el7.src.rpm", Size: 601208, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 206577, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 1337825, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtool", Version: "2.4.2", Release: "22.el7_3", Arch: "x86_64", SourceRpm: "libtool-2.4.2-22.el7_3.src.rpm", Size: 2323761, License: "GPLv2+ and LGPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "gmp", Version: "6.0.0", Release: "15.el7", Arch: "x86_64", SourceRpm: "gmp-6.0.0-15.el7.src.rpm", Size: 657046, License: "LGPLv3+ or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cscope", Version: "15.8", Release: "10.el7", Arch: "x86_64", SourceRpm: "cscope-15.8-10.el7.src.rpm", Size: 939744, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "4.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "libtasn1-4.10-1.el7.src.rpm", Size: 424486, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patchutils", Version: "0.3.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "patchutils-0.3.3-4.el7.src.rpm", Size: 260135, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "70.0.el7_5", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", Size: 973960, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bison", Version: "3.0.4", Release: "2.el7", Arch: "x86_64", SourceRpm: "bison-3.0.4-2.el7.src.rpm", Size: 2154174, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.22", Release: "21.el7", Arch: "x86_64", SourceRpm: "coreutils-8.22-21.el7.src.rpm", Size: 14588989, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-sign", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 17397, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.15.1", Release: "19.el7", Arch: "x86_64", SourceRpm: "krb5-1.15.1-19.el7.src.rpm", Size: 1984782, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "swig", Version: "2.0.10", Release: "5.el7", Arch: "x86_64", SourceRpm: "swig-2.0.10-5.el7.src.rpm", Size: 4926232, License: "GPLv3+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 70093, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rcs", Version: "5.9.0", Release: "5.el7", Arch: "x86_64", SourceRpm: "rcs-5.9.0-5.el7.src.rpm", Size: 624710, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ctags", Version: "5.8", Release: "13.el7", Arch: "x86_64", SourceRpm: "ctags-5.8-13.el7.src.rpm", Size: 359724, License: "GPLv2+ and LGPLv2+ and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1710062, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.2", Release: "10.el7", Arch: "x86_64", SourceRpm: "readline-6.2-10.el7.src.rpm", Size: 460464, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.11", Release: "27.el7", Arch: "x86_64", SourceRpm: "cpio-2.11-27.el7.src.rpm", Size: 689335, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 261837, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.54.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "glib2-2.54.2-2.el7.src.rpm", Size: 11986873, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.7.17", Release: "8.el7", Arch: "x86_64", SourceRpm: "sqlite-3.7.17-8.el7.src.rpm", Size: 814231, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 209610, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.28", Release: "4.el7", Arch: "x86_64", SourceRpm: "libidn-1.28-4.el7.src.rpm", Size: 630407, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap-ng", Version: "0.7.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcap-ng-0.7.5-4.el7.src.rpm", Size: 50510, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 9389116, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpwquality", Version: "1.2.3", Release: "5.el7", Arch: "x86_64", SourceRpm: "libpwquality-1.2.3-5.el7.src.rpm", Size: 332421, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 14061, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-pem", Version: "1.0.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "nss-pem-1.0.3-4.el7.src.rpm", Size: 201219, License: "MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 798130, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lz4", Version: "1.7.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "lz4-1.7.5-2.el7.src.rpm", Size: 366872, License: "GPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 2069571, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gobject-introspection", Version: "1.50.0", Release: "1.el7", Arch: "x86_64", SourceRpm: "gobject-introspection-1.50.0-1.el7.src.rpm", Size: 834149, License: "GPLv2+, LGPLv2+, MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb-utils", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 326487, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod-libs", Version: "20", Release: "21.el7", Arch: "x86_64", SourceRpm: "kmod-20-21.el7.src.rpm", Size: 91800, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.3", Release: "10.el7_2.1", Arch: "x86_64", SourceRpm: "libssh2-1.4.3-10.el7_2.1.src.rpm", Size: 341782, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.29.0", Release: "46.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-46.el7.src.rpm", Size: 540259, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.60", Release: "9.el7", Arch: "x86_64", SourceRpm: "libuser-0.60-9.el7.src.rpm", Size: 1952592, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.26", Release: "34.el7", Arch: "x86_64", SourceRpm: "tar-1.26-34.el7.src.rpm", Size: 2838271, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "acl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 201225, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "16.el7", Arch: "x86_64", SourceRpm: "ustr-1.0.4-16.el7.src.rpm", Size: 285943, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "24.el7", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-24.el7.src.rpm", Size: 3534490, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "hardlink", Version: "1.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "hardlink-1.0-19.el7.src.rpm", Size: 16545, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "util-linux", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 8642769, License: "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kpartx", Version: "0.4.9", Release: "119.el7_5.1", Arch: "x86_64", SourceRpm: "device-mapper-multipath-0.4.9-119.el7_5.1.src.rpm", Size: 41363, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper-libs", Version: "1.02.146", Release: "4.el7", Arch: "x86_64", SourceRpm: "lvm2-2.02.177-4.el7.src.rpm", Size: 400551, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dracut", Version: "033", Release: "535.el7_5.1", Arch: "x86_64", SourceRpm: "dracut-033-535.el7_5.1.src.rpm", Size: 898198, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.10.24", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-7.el7.src.rpm", Size: 362584, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-default-yama-scope", Version: "0.170", Release: "4.el7", Arch: "noarch", SourceRpm: "elfutils-0.170-4.el7.src.rpm", Size: 1810, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-glib", Version: "0.100", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-glib-0.100-7.el7.src.rpm", Size: 301237, License: "AFL and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.7.5", Release: "69.el7_5", Arch: "x86_64", SourceRpm: "python-2.7.5-69.el7_5.src.rpm", Size: 24713084, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2-python", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1503050, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-gobject-base", Version: "3.22.0", Release: "1.el7_4.1", Arch: "x86_64", SourceRpm: "pygobject3-3.22.0-1.el7_4.1.src.rpm", Size: 1123114, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.4", Release: "10.el7", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.4-10.el7.src.rpm", Size: 58789, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-19.el7.src.rpm", Size: 241513, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.4", Release: "9.el7", Arch: "noarch", SourceRpm: "python-iniparse-0.4-9.el7.src.rpm", Size: 115166, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-chardet", Version: "2.2.1", Release: "1.el7_1", Arch: "noarch", SourceRpm: "python-chardet-2.2.1-1.el7_1.src.rpm", Size: 1156541, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hostname", Version: "3.13", Release: "3.el7", Arch: "x86_64", SourceRpm: "hostname-3.13-3.el7.src.rpm", Size: 19449, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "23.el7", Arch: "x86_64", SourceRpm: "pth-2.0.7-23.el7.src.rpm", Size: 267851, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.3.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "gpgme-1.3.2-5.el7.src.rpm", Size: 547534, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 53895, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-license", Version: "9.9.4", Release: "61.el7_5.1", Arch: "noarch", SourceRpm: "bind-9.9.4-61.el7_5.1.src.rpm", Size: 26831, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 22399, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.79", Release: "4.el7", Arch: "x86_64", SourceRpm: "passwd-0.79-4.el7.src.rpm", Size: 429874, License: "BSD or GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "11.el7", Arch: "noarch", SourceRpm: "rootfiles-8.1-11.el7.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 179328, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 14346480, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 913443, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 611536, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 793087, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmpc", Version: "1.0.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "libmpc-1.0.1-3.el7.src.rpm", Size: 113833, License: "LGPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck", Version: "1.4.1", Release: "6.el7", Arch: "x86_64", SourceRpm: "fipscheck-1.4.1-6.el7.src.rpm", Size: 38839, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgomp", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 212184, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "m4", Version: "1.4.16", Release: "10.el7", Arch: "x86_64", SourceRpm: "m4-1.4.16-10.el7.src.rpm", Size: 525707, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util", Version: "1.5.2", Release: "6.el7", Arch: "x86_64", SourceRpm: "apr-util-1.5.2-6.el7.src.rpm", Size: 198751, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "groff-base", Version: "1.22.2", Release: "8.el7", Arch: "x86_64", SourceRpm: "groff-1.22.2-8.el7.src.rpm", Size: 3453946, License: "GPLv3+ and GFDL and BSD and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libunistring", Version: "0.9.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "libunistring-0.9.3-9.el7.src.rpm", Size: 1145761, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcroco", Version: "0.6.12", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcroco-0.6.12-4.el7.src.rpm", Size: 320955, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patch", Version: "2.7.1", Release: "10.el7_5", Arch: "x86_64", SourceRpm: "patch-2.7.1-10.el7_5.src.rpm", Size: 215449, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zip", Version: "3.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "zip-3.0-11.el7.src.rpm", Size: 815173, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 5029340, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTTP-Tiny", Version: "0.033", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-HTTP-Tiny-0.033-3.el7.src.rpm", Size: 97210, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Perldoc", Version: "3.20", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Perldoc-3.20-4.el7.src.rpm", Size: 166910, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Text-ParseWords", Version: "3.29", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Text-ParseWords-3.29-4.el7.src.rpm", Size: 16431, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Usage", Version: "1.63", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Pod-Usage-1.63-3.el7.src.rpm", Size: 44671, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Socket", Version: "2.010", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-Socket-2.010-4.el7.src.rpm", Size: 114497, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-Time-HiRes", Version: "1.9725", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Time-HiRes-1.9725-3.el7.src.rpm", Size: 94069, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Carp", Version: "1.26", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-Carp-1.26-244.el7.src.rpm", Size: 28276, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Exporter", Version: "5.68", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Exporter-5.68-3.el7.src.rpm", Size: 56612, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Time-Local", Version: "1.2300", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Time-Local-1.2300-2.el7.src.rpm", Size: 44062, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads-shared", Version: "1.43", Release: "6.el7", Arch: "x86_64", SourceRpm: "perl-threads-shared-1.43-6.el7.src.rpm", Size: 73972, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Path", Version: "2.09", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-File-Path-2.09-2.el7.src.rpm", Size: 50067, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Scalar-List-Utils", Version: "1.27", Release: "248.el7", Arch: "x86_64", SourceRpm: "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", Size: 67994, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Getopt-Long", Version: "2.40", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Getopt-Long-2.40-3.el7.src.rpm", Size: 134846, License: "GPLv2+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Thread-Queue", Version: "3.02", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Thread-Queue-3.02-2.el7.src.rpm", Size: 27642, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-TermReadKey", Version: "2.30", Release: "20.el7", Arch: "x86_64", SourceRpm: "perl-TermReadKey-2.30-20.el7.src.rpm", Size: 59999, License: "(Copyright only) and (Artistic or GPL+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "autoconf", Version: "2.69", Release: "11.el7", Arch: "noarch", SourceRpm: "autoconf-2.69-11.el7.src.rpm", Size: 2320371, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Test-Harness", Version: "3.28", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Test-Harness-3.28-3.el7.src.rpm", Size: 607239, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-debug-devel", Version: "3.10.0", Release: "957.10.1.el7", Arch: "x86_64", SourceRpm: "kernel-3.10.0-957.10.1.el7.src.rpm", Size: 39270753, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "boost-thread", Version: "1.53.0", Release: "27.el7", Arch: "x86_64", SourceRpm: "boost-1.53.0-27.el7.src.rpm", Size: 95658, License: "Boost and MIT and Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh", Version: "7.4p1", Release: "16.el7", Arch: "x86_64", SourceRpm: "openssh-7.4p1-16.el7.src.rpm", Size: 1995364, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 811817, License: "GPLv3+ and (GPLv2+ or LGPLv3+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 24440903, License: "LGPLv2+ and MIT and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rsync", Version: "3.1.2", Release: "4.el7", Arch: "x86_64", SourceRpm: "rsync-3.1.2-4.el7.src.rpm", Size: 834618, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file", Version: "5.11", Release: "35.el7", Arch: "x86_64", SourceRpm: "file-5.11-35.el7.src.rpm", Size: 67448, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dwz", Version: "0.11", Release: "3.el7", Arch: "x86_64", SourceRpm: "dwz-0.11-3.el7.src.rpm", Size: 225103, License: "GPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dyninst", Version: "9.3.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "dyninst-9.3.1-2.el7.src.rpm", Size: 13049000, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++-devel", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 8436804, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
 	}
 
 	// docker run --rm -it centos/httpd-24-centos7 bash
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
 	CentOS7Httpd24 = []PackageInfo{
-		{intRef(32), "bind-license", "9.9.4", "73.el7_6", "noarch", "bind-9.9.4-73.el7_6.src.rpm", 26831, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.2.46", "31.el7", "x86_64", "bash-4.2.46-31.el7.src.rpm", 3667773, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.17", "260.el7_6.3", "x86_64", "glibc-2.17-260.el7_6.3.src.rpm", 120499441, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 565628, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "3.2", "25.el7", "x86_64", "filesystem-3.2-25.el7.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.17", "260.el7_6.3", "x86_64", "glibc-2.17-260.el7_6.3.src.rpm", 14346456, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el7_5", "x86_64", "nspr-4.19.0-1.el7_5.src.rpm", 287728, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "16.el7", "x86_64", "popt-1.13-16.el7.src.rpm", 88516, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.42.9", "13.el7", "x86_64", "e2fsprogs-1.42.9-13.el7.src.rpm", 60489, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.22", "9.el7", "x86_64", "libcap-2.22-9.el7.src.rpm", 111445, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.8.5", "36.el7", "x86_64", "gcc-4.8.5-36.el7.src.rpm", 1077442, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "5.1", "5.el7", "x86_64", "texinfo-5.1-5.el7.src.rpm", 494630, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "4.0.2", "4.el7_3.1", "x86_64", "gawk-4.0.2-4.el7_3.1.src.rpm", 2435978, "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.5", "14.1.el7", "x86_64", "libselinux-2.5-14.1.el7.src.rpm", 217874, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "3.el7", "x86_64", "grep-2.20-3.el7.src.rpm", 1195131, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.5.8", "3.el7", "x86_64", "keyutils-1.5.8-3.el7.src.rpm", 42138, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libverto", "0.2.5", "4.el7", "x86_64", "libverto-0.2.5-4.el7.src.rpm", 23060, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 437261, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.15.1", "37.el7_6", "x86_64", "krb5-1.15.1-37.el7_6.src.rpm", 2200985, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 239967, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 1858008, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.12", "3.el7", "x86_64", "libgpg-error-1.12-3.el7.src.rpm", 350865, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.5.3", "14.el7", "x86_64", "libgcrypt-1.5.3-14.el7.src.rpm", 597727, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "15.el7", "x86_64", "lua-5.1.4-15.el7.src.rpm", 640319, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.23.2", "59.el7", "x86_64", "util-linux-2.23.2-59.el7.src.rpm", 20326, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmount", "2.23.2", "59.el7", "x86_64", "util-linux-2.23.2-59.el7.src.rpm", 278173, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "1.8", "4.el7", "x86_64", "shared-mime-info-1.8-4.el7.src.rpm", 2379317, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.5", "10.el7", "x86_64", "gzip-1.5-10.el7.src.rpm", 250440, "GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.5.11", "6.el7", "x86_64", "findutils-4.5.11-6.el7.src.rpm", 1855882, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "3.3", "4.el7", "x86_64", "diffutils-3.3-4.el7.src.rpm", 1065157, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.1.0", "10.el7_3", "x86_64", "expat-2.1.0-10.el7_3.src.rpm", 208315, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.8.4", "4.el7", "x86_64", "audit-2.8.4-4.el7.src.rpm", 256362, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.8", "22.el7", "x86_64", "pam-1.1.8-22.el7.src.rpm", 2630324, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 1130670, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "7.1.el7_6", "x86_64", "nss-3.36.0-7.1.el7_6.src.rpm", 2425024, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libassuan", "2.1.0", "3.el7", "x86_64", "libassuan-2.1.0-3.el7.src.rpm", 155391, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "7.1.el7_6", "x86_64", "nss-3.36.0-7.1.el7_6.src.rpm", 2069587, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gobject-introspection", "1.56.1", "1.el7", "x86_64", "gobject-introspection-1.56.1-1.el7.src.rpm", 854207, "GPLv2+, LGPLv2+, MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb-utils", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 326487, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod-libs", "20", "23.el7", "x86_64", "kmod-20-23.el7.src.rpm", 91848, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.3", "12.el7", "x86_64", "libssh2-1.4.3-12.el7.src.rpm", 341886, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.29.0", "51.el7", "x86_64", "curl-7.29.0-51.el7.src.rpm", 540293, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 2622266, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.60", "9.el7", "x86_64", "libuser-0.60-9.el7.src.rpm", 1952592, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.26", "35.el7", "x86_64", "tar-1.26-35.el7.src.rpm", 2838510, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "acl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 201225, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsmartcols", "2.23.2", "59.el7", "x86_64", "util-linux-2.23.2-59.el7.src.rpm", 164608, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.5", "14.el7", "x86_64", "libsemanage-2.5-14.el7.src.rpm", 302329, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.6", "4.el7", "x86_64", "libutempter-1.1.6-4.el7.src.rpm", 49749, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "qrencode-libs", "3.4.1", "3.el7", "x86_64", "qrencode-3.4.1-3.el7.src.rpm", 126732, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.7.5", "76.el7", "x86_64", "python-2.7.5-76.el7.src.rpm", 24713067, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-gobject-base", "3.22.0", "1.el7_4.1", "x86_64", "pygobject3-3.22.0-1.el7_4.1.src.rpm", 1123114, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyliblzma", "0.5.3", "11.el7", "x86_64", "pyliblzma-0.5.3-11.el7.src.rpm", 190112, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.10", "9.el7", "noarch", "python-urlgrabber-3.10-9.el7.src.rpm", 503349, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyxattr", "0.5.1", "5.el7", "x86_64", "pyxattr-0.5.1-5.el7.src.rpm", 63304, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-kitchen", "1.1.1", "5.el7", "noarch", "python-kitchen-1.1.1-5.el7.src.rpm", 1465161, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "json-c", "0.11", "4.el7_0", "x86_64", "json-c-0.11-4.el7_0.src.rpm", 65593, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps-ng", "3.3.10", "23.el7", "x86_64", "procps-ng-3.3.10-23.el7.src.rpm", 760565, "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper", "1.02.149", "10.el7_6.3", "x86_64", "lvm2-2.02.180-10.el7_6.3.src.rpm", 339987, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cryptsetup-libs", "2.0.3", "3.el7", "x86_64", "cryptsetup-2.0.3-3.el7.src.rpm", 1219591, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod", "20", "23.el7", "x86_64", "kmod-20-23.el7.src.rpm", 243559, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd-libs", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 1263456, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 24440903, "LGPLv2+ and MIT and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-default-yama-scope", "0.172", "2.el7", "noarch", "elfutils-0.172-2.el7.src.rpm", 1810, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iputils", "20160308", "10.el7", "x86_64", "iputils-20160308-10.el7.src.rpm", 343497, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-glib", "0.100", "7.el7", "x86_64", "dbus-glib-0.100-7.el7.src.rpm", 301237, "AFL and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "23.el7", "x86_64", "pth-2.0.7-23.el7.src.rpm", 267851, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 166776, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.3.2", "5.el7", "x86_64", "gpgme-1.3.2-5.el7.src.rpm", 547534, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.31", "50.el7", "noarch", "yum-utils-1.1.31-50.el7.src.rpm", 53895, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-utils", "1.1.31", "50.el7", "noarch", "yum-utils-1.1.31-50.el7.src.rpm", 345277, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.79", "4.el7", "x86_64", "passwd-0.79-4.el7.src.rpm", 429874, "BSD or GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.160", "5.el7", "x86_64", "vim-7.4.160-5.el7.src.rpm", 917744, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "f4a80eb5", "53a7ff4b", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lzo", "2.06", "8.el7", "x86_64", "lzo-2.06-8.el7.src.rpm", 165360, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcroco", "0.6.12", "4.el7", "x86_64", "libcroco-0.6.12-4.el7.src.rpm", 320955, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libarchive", "3.1.2", "10.el7_2", "x86_64", "libarchive-3.1.2-10.el7_2.src.rpm", 745291, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 5029340, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "groff-base", "1.22.2", "8.el7", "x86_64", "groff-1.22.2-8.el7.src.rpm", 3453946, "GPLv3+ and GFDL and BSD and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release-scl-rh", "2", "3.el7.centos", "noarch", "centos-release-scl-rh-2-3.el7.centos.src.rpm", 20015, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "epel-release", "7", "11", "noarch", "epel-release-7-11.src.rpm", 24834, "GPLv2", "Fedora Project", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "352c64e5", "52ae6884", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr", "1.4.8", "3.el7_4.1", "x86_64", "apr-1.4.8-3.el7_4.1.src.rpm", 226686, "ASL 2.0 and BSD with advertising and ISC and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtool-ltdl", "2.4.2", "22.el7_3", "x86_64", "libtool-2.4.2-22.el7_3.src.rpm", 67814, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util", "1.5.2", "6.el7", "x86_64", "apr-util-1.5.2-6.el7.src.rpm", 198751, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-libs", "9.9.4", "73.el7_6", "x86_64", "bind-9.9.4-73.el7_6.src.rpm", 2720960, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lasso", "2.5.1", "2.el7", "x86_64", "lasso-2.5.1-2.el7.src.rpm", 570546, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTTP-Tiny", "0.033", "3.el7", "noarch", "perl-HTTP-Tiny-0.033-3.el7.src.rpm", 97210, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Perldoc", "3.20", "4.el7", "noarch", "perl-Pod-Perldoc-3.20-4.el7.src.rpm", 166910, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Text-ParseWords", "3.29", "4.el7", "noarch", "perl-Text-ParseWords-3.29-4.el7.src.rpm", 16431, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Usage", "1.63", "3.el7", "noarch", "perl-Pod-Usage-1.63-3.el7.src.rpm", 44671, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-macros", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 5134, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Exporter", "5.68", "3.el7", "noarch", "perl-Exporter-5.68-3.el7.src.rpm", 56612, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Time-Local", "1.2300", "2.el7", "noarch", "perl-Time-Local-1.2300-2.el7.src.rpm", 44062, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Carp", "1.26", "244.el7", "noarch", "perl-Carp-1.26-244.el7.src.rpm", 28276, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads-shared", "1.43", "6.el7", "x86_64", "perl-threads-shared-1.43-6.el7.src.rpm", 73972, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Scalar-List-Utils", "1.27", "248.el7", "x86_64", "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", 67994, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Temp", "0.23.01", "3.el7", "noarch", "perl-File-Temp-0.23.01-3.el7.src.rpm", 158781, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Filter", "1.49", "3.el7", "x86_64", "perl-Filter-1.49-3.el7.src.rpm", 148475, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Getopt-Long", "2.40", "3.el7", "noarch", "perl-Getopt-Long-2.40-3.el7.src.rpm", 134846, "GPLv2+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "make", "3.82", "23.el7", "x86_64", "make-3.82-23.el7.src.rpm", 1160684, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcgroup", "0.41", "20.el7", "x86_64", "libcgroup-0.41-20.el7.src.rpm", 137210, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "jansson", "2.10", "1.el7", "x86_64", "jansson-2.10-1.el7.src.rpm", 74998, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-utils", "2.5", "14.1.el7", "x86_64", "libselinux-2.5-14.1.el7.src.rpm", 172645, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage-python", "2.5", "14.el7", "x86_64", "libsemanage-2.5-14.el7.src.rpm", 451817, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-IPy", "0.75", "6.el7", "noarch", "python-IPy-0.75-6.el7.src.rpm", 121946, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-logos", "70.0.6", "3.el7.centos", "noarch", "centos-logos-70.0.6-3.el7.centos.src.rpm", 22548401, "Copyright © 2014 The CentOS Project.  All rights reserved.", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs-python", "2.8.4", "4.el7", "x86_64", "audit-2.8.4-4.el7.src.rpm", 327265, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-runtime", "1.1", "18.el7", "x86_64", "httpd24-1.1-18.el7.src.rpm", 20989, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-libnghttp2", "1.7.1", "7.el7", "x86_64", "httpd24-nghttp2-1.7.1-7.el7.src.rpm", 142738, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-httpd", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 4739300, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "httpd24-mod_ssl", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 241612, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss_wrapper", "1.1.5", "1.el7", "x86_64", "nss_wrapper-1.1.5-1.el7.src.rpm", 75009, "BSD", "Fedora Project", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.8.5", "36.el7", "x86_64", "gcc-4.8.5-36.el7.src.rpm", 179328, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata", "2018i", "1.el7", "noarch", "tzdata-2018i-1.el7.src.rpm", 2007607, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.9", "14.20130511.el7_4", "noarch", "ncurses-5.9-14.20130511.el7_4.src.rpm", 223432, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.7.4", "1.el7", "x86_64", "chkconfig-1.7.4-1.el7.src.rpm", 779531, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 439378, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setup", "2.8.71", "10.el7", "noarch", "setup-2.8.71-10.el7.src.rpm", 696893, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "7.el7.centos", "noarch", "basesystem-10.0-7.el7.centos.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.7", "18.el7", "x86_64", "zlib-1.2.7-18.el7.src.rpm", 185294, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.1.el7_6", "x86_64", "nss-util-3.36.0-1.1.el7_6.src.rpm", 199008, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.13", "18.el7", "x86_64", "libffi-3.0.13-18.el7.src.rpm", 47766, "MIT and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.46", "13.el7", "x86_64", "attr-2.4.46-13.el7.src.rpm", 19896, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 37056, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 1028216, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.5", "10.el7", "x86_64", "libsepol-2.5-10.el7.src.rpm", 686640, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "8.32", "17.el7", "x86_64", "pcre-8.32-17.el7.src.rpm", 1475532, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.2", "5.el7", "x86_64", "sed-4.2.2-5.el7.src.rpm", 601208, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 1337825, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "gmp", "6.0.0", "15.el7", "x86_64", "gmp-6.0.0-15.el7.src.rpm", 657046, "LGPLv3+ or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "4.10", "1.el7", "x86_64", "libtasn1-4.10-1.el7.src.rpm", 424486, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "70.0.el7_5", "noarch", "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", 973960, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.22", "23.el7", "x86_64", "coreutils-8.22-23.el7.src.rpm", 14588674, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "7", "6.1810.2.el7.centos", "x86_64", "centos-release-7-6.1810.2.el7.centos.src.rpm", 41271, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 70093, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 913443, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1710062, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.2", "10.el7", "x86_64", "readline-6.2-10.el7.src.rpm", 460464, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.11", "27.el7", "x86_64", "cpio-2.11-27.el7.src.rpm", 689335, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.23.2", "59.el7", "x86_64", "util-linux-2.23.2-59.el7.src.rpm", 265973, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.56.1", "2.el7", "x86_64", "glib2-2.56.1-2.el7.src.rpm", 12166425, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.7.17", "8.el7", "x86_64", "sqlite-3.7.17-8.el7.src.rpm", 814231, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 209610, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.28", "4.el7", "x86_64", "libidn-1.28-4.el7.src.rpm", 630407, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap-ng", "0.7.5", "4.el7", "x86_64", "libcap-ng-0.7.5-4.el7.src.rpm", 50510, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 9389116, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpwquality", "1.2.3", "5.el7", "x86_64", "libpwquality-1.2.3-5.el7.src.rpm", 332421, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-pem", "1.0.3", "5.el7", "x86_64", "nss-pem-1.0.3-5.el7.src.rpm", 205539, "MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "7.1.el7_6", "x86_64", "nss-3.36.0-7.1.el7_6.src.rpm", 14067, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 798130, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.11", "35.el7", "x86_64", "file-5.11-35.el7.src.rpm", 3076831, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lz4", "1.7.5", "2.el7", "x86_64", "lz4-1.7.5-2.el7.src.rpm", 366872, "GPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.27.1", "4.el7", "x86_64", "pkgconfig-0.27.1-4.el7.src.rpm", 105522, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.26", "23.el7", "x86_64", "cyrus-sasl-2.1.26-23.el7.src.rpm", 396911, "BSD with advertising", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.27", "34.base.el7", "x86_64", "binutils-2.27-34.base.el7.src.rpm", 25150000, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.29.0", "51.el7", "x86_64", "curl-7.29.0-51.el7.src.rpm", 435192, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 611536, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.44", "21.el7_6", "x86_64", "openldap-2.4.44-21.el7_6.src.rpm", 1037424, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.8.1", "17.el7", "x86_64", "pinentry-0.8.1-17.el7.src.rpm", 159929, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "16.el7", "x86_64", "ustr-1.0.4-16.el7.src.rpm", 285943, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "25.el7", "x86_64", "shadow-utils-4.1.5.1-25.el7.src.rpm", 3540592, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "hardlink", "1.0", "19.el7", "x86_64", "hardlink-1.0-19.el7.src.rpm", 16545, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.10", "8.el7", "x86_64", "gdbm-1.10-8.el7.src.rpm", 184322, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.7.5", "76.el7", "x86_64", "python-2.7.5-76.el7.src.rpm", 80907, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2-python", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1503050, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.4", "10.el7", "x86_64", "yum-metadata-parser-1.1.4-10.el7.src.rpm", 58789, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "19.el7", "x86_64", "python-pycurl-7.19.0-19.el7.src.rpm", 241513, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.4", "9.el7", "noarch", "python-iniparse-0.4-9.el7.src.rpm", 115166, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-chardet", "2.2.1", "1.el7_1", "noarch", "python-chardet-2.2.1-1.el7_1.src.rpm", 1156541, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hostname", "3.13", "3.el7", "x86_64", "hostname-3.13-3.el7.src.rpm", 19449, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "util-linux", "2.23.2", "59.el7", "x86_64", "util-linux-2.23.2-59.el7.src.rpm", 8447471, "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kpartx", "0.4.9", "123.el7", "x86_64", "device-mapper-multipath-0.4.9-123.el7.src.rpm", 41363, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper-libs", "1.02.149", "10.el7_6.3", "x86_64", "lvm2-2.02.180-10.el7_6.3.src.rpm", 400607, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dracut", "033", "554.el7", "x86_64", "dracut-033-554.el7.src.rpm", 903230, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libs", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 793087, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.10.24", "12.el7", "x86_64", "dbus-1.10.24-12.el7.src.rpm", 362560, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus", "1.10.24", "12.el7", "x86_64", "dbus-1.10.24-12.el7.src.rpm", 595210, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-python", "1.1.1", "9.el7", "x86_64", "dbus-python-1.1.1-9.el7.src.rpm", 848122, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.22", "5.el7_5", "x86_64", "gnupg2-2.0.22-5.el7_5.src.rpm", 6637796, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 149898, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.3", "9.el7", "x86_64", "pygpgme-0.3-9.el7.src.rpm", 197501, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.4.3", "161.el7.centos", "noarch", "yum-3.4.3-161.el7.centos.src.rpm", 5824869, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.31", "50.el7", "noarch", "yum-utils-1.1.31-50.el7.src.rpm", 22399, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "11.el7", "noarch", "rootfiles-8.1-11.el7.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgomp", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 212184, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libunistring", "0.9.3", "9.el7", "x86_64", "libunistring-0.9.3-9.el7.src.rpm", 1145761, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-libs", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 1546952, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bsdtar", "3.1.2", "10.el7_2", "x86_64", "libarchive-3.1.2-10.el7_2.src.rpm", 110459, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "unzip", "6.0", "19.el7", "x86_64", "unzip-6.0-19.el7.src.rpm", 373986, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "scl-utils", "20130529", "19.el7", "x86_64", "scl-utils-20130529-19.el7.src.rpm", 25141, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release-scl", "2", "3.el7.centos", "noarch", "centos-release-scl-2-3.el7.centos.src.rpm", 20042, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "f2ee9d55", "560cfc0a", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl-libs", "1.0.2k", "16.el7_6.1", "x86_64", "openssl-1.0.2k-16.el7_6.1.src.rpm", 3204708, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxslt", "1.1.28", "5.el7", "x86_64", "libxslt-1.1.28-5.el7.src.rpm", 497582, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xmlsec1", "1.2.20", "7.el7_4", "x86_64", "xmlsec1-1.2.20-7.el7_4.src.rpm", 568638, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "GeoIP", "1.5.0", "13.el7", "x86_64", "GeoIP-1.5.0-13.el7.src.rpm", 3912607, "LGPLv2+ and GPLv2+ and CC-BY-SA", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xmlsec1-openssl", "1.2.20", "7.el7_4", "x86_64", "xmlsec1-1.2.20-7.el7_4.src.rpm", 245128, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-parent", "0.225", "244.el7", "noarch", "perl-parent-0.225-244.el7.src.rpm", 8141, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-podlators", "2.5.1", "3.el7", "noarch", "perl-podlators-2.5.1-3.el7.src.rpm", 287679, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Escapes", "1.04", "294.el7_6", "noarch", "perl-5.16.3-294.el7_6.src.rpm", 21091, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Encode", "2.51", "7.el7", "x86_64", "perl-Encode-2.51-7.el7.src.rpm", 10176350, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-libs", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 1647344, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads", "1.87", "4.el7", "x86_64", "perl-threads-1.87-4.el7.src.rpm", 98615, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-constant", "1.27", "2.el7", "noarch", "perl-constant-1.27-2.el7.src.rpm", 26364, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Socket", "2.010", "4.el7", "x86_64", "perl-Socket-2.010-4.el7.src.rpm", 114497, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-Time-HiRes", "1.9725", "3.el7", "x86_64", "perl-Time-HiRes-1.9725-3.el7.src.rpm", 94069, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-PathTools", "3.40", "5.el7", "x86_64", "perl-PathTools-3.40-5.el7.src.rpm", 174131, "(GPL+ or Artistic) and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Storable", "2.45", "3.el7", "x86_64", "perl-Storable-2.45-3.el7.src.rpm", 181031, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Path", "2.09", "2.el7", "noarch", "perl-File-Path-2.09-2.el7.src.rpm", 50067, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Simple", "3.28", "4.el7", "noarch", "perl-Pod-Simple-3.28-4.el7.src.rpm", 538320, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 23552128, "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl", "1.0.2k", "16.el7_6.1", "x86_64", "openssl-1.0.2k-16.el7_6.1.src.rpm", 833657, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cmake", "2.8.12.2", "2.el7", "x86_64", "cmake-2.8.12.2-2.el7.src.rpm", 28036363, "BSD and MIT and zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-python", "2.5", "14.1.el7", "x86_64", "libselinux-2.5-14.1.el7.src.rpm", 603236, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "policycoreutils", "2.5", "29.el7_6.1", "x86_64", "policycoreutils-2.5-29.el7_6.1.src.rpm", 5321515, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setools-libs", "3.3.8", "4.el7", "x86_64", "setools-3.3.8-4.el7.src.rpm", 1917790, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "checkpolicy", "2.5", "8.el7", "x86_64", "checkpolicy-2.5-8.el7.src.rpm", 1288327, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mailcap", "2.1.41", "2.el7", "noarch", "mailcap-2.1.41-2.el7.src.rpm", 63360, "Public Domain and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "policycoreutils-python", "2.5", "29.el7_6.1", "x86_64", "policycoreutils-2.5-29.el7_6.1.src.rpm", 1299284, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-libcurl", "7.61.1", "1.el7", "x86_64", "httpd24-curl-7.61.1-1.el7.src.rpm", 545704, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-httpd-tools", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 177148, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-mod_auth_mellon", "0.13.1", "2.el7", "x86_64", "httpd24-mod_auth_mellon-0.13.1-2.el7.src.rpm", 1108011, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24", "1.1", "18.el7", "x86_64", "httpd24-1.1-18.el7.src.rpm", 0, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-utils", "9.9.4", "73.el7_6", "x86_64", "bind-9.9.4-73.el7_6.src.rpm", 441412, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(32), Name: "bind-license", Version: "9.9.4", Release: "73.el7_6", Arch: "noarch", SourceRpm: "bind-9.9.4-73.el7_6.src.rpm", Size: 26831, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.2.46", Release: "31.el7", Arch: "x86_64", SourceRpm: "bash-4.2.46-31.el7.src.rpm", Size: 3667773, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.17", Release: "260.el7_6.3", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.3.src.rpm", Size: 120499441, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 565628, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "3.2", Release: "25.el7", Arch: "x86_64", SourceRpm: "filesystem-3.2-25.el7.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.17", Release: "260.el7_6.3", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.3.src.rpm", Size: 14346456, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el7_5.src.rpm", Size: 287728, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "16.el7", Arch: "x86_64", SourceRpm: "popt-1.13-16.el7.src.rpm", Size: 88516, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.42.9", Release: "13.el7", Arch: "x86_64", SourceRpm: "e2fsprogs-1.42.9-13.el7.src.rpm", Size: 60489, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.22", Release: "9.el7", Arch: "x86_64", SourceRpm: "libcap-2.22-9.el7.src.rpm", Size: 111445, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.8.5", Release: "36.el7", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7.src.rpm", Size: 1077442, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "texinfo-5.1-5.el7.src.rpm", Size: 494630, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "4.0.2", Release: "4.el7_3.1", Arch: "x86_64", SourceRpm: "gawk-4.0.2-4.el7_3.1.src.rpm", Size: 2435978, License: "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.5", Release: "14.1.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-14.1.el7.src.rpm", Size: 217874, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "3.el7", Arch: "x86_64", SourceRpm: "grep-2.20-3.el7.src.rpm", Size: 1195131, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.5.8", Release: "3.el7", Arch: "x86_64", SourceRpm: "keyutils-1.5.8-3.el7.src.rpm", Size: 42138, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libverto", Version: "0.2.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libverto-0.2.5-4.el7.src.rpm", Size: 23060, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 437261, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.15.1", Release: "37.el7_6", Arch: "x86_64", SourceRpm: "krb5-1.15.1-37.el7_6.src.rpm", Size: 2200985, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 239967, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 1858008, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "libgpg-error-1.12-3.el7.src.rpm", Size: 350865, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.5.3", Release: "14.el7", Arch: "x86_64", SourceRpm: "libgcrypt-1.5.3-14.el7.src.rpm", Size: 597727, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "15.el7", Arch: "x86_64", SourceRpm: "lua-5.1.4-15.el7.src.rpm", Size: 640319, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.23.2", Release: "59.el7", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7.src.rpm", Size: 20326, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmount", Version: "2.23.2", Release: "59.el7", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7.src.rpm", Size: 278173, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "1.8", Release: "4.el7", Arch: "x86_64", SourceRpm: "shared-mime-info-1.8-4.el7.src.rpm", Size: 2379317, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "gzip-1.5-10.el7.src.rpm", Size: 250440, License: "GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.5.11", Release: "6.el7", Arch: "x86_64", SourceRpm: "findutils-4.5.11-6.el7.src.rpm", Size: 1855882, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "3.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "diffutils-3.3-4.el7.src.rpm", Size: 1065157, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.1.0", Release: "10.el7_3", Arch: "x86_64", SourceRpm: "expat-2.1.0-10.el7_3.src.rpm", Size: 208315, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.8.4", Release: "4.el7", Arch: "x86_64", SourceRpm: "audit-2.8.4-4.el7.src.rpm", Size: 256362, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.8", Release: "22.el7", Arch: "x86_64", SourceRpm: "pam-1.1.8-22.el7.src.rpm", Size: 2630324, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 1130670, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "7.1.el7_6", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.1.el7_6.src.rpm", Size: 2425024, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libassuan", Version: "2.1.0", Release: "3.el7", Arch: "x86_64", SourceRpm: "libassuan-2.1.0-3.el7.src.rpm", Size: 155391, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "7.1.el7_6", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.1.el7_6.src.rpm", Size: 2069587, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gobject-introspection", Version: "1.56.1", Release: "1.el7", Arch: "x86_64", SourceRpm: "gobject-introspection-1.56.1-1.el7.src.rpm", Size: 854207, License: "GPLv2+, LGPLv2+, MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb-utils", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 326487, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod-libs", Version: "20", Release: "23.el7", Arch: "x86_64", SourceRpm: "kmod-20-23.el7.src.rpm", Size: 91848, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.3", Release: "12.el7", Arch: "x86_64", SourceRpm: "libssh2-1.4.3-12.el7.src.rpm", Size: 341886, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.29.0", Release: "51.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-51.el7.src.rpm", Size: 540293, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 2622266, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.60", Release: "9.el7", Arch: "x86_64", SourceRpm: "libuser-0.60-9.el7.src.rpm", Size: 1952592, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.26", Release: "35.el7", Arch: "x86_64", SourceRpm: "tar-1.26-35.el7.src.rpm", Size: 2838510, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "acl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 201225, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsmartcols", Version: "2.23.2", Release: "59.el7", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7.src.rpm", Size: 164608, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.5", Release: "14.el7", Arch: "x86_64", SourceRpm: "libsemanage-2.5-14.el7.src.rpm", Size: 302329, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.6", Release: "4.el7", Arch: "x86_64", SourceRpm: "libutempter-1.1.6-4.el7.src.rpm", Size: 49749, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "qrencode-libs", Version: "3.4.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "qrencode-3.4.1-3.el7.src.rpm", Size: 126732, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.7.5", Release: "76.el7", Arch: "x86_64", SourceRpm: "python-2.7.5-76.el7.src.rpm", Size: 24713067, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-gobject-base", Version: "3.22.0", Release: "1.el7_4.1", Arch: "x86_64", SourceRpm: "pygobject3-3.22.0-1.el7_4.1.src.rpm", Size: 1123114, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyliblzma", Version: "0.5.3", Release: "11.el7", Arch: "x86_64", SourceRpm: "pyliblzma-0.5.3-11.el7.src.rpm", Size: 190112, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.10", Release: "9.el7", Arch: "noarch", SourceRpm: "python-urlgrabber-3.10-9.el7.src.rpm", Size: 503349, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyxattr", Version: "0.5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "pyxattr-0.5.1-5.el7.src.rpm", Size: 63304, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-kitchen", Version: "1.1.1", Release: "5.el7", Arch: "noarch", SourceRpm: "python-kitchen-1.1.1-5.el7.src.rpm", Size: 1465161, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "json-c", Version: "0.11", Release: "4.el7_0", Arch: "x86_64", SourceRpm: "json-c-0.11-4.el7_0.src.rpm", Size: 65593, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps-ng", Version: "3.3.10", Release: "23.el7", Arch: "x86_64", SourceRpm: "procps-ng-3.3.10-23.el7.src.rpm", Size: 760565, License: "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper", Version: "1.02.149", Release: "10.el7_6.3", Arch: "x86_64", SourceRpm: "lvm2-2.02.180-10.el7_6.3.src.rpm", Size: 339987, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cryptsetup-libs", Version: "2.0.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "cryptsetup-2.0.3-3.el7.src.rpm", Size: 1219591, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod", Version: "20", Release: "23.el7", Arch: "x86_64", SourceRpm: "kmod-20-23.el7.src.rpm", Size: 243559, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd-libs", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 1263456, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 24440903, License: "LGPLv2+ and MIT and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-default-yama-scope", Version: "0.172", Release: "2.el7", Arch: "noarch", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 1810, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iputils", Version: "20160308", Release: "10.el7", Arch: "x86_64", SourceRpm: "iputils-20160308-10.el7.src.rpm", Size: 343497, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-glib", Version: "0.100", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-glib-0.100-7.el7.src.rpm", Size: 301237, License: "AFL and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "23.el7", Arch: "x86_64", SourceRpm: "pth-2.0.7-23.el7.src.rpm", Size: 267851, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 166776, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.3.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "gpgme-1.3.2-5.el7.src.rpm", Size: 547534, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.31", Release: "50.el7", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-50.el7.src.rpm", Size: 53895, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-utils", Version: "1.1.31", Release: "50.el7", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-50.el7.src.rpm", Size: 345277, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.79", Release: "4.el7", Arch: "x86_64", SourceRpm: "passwd-0.79-4.el7.src.rpm", Size: 429874, License: "BSD or GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.160", Release: "5.el7", Arch: "x86_64", SourceRpm: "vim-7.4.160-5.el7.src.rpm", Size: 917744, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "f4a80eb5", Release: "53a7ff4b", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lzo", Version: "2.06", Release: "8.el7", Arch: "x86_64", SourceRpm: "lzo-2.06-8.el7.src.rpm", Size: 165360, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcroco", Version: "0.6.12", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcroco-0.6.12-4.el7.src.rpm", Size: 320955, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libarchive", Version: "3.1.2", Release: "10.el7_2", Arch: "x86_64", SourceRpm: "libarchive-3.1.2-10.el7_2.src.rpm", Size: 745291, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 5029340, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "groff-base", Version: "1.22.2", Release: "8.el7", Arch: "x86_64", SourceRpm: "groff-1.22.2-8.el7.src.rpm", Size: 3453946, License: "GPLv3+ and GFDL and BSD and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release-scl-rh", Version: "2", Release: "3.el7.centos", Arch: "noarch", SourceRpm: "centos-release-scl-rh-2-3.el7.centos.src.rpm", Size: 20015, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "epel-release", Version: "7", Release: "11", Arch: "noarch", SourceRpm: "epel-release-7-11.src.rpm", Size: 24834, License: "GPLv2", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "352c64e5", Release: "52ae6884", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr", Version: "1.4.8", Release: "3.el7_4.1", Arch: "x86_64", SourceRpm: "apr-1.4.8-3.el7_4.1.src.rpm", Size: 226686, License: "ASL 2.0 and BSD with advertising and ISC and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtool-ltdl", Version: "2.4.2", Release: "22.el7_3", Arch: "x86_64", SourceRpm: "libtool-2.4.2-22.el7_3.src.rpm", Size: 67814, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util", Version: "1.5.2", Release: "6.el7", Arch: "x86_64", SourceRpm: "apr-util-1.5.2-6.el7.src.rpm", Size: 198751, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-libs", Version: "9.9.4", Release: "73.el7_6", Arch: "x86_64", SourceRpm: "bind-9.9.4-73.el7_6.src.rpm", Size: 2720960, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lasso", Version: "2.5.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "lasso-2.5.1-2.el7.src.rpm", Size: 570546, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTTP-Tiny", Version: "0.033", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-HTTP-Tiny-0.033-3.el7.src.rpm", Size: 97210, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Perldoc", Version: "3.20", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Perldoc-3.20-4.el7.src.rpm", Size: 166910, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Text-ParseWords", Version: "3.29", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Text-ParseWords-3.29-4.el7.src.rpm", Size: 16431, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Usage", Version: "1.63", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Pod-Usage-1.63-3.el7.src.rpm", Size: 44671, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-macros", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 5134, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Exporter", Version: "5.68", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Exporter-5.68-3.el7.src.rpm", Size: 56612, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Time-Local", Version: "1.2300", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Time-Local-1.2300-2.el7.src.rpm", Size: 44062, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Carp", Version: "1.26", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-Carp-1.26-244.el7.src.rpm", Size: 28276, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads-shared", Version: "1.43", Release: "6.el7", Arch: "x86_64", SourceRpm: "perl-threads-shared-1.43-6.el7.src.rpm", Size: 73972, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Scalar-List-Utils", Version: "1.27", Release: "248.el7", Arch: "x86_64", SourceRpm: "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", Size: 67994, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Temp", Version: "0.23.01", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-File-Temp-0.23.01-3.el7.src.rpm", Size: 158781, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Filter", Version: "1.49", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Filter-1.49-3.el7.src.rpm", Size: 148475, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Getopt-Long", Version: "2.40", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Getopt-Long-2.40-3.el7.src.rpm", Size: 134846, License: "GPLv2+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "make", Version: "3.82", Release: "23.el7", Arch: "x86_64", SourceRpm: "make-3.82-23.el7.src.rpm", Size: 1160684, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcgroup", Version: "0.41", Release: "20.el7", Arch: "x86_64", SourceRpm: "libcgroup-0.41-20.el7.src.rpm", Size: 137210, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "jansson", Version: "2.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "jansson-2.10-1.el7.src.rpm", Size: 74998, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-utils", Version: "2.5", Release: "14.1.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-14.1.el7.src.rpm", Size: 172645, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage-python", Version: "2.5", Release: "14.el7", Arch: "x86_64", SourceRpm: "libsemanage-2.5-14.el7.src.rpm", Size: 451817, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-IPy", Version: "0.75", Release: "6.el7", Arch: "noarch", SourceRpm: "python-IPy-0.75-6.el7.src.rpm", Size: 121946, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-logos", Version: "70.0.6", Release: "3.el7.centos", Arch: "noarch", SourceRpm: "centos-logos-70.0.6-3.el7.centos.src.rpm", Size: 22548401, License: "Copyright © 2014 The CentOS Project.  All rights reserved.", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs-python", Version: "2.8.4", Release: "4.el7", Arch: "x86_64", SourceRpm: "audit-2.8.4-4.el7.src.rpm", Size: 327265, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-runtime", Version: "1.1", Release: "18.el7", Arch: "x86_64", SourceRpm: "httpd24-1.1-18.el7.src.rpm", Size: 20989, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-libnghttp2", Version: "1.7.1", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-nghttp2-1.7.1-7.el7.src.rpm", Size: 142738, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-httpd", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 4739300, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "httpd24-mod_ssl", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 241612, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss_wrapper", Version: "1.1.5", Release: "1.el7", Arch: "x86_64", SourceRpm: "nss_wrapper-1.1.5-1.el7.src.rpm", Size: 75009, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.8.5", Release: "36.el7", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7.src.rpm", Size: 179328, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018i", Release: "1.el7", Arch: "noarch", SourceRpm: "tzdata-2018i-1.el7.src.rpm", Size: 2007607, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.9", Release: "14.20130511.el7_4", Arch: "noarch", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 223432, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.7.4", Release: "1.el7", Arch: "x86_64", SourceRpm: "chkconfig-1.7.4-1.el7.src.rpm", Size: 779531, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 439378, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.71", Release: "10.el7", Arch: "noarch", SourceRpm: "setup-2.8.71-10.el7.src.rpm", Size: 696893, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "7.el7.centos", Arch: "noarch", SourceRpm: "basesystem-10.0-7.el7.centos.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.7", Release: "18.el7", Arch: "x86_64", SourceRpm: "zlib-1.2.7-18.el7.src.rpm", Size: 185294, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.1.el7_6", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.1.el7_6.src.rpm", Size: 199008, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.13", Release: "18.el7", Arch: "x86_64", SourceRpm: "libffi-3.0.13-18.el7.src.rpm", Size: 47766, License: "MIT and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.46", Release: "13.el7", Arch: "x86_64", SourceRpm: "attr-2.4.46-13.el7.src.rpm", Size: 19896, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 37056, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 1028216, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "libsepol-2.5-10.el7.src.rpm", Size: 686640, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "8.32", Release: "17.el7", Arch: "x86_64", SourceRpm: "pcre-8.32-17.el7.src.rpm", Size: 1475532, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "sed-4.2.2-5.el7.src.rpm", Size: 601208, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 1337825, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "gmp", Version: "6.0.0", Release: "15.el7", Arch: "x86_64", SourceRpm: "gmp-6.0.0-15.el7.src.rpm", Size: 657046, License: "LGPLv3+ or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "4.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "libtasn1-4.10-1.el7.src.rpm", Size: 424486, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "70.0.el7_5", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", Size: 973960, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.22", Release: "23.el7", Arch: "x86_64", SourceRpm: "coreutils-8.22-23.el7.src.rpm", Size: 14588674, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "7", Release: "6.1810.2.el7.centos", Arch: "x86_64", SourceRpm: "centos-release-7-6.1810.2.el7.centos.src.rpm", Size: 41271, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 70093, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 913443, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1710062, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.2", Release: "10.el7", Arch: "x86_64", SourceRpm: "readline-6.2-10.el7.src.rpm", Size: 460464, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.11", Release: "27.el7", Arch: "x86_64", SourceRpm: "cpio-2.11-27.el7.src.rpm", Size: 689335, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.23.2", Release: "59.el7", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7.src.rpm", Size: 265973, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.56.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "glib2-2.56.1-2.el7.src.rpm", Size: 12166425, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.7.17", Release: "8.el7", Arch: "x86_64", SourceRpm: "sqlite-3.7.17-8.el7.src.rpm", Size: 814231, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 209610, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.28", Release: "4.el7", Arch: "x86_64", SourceRpm: "libidn-1.28-4.el7.src.rpm", Size: 630407, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap-ng", Version: "0.7.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcap-ng-0.7.5-4.el7.src.rpm", Size: 50510, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 9389116, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpwquality", Version: "1.2.3", Release: "5.el7", Arch: "x86_64", SourceRpm: "libpwquality-1.2.3-5.el7.src.rpm", Size: 332421, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-pem", Version: "1.0.3", Release: "5.el7", Arch: "x86_64", SourceRpm: "nss-pem-1.0.3-5.el7.src.rpm", Size: 205539, License: "MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "7.1.el7_6", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.1.el7_6.src.rpm", Size: 14067, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 798130, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.11", Release: "35.el7", Arch: "x86_64", SourceRpm: "file-5.11-35.el7.src.rpm", Size: 3076831, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lz4", Version: "1.7.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "lz4-1.7.5-2.el7.src.rpm", Size: 366872, License: "GPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.27.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "pkgconfig-0.27.1-4.el7.src.rpm", Size: 105522, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.26", Release: "23.el7", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.26-23.el7.src.rpm", Size: 396911, License: "BSD with advertising", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.27", Release: "34.base.el7", Arch: "x86_64", SourceRpm: "binutils-2.27-34.base.el7.src.rpm", Size: 25150000, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.29.0", Release: "51.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-51.el7.src.rpm", Size: 435192, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 611536, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.44", Release: "21.el7_6", Arch: "x86_64", SourceRpm: "openldap-2.4.44-21.el7_6.src.rpm", Size: 1037424, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.8.1", Release: "17.el7", Arch: "x86_64", SourceRpm: "pinentry-0.8.1-17.el7.src.rpm", Size: 159929, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "16.el7", Arch: "x86_64", SourceRpm: "ustr-1.0.4-16.el7.src.rpm", Size: 285943, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "25.el7", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-25.el7.src.rpm", Size: 3540592, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "hardlink", Version: "1.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "hardlink-1.0-19.el7.src.rpm", Size: 16545, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.10", Release: "8.el7", Arch: "x86_64", SourceRpm: "gdbm-1.10-8.el7.src.rpm", Size: 184322, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.7.5", Release: "76.el7", Arch: "x86_64", SourceRpm: "python-2.7.5-76.el7.src.rpm", Size: 80907, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2-python", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1503050, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.4", Release: "10.el7", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.4-10.el7.src.rpm", Size: 58789, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-19.el7.src.rpm", Size: 241513, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.4", Release: "9.el7", Arch: "noarch", SourceRpm: "python-iniparse-0.4-9.el7.src.rpm", Size: 115166, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-chardet", Version: "2.2.1", Release: "1.el7_1", Arch: "noarch", SourceRpm: "python-chardet-2.2.1-1.el7_1.src.rpm", Size: 1156541, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hostname", Version: "3.13", Release: "3.el7", Arch: "x86_64", SourceRpm: "hostname-3.13-3.el7.src.rpm", Size: 19449, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "util-linux", Version: "2.23.2", Release: "59.el7", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7.src.rpm", Size: 8447471, License: "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kpartx", Version: "0.4.9", Release: "123.el7", Arch: "x86_64", SourceRpm: "device-mapper-multipath-0.4.9-123.el7.src.rpm", Size: 41363, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper-libs", Version: "1.02.149", Release: "10.el7_6.3", Arch: "x86_64", SourceRpm: "lvm2-2.02.180-10.el7_6.3.src.rpm", Size: 400607, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dracut", Version: "033", Release: "554.el7", Arch: "x86_64", SourceRpm: "dracut-033-554.el7.src.rpm", Size: 903230, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 793087, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.10.24", Release: "12.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-12.el7.src.rpm", Size: 362560, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus", Version: "1.10.24", Release: "12.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-12.el7.src.rpm", Size: 595210, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-python", Version: "1.1.1", Release: "9.el7", Arch: "x86_64", SourceRpm: "dbus-python-1.1.1-9.el7.src.rpm", Size: 848122, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.22", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "gnupg2-2.0.22-5.el7_5.src.rpm", Size: 6637796, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 149898, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "pygpgme-0.3-9.el7.src.rpm", Size: 197501, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.4.3", Release: "161.el7.centos", Arch: "noarch", SourceRpm: "yum-3.4.3-161.el7.centos.src.rpm", Size: 5824869, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.31", Release: "50.el7", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-50.el7.src.rpm", Size: 22399, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "11.el7", Arch: "noarch", SourceRpm: "rootfiles-8.1-11.el7.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgomp", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 212184, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libunistring", Version: "0.9.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "libunistring-0.9.3-9.el7.src.rpm", Size: 1145761, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-libs", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 1546952, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bsdtar", Version: "3.1.2", Release: "10.el7_2", Arch: "x86_64", SourceRpm: "libarchive-3.1.2-10.el7_2.src.rpm", Size: 110459, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "unzip", Version: "6.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "unzip-6.0-19.el7.src.rpm", Size: 373986, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "scl-utils", Version: "20130529", Release: "19.el7", Arch: "x86_64", SourceRpm: "scl-utils-20130529-19.el7.src.rpm", Size: 25141, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release-scl", Version: "2", Release: "3.el7.centos", Arch: "noarch", SourceRpm: "centos-release-scl-2-3.el7.centos.src.rpm", Size: 20042, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "f2ee9d55", Release: "560cfc0a", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl-libs", Version: "1.0.2k", Release: "16.el7_6.1", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-16.el7_6.1.src.rpm", Size: 3204708, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxslt", Version: "1.1.28", Release: "5.el7", Arch: "x86_64", SourceRpm: "libxslt-1.1.28-5.el7.src.rpm", Size: 497582, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xmlsec1", Version: "1.2.20", Release: "7.el7_4", Arch: "x86_64", SourceRpm: "xmlsec1-1.2.20-7.el7_4.src.rpm", Size: 568638, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "GeoIP", Version: "1.5.0", Release: "13.el7", Arch: "x86_64", SourceRpm: "GeoIP-1.5.0-13.el7.src.rpm", Size: 3912607, License: "LGPLv2+ and GPLv2+ and CC-BY-SA", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xmlsec1-openssl", Version: "1.2.20", Release: "7.el7_4", Arch: "x86_64", SourceRpm: "xmlsec1-1.2.20-7.el7_4.src.rpm", Size: 245128, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-parent", Version: "0.225", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-parent-0.225-244.el7.src.rpm", Size: 8141, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-podlators", Version: "2.5.1", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-podlators-2.5.1-3.el7.src.rpm", Size: 287679, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Escapes", Version: "1.04", Release: "294.el7_6", Arch: "noarch", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 21091, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Encode", Version: "2.51", Release: "7.el7", Arch: "x86_64", SourceRpm: "perl-Encode-2.51-7.el7.src.rpm", Size: 10176350, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-libs", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 1647344, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads", Version: "1.87", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-threads-1.87-4.el7.src.rpm", Size: 98615, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-constant", Version: "1.27", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-constant-1.27-2.el7.src.rpm", Size: 26364, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Socket", Version: "2.010", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-Socket-2.010-4.el7.src.rpm", Size: 114497, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-Time-HiRes", Version: "1.9725", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Time-HiRes-1.9725-3.el7.src.rpm", Size: 94069, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-PathTools", Version: "3.40", Release: "5.el7", Arch: "x86_64", SourceRpm: "perl-PathTools-3.40-5.el7.src.rpm", Size: 174131, License: "(GPL+ or Artistic) and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Storable", Version: "2.45", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Storable-2.45-3.el7.src.rpm", Size: 181031, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Path", Version: "2.09", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-File-Path-2.09-2.el7.src.rpm", Size: 50067, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Simple", Version: "3.28", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Simple-3.28-4.el7.src.rpm", Size: 538320, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 23552128, License: "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl", Version: "1.0.2k", Release: "16.el7_6.1", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-16.el7_6.1.src.rpm", Size: 833657, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cmake", Version: "2.8.12.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "cmake-2.8.12.2-2.el7.src.rpm", Size: 28036363, License: "BSD and MIT and zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-python", Version: "2.5", Release: "14.1.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-14.1.el7.src.rpm", Size: 603236, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "policycoreutils", Version: "2.5", Release: "29.el7_6.1", Arch: "x86_64", SourceRpm: "policycoreutils-2.5-29.el7_6.1.src.rpm", Size: 5321515, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setools-libs", Version: "3.3.8", Release: "4.el7", Arch: "x86_64", SourceRpm: "setools-3.3.8-4.el7.src.rpm", Size: 1917790, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "checkpolicy", Version: "2.5", Release: "8.el7", Arch: "x86_64", SourceRpm: "checkpolicy-2.5-8.el7.src.rpm", Size: 1288327, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mailcap", Version: "2.1.41", Release: "2.el7", Arch: "noarch", SourceRpm: "mailcap-2.1.41-2.el7.src.rpm", Size: 63360, License: "Public Domain and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "policycoreutils-python", Version: "2.5", Release: "29.el7_6.1", Arch: "x86_64", SourceRpm: "policycoreutils-2.5-29.el7_6.1.src.rpm", Size: 1299284, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-libcurl", Version: "7.61.1", Release: "1.el7", Arch: "x86_64", SourceRpm: "httpd24-curl-7.61.1-1.el7.src.rpm", Size: 545704, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-httpd-tools", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 177148, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-mod_auth_mellon", Version: "0.13.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "httpd24-mod_auth_mellon-0.13.1-2.el7.src.rpm", Size: 1108011, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24", Version: "1.1", Release: "18.el7", Arch: "x86_64", SourceRpm: "httpd24-1.1-18.el7.src.rpm", Size: 0, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-utils", Version: "9.9.4", Release: "73.el7_6", Arch: "x86_64", SourceRpm: "bind-9.9.4-73.el7_6.src.rpm", Size: 441412, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
 	}
 
 	// docker run --rm -it centos:7 bash
@@ -1256,899 +1256,1082 @@ var (
 	// yum install -y net-tools bc
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
 	CentOS7Many = []PackageInfo{
-		{intRef(), "gnutls", "3.3.29", "9.el7_6", "x86_64", "gnutls-3.3.29-9.el7_6.src.rpm", 2097819, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 565628, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh-clients", "7.4p1", "16.el7", "x86_64", "openssh-7.4p1-16.el7.src.rpm", 2651616, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "neon", "0.30.0", "3.el7", "x86_64", "neon-0.30.0-3.el7.src.rpm", 567772, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mokutil", "15", "2.el7.centos", "x86_64", "shim-signed-15-2.el7.centos.src.rpm", 83521, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "16.el7", "x86_64", "popt-1.13-16.el7.src.rpm", 88516, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-headers", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 2338328, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.22", "9.el7", "x86_64", "libcap-2.22-9.el7.src.rpm", 111445, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-devel", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 7910669, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 1028216, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-devel", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 1492653, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.5", "12.el7", "x86_64", "libselinux-2.5-12.el7.src.rpm", 217874, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-gfortran", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 16443248, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.5.8", "3.el7", "x86_64", "keyutils-1.5.8-3.el7.src.rpm", 42138, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "subversion", "1.7.14", "14.el7", "x86_64", "subversion-1.7.14-14.el7.src.rpm", 4844082, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 437261, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 149898, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "7", "5.1804.4.el7.centos", "x86_64", "centos-release-7-5.1804.4.el7.centos.src.rpm", 40338, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffstat", "1.57", "4.el7", "x86_64", "diffstat-1.57-4.el7.src.rpm", 54065, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 1858008, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "indent", "2.2.11", "13.el7", "x86_64", "indent-2.2.11-13.el7.src.rpm", 359131, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.5.3", "14.el7", "x86_64", "libgcrypt-1.5.3-14.el7.src.rpm", 597727, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libICE", "1.0.9", "9.el7", "x86_64", "libICE-1.0.9-9.el7.src.rpm", 152213, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 20326, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "cups-libs", "1.6.3", "35.el7", "x86_64", "cups-1.6.3-35.el7.src.rpm", 734207, "LGPLv2 and zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "1.8", "4.el7", "x86_64", "shared-mime-info-1.8-4.el7.src.rpm", 2379317, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "atk", "2.28.1", "1.el7", "x86_64", "atk-2.28.1-1.el7.src.rpm", 1273762, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.5.11", "5.el7", "x86_64", "findutils-4.5.11-5.el7.src.rpm", 1855626, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dejavu-fonts-common", "2.33", "6.el7", "noarch", "dejavu-fonts-2.33-6.el7.src.rpm", 130455, "Bitstream Vera and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.1.0", "10.el7_3", "x86_64", "expat-2.1.0-10.el7_3.src.rpm", 208315, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libfontenc", "1.1.3", "3.el7", "x86_64", "libfontenc-1.1.3-3.el7.src.rpm", 55701, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.8", "22.el7", "x86_64", "pam-1.1.8-22.el7.src.rpm", 2630324, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-filesystem", "7.4.160", "5.el7", "x86_64", "vim-7.4.160-5.el7.src.rpm", 0, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 2424993, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libusbx", "1.0.21", "1.el7", "x86_64", "libusbx-1.0.21-1.el7.src.rpm", 143429, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "opensc", "0.16.0", "10.20170227git777e2a3.el7", "x86_64", "opensc-0.16.0-10.20170227git777e2a3.el7.src.rpm", 3260615, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "poppler-data", "0.4.6", "3.el7", "noarch", "poppler-data-0.4.6-3.el7.src.rpm", 12013394, "BSD and GPLv2 and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hicolor-icon-theme", "0.12", "7.el7", "noarch", "hicolor-icon-theme-0.12-7.el7.src.rpm", 45788, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11-common", "1.6.5", "2.el7", "noarch", "libX11-1.6.5-2.el7.src.rpm", 1335662, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lynx", "2.8.8", "0.3.dev15.el7", "x86_64", "lynx-2.8.8-0.3.dev15.el7.src.rpm", 5618384, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdrm", "2.4.91", "3.el7", "x86_64", "libdrm-2.4.91-3.el7.src.rpm", 371903, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "docbook-style-xsl", "1.78.1", "3.el7", "noarch", "docbook-style-xsl-1.78.1-3.el7.src.rpm", 16671587, "DMIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXext", "1.3.3", "3.el7", "x86_64", "libXext-1.3.3-3.el7.src.rpm", 87102, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.6", "4.el7", "x86_64", "libutempter-1.1.6-4.el7.src.rpm", 49749, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXt", "1.1.5", "3.el7", "x86_64", "libXt-1.1.5-3.el7.src.rpm", 430105, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper", "1.02.146", "4.el7", "x86_64", "lvm2-2.02.177-4.el7.src.rpm", 338922, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXaw", "1.0.13", "4.el7", "x86_64", "libXaw-1.0.13-4.el7.src.rpm", 498789, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXcursor", "1.1.15", "1.el7", "x86_64", "libXcursor-1.1.15-1.el7.src.rpm", 47039, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXinerama", "1.1.3", "2.1.el7", "x86_64", "libXinerama-1.1.3-2.1.el7.src.rpm", 14967, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus", "1.10.24", "7.el7", "x86_64", "dbus-1.10.24-7.el7.src.rpm", 595223, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXcomposite", "0.4.4", "4.1.el7", "x86_64", "libXcomposite-0.4.4-4.1.el7.src.rpm", 35184, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.10", "8.el7", "x86_64", "gdbm-1.10-8.el7.src.rpm", 184322, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fribidi", "1.0.2", "1.el7", "x86_64", "fribidi-1.0.2-1.el7.src.rpm", 309831, "LGPLv2+ and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-python", "1.1.1", "9.el7", "x86_64", "dbus-python-1.1.1-9.el7.src.rpm", 848122, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtool-ltdl", "2.4.2", "22.el7_3", "x86_64", "libtool-2.4.2-22.el7_3.src.rpm", 67814, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-ExtUtils-MakeMaker", "6.68", "3.el7", "noarch", "perl-ExtUtils-MakeMaker-6.68-3.el7.src.rpm", 650532, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyliblzma", "0.5.3", "11.el7", "x86_64", "pyliblzma-0.5.3-11.el7.src.rpm", 190112, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "asciidoc", "8.6.8", "5.el7", "noarch", "asciidoc-8.6.8-5.el7.src.rpm", 976747, "GPL+ and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyxattr", "0.5.1", "5.el7", "x86_64", "pyxattr-0.5.1-5.el7.src.rpm", 63304, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.7", "18.el7", "x86_64", "zlib-1.2.7-18.el7.src.rpm", 185294, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-javapackages", "3.4.1", "11.el7", "noarch", "javapackages-tools-3.4.1-11.el7.src.rpm", 66505, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.27", "34.base.el7", "x86_64", "binutils-2.27-34.base.el7.src.rpm", 25150000, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.4.3", "158.el7.centos", "noarch", "yum-3.4.3-158.el7.centos.src.rpm", 5814102, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXtst", "1.2.3", "1.el7", "x86_64", "libXtst-1.2.3-1.el7.src.rpm", 29938, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "alsa-lib", "1.1.6", "2.el7", "x86_64", "alsa-lib-1.1.6-2.el7.src.rpm", 1433094, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-utils", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 343422, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs-devel", "2.8.4", "4.el7", "x86_64", "audit-2.8.4-4.el7.src.rpm", 88847, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.160", "4.el7", "x86_64", "vim-7.4.160-4.el7.src.rpm", 917640, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.7.5", "77.el7_6", "x86_64", "python-2.7.5-77.el7_6.src.rpm", 24714266, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 120497983, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "slang-devel", "2.2.4", "11.el7", "x86_64", "slang-2.2.4-11.el7.src.rpm", 356429, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 2622266, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "newt-devel", "0.52.15", "4.el7", "x86_64", "newt-0.52.15-4.el7.src.rpm", 127322, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mpfr", "3.1.1", "4.el7", "x86_64", "mpfr-3.1.1-4.el7.src.rpm", 554279, "LGPLv3+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bc", "1.06.95", "13.el7", "x86_64", "bc-1.06.95-13.el7.src.rpm", 219830, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck-lib", "1.4.1", "6.el7", "x86_64", "fipscheck-1.4.1-6.el7.src.rpm", 11466, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libquadmath", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 282811, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "unzip", "6.0", "19.el7", "x86_64", "unzip-6.0-19.el7.src.rpm", 373986, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-libs", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 1546952, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cryptsetup-libs", "2.0.3", "3.el7", "x86_64", "cryptsetup-2.0.3-3.el7.src.rpm", 1219591, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-podlators", "2.5.1", "3.el7", "noarch", "perl-podlators-2.5.1-3.el7.src.rpm", 287679, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Encode", "2.51", "7.el7", "x86_64", "perl-Encode-2.51-7.el7.src.rpm", 10176350, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Storable", "2.45", "3.el7", "x86_64", "perl-Storable-2.45-3.el7.src.rpm", 181031, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Filter", "1.49", "3.el7", "x86_64", "perl-Filter-1.49-3.el7.src.rpm", 148475, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-macros", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 5134, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-PathTools", "3.40", "5.el7", "x86_64", "perl-PathTools-3.40-5.el7.src.rpm", 174131, "(GPL+ or Artistic) and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 23552128, "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Data-Dumper", "2.145", "3.el7", "x86_64", "perl-Data-Dumper-2.145-3.el7.src.rpm", 99287, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "automake", "1.13.4", "3.el7", "noarch", "automake-1.13.4-3.el7.src.rpm", 1731909, "GPLv2+ and GFDL and Public Domain and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgfortran", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 1186168, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd-libs", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 1263456, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd-sysv", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 3979, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "boost-date-time", "1.53.0", "27.el7", "x86_64", "boost-1.53.0-27.el7.src.rpm", 139706, "Boost and MIT and Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmodman", "2.0.1", "8.el7", "x86_64", "libmodman-2.0.1-8.el7.src.rpm", 58871, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nettle", "2.7.1", "8.el7", "x86_64", "nettle-2.7.1-8.el7.src.rpm", 765042, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.9", "14.20130511.el7_4", "noarch", "ncurses-5.9-14.20130511.el7_4.src.rpm", 223432, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdb", "7.6.1", "114.el7", "x86_64", "gdb-7.6.1-114.el7.src.rpm", 7371507, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and BSD and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.2.46", "30.el7", "x86_64", "bash-4.2.46-30.el7.src.rpm", 3667709, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libedit", "3.0", "12.20121213cvs.el7", "x86_64", "libedit-3.0-12.20121213cvs.el7.src.rpm", 244257, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.7.4", "1.el7", "x86_64", "chkconfig-1.7.4-1.el7.src.rpm", 779531, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Git", "1.8.3.1", "20.el7", "noarch", "git-1.8.3.1-20.el7.src.rpm", 58567, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setup", "2.8.71", "9.el7", "noarch", "setup-2.8.71-9.el7.src.rpm", 696925, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pakchois", "0.4", "10.el7", "x86_64", "pakchois-0.4-10.el7.src.rpm", 29489, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "7.el7.centos", "noarch", "basesystem-10.0-7.el7.centos.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "subversion-libs", "1.7.14", "14.el7", "x86_64", "subversion-1.7.14-14.el7.src.rpm", 2615823, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "efivar-libs", "36", "11.el7_6.1", "x86_64", "efivar-36-11.el7_6.1.src.rpm", 252096, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.el7_5", "x86_64", "nss-util-3.36.0-1.el7_5.src.rpm", 194944, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-client", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 10146436, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.42.9", "12.el7_5", "x86_64", "e2fsprogs-1.42.9-12.el7_5.src.rpm", 60489, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-headers", "3.10.0", "957.10.1.el7", "x86_64", "kernel-3.10.0-957.10.1.el7.src.rpm", 3823832, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.46", "13.el7", "x86_64", "attr-2.4.46-13.el7.src.rpm", 19896, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-devel", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 1066078, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 37056, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libquadmath-devel", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 18514, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-srpm-macros", "1", "8.el7", "noarch", "perl-srpm-macros-1-8.el7.src.rpm", 794, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "5.1", "5.el7", "x86_64", "texinfo-5.1-5.el7.src.rpm", 494630, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-common-devel", "0.19.8.1", "2.el7", "noarch", "gettext-0.19.8.1-2.el7.src.rpm", 397912, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "8.32", "17.el7", "x86_64", "pcre-8.32-17.el7.src.rpm", 1475532, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "intltool", "0.50.2", "7.el7", "noarch", "intltool-0.50.2-7.el7.src.rpm", 170106, "GPLv2 with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.2", "5.el7", "x86_64", "sed-4.2.2-5.el7.src.rpm", 601208, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 206577, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 1337825, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtool", "2.4.2", "22.el7_3", "x86_64", "libtool-2.4.2-22.el7_3.src.rpm", 2323761, "GPLv2+ and LGPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "gmp", "6.0.0", "15.el7", "x86_64", "gmp-6.0.0-15.el7.src.rpm", 657046, "LGPLv3+ or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cscope", "15.8", "10.el7", "x86_64", "cscope-15.8-10.el7.src.rpm", 939744, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "4.10", "1.el7", "x86_64", "libtasn1-4.10-1.el7.src.rpm", 424486, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patchutils", "0.3.3", "4.el7", "x86_64", "patchutils-0.3.3-4.el7.src.rpm", 260135, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "70.0.el7_5", "noarch", "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", 973960, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bison", "3.0.4", "2.el7", "x86_64", "bison-3.0.4-2.el7.src.rpm", 2154174, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.22", "21.el7", "x86_64", "coreutils-8.22-21.el7.src.rpm", 14588989, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-sign", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 17397, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.15.1", "19.el7", "x86_64", "krb5-1.15.1-19.el7.src.rpm", 1984782, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "swig", "2.0.10", "5.el7", "x86_64", "swig-2.0.10-5.el7.src.rpm", 4926232, "GPLv3+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 70093, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rcs", "5.9.0", "5.el7", "x86_64", "rcs-5.9.0-5.el7.src.rpm", 624710, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ctags", "5.8", "13.el7", "x86_64", "ctags-5.8-13.el7.src.rpm", 359724, "GPLv2+ and LGPLv2+ and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1710062, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "libpng", "1.5.13", "7.el7_2", "x86_64", "libpng-1.5.13-7.el7_2.src.rpm", 616101, "zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.2", "10.el7", "x86_64", "readline-6.2-10.el7.src.rpm", 460464, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libjpeg-turbo", "1.2.90", "6.el7", "x86_64", "libjpeg-turbo-1.2.90-6.el7.src.rpm", 350499, "IJG", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.11", "27.el7", "x86_64", "cpio-2.11-27.el7.src.rpm", 689335, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libSM", "1.2.2", "2.el7", "x86_64", "libSM-1.2.2-2.el7.src.rpm", 81546, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 261837, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcsc-lite-libs", "1.8.8", "8.el7", "x86_64", "pcsc-lite-1.8.8-8.el7.src.rpm", 46708, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.54.2", "2.el7", "x86_64", "glib2-2.54.2-2.el7.src.rpm", 11986873, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "libglvnd", "1.0.1", "0.8.git5baa1e5.el7", "x86_64", "libglvnd-1.0.1-0.8.git5baa1e5.el7.src.rpm", 666456, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.7.17", "8.el7", "x86_64", "sqlite-3.7.17-8.el7.src.rpm", 814231, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xml-common", "0.6.3", "39.el7", "noarch", "sgml-common-0.6.3-39.el7.src.rpm", 46969, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 209610, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxslt", "1.1.28", "5.el7", "x86_64", "libxslt-1.1.28-5.el7.src.rpm", 497582, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.28", "4.el7", "x86_64", "libidn-1.28-4.el7.src.rpm", 630407, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libwayland-server", "1.15.0", "1.el7", "x86_64", "wayland-1.15.0-1.el7.src.rpm", 81084, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dejavu-sans-fonts", "2.33", "6.el7", "noarch", "dejavu-fonts-2.33-6.el7.src.rpm", 5395167, "Bitstream Vera and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap-ng", "0.7.5", "4.el7", "x86_64", "libcap-ng-0.7.5-4.el7.src.rpm", 50510, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "jasper-libs", "1.900.1", "33.el7", "x86_64", "jasper-1.900.1-33.el7.src.rpm", 350690, "JasPer", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 9389116, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "xorg-x11-font-utils", "7.5", "21.el7", "x86_64", "xorg-x11-font-utils-7.5-21.el7.src.rpm", 361874, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpwquality", "1.2.3", "5.el7", "x86_64", "libpwquality-1.2.3-5.el7.src.rpm", 332421, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ghostscript-fonts", "5.50", "32.el7", "noarch", "ghostscript-fonts-5.50-32.el7.src.rpm", 636425, "GPLv2+ and Hershey and MIT and OFL and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 14061, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lcms2", "2.6", "3.el7", "x86_64", "lcms2-2.6-3.el7.src.rpm", 377192, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-pem", "1.0.3", "4.el7", "x86_64", "nss-pem-1.0.3-4.el7.src.rpm", 201219, "MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtiff", "4.0.3", "27.el7_3", "x86_64", "libtiff-4.0.3-27.el7_3.src.rpm", 494377, "libtiff", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 798130, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcsc-lite-ccid", "1.4.10", "14.el7", "x86_64", "pcsc-lite-ccid-1.4.10-14.el7.src.rpm", 562872, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coolkey", "1.1.0", "40.el7", "x86_64", "coolkey-1.1.0-40.el7.src.rpm", 304556, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lz4", "1.7.5", "2.el7", "x86_64", "lz4-1.7.5-2.el7.src.rpm", 366872, "GPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libthai", "0.1.14", "9.el7", "x86_64", "libthai-0.1.14-9.el7.src.rpm", 704340, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-sdt-devel", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 178425, "GPLv2+ and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 2069571, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libicu", "50.1.2", "17.el7", "x86_64", "icu-50.1.2-17.el7.src.rpm", 25217861, "MIT and UCD and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "source-highlight", "3.1.6", "6.el7", "x86_64", "source-highlight-3.1.6-6.el7.src.rpm", 3024049, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gobject-introspection", "1.50.0", "1.el7", "x86_64", "gobject-introspection-1.50.0-1.el7.src.rpm", 834149, "GPLv2+, LGPLv2+, MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm-devel", "1.10", "8.el7", "x86_64", "gdbm-1.10-8.el7.src.rpm", 42866, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb-utils", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 326487, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-ExtUtils-Manifest", "1.61", "244.el7", "noarch", "perl-ExtUtils-Manifest-1.61-244.el7.src.rpm", 85359, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod-libs", "20", "21.el7", "x86_64", "kmod-20-21.el7.src.rpm", 91800, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "graphite2", "1.3.10", "1.el7_3", "x86_64", "graphite2-1.3.10-1.el7_3.src.rpm", 254548, "(LGPLv2+ or GPLv2+ or MPL) and (Netscape or GPLv2+ or LGPLv2+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.3", "10.el7_2.1", "x86_64", "libssh2-1.4.3-10.el7_2.1.src.rpm", 341782, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-indexhtml", "7", "9.el7.centos", "noarch", "centos-indexhtml-7-9.el7.centos.src.rpm", 92560, "Distributable", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.29.0", "46.el7", "x86_64", "curl-7.29.0-46.el7.src.rpm", 540259, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pixman", "0.34.0", "1.el7", "x86_64", "pixman-0.34.0-1.el7.src.rpm", 698744, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpciaccess", "0.14", "1.el7", "x86_64", "libpciaccess-0.14-1.el7.src.rpm", 45649, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.60", "9.el7", "x86_64", "libuser-0.60-9.el7.src.rpm", 1952592, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mesa-libgbm", "18.0.5", "4.el7_6", "x86_64", "mesa-18.0.5-4.el7_6.src.rpm", 58336, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.26", "34.el7", "x86_64", "tar-1.26-34.el7.src.rpm", 2838271, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "docbook-dtds", "1.0", "60.el7", "noarch", "docbook-dtds-1.0-60.el7.src.rpm", 4634764, "Copyright only", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "acl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 201225, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXau", "1.0.8", "2.1.el7", "x86_64", "libXau-1.0.8-2.1.el7.src.rpm", 55529, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "16.el7", "x86_64", "ustr-1.0.4-16.el7.src.rpm", 285943, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11", "1.6.5", "2.el7", "x86_64", "libX11-1.6.5-2.el7.src.rpm", 1325648, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "24.el7", "x86_64", "shadow-utils-4.1.5.1-24.el7.src.rpm", 3534490, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXrender", "0.9.10", "1.el7", "x86_64", "libXrender-0.9.10-1.el7.src.rpm", 46979, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "hardlink", "1.0", "19.el7", "x86_64", "hardlink-1.0-19.el7.src.rpm", 16545, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdk-pixbuf2", "2.36.12", "3.el7", "x86_64", "gdk-pixbuf2-2.36.12-3.el7.src.rpm", 2927994, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "util-linux", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 8642769, "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXpm", "3.5.12", "1.el7", "x86_64", "libXpm-3.5.12-1.el7.src.rpm", 116599, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kpartx", "0.4.9", "119.el7_5.1", "x86_64", "device-mapper-multipath-0.4.9-119.el7_5.1.src.rpm", 41363, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXdamage", "1.1.4", "4.1.el7", "x86_64", "libXdamage-1.1.4-4.1.el7.src.rpm", 29688, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper-libs", "1.02.146", "4.el7", "x86_64", "lvm2-2.02.177-4.el7.src.rpm", 400551, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gd", "2.0.35", "26.el7", "x86_64", "gd-2.0.35-26.el7.src.rpm", 554528, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dracut", "033", "535.el7_5.1", "x86_64", "dracut-033-535.el7_5.1.src.rpm", 898198, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gtk-update-icon-cache", "3.22.30", "3.el7", "x86_64", "gtk3-3.22.30-3.el7.src.rpm", 59878, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXrandr", "1.5.1", "2.el7", "x86_64", "libXrandr-1.5.1-2.el7.src.rpm", 48626, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.10.24", "7.el7", "x86_64", "dbus-1.10.24-7.el7.src.rpm", 362584, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXi", "1.7.9", "1.el7", "x86_64", "libXi-1.7.9-1.el7.src.rpm", 70019, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-default-yama-scope", "0.170", "4.el7", "noarch", "elfutils-0.170-4.el7.src.rpm", 1810, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXxf86vm", "1.1.4", "1.el7", "x86_64", "libXxf86vm-1.1.4-1.el7.src.rpm", 25680, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mesa-libGL", "18.0.5", "4.el7_6", "x86_64", "mesa-18.0.5-4.el7_6.src.rpm", 493744, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-glib", "0.100", "7.el7", "x86_64", "dbus-glib-0.100-7.el7.src.rpm", 301237, "AFL and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "libglvnd-egl", "1.0.1", "0.8.git5baa1e5.el7", "x86_64", "libglvnd-1.0.1-0.8.git5baa1e5.el7.src.rpm", 84096, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cairo", "1.15.12", "3.el7", "x86_64", "cairo-1.15.12-3.el7.src.rpm", 1894412, "LGPLv2 or MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pango", "1.42.4", "1.el7", "x86_64", "pango-1.42.4-1.el7.src.rpm", 755391, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2-python", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1503050, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gtk2", "2.24.31", "1.el7", "x86_64", "gtk2-2.24.31-1.el7.src.rpm", 13494501, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "graphviz", "2.30.1", "21.el7", "x86_64", "graphviz-2.30.1-21.el7.src.rpm", 4019006, "EPL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-gobject-base", "3.22.0", "1.el7_4.1", "x86_64", "pygobject3-3.22.0-1.el7_4.1.src.rpm", 1123114, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-ExtUtils-ParseXS", "3.18", "3.el7", "noarch", "perl-ExtUtils-ParseXS-3.18-3.el7.src.rpm", 178634, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.4", "10.el7", "x86_64", "yum-metadata-parser-1.1.4-10.el7.src.rpm", 58789, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-ExtUtils-Install", "1.58", "294.el7_6", "noarch", "perl-5.16.3-294.el7_6.src.rpm", 76408, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "19.el7", "x86_64", "python-pycurl-7.19.0-19.el7.src.rpm", 241513, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(0), "perl-ExtUtils-Embed", "1.30", "294.el7_6", "noarch", "perl-5.16.3-294.el7_6.src.rpm", 17136, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.4", "9.el7", "noarch", "python-iniparse-0.4-9.el7.src.rpm", 115166, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xmlto", "0.0.25", "7.el7", "x86_64", "xmlto-0.0.25-7.el7.src.rpm", 108322, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-chardet", "2.2.1", "1.el7_1", "noarch", "python-chardet-2.2.1-1.el7_1.src.rpm", 1156541, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hmaccalc", "0.9.13", "4.el7", "x86_64", "hmaccalc-0.9.13-4.el7.src.rpm", 102013, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hostname", "3.13", "3.el7", "x86_64", "hostname-3.13-3.el7.src.rpm", 19449, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib-devel", "1.2.7", "18.el7", "x86_64", "zlib-1.2.7-18.el7.src.rpm", 135536, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "23.el7", "x86_64", "pth-2.0.7-23.el7.src.rpm", 267851, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-lxml", "3.2.1", "4.el7", "x86_64", "python-lxml-3.2.1-4.el7.src.rpm", 2722705, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "javapackages-tools", "3.4.1", "11.el7", "noarch", "javapackages-tools-3.4.1-11.el7.src.rpm", 160151, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.3.2", "5.el7", "x86_64", "gpgme-1.3.2-5.el7.src.rpm", 547534, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xorg-x11-fonts-Type1", "7.5", "9.el7", "noarch", "xorg-x11-fonts-7.5-9.el7.src.rpm", 883992, "MIT and Lucida and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 53895, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-devel", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 168793, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-license", "9.9.4", "61.el7_5.1", "noarch", "bind-9.9.4-61.el7_5.1.src.rpm", 26831, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lksctp-tools", "1.0.17", "2.el7", "x86_64", "lksctp-tools-1.0.17-2.el7.src.rpm", 232487, "GPLv2 and GPLv2+ and LGPLv2 and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "copy-jdk-configs", "3.3", "10.el7_5", "noarch", "copy-jdk-configs-3.3-10.el7_5.src.rpm", 16264, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 22399, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.79", "4.el7", "x86_64", "passwd-0.79-4.el7.src.rpm", 429874, "BSD or GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "11.el7", "noarch", "rootfiles-8.1-11.el7.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "slang", "2.2.4", "11.el7", "x86_64", "slang-2.2.4-11.el7.src.rpm", 1881912, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 179328, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.17", "260.el7_6.4", "x86_64", "glibc-2.17-260.el7_6.4.src.rpm", 14346480, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 913443, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 611536, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libs", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 793087, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmpc", "1.0.1", "3.el7", "x86_64", "libmpc-1.0.1-3.el7.src.rpm", 113833, "LGPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck", "1.4.1", "6.el7", "x86_64", "fipscheck-1.4.1-6.el7.src.rpm", 38839, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "net-tools", "2.0", "0.24.20131004git.el7", "x86_64", "net-tools-2.0-0.24.20131004git.el7.src.rpm", 939930, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgomp", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 212184, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "m4", "1.4.16", "10.el7", "x86_64", "m4-1.4.16-10.el7.src.rpm", 525707, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util", "1.5.2", "6.el7", "x86_64", "apr-util-1.5.2-6.el7.src.rpm", 198751, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "groff-base", "1.22.2", "8.el7", "x86_64", "groff-1.22.2-8.el7.src.rpm", 3453946, "GPLv3+ and GFDL and BSD and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libunistring", "0.9.3", "9.el7", "x86_64", "libunistring-0.9.3-9.el7.src.rpm", 1145761, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcroco", "0.6.12", "4.el7", "x86_64", "libcroco-0.6.12-4.el7.src.rpm", 320955, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patch", "2.7.1", "10.el7_5", "x86_64", "patch-2.7.1-10.el7_5.src.rpm", 215449, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zip", "3.0", "11.el7", "x86_64", "zip-3.0-11.el7.src.rpm", 815173, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 5029340, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTTP-Tiny", "0.033", "3.el7", "noarch", "perl-HTTP-Tiny-0.033-3.el7.src.rpm", 97210, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Perldoc", "3.20", "4.el7", "noarch", "perl-Pod-Perldoc-3.20-4.el7.src.rpm", 166910, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Text-ParseWords", "3.29", "4.el7", "noarch", "perl-Text-ParseWords-3.29-4.el7.src.rpm", 16431, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Usage", "1.63", "3.el7", "noarch", "perl-Pod-Usage-1.63-3.el7.src.rpm", 44671, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Socket", "2.010", "4.el7", "x86_64", "perl-Socket-2.010-4.el7.src.rpm", 114497, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-Time-HiRes", "1.9725", "3.el7", "x86_64", "perl-Time-HiRes-1.9725-3.el7.src.rpm", 94069, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Carp", "1.26", "244.el7", "noarch", "perl-Carp-1.26-244.el7.src.rpm", 28276, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Exporter", "5.68", "3.el7", "noarch", "perl-Exporter-5.68-3.el7.src.rpm", 56612, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Time-Local", "1.2300", "2.el7", "noarch", "perl-Time-Local-1.2300-2.el7.src.rpm", 44062, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads-shared", "1.43", "6.el7", "x86_64", "perl-threads-shared-1.43-6.el7.src.rpm", 73972, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Path", "2.09", "2.el7", "noarch", "perl-File-Path-2.09-2.el7.src.rpm", 50067, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Scalar-List-Utils", "1.27", "248.el7", "x86_64", "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", 67994, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Getopt-Long", "2.40", "3.el7", "noarch", "perl-Getopt-Long-2.40-3.el7.src.rpm", 134846, "GPLv2+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Thread-Queue", "3.02", "2.el7", "noarch", "perl-Thread-Queue-3.02-2.el7.src.rpm", 27642, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-TermReadKey", "2.30", "20.el7", "x86_64", "perl-TermReadKey-2.30-20.el7.src.rpm", 59999, "(Copyright only) and (Artistic or GPL+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "autoconf", "2.69", "11.el7", "noarch", "autoconf-2.69-11.el7.src.rpm", 2320371, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Test-Harness", "3.28", "3.el7", "noarch", "perl-Test-Harness-3.28-3.el7.src.rpm", 607239, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-debug-devel", "3.10.0", "957.10.1.el7", "x86_64", "kernel-3.10.0-957.10.1.el7.src.rpm", 39270753, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "boost-thread", "1.53.0", "27.el7", "x86_64", "boost-1.53.0-27.el7.src.rpm", 95658, "Boost and MIT and Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh", "7.4p1", "16.el7", "x86_64", "openssh-7.4p1-16.el7.src.rpm", 1995364, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 811817, "GPLv3+ and (GPLv2+ or LGPLv3+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 24440903, "LGPLv2+ and MIT and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rsync", "3.1.2", "4.el7", "x86_64", "rsync-3.1.2-4.el7.src.rpm", 834618, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file", "5.11", "35.el7", "x86_64", "file-5.11-35.el7.src.rpm", 67448, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dwz", "0.11", "3.el7", "x86_64", "dwz-0.11-3.el7.src.rpm", 225103, "GPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dyninst", "9.3.1", "2.el7", "x86_64", "dyninst-9.3.1-2.el7.src.rpm", 13049000, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++-devel", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 8436804, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.8.4", "4.el7", "x86_64", "audit-2.8.4-4.el7.src.rpm", 256362, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "giflib", "4.1.6", "9.el7", "x86_64", "giflib-4.1.6-9.el7.src.rpm", 90124, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "java-1.8.0-openjdk-devel", "1.8.0.212.b04", "0.el7_6", "x86_64", "java-1.8.0-openjdk-1.8.0.212.b04-0.el7_6.src.rpm", 42212373, "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv2.0 and Public Domain and W3C and zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-devel", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 306300, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pciutils-libs", "3.5.1", "3.el7", "x86_64", "pciutils-3.5.1-3.el7.src.rpm", 72691, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.7.5", "77.el7_6", "x86_64", "python-2.7.5-77.el7_6.src.rpm", 80907, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "newt", "0.52.15", "4.el7", "x86_64", "newt-0.52.15-4.el7.src.rpm", 185196, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "numactl-devel", "2.0.9", "7.el7", "x86_64", "numactl-2.0.9-7.el7.src.rpm", 25174, "LGPLv2 and GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-devel", "2.7.5", "77.el7_6", "x86_64", "python-2.7.5-77.el7_6.src.rpm", 1104386, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-devel", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 2253171, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libproxy", "0.4.11", "11.el7", "x86_64", "libproxy-0.4.11-11.el7.src.rpm", 164014, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata", "2018e", "3.el7", "noarch", "tzdata-2018e-3.el7.src.rpm", 1966505, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "make", "3.82", "23.el7", "x86_64", "make-3.82-23.el7.src.rpm", 1160684, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 439378, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "git", "1.8.3.1", "20.el7", "x86_64", "git-1.8.3.1-20.el7.src.rpm", 23232282, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "3.2", "25.el7", "x86_64", "filesystem-3.2-25.el7.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 83791, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el7_5", "x86_64", "nspr-4.19.0-1.el7_5.src.rpm", 287728, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "emacs-filesystem", "24.3", "22.el7", "noarch", "emacs-24.3-22.el7.src.rpm", 0, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.13", "18.el7", "x86_64", "libffi-3.0.13-18.el7.src.rpm", 47766, "MIT and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 39238921, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.5", "8.1.el7", "x86_64", "libsepol-2.5-8.1.el7.src.rpm", 686568, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "redhat-rpm-config", "9.1.0", "87.el7.centos", "noarch", "redhat-rpm-config-9.1.0-87.el7.centos.src.rpm", 174572, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "4.0.2", "4.el7_3.1", "x86_64", "gawk-4.0.2-4.el7_3.1.src.rpm", 2435978, "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 328409, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "3.el7", "x86_64", "grep-2.20-3.el7.src.rpm", 1195131, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-c++", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 17257523, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libverto", "0.2.5", "4.el7", "x86_64", "libverto-0.2.5-4.el7.src.rpm", 23060, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "flex", "2.5.37", "6.el7", "x86_64", "flex-2.5.37-6.el7.src.rpm", 757468, "BSD and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl-libs", "1.0.2k", "12.el7", "x86_64", "openssl-1.0.2k-12.el7.src.rpm", 3200172, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "doxygen", "1.8.5", "3.el7", "x86_64", "doxygen-1.8.5-3.el7.src.rpm", 15389684, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 239967, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "byacc", "1.9.20130304", "3.el7", "x86_64", "byacc-1.9.20130304-3.el7.src.rpm", 135161, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.12", "3.el7", "x86_64", "libgpg-error-1.12-3.el7.src.rpm", 350865, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "freetype", "2.8", "12.el7_6.1", "x86_64", "freetype-2.8-12.el7_6.1.src.rpm", 824497, "(FTL or GPLv2+) and BSD and MIT and Public Domain and zlib with acknowledgement", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "15.el7", "x86_64", "lua-5.1.4-15.el7.src.rpm", 640319, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mesa-libglapi", "18.0.5", "4.el7_6", "x86_64", "mesa-18.0.5-4.el7_6.src.rpm", 204320, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmount", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 273965, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxshmfence", "1.2", "1.el7", "x86_64", "libxshmfence-1.2-1.el7.src.rpm", 11160, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.5", "10.el7", "x86_64", "gzip-1.5-10.el7.src.rpm", 250440, "GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fontpackages-filesystem", "1.44", "8.el7", "noarch", "fontpackages-1.44-8.el7.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "3.3", "4.el7", "x86_64", "diffutils-3.3-4.el7.src.rpm", 1065157, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fontconfig", "2.13.0", "4.3.el7", "x86_64", "fontconfig-2.13.0-4.3.el7.src.rpm", 666475, "MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "urw-fonts", "2.4", "16.el7", "noarch", "urw-fonts-2.4-16.el7.src.rpm", 4389400, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 1130670, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "jbigkit-libs", "2.0", "11.el7", "x86_64", "jbigkit-2.0-11.el7.src.rpm", 105882, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libassuan", "2.1.0", "3.el7", "x86_64", "libassuan-2.1.0-3.el7.src.rpm", 155391, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcsc-lite", "1.8.8", "8.el7", "x86_64", "pcsc-lite-1.8.8-8.el7.src.rpm", 634433, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyparsing", "1.5.6", "9.el7", "noarch", "pyparsing-1.5.6-9.el7.src.rpm", 375948, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "boost-regex", "1.53.0", "27.el7", "x86_64", "boost-1.53.0-27.el7.src.rpm", 2122578, "Boost and MIT and Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.27.1", "4.el7", "x86_64", "pkgconfig-0.27.1-4.el7.src.rpm", 105522, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libwayland-client", "1.15.0", "1.el7", "x86_64", "wayland-1.15.0-1.el7.src.rpm", 64076, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.26", "23.el7", "x86_64", "cyrus-sasl-2.1.26-23.el7.src.rpm", 396911, "BSD with advertising", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "harfbuzz", "1.7.5", "2.el7", "x86_64", "harfbuzz-1.7.5-2.el7.src.rpm", 709362, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.29.0", "46.el7", "x86_64", "curl-7.29.0-46.el7.src.rpm", 435192, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hwdata", "0.252", "9.1.el7", "x86_64", "hwdata-0.252-9.1.el7.src.rpm", 13726739, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.44", "15.el7_5", "x86_64", "openldap-2.4.44-15.el7_5.src.rpm", 1037299, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sgml-common", "0.6.3", "39.el7", "noarch", "sgml-common-0.6.3-39.el7.src.rpm", 170882, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.8.1", "17.el7", "x86_64", "pinentry-0.8.1-17.el7.src.rpm", 159929, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxcb", "1.13", "1.el7", "x86_64", "libxcb-1.13-1.el7.src.rpm", 1011680, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.5", "11.el7", "x86_64", "libsemanage-2.5-11.el7.src.rpm", 302369, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXfixes", "5.0.3", "1.el7", "x86_64", "libXfixes-5.0.3-1.el7.src.rpm", 26903, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "qrencode-libs", "3.4.1", "3.el7", "x86_64", "qrencode-3.4.1-3.el7.src.rpm", 126732, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXmu", "1.1.2", "2.el7", "x86_64", "libXmu-1.1.2-2.el7.src.rpm", 172054, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps-ng", "3.3.10", "17.el7_5.2", "x86_64", "procps-ng-3.3.10-17.el7_5.2.src.rpm", 760500, "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ghostscript", "9.07", "31.el7_6.10", "x86_64", "ghostscript-9.07-31.el7_6.10.src.rpm", 17357534, "AGPLv3+ and Redistributable, no modification permitted", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod", "20", "21.el7", "x86_64", "kmod-20-21.el7.src.rpm", 243127, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXft", "2.3.2", "2.el7", "x86_64", "libXft-2.3.2-2.el7.src.rpm", 125933, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "libglvnd-glx", "1.0.1", "0.8.git5baa1e5.el7", "x86_64", "libglvnd-1.0.1-0.8.git5baa1e5.el7.src.rpm", 657304, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iputils", "20160308", "10.el7", "x86_64", "iputils-20160308-10.el7.src.rpm", 343497, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mesa-libEGL", "18.0.5", "4.el7_6", "x86_64", "mesa-18.0.5-4.el7_6.src.rpm", 232401, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "librsvg2", "2.40.20", "1.el7", "x86_64", "librsvg2-2.40.20-1.el7.src.rpm", 308951, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb-devel", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 129076, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-devel", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 1930081, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.10", "8.el7", "noarch", "python-urlgrabber-3.10-8.el7.src.rpm", 500670, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pesign", "0.109", "10.el7", "x86_64", "pesign-0.109-10.el7.src.rpm", 368213, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-kitchen", "1.1.1", "5.el7", "noarch", "python-kitchen-1.1.1-5.el7.src.rpm", 1465161, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf-devel", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 32387, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.22", "5.el7_5", "x86_64", "gnupg2-2.0.22-5.el7_5.src.rpm", 6637796, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ttmkfdir", "3.0.9", "42.el7", "x86_64", "ttmkfdir-3.0.9-42.el7.src.rpm", 105418, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.3", "9.el7", "x86_64", "pygpgme-0.3-9.el7.src.rpm", 197501, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata-java", "2019a", "1.el7", "noarch", "tzdata-2019a-1.el7.src.rpm", 374277, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "java-1.8.0-openjdk-headless", "1.8.0.212.b04", "0.el7_6", "x86_64", "java-1.8.0-openjdk-1.8.0.212.b04-0.el7_6.src.rpm", 108711819, "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv2.0 and Public Domain and W3C and zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "java-1.8.0-openjdk", "1.8.0.212.b04", "0.el7_6", "x86_64", "java-1.8.0-openjdk-1.8.0.212.b04-0.el7_6.src.rpm", 541844, "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv2.0 and Public Domain and W3C and zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils-devel", "2.27", "34.base.el7", "x86_64", "binutils-2.27-34.base.el7.src.rpm", 4540848, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "f4a80eb5", "53a7ff4b", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pciutils", "3.5.1", "3.el7", "x86_64", "pciutils-3.5.1-3.el7.src.rpm", 200397, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 1077442, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "numactl-libs", "2.0.9", "7.el7", "x86_64", "numactl-2.0.9-7.el7.src.rpm", 50752, "LGPLv2 and GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.11", "35.el7", "x86_64", "file-5.11-35.el7.src.rpm", 3076831, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pciutils-devel", "3.5.1", "3.el7", "x86_64", "pciutils-3.5.1-3.el7.src.rpm", 78345, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 166776, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr", "1.4.8", "3.el7_4.1", "x86_64", "apr-1.4.8-3.el7_4.1.src.rpm", 226686, "ASL 2.0 and BSD with advertising and ISC and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "boost-system", "1.53.0", "27.el7", "x86_64", "boost-1.53.0-27.el7.src.rpm", 32538, "Boost and MIT and Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "avahi-libs", "0.6.31", "19.el7", "x86_64", "avahi-0.6.31-19.el7.src.rpm", 123808, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "json-c", "0.11", "4.el7_0", "x86_64", "json-c-0.11-4.el7_0.src.rpm", 65593, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-parent", "0.225", "244.el7", "noarch", "perl-parent-0.225-244.el7.src.rpm", 8141, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Escapes", "1.04", "294.el7_6", "noarch", "perl-5.16.3-294.el7_6.src.rpm", 21091, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-libs", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 1647344, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads", "1.87", "4.el7", "x86_64", "perl-threads-1.87-4.el7.src.rpm", 98615, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-constant", "1.27", "2.el7", "noarch", "perl-constant-1.27-2.el7.src.rpm", 26364, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Temp", "0.23.01", "3.el7", "noarch", "perl-File-Temp-0.23.01-3.el7.src.rpm", 158781, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Simple", "3.28", "4.el7", "noarch", "perl-Pod-Simple-3.28-4.el7.src.rpm", 538320, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Error", "0.17020", "2.el7", "noarch", "perl-Error-0.17020-2.el7.src.rpm", 50015, "(GPL+ or Artistic) and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-XML-Parser", "2.41", "10.el7", "x86_64", "perl-XML-Parser-2.41-10.el7.src.rpm", 642973, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "less", "458", "9.el7", "x86_64", "less-458-9.el7.src.rpm", 215376, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpp", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 15644861, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "trousers", "0.3.14", "2.el7", "x86_64", "trousers-0.3.14-2.el7.src.rpm", 836873, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdwarf", "20130207", "4.el7", "x86_64", "libdwarf-20130207-4.el7.src.rpm", 291679, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemtap-runtime", "3.3", "3.el7", "x86_64", "systemtap-3.3-3.el7.src.rpm", 1142954, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(), Name: "gnutls", Version: "3.3.29", Release: "9.el7_6", Arch: "x86_64", SourceRpm: "gnutls-3.3.29-9.el7_6.src.rpm", Size: 2097819, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 565628, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh-clients", Version: "7.4p1", Release: "16.el7", Arch: "x86_64", SourceRpm: "openssh-7.4p1-16.el7.src.rpm", Size: 2651616, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "neon", Version: "0.30.0", Release: "3.el7", Arch: "x86_64", SourceRpm: "neon-0.30.0-3.el7.src.rpm", Size: 567772, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mokutil", Version: "15", Release: "2.el7.centos", Arch: "x86_64", SourceRpm: "shim-signed-15-2.el7.centos.src.rpm", Size: 83521, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "16.el7", Arch: "x86_64", SourceRpm: "popt-1.13-16.el7.src.rpm", Size: 88516, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-headers", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 2338328, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.22", Release: "9.el7", Arch: "x86_64", SourceRpm: "libcap-2.22-9.el7.src.rpm", Size: 111445, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-devel", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 7910669, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 1028216, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-devel", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 1492653, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.5", Release: "12.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-12.el7.src.rpm", Size: 217874, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-gfortran", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 16443248, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.5.8", Release: "3.el7", Arch: "x86_64", SourceRpm: "keyutils-1.5.8-3.el7.src.rpm", Size: 42138, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "subversion", Version: "1.7.14", Release: "14.el7", Arch: "x86_64", SourceRpm: "subversion-1.7.14-14.el7.src.rpm", Size: 4844082, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 437261, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 149898, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "7", Release: "5.1804.4.el7.centos", Arch: "x86_64", SourceRpm: "centos-release-7-5.1804.4.el7.centos.src.rpm", Size: 40338, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffstat", Version: "1.57", Release: "4.el7", Arch: "x86_64", SourceRpm: "diffstat-1.57-4.el7.src.rpm", Size: 54065, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 1858008, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "indent", Version: "2.2.11", Release: "13.el7", Arch: "x86_64", SourceRpm: "indent-2.2.11-13.el7.src.rpm", Size: 359131, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.5.3", Release: "14.el7", Arch: "x86_64", SourceRpm: "libgcrypt-1.5.3-14.el7.src.rpm", Size: 597727, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libICE", Version: "1.0.9", Release: "9.el7", Arch: "x86_64", SourceRpm: "libICE-1.0.9-9.el7.src.rpm", Size: 152213, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 20326, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "cups-libs", Version: "1.6.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "cups-1.6.3-35.el7.src.rpm", Size: 734207, License: "LGPLv2 and zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "1.8", Release: "4.el7", Arch: "x86_64", SourceRpm: "shared-mime-info-1.8-4.el7.src.rpm", Size: 2379317, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "atk", Version: "2.28.1", Release: "1.el7", Arch: "x86_64", SourceRpm: "atk-2.28.1-1.el7.src.rpm", Size: 1273762, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.5.11", Release: "5.el7", Arch: "x86_64", SourceRpm: "findutils-4.5.11-5.el7.src.rpm", Size: 1855626, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dejavu-fonts-common", Version: "2.33", Release: "6.el7", Arch: "noarch", SourceRpm: "dejavu-fonts-2.33-6.el7.src.rpm", Size: 130455, License: "Bitstream Vera and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.1.0", Release: "10.el7_3", Arch: "x86_64", SourceRpm: "expat-2.1.0-10.el7_3.src.rpm", Size: 208315, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libfontenc", Version: "1.1.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "libfontenc-1.1.3-3.el7.src.rpm", Size: 55701, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.8", Release: "22.el7", Arch: "x86_64", SourceRpm: "pam-1.1.8-22.el7.src.rpm", Size: 2630324, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-filesystem", Version: "7.4.160", Release: "5.el7", Arch: "x86_64", SourceRpm: "vim-7.4.160-5.el7.src.rpm", Size: 0, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 2424993, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libusbx", Version: "1.0.21", Release: "1.el7", Arch: "x86_64", SourceRpm: "libusbx-1.0.21-1.el7.src.rpm", Size: 143429, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "opensc", Version: "0.16.0", Release: "10.20170227git777e2a3.el7", Arch: "x86_64", SourceRpm: "opensc-0.16.0-10.20170227git777e2a3.el7.src.rpm", Size: 3260615, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "poppler-data", Version: "0.4.6", Release: "3.el7", Arch: "noarch", SourceRpm: "poppler-data-0.4.6-3.el7.src.rpm", Size: 12013394, License: "BSD and GPLv2 and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hicolor-icon-theme", Version: "0.12", Release: "7.el7", Arch: "noarch", SourceRpm: "hicolor-icon-theme-0.12-7.el7.src.rpm", Size: 45788, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11-common", Version: "1.6.5", Release: "2.el7", Arch: "noarch", SourceRpm: "libX11-1.6.5-2.el7.src.rpm", Size: 1335662, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lynx", Version: "2.8.8", Release: "0.3.dev15.el7", Arch: "x86_64", SourceRpm: "lynx-2.8.8-0.3.dev15.el7.src.rpm", Size: 5618384, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdrm", Version: "2.4.91", Release: "3.el7", Arch: "x86_64", SourceRpm: "libdrm-2.4.91-3.el7.src.rpm", Size: 371903, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "docbook-style-xsl", Version: "1.78.1", Release: "3.el7", Arch: "noarch", SourceRpm: "docbook-style-xsl-1.78.1-3.el7.src.rpm", Size: 16671587, License: "DMIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXext", Version: "1.3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "libXext-1.3.3-3.el7.src.rpm", Size: 87102, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.6", Release: "4.el7", Arch: "x86_64", SourceRpm: "libutempter-1.1.6-4.el7.src.rpm", Size: 49749, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXt", Version: "1.1.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "libXt-1.1.5-3.el7.src.rpm", Size: 430105, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper", Version: "1.02.146", Release: "4.el7", Arch: "x86_64", SourceRpm: "lvm2-2.02.177-4.el7.src.rpm", Size: 338922, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXaw", Version: "1.0.13", Release: "4.el7", Arch: "x86_64", SourceRpm: "libXaw-1.0.13-4.el7.src.rpm", Size: 498789, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXcursor", Version: "1.1.15", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXcursor-1.1.15-1.el7.src.rpm", Size: 47039, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXinerama", Version: "1.1.3", Release: "2.1.el7", Arch: "x86_64", SourceRpm: "libXinerama-1.1.3-2.1.el7.src.rpm", Size: 14967, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus", Version: "1.10.24", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-7.el7.src.rpm", Size: 595223, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXcomposite", Version: "0.4.4", Release: "4.1.el7", Arch: "x86_64", SourceRpm: "libXcomposite-0.4.4-4.1.el7.src.rpm", Size: 35184, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.10", Release: "8.el7", Arch: "x86_64", SourceRpm: "gdbm-1.10-8.el7.src.rpm", Size: 184322, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fribidi", Version: "1.0.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "fribidi-1.0.2-1.el7.src.rpm", Size: 309831, License: "LGPLv2+ and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-python", Version: "1.1.1", Release: "9.el7", Arch: "x86_64", SourceRpm: "dbus-python-1.1.1-9.el7.src.rpm", Size: 848122, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtool-ltdl", Version: "2.4.2", Release: "22.el7_3", Arch: "x86_64", SourceRpm: "libtool-2.4.2-22.el7_3.src.rpm", Size: 67814, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-ExtUtils-MakeMaker", Version: "6.68", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-ExtUtils-MakeMaker-6.68-3.el7.src.rpm", Size: 650532, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyliblzma", Version: "0.5.3", Release: "11.el7", Arch: "x86_64", SourceRpm: "pyliblzma-0.5.3-11.el7.src.rpm", Size: 190112, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "asciidoc", Version: "8.6.8", Release: "5.el7", Arch: "noarch", SourceRpm: "asciidoc-8.6.8-5.el7.src.rpm", Size: 976747, License: "GPL+ and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyxattr", Version: "0.5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "pyxattr-0.5.1-5.el7.src.rpm", Size: 63304, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.7", Release: "18.el7", Arch: "x86_64", SourceRpm: "zlib-1.2.7-18.el7.src.rpm", Size: 185294, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-javapackages", Version: "3.4.1", Release: "11.el7", Arch: "noarch", SourceRpm: "javapackages-tools-3.4.1-11.el7.src.rpm", Size: 66505, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.27", Release: "34.base.el7", Arch: "x86_64", SourceRpm: "binutils-2.27-34.base.el7.src.rpm", Size: 25150000, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.4.3", Release: "158.el7.centos", Arch: "noarch", SourceRpm: "yum-3.4.3-158.el7.centos.src.rpm", Size: 5814102, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXtst", Version: "1.2.3", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXtst-1.2.3-1.el7.src.rpm", Size: 29938, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "alsa-lib", Version: "1.1.6", Release: "2.el7", Arch: "x86_64", SourceRpm: "alsa-lib-1.1.6-2.el7.src.rpm", Size: 1433094, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-utils", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 343422, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs-devel", Version: "2.8.4", Release: "4.el7", Arch: "x86_64", SourceRpm: "audit-2.8.4-4.el7.src.rpm", Size: 88847, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.160", Release: "4.el7", Arch: "x86_64", SourceRpm: "vim-7.4.160-4.el7.src.rpm", Size: 917640, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.7.5", Release: "77.el7_6", Arch: "x86_64", SourceRpm: "python-2.7.5-77.el7_6.src.rpm", Size: 24714266, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 120497983, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "slang-devel", Version: "2.2.4", Release: "11.el7", Arch: "x86_64", SourceRpm: "slang-2.2.4-11.el7.src.rpm", Size: 356429, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 2622266, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "newt-devel", Version: "0.52.15", Release: "4.el7", Arch: "x86_64", SourceRpm: "newt-0.52.15-4.el7.src.rpm", Size: 127322, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mpfr", Version: "3.1.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "mpfr-3.1.1-4.el7.src.rpm", Size: 554279, License: "LGPLv3+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bc", Version: "1.06.95", Release: "13.el7", Arch: "x86_64", SourceRpm: "bc-1.06.95-13.el7.src.rpm", Size: 219830, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck-lib", Version: "1.4.1", Release: "6.el7", Arch: "x86_64", SourceRpm: "fipscheck-1.4.1-6.el7.src.rpm", Size: 11466, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libquadmath", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 282811, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "unzip", Version: "6.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "unzip-6.0-19.el7.src.rpm", Size: 373986, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-libs", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 1546952, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cryptsetup-libs", Version: "2.0.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "cryptsetup-2.0.3-3.el7.src.rpm", Size: 1219591, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-podlators", Version: "2.5.1", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-podlators-2.5.1-3.el7.src.rpm", Size: 287679, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Encode", Version: "2.51", Release: "7.el7", Arch: "x86_64", SourceRpm: "perl-Encode-2.51-7.el7.src.rpm", Size: 10176350, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Storable", Version: "2.45", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Storable-2.45-3.el7.src.rpm", Size: 181031, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Filter", Version: "1.49", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Filter-1.49-3.el7.src.rpm", Size: 148475, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-macros", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 5134, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-PathTools", Version: "3.40", Release: "5.el7", Arch: "x86_64", SourceRpm: "perl-PathTools-3.40-5.el7.src.rpm", Size: 174131, License: "(GPL+ or Artistic) and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 23552128, License: "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Data-Dumper", Version: "2.145", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Data-Dumper-2.145-3.el7.src.rpm", Size: 99287, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "automake", Version: "1.13.4", Release: "3.el7", Arch: "noarch", SourceRpm: "automake-1.13.4-3.el7.src.rpm", Size: 1731909, License: "GPLv2+ and GFDL and Public Domain and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgfortran", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 1186168, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd-libs", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 1263456, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd-sysv", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 3979, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "boost-date-time", Version: "1.53.0", Release: "27.el7", Arch: "x86_64", SourceRpm: "boost-1.53.0-27.el7.src.rpm", Size: 139706, License: "Boost and MIT and Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmodman", Version: "2.0.1", Release: "8.el7", Arch: "x86_64", SourceRpm: "libmodman-2.0.1-8.el7.src.rpm", Size: 58871, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nettle", Version: "2.7.1", Release: "8.el7", Arch: "x86_64", SourceRpm: "nettle-2.7.1-8.el7.src.rpm", Size: 765042, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.9", Release: "14.20130511.el7_4", Arch: "noarch", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 223432, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdb", Version: "7.6.1", Release: "114.el7", Arch: "x86_64", SourceRpm: "gdb-7.6.1-114.el7.src.rpm", Size: 7371507, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and BSD and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.2.46", Release: "30.el7", Arch: "x86_64", SourceRpm: "bash-4.2.46-30.el7.src.rpm", Size: 3667709, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libedit", Version: "3.0", Release: "12.20121213cvs.el7", Arch: "x86_64", SourceRpm: "libedit-3.0-12.20121213cvs.el7.src.rpm", Size: 244257, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.7.4", Release: "1.el7", Arch: "x86_64", SourceRpm: "chkconfig-1.7.4-1.el7.src.rpm", Size: 779531, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Git", Version: "1.8.3.1", Release: "20.el7", Arch: "noarch", SourceRpm: "git-1.8.3.1-20.el7.src.rpm", Size: 58567, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.71", Release: "9.el7", Arch: "noarch", SourceRpm: "setup-2.8.71-9.el7.src.rpm", Size: 696925, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pakchois", Version: "0.4", Release: "10.el7", Arch: "x86_64", SourceRpm: "pakchois-0.4-10.el7.src.rpm", Size: 29489, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "7.el7.centos", Arch: "noarch", SourceRpm: "basesystem-10.0-7.el7.centos.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "subversion-libs", Version: "1.7.14", Release: "14.el7", Arch: "x86_64", SourceRpm: "subversion-1.7.14-14.el7.src.rpm", Size: 2615823, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "efivar-libs", Version: "36", Release: "11.el7_6.1", Arch: "x86_64", SourceRpm: "efivar-36-11.el7_6.1.src.rpm", Size: 252096, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.el7_5.src.rpm", Size: 194944, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-client", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 10146436, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.42.9", Release: "12.el7_5", Arch: "x86_64", SourceRpm: "e2fsprogs-1.42.9-12.el7_5.src.rpm", Size: 60489, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-headers", Version: "3.10.0", Release: "957.10.1.el7", Arch: "x86_64", SourceRpm: "kernel-3.10.0-957.10.1.el7.src.rpm", Size: 3823832, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.46", Release: "13.el7", Arch: "x86_64", SourceRpm: "attr-2.4.46-13.el7.src.rpm", Size: 19896, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-devel", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 1066078, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 37056, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libquadmath-devel", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 18514, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-srpm-macros", Version: "1", Release: "8.el7", Arch: "noarch", SourceRpm: "perl-srpm-macros-1-8.el7.src.rpm", Size: 794, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "texinfo-5.1-5.el7.src.rpm", Size: 494630, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-common-devel", Version: "0.19.8.1", Release: "2.el7", Arch: "noarch", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 397912, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "8.32", Release: "17.el7", Arch: "x86_64", SourceRpm: "pcre-8.32-17.el7.src.rpm", Size: 1475532, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "intltool", Version: "0.50.2", Release: "7.el7", Arch: "noarch", SourceRpm: "intltool-0.50.2-7.el7.src.rpm", Size: 170106, License: "GPLv2 with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "sed-4.2.2-5.el7.src.rpm", Size: 601208, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 206577, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 1337825, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtool", Version: "2.4.2", Release: "22.el7_3", Arch: "x86_64", SourceRpm: "libtool-2.4.2-22.el7_3.src.rpm", Size: 2323761, License: "GPLv2+ and LGPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "gmp", Version: "6.0.0", Release: "15.el7", Arch: "x86_64", SourceRpm: "gmp-6.0.0-15.el7.src.rpm", Size: 657046, License: "LGPLv3+ or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cscope", Version: "15.8", Release: "10.el7", Arch: "x86_64", SourceRpm: "cscope-15.8-10.el7.src.rpm", Size: 939744, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "4.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "libtasn1-4.10-1.el7.src.rpm", Size: 424486, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patchutils", Version: "0.3.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "patchutils-0.3.3-4.el7.src.rpm", Size: 260135, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "70.0.el7_5", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", Size: 973960, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bison", Version: "3.0.4", Release: "2.el7", Arch: "x86_64", SourceRpm: "bison-3.0.4-2.el7.src.rpm", Size: 2154174, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.22", Release: "21.el7", Arch: "x86_64", SourceRpm: "coreutils-8.22-21.el7.src.rpm", Size: 14588989, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-sign", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 17397, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.15.1", Release: "19.el7", Arch: "x86_64", SourceRpm: "krb5-1.15.1-19.el7.src.rpm", Size: 1984782, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "swig", Version: "2.0.10", Release: "5.el7", Arch: "x86_64", SourceRpm: "swig-2.0.10-5.el7.src.rpm", Size: 4926232, License: "GPLv3+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 70093, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rcs", Version: "5.9.0", Release: "5.el7", Arch: "x86_64", SourceRpm: "rcs-5.9.0-5.el7.src.rpm", Size: 624710, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ctags", Version: "5.8", Release: "13.el7", Arch: "x86_64", SourceRpm: "ctags-5.8-13.el7.src.rpm", Size: 359724, License: "GPLv2+ and LGPLv2+ and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1710062, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "libpng", Version: "1.5.13", Release: "7.el7_2", Arch: "x86_64", SourceRpm: "libpng-1.5.13-7.el7_2.src.rpm", Size: 616101, License: "zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.2", Release: "10.el7", Arch: "x86_64", SourceRpm: "readline-6.2-10.el7.src.rpm", Size: 460464, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libjpeg-turbo", Version: "1.2.90", Release: "6.el7", Arch: "x86_64", SourceRpm: "libjpeg-turbo-1.2.90-6.el7.src.rpm", Size: 350499, License: "IJG", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.11", Release: "27.el7", Arch: "x86_64", SourceRpm: "cpio-2.11-27.el7.src.rpm", Size: 689335, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libSM", Version: "1.2.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "libSM-1.2.2-2.el7.src.rpm", Size: 81546, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 261837, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcsc-lite-libs", Version: "1.8.8", Release: "8.el7", Arch: "x86_64", SourceRpm: "pcsc-lite-1.8.8-8.el7.src.rpm", Size: 46708, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.54.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "glib2-2.54.2-2.el7.src.rpm", Size: 11986873, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "libglvnd", Version: "1.0.1", Release: "0.8.git5baa1e5.el7", Arch: "x86_64", SourceRpm: "libglvnd-1.0.1-0.8.git5baa1e5.el7.src.rpm", Size: 666456, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.7.17", Release: "8.el7", Arch: "x86_64", SourceRpm: "sqlite-3.7.17-8.el7.src.rpm", Size: 814231, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xml-common", Version: "0.6.3", Release: "39.el7", Arch: "noarch", SourceRpm: "sgml-common-0.6.3-39.el7.src.rpm", Size: 46969, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 209610, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxslt", Version: "1.1.28", Release: "5.el7", Arch: "x86_64", SourceRpm: "libxslt-1.1.28-5.el7.src.rpm", Size: 497582, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.28", Release: "4.el7", Arch: "x86_64", SourceRpm: "libidn-1.28-4.el7.src.rpm", Size: 630407, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libwayland-server", Version: "1.15.0", Release: "1.el7", Arch: "x86_64", SourceRpm: "wayland-1.15.0-1.el7.src.rpm", Size: 81084, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dejavu-sans-fonts", Version: "2.33", Release: "6.el7", Arch: "noarch", SourceRpm: "dejavu-fonts-2.33-6.el7.src.rpm", Size: 5395167, License: "Bitstream Vera and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap-ng", Version: "0.7.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcap-ng-0.7.5-4.el7.src.rpm", Size: 50510, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "jasper-libs", Version: "1.900.1", Release: "33.el7", Arch: "x86_64", SourceRpm: "jasper-1.900.1-33.el7.src.rpm", Size: 350690, License: "JasPer", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 9389116, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "xorg-x11-font-utils", Version: "7.5", Release: "21.el7", Arch: "x86_64", SourceRpm: "xorg-x11-font-utils-7.5-21.el7.src.rpm", Size: 361874, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpwquality", Version: "1.2.3", Release: "5.el7", Arch: "x86_64", SourceRpm: "libpwquality-1.2.3-5.el7.src.rpm", Size: 332421, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ghostscript-fonts", Version: "5.50", Release: "32.el7", Arch: "noarch", SourceRpm: "ghostscript-fonts-5.50-32.el7.src.rpm", Size: 636425, License: "GPLv2+ and Hershey and MIT and OFL and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 14061, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lcms2", Version: "2.6", Release: "3.el7", Arch: "x86_64", SourceRpm: "lcms2-2.6-3.el7.src.rpm", Size: 377192, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-pem", Version: "1.0.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "nss-pem-1.0.3-4.el7.src.rpm", Size: 201219, License: "MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtiff", Version: "4.0.3", Release: "27.el7_3", Arch: "x86_64", SourceRpm: "libtiff-4.0.3-27.el7_3.src.rpm", Size: 494377, License: "libtiff", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 798130, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcsc-lite-ccid", Version: "1.4.10", Release: "14.el7", Arch: "x86_64", SourceRpm: "pcsc-lite-ccid-1.4.10-14.el7.src.rpm", Size: 562872, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coolkey", Version: "1.1.0", Release: "40.el7", Arch: "x86_64", SourceRpm: "coolkey-1.1.0-40.el7.src.rpm", Size: 304556, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lz4", Version: "1.7.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "lz4-1.7.5-2.el7.src.rpm", Size: 366872, License: "GPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libthai", Version: "0.1.14", Release: "9.el7", Arch: "x86_64", SourceRpm: "libthai-0.1.14-9.el7.src.rpm", Size: 704340, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-sdt-devel", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 178425, License: "GPLv2+ and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 2069571, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libicu", Version: "50.1.2", Release: "17.el7", Arch: "x86_64", SourceRpm: "icu-50.1.2-17.el7.src.rpm", Size: 25217861, License: "MIT and UCD and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "source-highlight", Version: "3.1.6", Release: "6.el7", Arch: "x86_64", SourceRpm: "source-highlight-3.1.6-6.el7.src.rpm", Size: 3024049, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gobject-introspection", Version: "1.50.0", Release: "1.el7", Arch: "x86_64", SourceRpm: "gobject-introspection-1.50.0-1.el7.src.rpm", Size: 834149, License: "GPLv2+, LGPLv2+, MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm-devel", Version: "1.10", Release: "8.el7", Arch: "x86_64", SourceRpm: "gdbm-1.10-8.el7.src.rpm", Size: 42866, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb-utils", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 326487, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-ExtUtils-Manifest", Version: "1.61", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-ExtUtils-Manifest-1.61-244.el7.src.rpm", Size: 85359, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod-libs", Version: "20", Release: "21.el7", Arch: "x86_64", SourceRpm: "kmod-20-21.el7.src.rpm", Size: 91800, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "graphite2", Version: "1.3.10", Release: "1.el7_3", Arch: "x86_64", SourceRpm: "graphite2-1.3.10-1.el7_3.src.rpm", Size: 254548, License: "(LGPLv2+ or GPLv2+ or MPL) and (Netscape or GPLv2+ or LGPLv2+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.3", Release: "10.el7_2.1", Arch: "x86_64", SourceRpm: "libssh2-1.4.3-10.el7_2.1.src.rpm", Size: 341782, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-indexhtml", Version: "7", Release: "9.el7.centos", Arch: "noarch", SourceRpm: "centos-indexhtml-7-9.el7.centos.src.rpm", Size: 92560, License: "Distributable", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.29.0", Release: "46.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-46.el7.src.rpm", Size: 540259, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pixman", Version: "0.34.0", Release: "1.el7", Arch: "x86_64", SourceRpm: "pixman-0.34.0-1.el7.src.rpm", Size: 698744, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpciaccess", Version: "0.14", Release: "1.el7", Arch: "x86_64", SourceRpm: "libpciaccess-0.14-1.el7.src.rpm", Size: 45649, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.60", Release: "9.el7", Arch: "x86_64", SourceRpm: "libuser-0.60-9.el7.src.rpm", Size: 1952592, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mesa-libgbm", Version: "18.0.5", Release: "4.el7_6", Arch: "x86_64", SourceRpm: "mesa-18.0.5-4.el7_6.src.rpm", Size: 58336, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.26", Release: "34.el7", Arch: "x86_64", SourceRpm: "tar-1.26-34.el7.src.rpm", Size: 2838271, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "docbook-dtds", Version: "1.0", Release: "60.el7", Arch: "noarch", SourceRpm: "docbook-dtds-1.0-60.el7.src.rpm", Size: 4634764, License: "Copyright only", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "acl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 201225, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXau", Version: "1.0.8", Release: "2.1.el7", Arch: "x86_64", SourceRpm: "libXau-1.0.8-2.1.el7.src.rpm", Size: 55529, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "16.el7", Arch: "x86_64", SourceRpm: "ustr-1.0.4-16.el7.src.rpm", Size: 285943, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11", Version: "1.6.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "libX11-1.6.5-2.el7.src.rpm", Size: 1325648, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "24.el7", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-24.el7.src.rpm", Size: 3534490, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXrender", Version: "0.9.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXrender-0.9.10-1.el7.src.rpm", Size: 46979, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "hardlink", Version: "1.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "hardlink-1.0-19.el7.src.rpm", Size: 16545, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdk-pixbuf2", Version: "2.36.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "gdk-pixbuf2-2.36.12-3.el7.src.rpm", Size: 2927994, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "util-linux", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 8642769, License: "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXpm", Version: "3.5.12", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXpm-3.5.12-1.el7.src.rpm", Size: 116599, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kpartx", Version: "0.4.9", Release: "119.el7_5.1", Arch: "x86_64", SourceRpm: "device-mapper-multipath-0.4.9-119.el7_5.1.src.rpm", Size: 41363, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXdamage", Version: "1.1.4", Release: "4.1.el7", Arch: "x86_64", SourceRpm: "libXdamage-1.1.4-4.1.el7.src.rpm", Size: 29688, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper-libs", Version: "1.02.146", Release: "4.el7", Arch: "x86_64", SourceRpm: "lvm2-2.02.177-4.el7.src.rpm", Size: 400551, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gd", Version: "2.0.35", Release: "26.el7", Arch: "x86_64", SourceRpm: "gd-2.0.35-26.el7.src.rpm", Size: 554528, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dracut", Version: "033", Release: "535.el7_5.1", Arch: "x86_64", SourceRpm: "dracut-033-535.el7_5.1.src.rpm", Size: 898198, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gtk-update-icon-cache", Version: "3.22.30", Release: "3.el7", Arch: "x86_64", SourceRpm: "gtk3-3.22.30-3.el7.src.rpm", Size: 59878, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXrandr", Version: "1.5.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "libXrandr-1.5.1-2.el7.src.rpm", Size: 48626, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.10.24", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-7.el7.src.rpm", Size: 362584, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXi", Version: "1.7.9", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXi-1.7.9-1.el7.src.rpm", Size: 70019, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-default-yama-scope", Version: "0.170", Release: "4.el7", Arch: "noarch", SourceRpm: "elfutils-0.170-4.el7.src.rpm", Size: 1810, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXxf86vm", Version: "1.1.4", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXxf86vm-1.1.4-1.el7.src.rpm", Size: 25680, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mesa-libGL", Version: "18.0.5", Release: "4.el7_6", Arch: "x86_64", SourceRpm: "mesa-18.0.5-4.el7_6.src.rpm", Size: 493744, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-glib", Version: "0.100", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-glib-0.100-7.el7.src.rpm", Size: 301237, License: "AFL and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "libglvnd-egl", Version: "1.0.1", Release: "0.8.git5baa1e5.el7", Arch: "x86_64", SourceRpm: "libglvnd-1.0.1-0.8.git5baa1e5.el7.src.rpm", Size: 84096, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cairo", Version: "1.15.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "cairo-1.15.12-3.el7.src.rpm", Size: 1894412, License: "LGPLv2 or MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pango", Version: "1.42.4", Release: "1.el7", Arch: "x86_64", SourceRpm: "pango-1.42.4-1.el7.src.rpm", Size: 755391, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2-python", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1503050, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gtk2", Version: "2.24.31", Release: "1.el7", Arch: "x86_64", SourceRpm: "gtk2-2.24.31-1.el7.src.rpm", Size: 13494501, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "graphviz", Version: "2.30.1", Release: "21.el7", Arch: "x86_64", SourceRpm: "graphviz-2.30.1-21.el7.src.rpm", Size: 4019006, License: "EPL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-gobject-base", Version: "3.22.0", Release: "1.el7_4.1", Arch: "x86_64", SourceRpm: "pygobject3-3.22.0-1.el7_4.1.src.rpm", Size: 1123114, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-ExtUtils-ParseXS", Version: "3.18", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-ExtUtils-ParseXS-3.18-3.el7.src.rpm", Size: 178634, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.4", Release: "10.el7", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.4-10.el7.src.rpm", Size: 58789, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-ExtUtils-Install", Version: "1.58", Release: "294.el7_6", Arch: "noarch", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 76408, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-19.el7.src.rpm", Size: 241513, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(0), Name: "perl-ExtUtils-Embed", Version: "1.30", Release: "294.el7_6", Arch: "noarch", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 17136, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.4", Release: "9.el7", Arch: "noarch", SourceRpm: "python-iniparse-0.4-9.el7.src.rpm", Size: 115166, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xmlto", Version: "0.0.25", Release: "7.el7", Arch: "x86_64", SourceRpm: "xmlto-0.0.25-7.el7.src.rpm", Size: 108322, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-chardet", Version: "2.2.1", Release: "1.el7_1", Arch: "noarch", SourceRpm: "python-chardet-2.2.1-1.el7_1.src.rpm", Size: 1156541, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hmaccalc", Version: "0.9.13", Release: "4.el7", Arch: "x86_64", SourceRpm: "hmaccalc-0.9.13-4.el7.src.rpm", Size: 102013, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hostname", Version: "3.13", Release: "3.el7", Arch: "x86_64", SourceRpm: "hostname-3.13-3.el7.src.rpm", Size: 19449, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib-devel", Version: "1.2.7", Release: "18.el7", Arch: "x86_64", SourceRpm: "zlib-1.2.7-18.el7.src.rpm", Size: 135536, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "23.el7", Arch: "x86_64", SourceRpm: "pth-2.0.7-23.el7.src.rpm", Size: 267851, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-lxml", Version: "3.2.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "python-lxml-3.2.1-4.el7.src.rpm", Size: 2722705, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "javapackages-tools", Version: "3.4.1", Release: "11.el7", Arch: "noarch", SourceRpm: "javapackages-tools-3.4.1-11.el7.src.rpm", Size: 160151, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.3.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "gpgme-1.3.2-5.el7.src.rpm", Size: 547534, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xorg-x11-fonts-Type1", Version: "7.5", Release: "9.el7", Arch: "noarch", SourceRpm: "xorg-x11-fonts-7.5-9.el7.src.rpm", Size: 883992, License: "MIT and Lucida and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 53895, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-devel", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 168793, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-license", Version: "9.9.4", Release: "61.el7_5.1", Arch: "noarch", SourceRpm: "bind-9.9.4-61.el7_5.1.src.rpm", Size: 26831, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lksctp-tools", Version: "1.0.17", Release: "2.el7", Arch: "x86_64", SourceRpm: "lksctp-tools-1.0.17-2.el7.src.rpm", Size: 232487, License: "GPLv2 and GPLv2+ and LGPLv2 and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "copy-jdk-configs", Version: "3.3", Release: "10.el7_5", Arch: "noarch", SourceRpm: "copy-jdk-configs-3.3-10.el7_5.src.rpm", Size: 16264, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 22399, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.79", Release: "4.el7", Arch: "x86_64", SourceRpm: "passwd-0.79-4.el7.src.rpm", Size: 429874, License: "BSD or GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "11.el7", Arch: "noarch", SourceRpm: "rootfiles-8.1-11.el7.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "slang", Version: "2.2.4", Release: "11.el7", Arch: "x86_64", SourceRpm: "slang-2.2.4-11.el7.src.rpm", Size: 1881912, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 179328, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.17", Release: "260.el7_6.4", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.4.src.rpm", Size: 14346480, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 913443, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 611536, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 793087, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmpc", Version: "1.0.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "libmpc-1.0.1-3.el7.src.rpm", Size: 113833, License: "LGPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck", Version: "1.4.1", Release: "6.el7", Arch: "x86_64", SourceRpm: "fipscheck-1.4.1-6.el7.src.rpm", Size: 38839, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "net-tools", Version: "2.0", Release: "0.24.20131004git.el7", Arch: "x86_64", SourceRpm: "net-tools-2.0-0.24.20131004git.el7.src.rpm", Size: 939930, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgomp", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 212184, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "m4", Version: "1.4.16", Release: "10.el7", Arch: "x86_64", SourceRpm: "m4-1.4.16-10.el7.src.rpm", Size: 525707, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util", Version: "1.5.2", Release: "6.el7", Arch: "x86_64", SourceRpm: "apr-util-1.5.2-6.el7.src.rpm", Size: 198751, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "groff-base", Version: "1.22.2", Release: "8.el7", Arch: "x86_64", SourceRpm: "groff-1.22.2-8.el7.src.rpm", Size: 3453946, License: "GPLv3+ and GFDL and BSD and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libunistring", Version: "0.9.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "libunistring-0.9.3-9.el7.src.rpm", Size: 1145761, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcroco", Version: "0.6.12", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcroco-0.6.12-4.el7.src.rpm", Size: 320955, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patch", Version: "2.7.1", Release: "10.el7_5", Arch: "x86_64", SourceRpm: "patch-2.7.1-10.el7_5.src.rpm", Size: 215449, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zip", Version: "3.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "zip-3.0-11.el7.src.rpm", Size: 815173, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 5029340, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTTP-Tiny", Version: "0.033", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-HTTP-Tiny-0.033-3.el7.src.rpm", Size: 97210, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Perldoc", Version: "3.20", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Perldoc-3.20-4.el7.src.rpm", Size: 166910, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Text-ParseWords", Version: "3.29", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Text-ParseWords-3.29-4.el7.src.rpm", Size: 16431, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Usage", Version: "1.63", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Pod-Usage-1.63-3.el7.src.rpm", Size: 44671, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Socket", Version: "2.010", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-Socket-2.010-4.el7.src.rpm", Size: 114497, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-Time-HiRes", Version: "1.9725", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Time-HiRes-1.9725-3.el7.src.rpm", Size: 94069, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Carp", Version: "1.26", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-Carp-1.26-244.el7.src.rpm", Size: 28276, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Exporter", Version: "5.68", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Exporter-5.68-3.el7.src.rpm", Size: 56612, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Time-Local", Version: "1.2300", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Time-Local-1.2300-2.el7.src.rpm", Size: 44062, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads-shared", Version: "1.43", Release: "6.el7", Arch: "x86_64", SourceRpm: "perl-threads-shared-1.43-6.el7.src.rpm", Size: 73972, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Path", Version: "2.09", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-File-Path-2.09-2.el7.src.rpm", Size: 50067, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Scalar-List-Utils", Version: "1.27", Release: "248.el7", Arch: "x86_64", SourceRpm: "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", Size: 67994, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Getopt-Long", Version: "2.40", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Getopt-Long-2.40-3.el7.src.rpm", Size: 134846, License: "GPLv2+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Thread-Queue", Version: "3.02", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Thread-Queue-3.02-2.el7.src.rpm", Size: 27642, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-TermReadKey", Version: "2.30", Release: "20.el7", Arch: "x86_64", SourceRpm: "perl-TermReadKey-2.30-20.el7.src.rpm", Size: 59999, License: "(Copyright only) and (Artistic or GPL+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "autoconf", Version: "2.69", Release: "11.el7", Arch: "noarch", SourceRpm: "autoconf-2.69-11.el7.src.rpm", Size: 2320371, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Test-Harness", Version: "3.28", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Test-Harness-3.28-3.el7.src.rpm", Size: 607239, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-debug-devel", Version: "3.10.0", Release: "957.10.1.el7", Arch: "x86_64", SourceRpm: "kernel-3.10.0-957.10.1.el7.src.rpm", Size: 39270753, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "boost-thread", Version: "1.53.0", Release: "27.el7", Arch: "x86_64", SourceRpm: "boost-1.53.0-27.el7.src.rpm", Size: 95658, License: "Boost and MIT and Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh", Version: "7.4p1", Release: "16.el7", Arch: "x86_64", SourceRpm: "openssh-7.4p1-16.el7.src.rpm", Size: 1995364, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 811817, License: "GPLv3+ and (GPLv2+ or LGPLv3+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 24440903, License: "LGPLv2+ and MIT and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rsync", Version: "3.1.2", Release: "4.el7", Arch: "x86_64", SourceRpm: "rsync-3.1.2-4.el7.src.rpm", Size: 834618, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file", Version: "5.11", Release: "35.el7", Arch: "x86_64", SourceRpm: "file-5.11-35.el7.src.rpm", Size: 67448, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dwz", Version: "0.11", Release: "3.el7", Arch: "x86_64", SourceRpm: "dwz-0.11-3.el7.src.rpm", Size: 225103, License: "GPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dyninst", Version: "9.3.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "dyninst-9.3.1-2.el7.src.rpm", Size: 13049000, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++-devel", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 8436804, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.8.4", Release: "4.el7", Arch: "x86_64", SourceRpm: "audit-2.8.4-4.el7.src.rpm", Size: 256362, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "giflib", Version: "4.1.6", Release: "9.el7", Arch: "x86_64", SourceRpm: "giflib-4.1.6-9.el7.src.rpm", Size: 90124, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "java-1.8.0-openjdk-devel", Version: "1.8.0.212.b04", Release: "0.el7_6", Arch: "x86_64", SourceRpm: "java-1.8.0-openjdk-1.8.0.212.b04-0.el7_6.src.rpm", Size: 42212373, License: "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv2.0 and Public Domain and W3C and zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-devel", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 306300, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pciutils-libs", Version: "3.5.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "pciutils-3.5.1-3.el7.src.rpm", Size: 72691, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.7.5", Release: "77.el7_6", Arch: "x86_64", SourceRpm: "python-2.7.5-77.el7_6.src.rpm", Size: 80907, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "newt", Version: "0.52.15", Release: "4.el7", Arch: "x86_64", SourceRpm: "newt-0.52.15-4.el7.src.rpm", Size: 185196, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "numactl-devel", Version: "2.0.9", Release: "7.el7", Arch: "x86_64", SourceRpm: "numactl-2.0.9-7.el7.src.rpm", Size: 25174, License: "LGPLv2 and GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-devel", Version: "2.7.5", Release: "77.el7_6", Arch: "x86_64", SourceRpm: "python-2.7.5-77.el7_6.src.rpm", Size: 1104386, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-devel", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 2253171, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libproxy", Version: "0.4.11", Release: "11.el7", Arch: "x86_64", SourceRpm: "libproxy-0.4.11-11.el7.src.rpm", Size: 164014, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018e", Release: "3.el7", Arch: "noarch", SourceRpm: "tzdata-2018e-3.el7.src.rpm", Size: 1966505, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "make", Version: "3.82", Release: "23.el7", Arch: "x86_64", SourceRpm: "make-3.82-23.el7.src.rpm", Size: 1160684, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 439378, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "git", Version: "1.8.3.1", Release: "20.el7", Arch: "x86_64", SourceRpm: "git-1.8.3.1-20.el7.src.rpm", Size: 23232282, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "3.2", Release: "25.el7", Arch: "x86_64", SourceRpm: "filesystem-3.2-25.el7.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 83791, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el7_5.src.rpm", Size: 287728, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "emacs-filesystem", Version: "24.3", Release: "22.el7", Arch: "noarch", SourceRpm: "emacs-24.3-22.el7.src.rpm", Size: 0, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.13", Release: "18.el7", Arch: "x86_64", SourceRpm: "libffi-3.0.13-18.el7.src.rpm", Size: 47766, License: "MIT and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 39238921, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.5", Release: "8.1.el7", Arch: "x86_64", SourceRpm: "libsepol-2.5-8.1.el7.src.rpm", Size: 686568, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "redhat-rpm-config", Version: "9.1.0", Release: "87.el7.centos", Arch: "noarch", SourceRpm: "redhat-rpm-config-9.1.0-87.el7.centos.src.rpm", Size: 174572, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "4.0.2", Release: "4.el7_3.1", Arch: "x86_64", SourceRpm: "gawk-4.0.2-4.el7_3.1.src.rpm", Size: 2435978, License: "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 328409, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "3.el7", Arch: "x86_64", SourceRpm: "grep-2.20-3.el7.src.rpm", Size: 1195131, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-c++", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 17257523, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libverto", Version: "0.2.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libverto-0.2.5-4.el7.src.rpm", Size: 23060, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "flex", Version: "2.5.37", Release: "6.el7", Arch: "x86_64", SourceRpm: "flex-2.5.37-6.el7.src.rpm", Size: 757468, License: "BSD and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl-libs", Version: "1.0.2k", Release: "12.el7", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-12.el7.src.rpm", Size: 3200172, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "doxygen", Version: "1.8.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "doxygen-1.8.5-3.el7.src.rpm", Size: 15389684, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 239967, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "byacc", Version: "1.9.20130304", Release: "3.el7", Arch: "x86_64", SourceRpm: "byacc-1.9.20130304-3.el7.src.rpm", Size: 135161, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "libgpg-error-1.12-3.el7.src.rpm", Size: 350865, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "freetype", Version: "2.8", Release: "12.el7_6.1", Arch: "x86_64", SourceRpm: "freetype-2.8-12.el7_6.1.src.rpm", Size: 824497, License: "(FTL or GPLv2+) and BSD and MIT and Public Domain and zlib with acknowledgement", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "15.el7", Arch: "x86_64", SourceRpm: "lua-5.1.4-15.el7.src.rpm", Size: 640319, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mesa-libglapi", Version: "18.0.5", Release: "4.el7_6", Arch: "x86_64", SourceRpm: "mesa-18.0.5-4.el7_6.src.rpm", Size: 204320, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmount", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 273965, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxshmfence", Version: "1.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "libxshmfence-1.2-1.el7.src.rpm", Size: 11160, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "gzip-1.5-10.el7.src.rpm", Size: 250440, License: "GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fontpackages-filesystem", Version: "1.44", Release: "8.el7", Arch: "noarch", SourceRpm: "fontpackages-1.44-8.el7.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "3.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "diffutils-3.3-4.el7.src.rpm", Size: 1065157, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fontconfig", Version: "2.13.0", Release: "4.3.el7", Arch: "x86_64", SourceRpm: "fontconfig-2.13.0-4.3.el7.src.rpm", Size: 666475, License: "MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "urw-fonts", Version: "2.4", Release: "16.el7", Arch: "noarch", SourceRpm: "urw-fonts-2.4-16.el7.src.rpm", Size: 4389400, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 1130670, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "jbigkit-libs", Version: "2.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "jbigkit-2.0-11.el7.src.rpm", Size: 105882, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libassuan", Version: "2.1.0", Release: "3.el7", Arch: "x86_64", SourceRpm: "libassuan-2.1.0-3.el7.src.rpm", Size: 155391, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcsc-lite", Version: "1.8.8", Release: "8.el7", Arch: "x86_64", SourceRpm: "pcsc-lite-1.8.8-8.el7.src.rpm", Size: 634433, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyparsing", Version: "1.5.6", Release: "9.el7", Arch: "noarch", SourceRpm: "pyparsing-1.5.6-9.el7.src.rpm", Size: 375948, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "boost-regex", Version: "1.53.0", Release: "27.el7", Arch: "x86_64", SourceRpm: "boost-1.53.0-27.el7.src.rpm", Size: 2122578, License: "Boost and MIT and Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.27.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "pkgconfig-0.27.1-4.el7.src.rpm", Size: 105522, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libwayland-client", Version: "1.15.0", Release: "1.el7", Arch: "x86_64", SourceRpm: "wayland-1.15.0-1.el7.src.rpm", Size: 64076, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.26", Release: "23.el7", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.26-23.el7.src.rpm", Size: 396911, License: "BSD with advertising", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "harfbuzz", Version: "1.7.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "harfbuzz-1.7.5-2.el7.src.rpm", Size: 709362, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.29.0", Release: "46.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-46.el7.src.rpm", Size: 435192, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hwdata", Version: "0.252", Release: "9.1.el7", Arch: "x86_64", SourceRpm: "hwdata-0.252-9.1.el7.src.rpm", Size: 13726739, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.44", Release: "15.el7_5", Arch: "x86_64", SourceRpm: "openldap-2.4.44-15.el7_5.src.rpm", Size: 1037299, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sgml-common", Version: "0.6.3", Release: "39.el7", Arch: "noarch", SourceRpm: "sgml-common-0.6.3-39.el7.src.rpm", Size: 170882, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.8.1", Release: "17.el7", Arch: "x86_64", SourceRpm: "pinentry-0.8.1-17.el7.src.rpm", Size: 159929, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxcb", Version: "1.13", Release: "1.el7", Arch: "x86_64", SourceRpm: "libxcb-1.13-1.el7.src.rpm", Size: 1011680, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.5", Release: "11.el7", Arch: "x86_64", SourceRpm: "libsemanage-2.5-11.el7.src.rpm", Size: 302369, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXfixes", Version: "5.0.3", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXfixes-5.0.3-1.el7.src.rpm", Size: 26903, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "qrencode-libs", Version: "3.4.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "qrencode-3.4.1-3.el7.src.rpm", Size: 126732, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXmu", Version: "1.1.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "libXmu-1.1.2-2.el7.src.rpm", Size: 172054, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps-ng", Version: "3.3.10", Release: "17.el7_5.2", Arch: "x86_64", SourceRpm: "procps-ng-3.3.10-17.el7_5.2.src.rpm", Size: 760500, License: "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ghostscript", Version: "9.07", Release: "31.el7_6.10", Arch: "x86_64", SourceRpm: "ghostscript-9.07-31.el7_6.10.src.rpm", Size: 17357534, License: "AGPLv3+ and Redistributable, no modification permitted", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod", Version: "20", Release: "21.el7", Arch: "x86_64", SourceRpm: "kmod-20-21.el7.src.rpm", Size: 243127, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXft", Version: "2.3.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "libXft-2.3.2-2.el7.src.rpm", Size: 125933, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "libglvnd-glx", Version: "1.0.1", Release: "0.8.git5baa1e5.el7", Arch: "x86_64", SourceRpm: "libglvnd-1.0.1-0.8.git5baa1e5.el7.src.rpm", Size: 657304, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iputils", Version: "20160308", Release: "10.el7", Arch: "x86_64", SourceRpm: "iputils-20160308-10.el7.src.rpm", Size: 343497, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mesa-libEGL", Version: "18.0.5", Release: "4.el7_6", Arch: "x86_64", SourceRpm: "mesa-18.0.5-4.el7_6.src.rpm", Size: 232401, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "librsvg2", Version: "2.40.20", Release: "1.el7", Arch: "x86_64", SourceRpm: "librsvg2-2.40.20-1.el7.src.rpm", Size: 308951, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb-devel", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 129076, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-devel", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 1930081, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.10", Release: "8.el7", Arch: "noarch", SourceRpm: "python-urlgrabber-3.10-8.el7.src.rpm", Size: 500670, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pesign", Version: "0.109", Release: "10.el7", Arch: "x86_64", SourceRpm: "pesign-0.109-10.el7.src.rpm", Size: 368213, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-kitchen", Version: "1.1.1", Release: "5.el7", Arch: "noarch", SourceRpm: "python-kitchen-1.1.1-5.el7.src.rpm", Size: 1465161, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf-devel", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 32387, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.22", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "gnupg2-2.0.22-5.el7_5.src.rpm", Size: 6637796, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ttmkfdir", Version: "3.0.9", Release: "42.el7", Arch: "x86_64", SourceRpm: "ttmkfdir-3.0.9-42.el7.src.rpm", Size: 105418, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "pygpgme-0.3-9.el7.src.rpm", Size: 197501, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata-java", Version: "2019a", Release: "1.el7", Arch: "noarch", SourceRpm: "tzdata-2019a-1.el7.src.rpm", Size: 374277, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "java-1.8.0-openjdk-headless", Version: "1.8.0.212.b04", Release: "0.el7_6", Arch: "x86_64", SourceRpm: "java-1.8.0-openjdk-1.8.0.212.b04-0.el7_6.src.rpm", Size: 108711819, License: "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv2.0 and Public Domain and W3C and zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "java-1.8.0-openjdk", Version: "1.8.0.212.b04", Release: "0.el7_6", Arch: "x86_64", SourceRpm: "java-1.8.0-openjdk-1.8.0.212.b04-0.el7_6.src.rpm", Size: 541844, License: "ASL 1.1 and ASL 2.0 and BSD and BSD with advertising and GPL+ and GPLv2 and GPLv2 with exceptions and IJG and LGPLv2+ and MIT and MPLv2.0 and Public Domain and W3C and zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils-devel", Version: "2.27", Release: "34.base.el7", Arch: "x86_64", SourceRpm: "binutils-2.27-34.base.el7.src.rpm", Size: 4540848, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "f4a80eb5", Release: "53a7ff4b", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pciutils", Version: "3.5.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "pciutils-3.5.1-3.el7.src.rpm", Size: 200397, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 1077442, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "numactl-libs", Version: "2.0.9", Release: "7.el7", Arch: "x86_64", SourceRpm: "numactl-2.0.9-7.el7.src.rpm", Size: 50752, License: "LGPLv2 and GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.11", Release: "35.el7", Arch: "x86_64", SourceRpm: "file-5.11-35.el7.src.rpm", Size: 3076831, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pciutils-devel", Version: "3.5.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "pciutils-3.5.1-3.el7.src.rpm", Size: 78345, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 166776, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr", Version: "1.4.8", Release: "3.el7_4.1", Arch: "x86_64", SourceRpm: "apr-1.4.8-3.el7_4.1.src.rpm", Size: 226686, License: "ASL 2.0 and BSD with advertising and ISC and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "boost-system", Version: "1.53.0", Release: "27.el7", Arch: "x86_64", SourceRpm: "boost-1.53.0-27.el7.src.rpm", Size: 32538, License: "Boost and MIT and Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "avahi-libs", Version: "0.6.31", Release: "19.el7", Arch: "x86_64", SourceRpm: "avahi-0.6.31-19.el7.src.rpm", Size: 123808, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "json-c", Version: "0.11", Release: "4.el7_0", Arch: "x86_64", SourceRpm: "json-c-0.11-4.el7_0.src.rpm", Size: 65593, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-parent", Version: "0.225", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-parent-0.225-244.el7.src.rpm", Size: 8141, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Escapes", Version: "1.04", Release: "294.el7_6", Arch: "noarch", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 21091, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-libs", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 1647344, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads", Version: "1.87", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-threads-1.87-4.el7.src.rpm", Size: 98615, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-constant", Version: "1.27", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-constant-1.27-2.el7.src.rpm", Size: 26364, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Temp", Version: "0.23.01", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-File-Temp-0.23.01-3.el7.src.rpm", Size: 158781, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Simple", Version: "3.28", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Simple-3.28-4.el7.src.rpm", Size: 538320, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Error", Version: "0.17020", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Error-0.17020-2.el7.src.rpm", Size: 50015, License: "(GPL+ or Artistic) and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-XML-Parser", Version: "2.41", Release: "10.el7", Arch: "x86_64", SourceRpm: "perl-XML-Parser-2.41-10.el7.src.rpm", Size: 642973, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "less", Version: "458", Release: "9.el7", Arch: "x86_64", SourceRpm: "less-458-9.el7.src.rpm", Size: 215376, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpp", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 15644861, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "trousers", Version: "0.3.14", Release: "2.el7", Arch: "x86_64", SourceRpm: "trousers-0.3.14-2.el7.src.rpm", Size: 836873, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdwarf", Version: "20130207", Release: "4.el7", Arch: "x86_64", SourceRpm: "libdwarf-20130207-4.el7.src.rpm", Size: 291679, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemtap-runtime", Version: "3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "systemtap-3.3-3.el7.src.rpm", Size: 1142954, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
 	}
 
 	// docker run --rm -it centos:7 bash
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/(none)/0/g"
 	CentOS7Plain = []PackageInfo{
-		{intRef(), "tzdata", "2018e", "3.el7", "noarch", "tzdata-2018e-3.el7.src.rpm", 1966505, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 565628, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 439378, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.17", "222.el7", "x86_64", "glibc-2.17-222.el7.src.rpm", 120325207, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "3.2", "25.el7", "x86_64", "filesystem-3.2-25.el7.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.17", "222.el7", "x86_64", "glibc-2.17-222.el7.src.rpm", 14223248, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el7_5", "x86_64", "nspr-4.19.0-1.el7_5.src.rpm", 287728, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "16.el7", "x86_64", "popt-1.13-16.el7.src.rpm", 88516, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.13", "18.el7", "x86_64", "libffi-3.0.13-18.el7.src.rpm", 47766, "MIT and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.22", "9.el7", "x86_64", "libcap-2.22-9.el7.src.rpm", 111445, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.5", "8.1.el7", "x86_64", "libsepol-2.5-8.1.el7.src.rpm", 686568, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 1028216, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "4.0.2", "4.el7_3.1", "x86_64", "gawk-4.0.2-4.el7_3.1.src.rpm", 2435978, "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.5", "12.el7", "x86_64", "libselinux-2.5-12.el7.src.rpm", 217874, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "3.el7", "x86_64", "grep-2.20-3.el7.src.rpm", 1195131, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.5.8", "3.el7", "x86_64", "keyutils-1.5.8-3.el7.src.rpm", 42138, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libverto", "0.2.5", "4.el7", "x86_64", "libverto-0.2.5-4.el7.src.rpm", 23060, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 437261, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl-libs", "1.0.2k", "12.el7", "x86_64", "openssl-1.0.2k-12.el7.src.rpm", 3200172, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "7", "5.1804.4.el7.centos", "x86_64", "centos-release-7-5.1804.4.el7.centos.src.rpm", 40338, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 239967, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 1858008, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.12", "3.el7", "x86_64", "libgpg-error-1.12-3.el7.src.rpm", 350865, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.5.3", "14.el7", "x86_64", "libgcrypt-1.5.3-14.el7.src.rpm", 597727, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "15.el7", "x86_64", "lua-5.1.4-15.el7.src.rpm", 640319, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 20326, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmount", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 273965, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "1.8", "4.el7", "x86_64", "shared-mime-info-1.8-4.el7.src.rpm", 2379317, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.5", "10.el7", "x86_64", "gzip-1.5-10.el7.src.rpm", 250440, "GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.5.11", "5.el7", "x86_64", "findutils-4.5.11-5.el7.src.rpm", 1855626, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "3.3", "4.el7", "x86_64", "diffutils-3.3-4.el7.src.rpm", 1065157, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.1.0", "10.el7_3", "x86_64", "expat-2.1.0-10.el7_3.src.rpm", 208315, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.8.1", "3.el7_5.1", "x86_64", "audit-2.8.1-3.el7_5.1.src.rpm", 256370, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.8", "22.el7", "x86_64", "pam-1.1.8-22.el7.src.rpm", 2630324, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 1130670, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 2424993, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libassuan", "2.1.0", "3.el7", "x86_64", "libassuan-2.1.0-3.el7.src.rpm", 155391, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.11", "33.el7", "x86_64", "file-5.11-33.el7.src.rpm", 3077690, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.27.1", "4.el7", "x86_64", "pkgconfig-0.27.1-4.el7.src.rpm", 105522, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.26", "23.el7", "x86_64", "cyrus-sasl-2.1.26-23.el7.src.rpm", 396911, "BSD with advertising", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.27", "28.base.el7_5.1", "x86_64", "binutils-2.27-28.base.el7_5.1.src.rpm", 25149789, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.29.0", "46.el7", "x86_64", "curl-7.29.0-46.el7.src.rpm", 435192, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.11.3", "32.el7", "x86_64", "rpm-4.11.3-32.el7.src.rpm", 611384, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.44", "15.el7_5", "x86_64", "openldap-2.4.44-15.el7_5.src.rpm", 1037299, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.8.1", "17.el7", "x86_64", "pinentry-0.8.1-17.el7.src.rpm", 159929, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.5", "11.el7", "x86_64", "libsemanage-2.5-11.el7.src.rpm", 302369, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.6", "4.el7", "x86_64", "libutempter-1.1.6-4.el7.src.rpm", 49749, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "qrencode-libs", "3.4.1", "3.el7", "x86_64", "qrencode-3.4.1-3.el7.src.rpm", 126732, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper", "1.02.146", "4.el7", "x86_64", "lvm2-2.02.177-4.el7.src.rpm", 338922, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps-ng", "3.3.10", "17.el7_5.2", "x86_64", "procps-ng-3.3.10-17.el7_5.2.src.rpm", 760500, "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cryptsetup-libs", "1.7.4", "4.el7", "x86_64", "cryptsetup-1.7.4-4.el7.src.rpm", 969908, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod", "20", "21.el7", "x86_64", "kmod-20-21.el7.src.rpm", 243127, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd-libs", "219", "57.el7_5.3", "x86_64", "systemd-219-57.el7_5.3.src.rpm", 1263552, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd", "219", "57.el7_5.3", "x86_64", "systemd-219-57.el7_5.3.src.rpm", 24402038, "LGPLv2+ and MIT and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus", "1.10.24", "7.el7", "x86_64", "dbus-1.10.24-7.el7.src.rpm", 595223, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iputils", "20160308", "10.el7", "x86_64", "iputils-20160308-10.el7.src.rpm", 343497, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.10", "8.el7", "x86_64", "gdbm-1.10-8.el7.src.rpm", 184322, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.7.5", "69.el7_5", "x86_64", "python-2.7.5-69.el7_5.src.rpm", 80907, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-python", "1.1.1", "9.el7", "x86_64", "dbus-python-1.1.1-9.el7.src.rpm", 848122, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyliblzma", "0.5.3", "11.el7", "x86_64", "pyliblzma-0.5.3-11.el7.src.rpm", 190112, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.10", "8.el7", "noarch", "python-urlgrabber-3.10-8.el7.src.rpm", 500670, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyxattr", "0.5.1", "5.el7", "x86_64", "pyxattr-0.5.1-5.el7.src.rpm", 63304, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-kitchen", "1.1.1", "5.el7", "noarch", "python-kitchen-1.1.1-5.el7.src.rpm", 1465161, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.22", "5.el7_5", "x86_64", "gnupg2-2.0.22-5.el7_5.src.rpm", 6637796, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.11.3", "32.el7", "x86_64", "rpm-4.11.3-32.el7.src.rpm", 149714, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.3", "9.el7", "x86_64", "pygpgme-0.3-9.el7.src.rpm", 197501, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.4.3", "158.el7.centos", "noarch", "yum-3.4.3-158.el7.centos.src.rpm", 5814102, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-utils", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 343422, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.160", "4.el7", "x86_64", "vim-7.4.160-4.el7.src.rpm", 917640, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.8.5", "28.el7_5.1", "x86_64", "gcc-4.8.5-28.el7_5.1.src.rpm", 179328, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.9", "14.20130511.el7_4", "noarch", "ncurses-5.9-14.20130511.el7_4.src.rpm", 223432, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.2.46", "30.el7", "x86_64", "bash-4.2.46-30.el7.src.rpm", 3667709, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.7.4", "1.el7", "x86_64", "chkconfig-1.7.4-1.el7.src.rpm", 779531, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setup", "2.8.71", "9.el7", "noarch", "setup-2.8.71-9.el7.src.rpm", 696925, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "7.el7.centos", "noarch", "basesystem-10.0-7.el7.centos.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.7", "17.el7", "x86_64", "zlib-1.2.7-17.el7.src.rpm", 185710, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.el7_5", "x86_64", "nss-util-3.36.0-1.el7_5.src.rpm", 194944, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.42.9", "12.el7_5", "x86_64", "e2fsprogs-1.42.9-12.el7_5.src.rpm", 60489, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.46", "13.el7", "x86_64", "attr-2.4.46-13.el7.src.rpm", 19896, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 37056, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.8.5", "28.el7_5.1", "x86_64", "gcc-4.8.5-28.el7_5.1.src.rpm", 1077442, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "5.1", "5.el7", "x86_64", "texinfo-5.1-5.el7.src.rpm", 494630, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "8.32", "17.el7", "x86_64", "pcre-8.32-17.el7.src.rpm", 1475532, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.2", "5.el7", "x86_64", "sed-4.2.2-5.el7.src.rpm", 601208, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 1337825, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "gmp", "6.0.0", "15.el7", "x86_64", "gmp-6.0.0-15.el7.src.rpm", 657046, "LGPLv3+ or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "4.10", "1.el7", "x86_64", "libtasn1-4.10-1.el7.src.rpm", 424486, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "70.0.el7_5", "noarch", "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", 973960, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.22", "21.el7", "x86_64", "coreutils-8.22-21.el7.src.rpm", 14588989, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.15.1", "19.el7", "x86_64", "krb5-1.15.1-19.el7.src.rpm", 1984782, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 70093, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.170", "4.el7", "x86_64", "elfutils-0.170-4.el7.src.rpm", 936931, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1710062, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.2", "10.el7", "x86_64", "readline-6.2-10.el7.src.rpm", 460464, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.11", "27.el7", "x86_64", "cpio-2.11-27.el7.src.rpm", 689335, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 261837, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.54.2", "2.el7", "x86_64", "glib2-2.54.2-2.el7.src.rpm", 11986873, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.7.17", "8.el7", "x86_64", "sqlite-3.7.17-8.el7.src.rpm", 814231, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 209610, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.28", "4.el7", "x86_64", "libidn-1.28-4.el7.src.rpm", 630407, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap-ng", "0.7.5", "4.el7", "x86_64", "libcap-ng-0.7.5-4.el7.src.rpm", 50510, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 9389116, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpwquality", "1.2.3", "5.el7", "x86_64", "libpwquality-1.2.3-5.el7.src.rpm", 332421, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 14061, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-pem", "1.0.3", "4.el7", "x86_64", "nss-pem-1.0.3-4.el7.src.rpm", 201219, "MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 798130, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lz4", "1.7.5", "2.el7", "x86_64", "lz4-1.7.5-2.el7.src.rpm", 366872, "GPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "7.el7_5", "x86_64", "nss-3.36.0-7.el7_5.src.rpm", 2069571, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gobject-introspection", "1.50.0", "1.el7", "x86_64", "gobject-introspection-1.50.0-1.el7.src.rpm", 834149, "GPLv2+, LGPLv2+, MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb-utils", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 326487, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod-libs", "20", "21.el7", "x86_64", "kmod-20-21.el7.src.rpm", 91800, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.3", "10.el7_2.1", "x86_64", "libssh2-1.4.3-10.el7_2.1.src.rpm", 341782, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.29.0", "46.el7", "x86_64", "curl-7.29.0-46.el7.src.rpm", 540259, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.11.3", "32.el7", "x86_64", "rpm-4.11.3-32.el7.src.rpm", 2621900, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.60", "9.el7", "x86_64", "libuser-0.60-9.el7.src.rpm", 1952592, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.26", "34.el7", "x86_64", "tar-1.26-34.el7.src.rpm", 2838271, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "acl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 201225, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "16.el7", "x86_64", "ustr-1.0.4-16.el7.src.rpm", 285943, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "24.el7", "x86_64", "shadow-utils-4.1.5.1-24.el7.src.rpm", 3534490, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "hardlink", "1.0", "19.el7", "x86_64", "hardlink-1.0-19.el7.src.rpm", 16545, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "util-linux", "2.23.2", "52.el7_5.1", "x86_64", "util-linux-2.23.2-52.el7_5.1.src.rpm", 8642769, "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kpartx", "0.4.9", "119.el7_5.1", "x86_64", "device-mapper-multipath-0.4.9-119.el7_5.1.src.rpm", 41363, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper-libs", "1.02.146", "4.el7", "x86_64", "lvm2-2.02.177-4.el7.src.rpm", 400551, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dracut", "033", "535.el7_5.1", "x86_64", "dracut-033-535.el7_5.1.src.rpm", 898198, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libs", "0.170", "4.el7", "x86_64", "elfutils-0.170-4.el7.src.rpm", 747527, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.10.24", "7.el7", "x86_64", "dbus-1.10.24-7.el7.src.rpm", 362584, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-default-yama-scope", "0.170", "4.el7", "noarch", "elfutils-0.170-4.el7.src.rpm", 1810, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-glib", "0.100", "7.el7", "x86_64", "dbus-glib-0.100-7.el7.src.rpm", 301237, "AFL and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.7.5", "69.el7_5", "x86_64", "python-2.7.5-69.el7_5.src.rpm", 24713084, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2-python", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1503050, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-gobject-base", "3.22.0", "1.el7_4.1", "x86_64", "pygobject3-3.22.0-1.el7_4.1.src.rpm", 1123114, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.4", "10.el7", "x86_64", "yum-metadata-parser-1.1.4-10.el7.src.rpm", 58789, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "19.el7", "x86_64", "python-pycurl-7.19.0-19.el7.src.rpm", 241513, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.4", "9.el7", "noarch", "python-iniparse-0.4-9.el7.src.rpm", 115166, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-chardet", "2.2.1", "1.el7_1", "noarch", "python-chardet-2.2.1-1.el7_1.src.rpm", 1156541, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hostname", "3.13", "3.el7", "x86_64", "hostname-3.13-3.el7.src.rpm", 19449, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "23.el7", "x86_64", "pth-2.0.7-23.el7.src.rpm", 267851, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build-libs", "4.11.3", "32.el7", "x86_64", "rpm-4.11.3-32.el7.src.rpm", 166664, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.3.2", "5.el7", "x86_64", "gpgme-1.3.2-5.el7.src.rpm", 547534, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 53895, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-license", "9.9.4", "61.el7_5.1", "noarch", "bind-9.9.4-61.el7_5.1.src.rpm", 26831, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.31", "46.el7_5", "noarch", "yum-utils-1.1.31-46.el7_5.src.rpm", 22399, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.79", "4.el7", "x86_64", "passwd-0.79-4.el7.src.rpm", 429874, "BSD or GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "11.el7", "noarch", "rootfiles-8.1-11.el7.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018e", Release: "3.el7", Arch: "noarch", SourceRpm: "tzdata-2018e-3.el7.src.rpm", Size: 1966505, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 565628, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 439378, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.17", Release: "222.el7", Arch: "x86_64", SourceRpm: "glibc-2.17-222.el7.src.rpm", Size: 120325207, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "3.2", Release: "25.el7", Arch: "x86_64", SourceRpm: "filesystem-3.2-25.el7.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.17", Release: "222.el7", Arch: "x86_64", SourceRpm: "glibc-2.17-222.el7.src.rpm", Size: 14223248, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el7_5.src.rpm", Size: 287728, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "16.el7", Arch: "x86_64", SourceRpm: "popt-1.13-16.el7.src.rpm", Size: 88516, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.13", Release: "18.el7", Arch: "x86_64", SourceRpm: "libffi-3.0.13-18.el7.src.rpm", Size: 47766, License: "MIT and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.22", Release: "9.el7", Arch: "x86_64", SourceRpm: "libcap-2.22-9.el7.src.rpm", Size: 111445, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.5", Release: "8.1.el7", Arch: "x86_64", SourceRpm: "libsepol-2.5-8.1.el7.src.rpm", Size: 686568, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 1028216, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "4.0.2", Release: "4.el7_3.1", Arch: "x86_64", SourceRpm: "gawk-4.0.2-4.el7_3.1.src.rpm", Size: 2435978, License: "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.5", Release: "12.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-12.el7.src.rpm", Size: 217874, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "3.el7", Arch: "x86_64", SourceRpm: "grep-2.20-3.el7.src.rpm", Size: 1195131, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.5.8", Release: "3.el7", Arch: "x86_64", SourceRpm: "keyutils-1.5.8-3.el7.src.rpm", Size: 42138, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libverto", Version: "0.2.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libverto-0.2.5-4.el7.src.rpm", Size: 23060, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 437261, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl-libs", Version: "1.0.2k", Release: "12.el7", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-12.el7.src.rpm", Size: 3200172, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "7", Release: "5.1804.4.el7.centos", Arch: "x86_64", SourceRpm: "centos-release-7-5.1804.4.el7.centos.src.rpm", Size: 40338, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 239967, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 1858008, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "libgpg-error-1.12-3.el7.src.rpm", Size: 350865, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.5.3", Release: "14.el7", Arch: "x86_64", SourceRpm: "libgcrypt-1.5.3-14.el7.src.rpm", Size: 597727, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "15.el7", Arch: "x86_64", SourceRpm: "lua-5.1.4-15.el7.src.rpm", Size: 640319, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 20326, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmount", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 273965, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "1.8", Release: "4.el7", Arch: "x86_64", SourceRpm: "shared-mime-info-1.8-4.el7.src.rpm", Size: 2379317, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "gzip-1.5-10.el7.src.rpm", Size: 250440, License: "GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.5.11", Release: "5.el7", Arch: "x86_64", SourceRpm: "findutils-4.5.11-5.el7.src.rpm", Size: 1855626, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "3.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "diffutils-3.3-4.el7.src.rpm", Size: 1065157, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.1.0", Release: "10.el7_3", Arch: "x86_64", SourceRpm: "expat-2.1.0-10.el7_3.src.rpm", Size: 208315, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.8.1", Release: "3.el7_5.1", Arch: "x86_64", SourceRpm: "audit-2.8.1-3.el7_5.1.src.rpm", Size: 256370, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.8", Release: "22.el7", Arch: "x86_64", SourceRpm: "pam-1.1.8-22.el7.src.rpm", Size: 2630324, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 1130670, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 2424993, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libassuan", Version: "2.1.0", Release: "3.el7", Arch: "x86_64", SourceRpm: "libassuan-2.1.0-3.el7.src.rpm", Size: 155391, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.11", Release: "33.el7", Arch: "x86_64", SourceRpm: "file-5.11-33.el7.src.rpm", Size: 3077690, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.27.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "pkgconfig-0.27.1-4.el7.src.rpm", Size: 105522, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.26", Release: "23.el7", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.26-23.el7.src.rpm", Size: 396911, License: "BSD with advertising", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.27", Release: "28.base.el7_5.1", Arch: "x86_64", SourceRpm: "binutils-2.27-28.base.el7_5.1.src.rpm", Size: 25149789, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.29.0", Release: "46.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-46.el7.src.rpm", Size: 435192, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.11.3", Release: "32.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-32.el7.src.rpm", Size: 611384, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.44", Release: "15.el7_5", Arch: "x86_64", SourceRpm: "openldap-2.4.44-15.el7_5.src.rpm", Size: 1037299, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.8.1", Release: "17.el7", Arch: "x86_64", SourceRpm: "pinentry-0.8.1-17.el7.src.rpm", Size: 159929, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.5", Release: "11.el7", Arch: "x86_64", SourceRpm: "libsemanage-2.5-11.el7.src.rpm", Size: 302369, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.6", Release: "4.el7", Arch: "x86_64", SourceRpm: "libutempter-1.1.6-4.el7.src.rpm", Size: 49749, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "qrencode-libs", Version: "3.4.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "qrencode-3.4.1-3.el7.src.rpm", Size: 126732, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper", Version: "1.02.146", Release: "4.el7", Arch: "x86_64", SourceRpm: "lvm2-2.02.177-4.el7.src.rpm", Size: 338922, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps-ng", Version: "3.3.10", Release: "17.el7_5.2", Arch: "x86_64", SourceRpm: "procps-ng-3.3.10-17.el7_5.2.src.rpm", Size: 760500, License: "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cryptsetup-libs", Version: "1.7.4", Release: "4.el7", Arch: "x86_64", SourceRpm: "cryptsetup-1.7.4-4.el7.src.rpm", Size: 969908, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod", Version: "20", Release: "21.el7", Arch: "x86_64", SourceRpm: "kmod-20-21.el7.src.rpm", Size: 243127, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd-libs", Version: "219", Release: "57.el7_5.3", Arch: "x86_64", SourceRpm: "systemd-219-57.el7_5.3.src.rpm", Size: 1263552, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd", Version: "219", Release: "57.el7_5.3", Arch: "x86_64", SourceRpm: "systemd-219-57.el7_5.3.src.rpm", Size: 24402038, License: "LGPLv2+ and MIT and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus", Version: "1.10.24", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-7.el7.src.rpm", Size: 595223, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iputils", Version: "20160308", Release: "10.el7", Arch: "x86_64", SourceRpm: "iputils-20160308-10.el7.src.rpm", Size: 343497, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.10", Release: "8.el7", Arch: "x86_64", SourceRpm: "gdbm-1.10-8.el7.src.rpm", Size: 184322, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.7.5", Release: "69.el7_5", Arch: "x86_64", SourceRpm: "python-2.7.5-69.el7_5.src.rpm", Size: 80907, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-python", Version: "1.1.1", Release: "9.el7", Arch: "x86_64", SourceRpm: "dbus-python-1.1.1-9.el7.src.rpm", Size: 848122, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyliblzma", Version: "0.5.3", Release: "11.el7", Arch: "x86_64", SourceRpm: "pyliblzma-0.5.3-11.el7.src.rpm", Size: 190112, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.10", Release: "8.el7", Arch: "noarch", SourceRpm: "python-urlgrabber-3.10-8.el7.src.rpm", Size: 500670, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyxattr", Version: "0.5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "pyxattr-0.5.1-5.el7.src.rpm", Size: 63304, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-kitchen", Version: "1.1.1", Release: "5.el7", Arch: "noarch", SourceRpm: "python-kitchen-1.1.1-5.el7.src.rpm", Size: 1465161, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.22", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "gnupg2-2.0.22-5.el7_5.src.rpm", Size: 6637796, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.11.3", Release: "32.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-32.el7.src.rpm", Size: 149714, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "pygpgme-0.3-9.el7.src.rpm", Size: 197501, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.4.3", Release: "158.el7.centos", Arch: "noarch", SourceRpm: "yum-3.4.3-158.el7.centos.src.rpm", Size: 5814102, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-utils", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 343422, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.160", Release: "4.el7", Arch: "x86_64", SourceRpm: "vim-7.4.160-4.el7.src.rpm", Size: 917640, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.8.5", Release: "28.el7_5.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-28.el7_5.1.src.rpm", Size: 179328, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.9", Release: "14.20130511.el7_4", Arch: "noarch", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 223432, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.2.46", Release: "30.el7", Arch: "x86_64", SourceRpm: "bash-4.2.46-30.el7.src.rpm", Size: 3667709, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.7.4", Release: "1.el7", Arch: "x86_64", SourceRpm: "chkconfig-1.7.4-1.el7.src.rpm", Size: 779531, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.71", Release: "9.el7", Arch: "noarch", SourceRpm: "setup-2.8.71-9.el7.src.rpm", Size: 696925, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "7.el7.centos", Arch: "noarch", SourceRpm: "basesystem-10.0-7.el7.centos.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.7", Release: "17.el7", Arch: "x86_64", SourceRpm: "zlib-1.2.7-17.el7.src.rpm", Size: 185710, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.el7_5.src.rpm", Size: 194944, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.42.9", Release: "12.el7_5", Arch: "x86_64", SourceRpm: "e2fsprogs-1.42.9-12.el7_5.src.rpm", Size: 60489, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.46", Release: "13.el7", Arch: "x86_64", SourceRpm: "attr-2.4.46-13.el7.src.rpm", Size: 19896, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 37056, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.8.5", Release: "28.el7_5.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-28.el7_5.1.src.rpm", Size: 1077442, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "texinfo-5.1-5.el7.src.rpm", Size: 494630, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "8.32", Release: "17.el7", Arch: "x86_64", SourceRpm: "pcre-8.32-17.el7.src.rpm", Size: 1475532, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "sed-4.2.2-5.el7.src.rpm", Size: 601208, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 1337825, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "gmp", Version: "6.0.0", Release: "15.el7", Arch: "x86_64", SourceRpm: "gmp-6.0.0-15.el7.src.rpm", Size: 657046, License: "LGPLv3+ or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "4.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "libtasn1-4.10-1.el7.src.rpm", Size: 424486, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "70.0.el7_5", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", Size: 973960, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.22", Release: "21.el7", Arch: "x86_64", SourceRpm: "coreutils-8.22-21.el7.src.rpm", Size: 14588989, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.15.1", Release: "19.el7", Arch: "x86_64", SourceRpm: "krb5-1.15.1-19.el7.src.rpm", Size: 1984782, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 70093, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.170", Release: "4.el7", Arch: "x86_64", SourceRpm: "elfutils-0.170-4.el7.src.rpm", Size: 936931, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1710062, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.2", Release: "10.el7", Arch: "x86_64", SourceRpm: "readline-6.2-10.el7.src.rpm", Size: 460464, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.11", Release: "27.el7", Arch: "x86_64", SourceRpm: "cpio-2.11-27.el7.src.rpm", Size: 689335, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 261837, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.54.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "glib2-2.54.2-2.el7.src.rpm", Size: 11986873, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.7.17", Release: "8.el7", Arch: "x86_64", SourceRpm: "sqlite-3.7.17-8.el7.src.rpm", Size: 814231, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 209610, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.28", Release: "4.el7", Arch: "x86_64", SourceRpm: "libidn-1.28-4.el7.src.rpm", Size: 630407, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap-ng", Version: "0.7.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcap-ng-0.7.5-4.el7.src.rpm", Size: 50510, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 9389116, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpwquality", Version: "1.2.3", Release: "5.el7", Arch: "x86_64", SourceRpm: "libpwquality-1.2.3-5.el7.src.rpm", Size: 332421, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 14061, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-pem", Version: "1.0.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "nss-pem-1.0.3-4.el7.src.rpm", Size: 201219, License: "MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 798130, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lz4", Version: "1.7.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "lz4-1.7.5-2.el7.src.rpm", Size: 366872, License: "GPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "7.el7_5", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.el7_5.src.rpm", Size: 2069571, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gobject-introspection", Version: "1.50.0", Release: "1.el7", Arch: "x86_64", SourceRpm: "gobject-introspection-1.50.0-1.el7.src.rpm", Size: 834149, License: "GPLv2+, LGPLv2+, MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb-utils", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 326487, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod-libs", Version: "20", Release: "21.el7", Arch: "x86_64", SourceRpm: "kmod-20-21.el7.src.rpm", Size: 91800, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.3", Release: "10.el7_2.1", Arch: "x86_64", SourceRpm: "libssh2-1.4.3-10.el7_2.1.src.rpm", Size: 341782, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.29.0", Release: "46.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-46.el7.src.rpm", Size: 540259, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.11.3", Release: "32.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-32.el7.src.rpm", Size: 2621900, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.60", Release: "9.el7", Arch: "x86_64", SourceRpm: "libuser-0.60-9.el7.src.rpm", Size: 1952592, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.26", Release: "34.el7", Arch: "x86_64", SourceRpm: "tar-1.26-34.el7.src.rpm", Size: 2838271, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "acl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 201225, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "16.el7", Arch: "x86_64", SourceRpm: "ustr-1.0.4-16.el7.src.rpm", Size: 285943, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "24.el7", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-24.el7.src.rpm", Size: 3534490, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "hardlink", Version: "1.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "hardlink-1.0-19.el7.src.rpm", Size: 16545, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "util-linux", Version: "2.23.2", Release: "52.el7_5.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-52.el7_5.1.src.rpm", Size: 8642769, License: "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kpartx", Version: "0.4.9", Release: "119.el7_5.1", Arch: "x86_64", SourceRpm: "device-mapper-multipath-0.4.9-119.el7_5.1.src.rpm", Size: 41363, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper-libs", Version: "1.02.146", Release: "4.el7", Arch: "x86_64", SourceRpm: "lvm2-2.02.177-4.el7.src.rpm", Size: 400551, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dracut", Version: "033", Release: "535.el7_5.1", Arch: "x86_64", SourceRpm: "dracut-033-535.el7_5.1.src.rpm", Size: 898198, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.170", Release: "4.el7", Arch: "x86_64", SourceRpm: "elfutils-0.170-4.el7.src.rpm", Size: 747527, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.10.24", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-7.el7.src.rpm", Size: 362584, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-default-yama-scope", Version: "0.170", Release: "4.el7", Arch: "noarch", SourceRpm: "elfutils-0.170-4.el7.src.rpm", Size: 1810, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-glib", Version: "0.100", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-glib-0.100-7.el7.src.rpm", Size: 301237, License: "AFL and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.7.5", Release: "69.el7_5", Arch: "x86_64", SourceRpm: "python-2.7.5-69.el7_5.src.rpm", Size: 24713084, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2-python", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1503050, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-gobject-base", Version: "3.22.0", Release: "1.el7_4.1", Arch: "x86_64", SourceRpm: "pygobject3-3.22.0-1.el7_4.1.src.rpm", Size: 1123114, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.4", Release: "10.el7", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.4-10.el7.src.rpm", Size: 58789, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-19.el7.src.rpm", Size: 241513, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.4", Release: "9.el7", Arch: "noarch", SourceRpm: "python-iniparse-0.4-9.el7.src.rpm", Size: 115166, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-chardet", Version: "2.2.1", Release: "1.el7_1", Arch: "noarch", SourceRpm: "python-chardet-2.2.1-1.el7_1.src.rpm", Size: 1156541, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hostname", Version: "3.13", Release: "3.el7", Arch: "x86_64", SourceRpm: "hostname-3.13-3.el7.src.rpm", Size: 19449, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "23.el7", Arch: "x86_64", SourceRpm: "pth-2.0.7-23.el7.src.rpm", Size: 267851, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build-libs", Version: "4.11.3", Release: "32.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-32.el7.src.rpm", Size: 166664, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.3.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "gpgme-1.3.2-5.el7.src.rpm", Size: 547534, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 53895, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-license", Version: "9.9.4", Release: "61.el7_5.1", Arch: "noarch", SourceRpm: "bind-9.9.4-61.el7_5.1.src.rpm", Size: 26831, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.31", Release: "46.el7_5", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-46.el7_5.src.rpm", Size: 22399, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.79", Release: "4.el7", Arch: "x86_64", SourceRpm: "passwd-0.79-4.el7.src.rpm", Size: 429874, License: "BSD or GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "11.el7", Arch: "noarch", SourceRpm: "rootfiles-8.1-11.el7.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
 	}
 
 	// docker run --rm -it centos/python-35-centos7 bash
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
 	CentOS7Python35 = []PackageInfo{
-		{intRef(), "fontconfig-devel", "2.13.0", "4.3.el7", "x86_64", "fontconfig-2.13.0-4.3.el7.src.rpm", 111050, "MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(32), "bind-license", "9.9.4", "73.el7_6", "noarch", "bind-9.9.4-73.el7_6.src.rpm", 26831, "ISC", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "less", "458", "9.el7", "x86_64", "less-458-9.el7.src.rpm", 215376, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2-devel", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 9213393, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bash", "4.2.46", "31.el7", "x86_64", "bash-4.2.46-31.el7.src.rpm", 3667773, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "util-linux", "2.23.2", "59.el7_6.1", "x86_64", "util-linux-2.23.2-59.el7_6.1.src.rpm", 8460215, "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-common", "2.17", "260.el7_6.3", "x86_64", "glibc-2.17-260.el7_6.3.src.rpm", 120499441, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxslt", "1.1.28", "5.el7", "x86_64", "libxslt-1.1.28-5.el7.src.rpm", 497582, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn-freebl", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 565628, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-headers", "2.17", "260.el7_6.3", "x86_64", "glibc-2.17-260.el7_6.3.src.rpm", 2338328, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "filesystem", "3.2", "25.el7", "x86_64", "filesystem-3.2-25.el7.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 39238921, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc", "2.17", "260.el7_6.3", "x86_64", "glibc-2.17-260.el7_6.3.src.rpm", 14346456, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "autoconf", "2.69", "11.el7", "noarch", "autoconf-2.69-11.el7.src.rpm", 2320371, "GPLv2+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nspr", "4.19.0", "1.el7_5", "x86_64", "nspr-4.19.0-1.el7_5.src.rpm", 287728, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11-common", "1.6.5", "2.el7", "noarch", "libX11-1.6.5-2.el7.src.rpm", 1335662, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "popt", "1.13", "16.el7", "x86_64", "popt-1.13-16.el7.src.rpm", 88516, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXpm", "3.5.12", "1.el7", "x86_64", "libXpm-3.5.12-1.el7.src.rpm", 116599, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err", "1.42.9", "13.el7", "x86_64", "e2fsprogs-1.42.9-13.el7.src.rpm", 60489, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gd", "2.0.35", "26.el7", "x86_64", "gd-2.0.35-26.el7.src.rpm", 554528, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap", "2.22", "9.el7", "x86_64", "libcap-2.22-9.el7.src.rpm", 111445, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXt", "1.1.5", "3.el7", "x86_64", "libXt-1.1.5-3.el7.src.rpm", 430105, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libkadm5", "1.15.1", "37.el7_6", "x86_64", "krb5-1.15.1-37.el7_6.src.rpm", 221096, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "info", "5.1", "5.el7", "x86_64", "texinfo-5.1-5.el7.src.rpm", 494630, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs-devel", "1.5.8", "3.el7", "x86_64", "keyutils-1.5.8-3.el7.src.rpm", 29186, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gawk", "4.0.2", "4.el7_3.1", "x86_64", "gawk-4.0.2-4.el7_3.1.src.rpm", 2435978, "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt-devel", "1.5.3", "14.el7", "x86_64", "libgcrypt-1.5.3-14.el7.src.rpm", 220369, "LGPLv2+ and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux", "2.5", "14.1.el7", "x86_64", "libselinux-2.5-14.1.el7.src.rpm", 217874, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh-clients", "7.4p1", "16.el7", "x86_64", "openssh-7.4p1-16.el7.src.rpm", 2651616, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "grep", "2.20", "3.el7", "x86_64", "grep-2.20-3.el7.src.rpm", 1195131, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "git", "1.8.3.1", "20.el7", "x86_64", "git-1.8.3.1-20.el7.src.rpm", 23232282, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "keyutils-libs", "1.5.8", "3.el7", "x86_64", "keyutils-1.5.8-3.el7.src.rpm", 42138, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-devel", "2.5", "14.1.el7", "x86_64", "libselinux-2.5-14.1.el7.src.rpm", 194101, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libverto", "0.2.5", "4.el7", "x86_64", "libverto-0.2.5-4.el7.src.rpm", 23060, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-devel", "1.15.1", "37.el7_6", "x86_64", "krb5-1.15.1-37.el7_6.src.rpm", 796853, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit-trust", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 437261, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "mariadb-devel", "5.5.60", "1.el7_5", "x86_64", "mariadb-5.5.60-1.el7_5.src.rpm", 3478237, "GPLv2 with exceptions and LGPLv2 and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gd-devel", "2.0.35", "26.el7", "x86_64", "gd-2.0.35-26.el7.src.rpm", 300168, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "krb5-libs", "1.15.1", "37.el7_6", "x86_64", "krb5-1.15.1-37.el7_6.src.rpm", 2200985, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-c++", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 17257523, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-libs", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 239967, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdb", "7.6.1", "114.el7", "x86_64", "gdb-7.6.1-114.el7.src.rpm", 7371507, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and BSD and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 1858008, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lsof", "4.87", "6.el7", "x86_64", "lsof-4.87-6.el7.src.rpm", 949035, "zlib and Sendmail and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error", "1.12", "3.el7", "x86_64", "libgpg-error-1.12-3.el7.src.rpm", 350865, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "patch", "2.7.1", "10.el7_5", "x86_64", "patch-2.7.1-10.el7_5.src.rpm", 215449, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcrypt", "1.5.3", "14.el7", "x86_64", "libgcrypt-1.5.3-14.el7.src.rpm", 597727, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 83791, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lua", "5.1.4", "15.el7", "x86_64", "lua-5.1.4-15.el7.src.rpm", 640319, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "which", "2.20", "7.el7", "x86_64", "which-2.20-7.el7.src.rpm", 76962, "GPLv3", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr", "1.4.8", "3.el7_4.1", "x86_64", "apr-1.4.8-3.el7_4.1.src.rpm", 226686, "ASL 2.0 and BSD with advertising and ISC and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libquadmath", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 282811, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "shared-mime-info", "1.8", "4.el7", "x86_64", "shared-mime-info-1.8-4.el7.src.rpm", 2379317, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-devel", "1.4.8", "3.el7_4.1", "x86_64", "apr-1.4.8-3.el7_4.1.src.rpm", 789433, "ASL 2.0 and BSD with advertising and ISC and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gzip", "1.5", "10.el7", "x86_64", "gzip-1.5-10.el7.src.rpm", 250440, "GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35-python-libs", "3.5.1", "11.el7", "x86_64", "rh-python35-python-3.5.1-11.el7.src.rpm", 40247447, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "findutils", "4.5.11", "6.el7", "x86_64", "findutils-4.5.11-6.el7.src.rpm", 1855882, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35-python-pip", "7.1.0", "2.el7", "noarch", "rh-python35-python-pip-7.1.0-2.el7.src.rpm", 8198956, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "diffutils", "3.3", "4.el7", "x86_64", "diffutils-3.3-4.el7.src.rpm", 1065157, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmnl", "1.0.3", "7.el7", "x86_64", "libmnl-1.0.3-7.el7.src.rpm", 51847, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat", "2.1.0", "10.el7_3", "x86_64", "expat-2.1.0-10.el7_3.src.rpm", 208315, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iptables", "1.4.21", "28.el7", "x86_64", "iptables-1.4.21-28.el7.src.rpm", 1555961, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs", "2.8.4", "4.el7", "x86_64", "audit-2.8.4-4.el7.src.rpm", 256362, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "atlas", "3.10.1", "12.el7", "x86_64", "atlas-3.10.1-12.el7.src.rpm", 21813025, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pam", "1.1.8", "22.el7", "x86_64", "pam-1.1.8-22.el7.src.rpm", 2630324, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util-ldap", "1.5.2", "6.el7", "x86_64", "apr-util-1.5.2-6.el7.src.rpm", 11408, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-softokn", "3.36.0", "5.el7_5", "x86_64", "nss-softokn-3.36.0-5.el7_5.src.rpm", 1130670, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hunspell", "1.3.2", "15.el7", "x86_64", "hunspell-1.3.2-15.el7.src.rpm", 583000, "LGPLv2+ or GPLv2+ or MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss", "3.36.0", "7.1.el7_6", "x86_64", "nss-3.36.0-7.1.el7_6.src.rpm", 2425024, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dwz", "0.11", "3.el7", "x86_64", "dwz-0.11-3.el7.src.rpm", 225103, "GPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libassuan", "2.1.0", "3.el7", "x86_64", "libassuan-2.1.0-3.el7.src.rpm", 155391, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "policycoreutils", "2.5", "29.el7_6.1", "x86_64", "policycoreutils-2.5-29.el7_6.1.src.rpm", 5321515, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcgroup", "0.41", "20.el7", "x86_64", "libcgroup-0.41-20.el7.src.rpm", 137210, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iso-codes", "3.46", "2.el7", "noarch", "iso-codes-3.46-2.el7.src.rpm", 14504447, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage-python", "2.5", "14.el7", "x86_64", "libsemanage-2.5-14.el7.src.rpm", 451817, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-tools", "3.36.0", "7.1.el7_6", "x86_64", "nss-3.36.0-7.1.el7_6.src.rpm", 2069587, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "jansson", "2.10", "1.el7", "x86_64", "jansson-2.10-1.el7.src.rpm", 74998, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-srpm-macros", "1", "8.el7", "noarch", "perl-srpm-macros-1-8.el7.src.rpm", 794, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gobject-introspection", "1.56.1", "1.el7", "x86_64", "gobject-introspection-1.56.1-1.el7.src.rpm", 854207, "GPLv2+, LGPLv2+, MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "scl-utils-build", "20130529", "19.el7", "x86_64", "scl-utils-20130529-19.el7.src.rpm", 18361, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb-utils", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 326487, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35-python-virtualenv", "13.1.2", "2.el7", "noarch", "rh-python35-python-virtualenv-13.1.2-2.el7.src.rpm", 2764777, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod-libs", "20", "23.el7", "x86_64", "kmod-20-23.el7.src.rpm", 91848, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "initscripts", "9.49.46", "1.el7", "x86_64", "initscripts-9.49.46-1.el7.src.rpm", 1519938, "GPLv2 and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libssh2", "1.4.3", "12.el7", "x86_64", "libssh2-1.4.3-12.el7.src.rpm", 341886, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-devel", "2.1.26", "23.el7", "x86_64", "cyrus-sasl-2.1.26-23.el7.src.rpm", 1024214, "BSD with advertising", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "curl", "7.29.0", "51.el7", "x86_64", "curl-7.29.0-51.el7.src.rpm", 540293, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libdb-devel", "5.3.21", "24.el7", "x86_64", "libdb-5.3.21-24.el7.src.rpm", 129076, "BSD and LGPLv2 and Sleepycat", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 2622266, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mailcap", "2.1.41", "2.el7", "noarch", "mailcap-2.1.41-2.el7.src.rpm", 63360, "Public Domain and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuser", "0.60", "9.el7", "x86_64", "libuser-0.60-9.el7.src.rpm", 1952592, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "audit-libs-python", "2.8.4", "4.el7", "x86_64", "audit-2.8.4-4.el7.src.rpm", 327265, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "tar", "1.26", "35.el7", "x86_64", "tar-1.26-35.el7.src.rpm", 2838510, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-runtime", "1.1", "18.el7", "x86_64", "httpd24-1.1-18.el7.src.rpm", 20989, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "acl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 201225, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-libnghttp2", "1.7.1", "7.el7", "x86_64", "httpd24-nghttp2-1.7.1-7.el7.src.rpm", 142738, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-httpd", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 4739300, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsemanage", "2.5", "14.el7", "x86_64", "libsemanage-2.5-14.el7.src.rpm", 302329, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "httpd24-mod_ssl", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 241612, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libutempter", "1.1.6", "4.el7", "x86_64", "libutempter-1.1.6-4.el7.src.rpm", 49749, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-mod_session", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 110671, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "qrencode-libs", "3.4.1", "3.el7", "x86_64", "qrencode-3.4.1-3.el7.src.rpm", 126732, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-httpd-devel", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 836817, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-libs", "2.7.5", "76.el7", "x86_64", "python-2.7.5-76.el7.src.rpm", 24713067, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "enchant", "1.6.0", "8.el7", "x86_64", "enchant-1.6.0-8.el7.src.rpm", 130894, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "atlas-devel", "3.10.1", "12.el7", "x86_64", "atlas-3.10.1-12.el7.src.rpm", 2201996, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtool-ltdl", "2.4.2", "22.el7_3", "x86_64", "libtool-2.4.2-22.el7_3.src.rpm", 67814, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-gobject-base", "3.22.0", "1.el7_4.1", "x86_64", "pygobject3-3.22.0-1.el7_4.1.src.rpm", 1123114, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyliblzma", "0.5.3", "11.el7", "x86_64", "pyliblzma-0.5.3-11.el7.src.rpm", 190112, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-urlgrabber", "3.10", "9.el7", "noarch", "python-urlgrabber-3.10-9.el7.src.rpm", 503349, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pyxattr", "0.5.1", "5.el7", "x86_64", "pyxattr-0.5.1-5.el7.src.rpm", 63304, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-kitchen", "1.1.1", "5.el7", "noarch", "python-kitchen-1.1.1-5.el7.src.rpm", 1465161, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "json-c", "0.11", "4.el7_0", "x86_64", "json-c-0.11-4.el7_0.src.rpm", 65593, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "procps-ng", "3.3.10", "23.el7", "x86_64", "procps-ng-3.3.10-23.el7.src.rpm", 760565, "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper", "1.02.149", "10.el7_6.3", "x86_64", "lvm2-2.02.180-10.el7_6.3.src.rpm", 339987, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cryptsetup-libs", "2.0.3", "3.el7", "x86_64", "cryptsetup-2.0.3-3.el7.src.rpm", 1219591, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kmod", "20", "23.el7", "x86_64", "kmod-20-23.el7.src.rpm", 243559, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd-libs", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 1263456, "LGPLv2+ and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "systemd", "219", "62.el7_6.5", "x86_64", "systemd-219-62.el7_6.5.src.rpm", 24440903, "LGPLv2+ and MIT and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-default-yama-scope", "0.172", "2.el7", "noarch", "elfutils-0.172-2.el7.src.rpm", 1810, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iputils", "20160308", "10.el7", "x86_64", "iputils-20160308-10.el7.src.rpm", 343497, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-glib", "0.100", "7.el7", "x86_64", "dbus-glib-0.100-7.el7.src.rpm", 301237, "AFL and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pth", "2.0.7", "23.el7", "x86_64", "pth-2.0.7-23.el7.src.rpm", 267851, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-build-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 166776, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpgme", "1.3.2", "5.el7", "x86_64", "gpgme-1.3.2-5.el7.src.rpm", 547534, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-fastestmirror", "1.1.31", "50.el7", "noarch", "yum-utils-1.1.31-50.el7.src.rpm", 53895, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-utils", "1.1.31", "50.el7", "noarch", "yum-utils-1.1.31-50.el7.src.rpm", 345277, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "passwd", "0.79", "4.el7", "x86_64", "passwd-0.79-4.el7.src.rpm", 429874, "BSD or GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "vim-minimal", "7.4.160", "5.el7", "x86_64", "vim-7.4.160-5.el7.src.rpm", 917744, "Vim", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "f4a80eb5", "53a7ff4b", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lzo", "2.06", "8.el7", "x86_64", "lzo-2.06-8.el7.src.rpm", 165360, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcroco", "0.6.12", "4.el7", "x86_64", "libcroco-0.6.12-4.el7.src.rpm", 320955, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libarchive", "3.1.2", "10.el7_2", "x86_64", "libarchive-3.1.2-10.el7_2.src.rpm", 745291, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 5029340, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "groff-base", "1.22.2", "8.el7", "x86_64", "groff-1.22.2-8.el7.src.rpm", 3453946, "GPLv3+ and GFDL and BSD and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release-scl-rh", "2", "3.el7.centos", "noarch", "centos-release-scl-rh-2-3.el7.centos.src.rpm", 20015, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gpg-pubkey", "f2ee9d55", "560cfc0a", "", "", 0, "pubkey", "", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid", "2.23.2", "59.el7_6.1", "x86_64", "util-linux-2.23.2-59.el7_6.1.src.rpm", 20326, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "mpfr", "3.1.1", "4.el7", "x86_64", "mpfr-3.1.1-4.el7.src.rpm", 554279, "LGPLv3+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 1077442, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "libpng", "1.5.13", "7.el7_2", "x86_64", "libpng-1.5.13-7.el7_2.src.rpm", 616101, "zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "libpng-devel", "1.5.13", "7.el7_2", "x86_64", "libpng-1.5.13-7.el7_2.src.rpm", 216267, "zlib", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libblkid", "2.23.2", "59.el7_6.1", "x86_64", "util-linux-2.23.2-59.el7_6.1.src.rpm", 265973, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libjpeg-turbo", "1.2.90", "6.el7", "x86_64", "libjpeg-turbo-1.2.90-6.el7.src.rpm", 350499, "IJG", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-nodejs10-runtime", "3.2", "2.el7", "x86_64", "rh-nodejs10-3.2-2.el7.src.rpm", 3921, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXau", "1.0.8", "2.1.el7", "x86_64", "libXau-1.0.8-2.1.el7.src.rpm", 55529, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xorg-x11-proto-devel", "2018.4", "1.el7", "noarch", "xorg-x11-proto-devel-2018.4-1.el7.src.rpm", 1835516, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxcb-devel", "1.13", "1.el7", "x86_64", "libxcb-1.13-1.el7.src.rpm", 2717470, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-nodejs10-npm", "6.4.1", "10.10.0.2.el7", "x86_64", "rh-nodejs10-nodejs-10.10.0-2.el7.src.rpm", 16465524, "MIT and ASL 2.0 and ISC and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dejavu-fonts-common", "2.33", "6.el7", "noarch", "dejavu-fonts-2.33-6.el7.src.rpm", 130455, "Bitstream Vera and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fontconfig", "2.13.0", "4.3.el7", "x86_64", "fontconfig-2.13.0-4.3.el7.src.rpm", 666475, "MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "postgresql", "9.2.24", "1.el7_5", "x86_64", "postgresql-9.2.24-1.el7_5.src.rpm", 16706464, "PostgreSQL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpp", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 15644861, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libstdc++-devel", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 8436804, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck-lib", "1.4.1", "6.el7", "x86_64", "fipscheck-1.4.1-6.el7.src.rpm", 11466, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-parent", "0.225", "244.el7", "noarch", "perl-parent-0.225-244.el7.src.rpm", 8141, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-podlators", "2.5.1", "3.el7", "noarch", "perl-podlators-2.5.1-3.el7.src.rpm", 287679, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Escapes", "1.04", "294.el7_6", "noarch", "perl-5.16.3-294.el7_6.src.rpm", 21091, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Encode", "2.51", "7.el7", "x86_64", "perl-Encode-2.51-7.el7.src.rpm", 10176350, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-libs", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 1647344, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-Time-HiRes", "1.9725", "3.el7", "x86_64", "perl-Time-HiRes-1.9725-3.el7.src.rpm", 94069, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads", "1.87", "4.el7", "x86_64", "perl-threads-1.87-4.el7.src.rpm", 98615, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Carp", "1.26", "244.el7", "noarch", "perl-Carp-1.26-244.el7.src.rpm", 28276, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-constant", "1.27", "2.el7", "noarch", "perl-constant-1.27-2.el7.src.rpm", 26364, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl-macros", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 5134, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Temp", "0.23.01", "3.el7", "noarch", "perl-File-Temp-0.23.01-3.el7.src.rpm", 158781, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-PathTools", "3.40", "5.el7", "x86_64", "perl-PathTools-3.40-5.el7.src.rpm", 174131, "(GPL+ or Artistic) and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Pod-Simple", "3.28", "4.el7", "noarch", "perl-Pod-Simple-3.28-4.el7.src.rpm", 538320, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(4), "perl", "5.16.3", "294.el7_6", "x86_64", "perl-5.16.3-294.el7_6.src.rpm", 23552128, "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-TermReadKey", "2.30", "20.el7", "x86_64", "perl-TermReadKey-2.30-20.el7.src.rpm", 59999, "(Copyright only) and (Artistic or GPL+)", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Test-Harness", "3.28", "3.el7", "noarch", "perl-Test-Harness-3.28-3.el7.src.rpm", 607239, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "expat-devel", "2.1.0", "10.el7_3", "x86_64", "expat-2.1.0-10.el7_3.src.rpm", 182002, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcom_err-devel", "1.42.9", "13.el7", "x86_64", "e2fsprogs-1.42.9-13.el7.src.rpm", 17196, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "tzdata", "2018i", "1.el7", "noarch", "tzdata-2018i-1.el7.src.rpm", 2007607, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz-devel", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 168793, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-base", "5.9", "14.20130511.el7_4", "noarch", "ncurses-5.9-14.20130511.el7_4.src.rpm", 223432, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsmartcols", "2.23.2", "59.el7_6.1", "x86_64", "util-linux-2.23.2-59.el7_6.1.src.rpm", 164608, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "chkconfig", "1.7.4", "1.el7", "x86_64", "chkconfig-1.7.4-1.el7.src.rpm", 779531, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openssh", "7.4p1", "16.el7", "x86_64", "openssh-7.4p1-16.el7.src.rpm", 1995364, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 439378, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kernel-headers", "3.10.0", "957.10.1.el7", "x86_64", "kernel-3.10.0-957.10.1.el7.src.rpm", 3823832, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setup", "2.8.71", "10.el7", "noarch", "setup-2.8.71-10.el7.src.rpm", 696893, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glibc-devel", "2.17", "260.el7_6.3", "x86_64", "glibc-2.17-260.el7_6.3.src.rpm", 1066078, "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "basesystem", "10.0", "7.el7.centos", "noarch", "basesystem-10.0-7.el7.centos.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "m4", "1.4.16", "10.el7", "x86_64", "m4-1.4.16-10.el7.src.rpm", 525707, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib", "1.2.7", "18.el7", "x86_64", "zlib-1.2.7-18.el7.src.rpm", 185294, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre-devel", "8.32", "17.el7", "x86_64", "pcre-8.32-17.el7.src.rpm", 1505771, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-util", "3.36.0", "1.1.el7_6", "x86_64", "nss-util-3.36.0-1.1.el7_6.src.rpm", 199008, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11", "1.6.5", "2.el7", "x86_64", "libX11-1.6.5-2.el7.src.rpm", 1325648, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi", "3.0.13", "18.el7", "x86_64", "libffi-3.0.13-18.el7.src.rpm", 47766, "MIT and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libX11-devel", "1.6.5", "2.el7", "x86_64", "libX11-1.6.5-2.el7.src.rpm", 1100103, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libattr", "2.4.46", "13.el7", "x86_64", "attr-2.4.46-13.el7.src.rpm", 19896, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXext", "1.3.3", "3.el7", "x86_64", "libXext-1.3.3-3.el7.src.rpm", 87102, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libacl", "2.2.51", "14.el7", "x86_64", "acl-2.2.51-14.el7.src.rpm", 37056, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXpm-devel", "3.5.12", "1.el7", "x86_64", "libXpm-3.5.12-1.el7.src.rpm", 68654, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ncurses-libs", "5.9", "14.20130511.el7_4", "x86_64", "ncurses-5.9-14.20130511.el7_4.src.rpm", 1028216, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rsync", "3.1.2", "4.el7", "x86_64", "rsync-3.1.2-4.el7.src.rpm", 834618, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol", "2.5", "10.el7", "x86_64", "libsepol-2.5-10.el7.src.rpm", 686640, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgpg-error-devel", "1.12", "3.el7", "x86_64", "libgpg-error-1.12-3.el7.src.rpm", 29702, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pcre", "8.32", "17.el7", "x86_64", "pcre-8.32-17.el7.src.rpm", 1475532, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libedit", "3.0", "12.20121213cvs.el7", "x86_64", "libedit-3.0-12.20121213cvs.el7.src.rpm", 244257, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sed", "4.2.2", "5.el7", "x86_64", "sed-4.2.2-5.el7.src.rpm", 601208, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Git", "1.8.3.1", "20.el7", "noarch", "git-1.8.3.1-20.el7.src.rpm", 58567, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "p11-kit", "0.23.5", "3.el7", "x86_64", "p11-kit-0.23.5-3.el7.src.rpm", 1337825, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libsepol-devel", "2.5", "10.el7", "x86_64", "libsepol-2.5-10.el7.src.rpm", 126580, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "gmp", "6.0.0", "15.el7", "x86_64", "gmp-6.0.0-15.el7.src.rpm", 657046, "LGPLv3+ or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libverto-devel", "0.2.5", "4.el7", "x86_64", "libverto-0.2.5-4.el7.src.rpm", 25871, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libtasn1", "4.10", "1.el7", "x86_64", "libtasn1-4.10-1.el7.src.rpm", 424486, "GPLv3+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl-devel", "1.0.2k", "16.el7_6.1", "x86_64", "openssl-1.0.2k-16.el7_6.1.src.rpm", 3268426, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ca-certificates", "2018.2.22", "70.0.el7_5", "noarch", "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", 973960, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxslt-devel", "1.1.28", "5.el7", "x86_64", "libxslt-1.1.28-5.el7.src.rpm", 2502662, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "coreutils", "8.22", "23.el7", "x86_64", "coreutils-8.22-23.el7.src.rpm", 14588674, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "automake", "1.13.4", "3.el7", "noarch", "automake-1.13.4-3.el7.src.rpm", 1731909, "GPLv2+ and GFDL and Public Domain and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release", "7", "6.1810.2.el7.centos", "x86_64", "centos-release-7-6.1810.2.el7.centos.src.rpm", 41271, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "postgresql-devel", "9.2.24", "1.el7_5", "x86_64", "postgresql-9.2.24-1.el7_5.src.rpm", 4147268, "PostgreSQL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bzip2-libs", "1.0.6", "13.el7", "x86_64", "bzip2-1.0.6-13.el7.src.rpm", 70093, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "wget", "1.14", "18.el7", "x86_64", "wget-1.14-18.el7.src.rpm", 2055557, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libelf", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 913443, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl-devel", "7.29.0", "51.el7", "x86_64", "curl-7.29.0-51.el7.src.rpm", 638377, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1710062, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite-devel", "3.7.17", "8.el7", "x86_64", "sqlite-3.7.17-8.el7.src.rpm", 374846, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "readline", "6.2", "10.el7", "x86_64", "readline-6.2-10.el7.src.rpm", 460464, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "make", "3.82", "23.el7", "x86_64", "make-3.82-23.el7.src.rpm", 1160684, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cpio", "2.11", "27.el7", "x86_64", "cpio-2.11-27.el7.src.rpm", 689335, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35-runtime", "2.0", "2.el7", "x86_64", "rh-python35-2.0-2.el7.src.rpm", 21152, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util", "1.5.2", "6.el7", "x86_64", "apr-util-1.5.2-6.el7.src.rpm", 198751, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "glib2", "2.56.1", "2.el7", "x86_64", "glib2-2.56.1-2.el7.src.rpm", 12166425, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgfortran", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 1186168, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sqlite", "3.7.17", "8.el7", "x86_64", "sqlite-3.7.17-8.el7.src.rpm", 814231, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35-python-setuptools", "18.0.1", "2.el7", "noarch", "rh-python35-python-setuptools-18.0.1-2.el7.src.rpm", 1785163, "Python or ZPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 209610, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35-python", "3.5.1", "11.el7", "x86_64", "rh-python35-python-3.5.1-11.el7.src.rpm", 42812, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libidn", "1.28", "4.el7", "x86_64", "libidn-1.28-4.el7.src.rpm", 630407, "LGPLv2+ and GPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libnfnetlink", "1.0.1", "4.el7", "x86_64", "libnfnetlink-1.0.1-4.el7.src.rpm", 47123, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libnetfilter_conntrack", "1.0.6", "1.el7_3", "x86_64", "libnetfilter_conntrack-1.0.6-1.el7_3.src.rpm", 143566, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcap-ng", "0.7.5", "4.el7", "x86_64", "libcap-ng-0.7.5-4.el7.src.rpm", 50510, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "iproute", "4.11.0", "14.el7", "x86_64", "iproute-4.11.0-14.el7.src.rpm", 1788731, "GPLv2+ and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cracklib-dicts", "2.9.0", "11.el7", "x86_64", "cracklib-2.9.0-11.el7.src.rpm", 9389116, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libquadmath-devel", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 18514, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libpwquality", "1.2.3", "5.el7", "x86_64", "libpwquality-1.2.3-5.el7.src.rpm", 332421, "BSD or GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util-openssl", "1.5.2", "6.el7", "x86_64", "apr-util-1.5.2-6.el7.src.rpm", 19896, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-pem", "1.0.3", "5.el7", "x86_64", "nss-pem-1.0.3-5.el7.src.rpm", 205539, "MPLv1.1", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hunspell-en-US", "0.20121024", "6.el7", "noarch", "hunspell-en-0.20121024-6.el7.src.rpm", 549311, "LGPLv2+ and LGPLv2 and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss-sysinit", "3.36.0", "7.1.el7_6", "x86_64", "nss-3.36.0-7.1.el7_6.src.rpm", 14067, "MPLv2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-utils", "2.5", "14.1.el7", "x86_64", "libselinux-2.5-14.1.el7.src.rpm", 172645, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xz", "5.2.2", "1.el7", "x86_64", "xz-5.2.2-1.el7.src.rpm", 798130, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zip", "3.0", "11.el7", "x86_64", "zip-3.0-11.el7.src.rpm", 815173, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "file-libs", "5.11", "35.el7", "x86_64", "file-5.11-35.el7.src.rpm", 3076831, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "xml-common", "0.6.3", "39.el7", "noarch", "sgml-common-0.6.3-39.el7.src.rpm", 46969, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "lz4", "1.7.5", "2.el7", "x86_64", "lz4-1.7.5-2.el7.src.rpm", 366872, "GPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libselinux-python", "2.5", "14.1.el7", "x86_64", "libselinux-2.5-14.1.el7.src.rpm", 603236, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "checkpolicy", "2.5", "8.el7", "x86_64", "checkpolicy-2.5-8.el7.src.rpm", 1288327, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl", "1.0.2k", "16.el7_6.1", "x86_64", "openssl-1.0.2k-16.el7_6.1.src.rpm", 833657, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-IPy", "0.75", "6.el7", "noarch", "python-IPy-0.75-6.el7.src.rpm", 121946, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "pkgconfig", "0.27.1", "4.el7", "x86_64", "pkgconfig-0.27.1-4.el7.src.rpm", 105522, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "redhat-rpm-config", "9.1.0", "87.el7.centos", "noarch", "redhat-rpm-config-9.1.0-87.el7.centos.src.rpm", 174572, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35-python-devel", "3.5.1", "11.el7", "x86_64", "rh-python35-python-3.5.1-11.el7.src.rpm", 600179, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl-lib", "2.1.26", "23.el7", "x86_64", "cyrus-sasl-2.1.26-23.el7.src.rpm", 396911, "BSD with advertising", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "sysvinit-tools", "2.88", "14.dsf.el7", "x86_64", "sysvinit-2.88-14.dsf.el7.src.rpm", 109118, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "binutils", "2.27", "34.base.el7", "x86_64", "binutils-2.27-34.base.el7.src.rpm", 25150000, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "cyrus-sasl", "2.1.26", "23.el7", "x86_64", "cyrus-sasl-2.1.26-23.el7.src.rpm", 144492, "BSD with advertising", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libcurl", "7.29.0", "51.el7", "x86_64", "curl-7.29.0-51.el7.src.rpm", 435192, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap-devel", "2.4.44", "21.el7_6", "x86_64", "openldap-2.4.44-21.el7_6.src.rpm", 3847489, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-libs", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 611536, "GPLv2+ and LGPLv2+ with exceptions", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "apr-util-devel", "1.5.2", "6.el7", "x86_64", "apr-util-1.5.2-6.el7.src.rpm", 298354, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "openldap", "2.4.44", "21.el7_6", "x86_64", "openldap-2.4.44-21.el7_6.src.rpm", 1037424, "OpenLDAP", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "setools-libs", "3.3.8", "4.el7", "x86_64", "setools-3.3.8-4.el7.src.rpm", 1917790, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "policycoreutils-python", "2.5", "29.el7_6.1", "x86_64", "policycoreutils-2.5-29.el7_6.1.src.rpm", 1299284, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pinentry", "0.8.1", "17.el7", "x86_64", "pinentry-0.8.1-17.el7.src.rpm", 159929, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-libcurl", "7.61.1", "1.el7", "x86_64", "httpd24-curl-7.61.1-1.el7.src.rpm", 545704, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-httpd-tools", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 177148, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "ustr", "1.0.4", "16.el7", "x86_64", "ustr-1.0.4-16.el7.src.rpm", 285943, "MIT or LGPLv2+ or BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-mod_auth_kerb", "5.4", "33.el7", "x86_64", "httpd24-mod_auth_kerb-5.4-33.el7.src.rpm", 67371, "BSD and MIT and ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(2), "shadow-utils", "4.1.5.1", "25.el7", "x86_64", "shadow-utils-4.1.5.1-25.el7.src.rpm", 3540592, "BSD and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24-mod_ldap", "2.4.34", "7.el7", "x86_64", "httpd24-httpd-2.4.34-7.el7.src.rpm", 134213, "ASL 2.0", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "hardlink", "1.0", "19.el7", "x86_64", "hardlink-1.0-19.el7.src.rpm", 16545, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "httpd24", "1.1", "18.el7", "x86_64", "httpd24-1.1-18.el7.src.rpm", 0, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gdbm", "1.10", "8.el7", "x86_64", "gdbm-1.10-8.el7.src.rpm", 184322, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-python35", "2.0", "2.el7", "x86_64", "rh-python35-2.0-2.el7.src.rpm", 0, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python", "2.7.5", "76.el7", "x86_64", "python-2.7.5-76.el7.src.rpm", 80907, "Python", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gcc-gfortran", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 16443248, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxml2-python", "2.9.1", "6.el7_2.3", "x86_64", "libxml2-2.9.1-6.el7_2.3.src.rpm", 1503050, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "nss_wrapper", "1.0.3", "1.el7", "x86_64", "nss_wrapper-1.0.3-1.el7.src.rpm", 61024, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libffi-devel", "3.0.13", "18.el7", "x86_64", "libffi-3.0.13-18.el7.src.rpm", 27170, "MIT and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-metadata-parser", "1.1.4", "10.el7", "x86_64", "yum-metadata-parser-1.1.4-10.el7.src.rpm", 58789, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-pycurl", "7.19.0", "19.el7", "x86_64", "python-pycurl-7.19.0-19.el7.src.rpm", 241513, "LGPLv2+ or MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-iniparse", "0.4", "9.el7", "noarch", "python-iniparse-0.4-9.el7.src.rpm", 115166, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "python-chardet", "2.2.1", "1.el7_1", "noarch", "python-chardet-2.2.1-1.el7_1.src.rpm", 1156541, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "hostname", "3.13", "3.el7", "x86_64", "hostname-3.13-3.el7.src.rpm", 19449, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "kpartx", "0.4.9", "123.el7", "x86_64", "device-mapper-multipath-0.4.9-123.el7.src.rpm", 41363, "GPL+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(7), "device-mapper-libs", "1.02.149", "10.el7_6.3", "x86_64", "lvm2-2.02.180-10.el7_6.3.src.rpm", 400607, "LGPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dracut", "033", "554.el7", "x86_64", "dracut-033-554.el7.src.rpm", 903230, "GPLv2+ and LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "elfutils-libs", "0.172", "2.el7", "x86_64", "elfutils-0.172-2.el7.src.rpm", 793087, "GPLv2+ or LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus-libs", "1.10.24", "12.el7", "x86_64", "dbus-1.10.24-12.el7.src.rpm", 362560, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "dbus", "1.10.24", "12.el7", "x86_64", "dbus-1.10.24-12.el7.src.rpm", 595210, "(GPLv2+ or AFL) and GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dbus-python", "1.1.1", "9.el7", "x86_64", "dbus-python-1.1.1-9.el7.src.rpm", 848122, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gnupg2", "2.0.22", "5.el7_5", "x86_64", "gnupg2-2.0.22-5.el7_5.src.rpm", 6637796, "GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rpm-python", "4.11.3", "35.el7", "x86_64", "rpm-4.11.3-35.el7.src.rpm", 149898, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "pygpgme", "0.3", "9.el7", "x86_64", "pygpgme-0.3-9.el7.src.rpm", 197501, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum", "3.4.3", "161.el7.centos", "noarch", "yum-3.4.3-161.el7.centos.src.rpm", 5824869, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "yum-plugin-ovl", "1.1.31", "50.el7", "noarch", "yum-utils-1.1.31-50.el7.src.rpm", 22399, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rootfiles", "8.1", "11.el7", "noarch", "rootfiles-8.1-11.el7.src.rpm", 599, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgomp", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 212184, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libunistring", "0.9.3", "9.el7", "x86_64", "libunistring-0.9.3-9.el7.src.rpm", 1145761, "LGPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "gettext-libs", "0.19.8.1", "2.el7", "x86_64", "gettext-0.19.8.1-2.el7.src.rpm", 1546952, "LGPLv2+ and GPLv3+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "bsdtar", "3.1.2", "10.el7_2", "x86_64", "libarchive-3.1.2-10.el7_2.src.rpm", 110459, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "unzip", "6.0", "19.el7", "x86_64", "unzip-6.0-19.el7.src.rpm", 373986, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "scl-utils", "20130529", "19.el7", "x86_64", "scl-utils-20130529-19.el7.src.rpm", 25141, "GPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "centos-release-scl", "2", "3.el7.centos", "noarch", "centos-release-scl-2-3.el7.centos.src.rpm", 20042, "GPLv2", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "openssl-libs", "1.0.2k", "16.el7_6.1", "x86_64", "openssl-1.0.2k-16.el7_6.1.src.rpm", 3204708, "OpenSSL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "zlib-devel", "1.2.7", "18.el7", "x86_64", "zlib-1.2.7-18.el7.src.rpm", 135536, "zlib and Boost", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libgcc", "4.8.5", "36.el7_6.1", "x86_64", "gcc-4.8.5-36.el7_6.1.src.rpm", 179328, "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmpc", "1.0.1", "3.el7", "x86_64", "libmpc-1.0.1-3.el7.src.rpm", 113833, "LGPLv3+ and GFDL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "freetype", "2.8", "12.el7_6.1", "x86_64", "freetype-2.8-12.el7_6.1.src.rpm", 824497, "(FTL or GPLv2+) and BSD and MIT and Public Domain and zlib with acknowledgement", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "freetype-devel", "2.8", "12.el7_6.1", "x86_64", "freetype-2.8-12.el7_6.1.src.rpm", 2980705, "(FTL or GPLv2+) and BSD and MIT and Public Domain and zlib with acknowledgement", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "postgresql-libs", "9.2.24", "1.el7_5", "x86_64", "postgresql-9.2.24-1.el7_5.src.rpm", 683495, "PostgreSQL", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fontpackages-filesystem", "1.44", "8.el7", "noarch", "fontpackages-1.44-8.el7.src.rpm", 0, "Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libICE", "1.0.9", "9.el7", "x86_64", "libICE-1.0.9-9.el7.src.rpm", 152213, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libxcb", "1.13", "1.el7", "x86_64", "libxcb-1.13-1.el7.src.rpm", 1011680, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libXau-devel", "1.0.8", "2.1.el7", "x86_64", "libXau-1.0.8-2.1.el7.src.rpm", 6519, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libSM", "1.2.2", "2.el7", "x86_64", "libSM-1.2.2-2.el7.src.rpm", 81546, "MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "rh-nodejs10-nodejs", "10.10.0", "2.el7", "x86_64", "rh-nodejs10-nodejs-10.10.0-2.el7.src.rpm", 28549171, "MIT and ASL 2.0 and ISC and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "dejavu-sans-fonts", "2.33", "6.el7", "noarch", "dejavu-fonts-2.33-6.el7.src.rpm", 5395167, "Bitstream Vera and Public Domain", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libjpeg-turbo-devel", "1.2.90", "6.el7", "x86_64", "libjpeg-turbo-1.2.90-6.el7.src.rpm", 321845, "IJG", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libmount", "2.23.2", "59.el7_6.1", "x86_64", "util-linux-2.23.2-59.el7_6.1.src.rpm", 278173, "LGPLv2+", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "mariadb-libs", "5.5.60", "1.el7_5", "x86_64", "mariadb-5.5.60-1.el7_5.src.rpm", 4615006, "GPLv2 with exceptions and LGPLv2 and BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "libuuid-devel", "2.23.2", "59.el7_6.1", "x86_64", "util-linux-2.23.2-59.el7_6.1.src.rpm", 17258, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "fipscheck", "1.4.1", "6.el7", "x86_64", "fipscheck-1.4.1-6.el7.src.rpm", 38839, "BSD", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-HTTP-Tiny", "0.033", "3.el7", "noarch", "perl-HTTP-Tiny-0.033-3.el7.src.rpm", 97210, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Perldoc", "3.20", "4.el7", "noarch", "perl-Pod-Perldoc-3.20-4.el7.src.rpm", 166910, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Text-ParseWords", "3.29", "4.el7", "noarch", "perl-Text-ParseWords-3.29-4.el7.src.rpm", 16431, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Pod-Usage", "1.63", "3.el7", "noarch", "perl-Pod-Usage-1.63-3.el7.src.rpm", 44671, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Socket", "2.010", "4.el7", "x86_64", "perl-Socket-2.010-4.el7.src.rpm", 114497, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Filter", "1.49", "3.el7", "x86_64", "perl-Filter-1.49-3.el7.src.rpm", 148475, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Storable", "2.45", "3.el7", "x86_64", "perl-Storable-2.45-3.el7.src.rpm", 181031, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Exporter", "5.68", "3.el7", "noarch", "perl-Exporter-5.68-3.el7.src.rpm", 56612, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Time-Local", "1.2300", "2.el7", "noarch", "perl-Time-Local-1.2300-2.el7.src.rpm", 44062, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-threads-shared", "1.43", "6.el7", "x86_64", "perl-threads-shared-1.43-6.el7.src.rpm", 73972, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-File-Path", "2.09", "2.el7", "noarch", "perl-File-Path-2.09-2.el7.src.rpm", 50067, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Scalar-List-Utils", "1.27", "248.el7", "x86_64", "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", 67994, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Getopt-Long", "2.40", "3.el7", "noarch", "perl-Getopt-Long-2.40-3.el7.src.rpm", 134846, "GPLv2+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(1), "perl-Error", "0.17020", "2.el7", "noarch", "perl-Error-0.17020-2.el7.src.rpm", 50015, "(GPL+ or Artistic) and MIT", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Data-Dumper", "2.145", "3.el7", "x86_64", "perl-Data-Dumper-2.145-3.el7.src.rpm", 99287, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
-		{intRef(), "perl-Thread-Queue", "3.02", "2.el7", "noarch", "perl-Thread-Queue-3.02-2.el7.src.rpm", 27642, "GPL+ or Artistic", "CentOS", PGPHASHALGO_SHA256, nil},
+		{Epoch: intRef(), Name: "fontconfig-devel", Version: "2.13.0", Release: "4.3.el7", Arch: "x86_64", SourceRpm: "fontconfig-2.13.0-4.3.el7.src.rpm", Size: 111050, License: "MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(32), Name: "bind-license", Version: "9.9.4", Release: "73.el7_6", Arch: "noarch", SourceRpm: "bind-9.9.4-73.el7_6.src.rpm", Size: 26831, License: "ISC", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "less", Version: "458", Release: "9.el7", Arch: "x86_64", SourceRpm: "less-458-9.el7.src.rpm", Size: 215376, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2-devel", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 9213393, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.2.46", Release: "31.el7", Arch: "x86_64", SourceRpm: "bash-4.2.46-31.el7.src.rpm", Size: 3667773, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "util-linux", Version: "2.23.2", Release: "59.el7_6.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7_6.1.src.rpm", Size: 8460215, License: "GPLv2 and GPLv2+ and LGPLv2+ and BSD with advertising and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.17", Release: "260.el7_6.3", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.3.src.rpm", Size: 120499441, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxslt", Version: "1.1.28", Release: "5.el7", Arch: "x86_64", SourceRpm: "libxslt-1.1.28-5.el7.src.rpm", Size: 497582, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn-freebl", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 565628, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-headers", Version: "2.17", Release: "260.el7_6.3", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.3.src.rpm", Size: 2338328, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "3.2", Release: "25.el7", Arch: "x86_64", SourceRpm: "filesystem-3.2-25.el7.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 39238921, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.17", Release: "260.el7_6.3", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.3.src.rpm", Size: 14346456, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "autoconf", Version: "2.69", Release: "11.el7", Arch: "noarch", SourceRpm: "autoconf-2.69-11.el7.src.rpm", Size: 2320371, License: "GPLv2+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nspr", Version: "4.19.0", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "nspr-4.19.0-1.el7_5.src.rpm", Size: 287728, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11-common", Version: "1.6.5", Release: "2.el7", Arch: "noarch", SourceRpm: "libX11-1.6.5-2.el7.src.rpm", Size: 1335662, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.13", Release: "16.el7", Arch: "x86_64", SourceRpm: "popt-1.13-16.el7.src.rpm", Size: 88516, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXpm", Version: "3.5.12", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXpm-3.5.12-1.el7.src.rpm", Size: 116599, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.42.9", Release: "13.el7", Arch: "x86_64", SourceRpm: "e2fsprogs-1.42.9-13.el7.src.rpm", Size: 60489, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gd", Version: "2.0.35", Release: "26.el7", Arch: "x86_64", SourceRpm: "gd-2.0.35-26.el7.src.rpm", Size: 554528, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.22", Release: "9.el7", Arch: "x86_64", SourceRpm: "libcap-2.22-9.el7.src.rpm", Size: 111445, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXt", Version: "1.1.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "libXt-1.1.5-3.el7.src.rpm", Size: 430105, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libkadm5", Version: "1.15.1", Release: "37.el7_6", Arch: "x86_64", SourceRpm: "krb5-1.15.1-37.el7_6.src.rpm", Size: 221096, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "info", Version: "5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "texinfo-5.1-5.el7.src.rpm", Size: 494630, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs-devel", Version: "1.5.8", Release: "3.el7", Arch: "x86_64", SourceRpm: "keyutils-1.5.8-3.el7.src.rpm", Size: 29186, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "4.0.2", Release: "4.el7_3.1", Arch: "x86_64", SourceRpm: "gawk-4.0.2-4.el7_3.1.src.rpm", Size: 2435978, License: "GPLv3+ and GPL and LGPLv3+ and LGPL and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt-devel", Version: "1.5.3", Release: "14.el7", Arch: "x86_64", SourceRpm: "libgcrypt-1.5.3-14.el7.src.rpm", Size: 220369, License: "LGPLv2+ and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "2.5", Release: "14.1.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-14.1.el7.src.rpm", Size: 217874, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh-clients", Version: "7.4p1", Release: "16.el7", Arch: "x86_64", SourceRpm: "openssh-7.4p1-16.el7.src.rpm", Size: 2651616, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "2.20", Release: "3.el7", Arch: "x86_64", SourceRpm: "grep-2.20-3.el7.src.rpm", Size: 1195131, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "git", Version: "1.8.3.1", Release: "20.el7", Arch: "x86_64", SourceRpm: "git-1.8.3.1-20.el7.src.rpm", Size: 23232282, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.5.8", Release: "3.el7", Arch: "x86_64", SourceRpm: "keyutils-1.5.8-3.el7.src.rpm", Size: 42138, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-devel", Version: "2.5", Release: "14.1.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-14.1.el7.src.rpm", Size: 194101, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libverto", Version: "0.2.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libverto-0.2.5-4.el7.src.rpm", Size: 23060, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-devel", Version: "1.15.1", Release: "37.el7_6", Arch: "x86_64", SourceRpm: "krb5-1.15.1-37.el7_6.src.rpm", Size: 796853, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 437261, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "mariadb-devel", Version: "5.5.60", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "mariadb-5.5.60-1.el7_5.src.rpm", Size: 3478237, License: "GPLv2 with exceptions and LGPLv2 and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gd-devel", Version: "2.0.35", Release: "26.el7", Arch: "x86_64", SourceRpm: "gd-2.0.35-26.el7.src.rpm", Size: 300168, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.15.1", Release: "37.el7_6", Arch: "x86_64", SourceRpm: "krb5-1.15.1-37.el7_6.src.rpm", Size: 2200985, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-c++", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 17257523, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 239967, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdb", Version: "7.6.1", Release: "114.el7", Arch: "x86_64", SourceRpm: "gdb-7.6.1-114.el7.src.rpm", Size: 7371507, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ and GPLv2+ with exceptions and GPL+ and LGPLv2+ and BSD and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 1858008, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lsof", Version: "4.87", Release: "6.el7", Arch: "x86_64", SourceRpm: "lsof-4.87-6.el7.src.rpm", Size: 949035, License: "zlib and Sendmail and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "libgpg-error-1.12-3.el7.src.rpm", Size: 350865, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "patch", Version: "2.7.1", Release: "10.el7_5", Arch: "x86_64", SourceRpm: "patch-2.7.1-10.el7_5.src.rpm", Size: 215449, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.5.3", Release: "14.el7", Arch: "x86_64", SourceRpm: "libgcrypt-1.5.3-14.el7.src.rpm", Size: 597727, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 83791, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua", Version: "5.1.4", Release: "15.el7", Arch: "x86_64", SourceRpm: "lua-5.1.4-15.el7.src.rpm", Size: 640319, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "which", Version: "2.20", Release: "7.el7", Arch: "x86_64", SourceRpm: "which-2.20-7.el7.src.rpm", Size: 76962, License: "GPLv3", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr", Version: "1.4.8", Release: "3.el7_4.1", Arch: "x86_64", SourceRpm: "apr-1.4.8-3.el7_4.1.src.rpm", Size: 226686, License: "ASL 2.0 and BSD with advertising and ISC and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libquadmath", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 282811, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "shared-mime-info", Version: "1.8", Release: "4.el7", Arch: "x86_64", SourceRpm: "shared-mime-info-1.8-4.el7.src.rpm", Size: 2379317, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-devel", Version: "1.4.8", Release: "3.el7_4.1", Arch: "x86_64", SourceRpm: "apr-1.4.8-3.el7_4.1.src.rpm", Size: 789433, License: "ASL 2.0 and BSD with advertising and ISC and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "gzip-1.5-10.el7.src.rpm", Size: 250440, License: "GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35-python-libs", Version: "3.5.1", Release: "11.el7", Arch: "x86_64", SourceRpm: "rh-python35-python-3.5.1-11.el7.src.rpm", Size: 40247447, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "findutils", Version: "4.5.11", Release: "6.el7", Arch: "x86_64", SourceRpm: "findutils-4.5.11-6.el7.src.rpm", Size: 1855882, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35-python-pip", Version: "7.1.0", Release: "2.el7", Arch: "noarch", SourceRpm: "rh-python35-python-pip-7.1.0-2.el7.src.rpm", Size: 8198956, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "diffutils", Version: "3.3", Release: "4.el7", Arch: "x86_64", SourceRpm: "diffutils-3.3-4.el7.src.rpm", Size: 1065157, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmnl", Version: "1.0.3", Release: "7.el7", Arch: "x86_64", SourceRpm: "libmnl-1.0.3-7.el7.src.rpm", Size: 51847, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.1.0", Release: "10.el7_3", Arch: "x86_64", SourceRpm: "expat-2.1.0-10.el7_3.src.rpm", Size: 208315, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iptables", Version: "1.4.21", Release: "28.el7", Arch: "x86_64", SourceRpm: "iptables-1.4.21-28.el7.src.rpm", Size: 1555961, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "2.8.4", Release: "4.el7", Arch: "x86_64", SourceRpm: "audit-2.8.4-4.el7.src.rpm", Size: 256362, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "atlas", Version: "3.10.1", Release: "12.el7", Arch: "x86_64", SourceRpm: "atlas-3.10.1-12.el7.src.rpm", Size: 21813025, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.1.8", Release: "22.el7", Arch: "x86_64", SourceRpm: "pam-1.1.8-22.el7.src.rpm", Size: 2630324, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util-ldap", Version: "1.5.2", Release: "6.el7", Arch: "x86_64", SourceRpm: "apr-util-1.5.2-6.el7.src.rpm", Size: 11408, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-softokn", Version: "3.36.0", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "nss-softokn-3.36.0-5.el7_5.src.rpm", Size: 1130670, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hunspell", Version: "1.3.2", Release: "15.el7", Arch: "x86_64", SourceRpm: "hunspell-1.3.2-15.el7.src.rpm", Size: 583000, License: "LGPLv2+ or GPLv2+ or MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss", Version: "3.36.0", Release: "7.1.el7_6", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.1.el7_6.src.rpm", Size: 2425024, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dwz", Version: "0.11", Release: "3.el7", Arch: "x86_64", SourceRpm: "dwz-0.11-3.el7.src.rpm", Size: 225103, License: "GPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libassuan", Version: "2.1.0", Release: "3.el7", Arch: "x86_64", SourceRpm: "libassuan-2.1.0-3.el7.src.rpm", Size: 155391, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "policycoreutils", Version: "2.5", Release: "29.el7_6.1", Arch: "x86_64", SourceRpm: "policycoreutils-2.5-29.el7_6.1.src.rpm", Size: 5321515, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcgroup", Version: "0.41", Release: "20.el7", Arch: "x86_64", SourceRpm: "libcgroup-0.41-20.el7.src.rpm", Size: 137210, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iso-codes", Version: "3.46", Release: "2.el7", Arch: "noarch", SourceRpm: "iso-codes-3.46-2.el7.src.rpm", Size: 14504447, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage-python", Version: "2.5", Release: "14.el7", Arch: "x86_64", SourceRpm: "libsemanage-2.5-14.el7.src.rpm", Size: 451817, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-tools", Version: "3.36.0", Release: "7.1.el7_6", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.1.el7_6.src.rpm", Size: 2069587, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "jansson", Version: "2.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "jansson-2.10-1.el7.src.rpm", Size: 74998, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-srpm-macros", Version: "1", Release: "8.el7", Arch: "noarch", SourceRpm: "perl-srpm-macros-1-8.el7.src.rpm", Size: 794, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gobject-introspection", Version: "1.56.1", Release: "1.el7", Arch: "x86_64", SourceRpm: "gobject-introspection-1.56.1-1.el7.src.rpm", Size: 854207, License: "GPLv2+, LGPLv2+, MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "scl-utils-build", Version: "20130529", Release: "19.el7", Arch: "x86_64", SourceRpm: "scl-utils-20130529-19.el7.src.rpm", Size: 18361, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb-utils", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 326487, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35-python-virtualenv", Version: "13.1.2", Release: "2.el7", Arch: "noarch", SourceRpm: "rh-python35-python-virtualenv-13.1.2-2.el7.src.rpm", Size: 2764777, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod-libs", Version: "20", Release: "23.el7", Arch: "x86_64", SourceRpm: "kmod-20-23.el7.src.rpm", Size: 91848, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "initscripts", Version: "9.49.46", Release: "1.el7", Arch: "x86_64", SourceRpm: "initscripts-9.49.46-1.el7.src.rpm", Size: 1519938, License: "GPLv2 and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh2", Version: "1.4.3", Release: "12.el7", Arch: "x86_64", SourceRpm: "libssh2-1.4.3-12.el7.src.rpm", Size: 341886, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-devel", Version: "2.1.26", Release: "23.el7", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.26-23.el7.src.rpm", Size: 1024214, License: "BSD with advertising", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.29.0", Release: "51.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-51.el7.src.rpm", Size: 540293, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb-devel", Version: "5.3.21", Release: "24.el7", Arch: "x86_64", SourceRpm: "libdb-5.3.21-24.el7.src.rpm", Size: 129076, License: "BSD and LGPLv2 and Sleepycat", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 2622266, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mailcap", Version: "2.1.41", Release: "2.el7", Arch: "noarch", SourceRpm: "mailcap-2.1.41-2.el7.src.rpm", Size: 63360, License: "Public Domain and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuser", Version: "0.60", Release: "9.el7", Arch: "x86_64", SourceRpm: "libuser-0.60-9.el7.src.rpm", Size: 1952592, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs-python", Version: "2.8.4", Release: "4.el7", Arch: "x86_64", SourceRpm: "audit-2.8.4-4.el7.src.rpm", Size: 327265, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.26", Release: "35.el7", Arch: "x86_64", SourceRpm: "tar-1.26-35.el7.src.rpm", Size: 2838510, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-runtime", Version: "1.1", Release: "18.el7", Arch: "x86_64", SourceRpm: "httpd24-1.1-18.el7.src.rpm", Size: 20989, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "acl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 201225, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-libnghttp2", Version: "1.7.1", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-nghttp2-1.7.1-7.el7.src.rpm", Size: 142738, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-httpd", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 4739300, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "2.5", Release: "14.el7", Arch: "x86_64", SourceRpm: "libsemanage-2.5-14.el7.src.rpm", Size: 302329, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "httpd24-mod_ssl", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 241612, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libutempter", Version: "1.1.6", Release: "4.el7", Arch: "x86_64", SourceRpm: "libutempter-1.1.6-4.el7.src.rpm", Size: 49749, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-mod_session", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 110671, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "qrencode-libs", Version: "3.4.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "qrencode-3.4.1-3.el7.src.rpm", Size: 126732, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-httpd-devel", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 836817, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-libs", Version: "2.7.5", Release: "76.el7", Arch: "x86_64", SourceRpm: "python-2.7.5-76.el7.src.rpm", Size: 24713067, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "enchant", Version: "1.6.0", Release: "8.el7", Arch: "x86_64", SourceRpm: "enchant-1.6.0-8.el7.src.rpm", Size: 130894, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "atlas-devel", Version: "3.10.1", Release: "12.el7", Arch: "x86_64", SourceRpm: "atlas-3.10.1-12.el7.src.rpm", Size: 2201996, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtool-ltdl", Version: "2.4.2", Release: "22.el7_3", Arch: "x86_64", SourceRpm: "libtool-2.4.2-22.el7_3.src.rpm", Size: 67814, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-gobject-base", Version: "3.22.0", Release: "1.el7_4.1", Arch: "x86_64", SourceRpm: "pygobject3-3.22.0-1.el7_4.1.src.rpm", Size: 1123114, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyliblzma", Version: "0.5.3", Release: "11.el7", Arch: "x86_64", SourceRpm: "pyliblzma-0.5.3-11.el7.src.rpm", Size: 190112, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-urlgrabber", Version: "3.10", Release: "9.el7", Arch: "noarch", SourceRpm: "python-urlgrabber-3.10-9.el7.src.rpm", Size: 503349, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pyxattr", Version: "0.5.1", Release: "5.el7", Arch: "x86_64", SourceRpm: "pyxattr-0.5.1-5.el7.src.rpm", Size: 63304, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-kitchen", Version: "1.1.1", Release: "5.el7", Arch: "noarch", SourceRpm: "python-kitchen-1.1.1-5.el7.src.rpm", Size: 1465161, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "json-c", Version: "0.11", Release: "4.el7_0", Arch: "x86_64", SourceRpm: "json-c-0.11-4.el7_0.src.rpm", Size: 65593, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "procps-ng", Version: "3.3.10", Release: "23.el7", Arch: "x86_64", SourceRpm: "procps-ng-3.3.10-23.el7.src.rpm", Size: 760565, License: "GPL+ and GPLv2 and GPLv2+ and GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper", Version: "1.02.149", Release: "10.el7_6.3", Arch: "x86_64", SourceRpm: "lvm2-2.02.180-10.el7_6.3.src.rpm", Size: 339987, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cryptsetup-libs", Version: "2.0.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "cryptsetup-2.0.3-3.el7.src.rpm", Size: 1219591, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kmod", Version: "20", Release: "23.el7", Arch: "x86_64", SourceRpm: "kmod-20-23.el7.src.rpm", Size: 243559, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd-libs", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 1263456, License: "LGPLv2+ and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "systemd", Version: "219", Release: "62.el7_6.5", Arch: "x86_64", SourceRpm: "systemd-219-62.el7_6.5.src.rpm", Size: 24440903, License: "LGPLv2+ and MIT and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-default-yama-scope", Version: "0.172", Release: "2.el7", Arch: "noarch", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 1810, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iputils", Version: "20160308", Release: "10.el7", Arch: "x86_64", SourceRpm: "iputils-20160308-10.el7.src.rpm", Size: 343497, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-glib", Version: "0.100", Release: "7.el7", Arch: "x86_64", SourceRpm: "dbus-glib-0.100-7.el7.src.rpm", Size: 301237, License: "AFL and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pth", Version: "2.0.7", Release: "23.el7", Arch: "x86_64", SourceRpm: "pth-2.0.7-23.el7.src.rpm", Size: 267851, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 166776, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.3.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "gpgme-1.3.2-5.el7.src.rpm", Size: 547534, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-fastestmirror", Version: "1.1.31", Release: "50.el7", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-50.el7.src.rpm", Size: 53895, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-utils", Version: "1.1.31", Release: "50.el7", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-50.el7.src.rpm", Size: 345277, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "passwd", Version: "0.79", Release: "4.el7", Arch: "x86_64", SourceRpm: "passwd-0.79-4.el7.src.rpm", Size: 429874, License: "BSD or GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "7.4.160", Release: "5.el7", Arch: "x86_64", SourceRpm: "vim-7.4.160-5.el7.src.rpm", Size: 917744, License: "Vim", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "f4a80eb5", Release: "53a7ff4b", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lzo", Version: "2.06", Release: "8.el7", Arch: "x86_64", SourceRpm: "lzo-2.06-8.el7.src.rpm", Size: 165360, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcroco", Version: "0.6.12", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcroco-0.6.12-4.el7.src.rpm", Size: 320955, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libarchive", Version: "3.1.2", Release: "10.el7_2", Arch: "x86_64", SourceRpm: "libarchive-3.1.2-10.el7_2.src.rpm", Size: 745291, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 5029340, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "groff-base", Version: "1.22.2", Release: "8.el7", Arch: "x86_64", SourceRpm: "groff-1.22.2-8.el7.src.rpm", Size: 3453946, License: "GPLv3+ and GFDL and BSD and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release-scl-rh", Version: "2", Release: "3.el7.centos", Arch: "noarch", SourceRpm: "centos-release-scl-rh-2-3.el7.centos.src.rpm", Size: 20015, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "f2ee9d55", Release: "560cfc0a", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: "", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.23.2", Release: "59.el7_6.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7_6.1.src.rpm", Size: 20326, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mpfr", Version: "3.1.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "mpfr-3.1.1-4.el7.src.rpm", Size: 554279, License: "LGPLv3+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 1077442, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "libpng", Version: "1.5.13", Release: "7.el7_2", Arch: "x86_64", SourceRpm: "libpng-1.5.13-7.el7_2.src.rpm", Size: 616101, License: "zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "libpng-devel", Version: "1.5.13", Release: "7.el7_2", Arch: "x86_64", SourceRpm: "libpng-1.5.13-7.el7_2.src.rpm", Size: 216267, License: "zlib", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.23.2", Release: "59.el7_6.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7_6.1.src.rpm", Size: 265973, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libjpeg-turbo", Version: "1.2.90", Release: "6.el7", Arch: "x86_64", SourceRpm: "libjpeg-turbo-1.2.90-6.el7.src.rpm", Size: 350499, License: "IJG", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-nodejs10-runtime", Version: "3.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "rh-nodejs10-3.2-2.el7.src.rpm", Size: 3921, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXau", Version: "1.0.8", Release: "2.1.el7", Arch: "x86_64", SourceRpm: "libXau-1.0.8-2.1.el7.src.rpm", Size: 55529, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xorg-x11-proto-devel", Version: "2018.4", Release: "1.el7", Arch: "noarch", SourceRpm: "xorg-x11-proto-devel-2018.4-1.el7.src.rpm", Size: 1835516, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxcb-devel", Version: "1.13", Release: "1.el7", Arch: "x86_64", SourceRpm: "libxcb-1.13-1.el7.src.rpm", Size: 2717470, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-nodejs10-npm", Version: "6.4.1", Release: "10.10.0.2.el7", Arch: "x86_64", SourceRpm: "rh-nodejs10-nodejs-10.10.0-2.el7.src.rpm", Size: 16465524, License: "MIT and ASL 2.0 and ISC and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dejavu-fonts-common", Version: "2.33", Release: "6.el7", Arch: "noarch", SourceRpm: "dejavu-fonts-2.33-6.el7.src.rpm", Size: 130455, License: "Bitstream Vera and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fontconfig", Version: "2.13.0", Release: "4.3.el7", Arch: "x86_64", SourceRpm: "fontconfig-2.13.0-4.3.el7.src.rpm", Size: 666475, License: "MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "postgresql", Version: "9.2.24", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "postgresql-9.2.24-1.el7_5.src.rpm", Size: 16706464, License: "PostgreSQL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpp", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 15644861, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++-devel", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 8436804, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck-lib", Version: "1.4.1", Release: "6.el7", Arch: "x86_64", SourceRpm: "fipscheck-1.4.1-6.el7.src.rpm", Size: 11466, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-parent", Version: "0.225", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-parent-0.225-244.el7.src.rpm", Size: 8141, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-podlators", Version: "2.5.1", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-podlators-2.5.1-3.el7.src.rpm", Size: 287679, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Escapes", Version: "1.04", Release: "294.el7_6", Arch: "noarch", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 21091, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Encode", Version: "2.51", Release: "7.el7", Arch: "x86_64", SourceRpm: "perl-Encode-2.51-7.el7.src.rpm", Size: 10176350, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-libs", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 1647344, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-Time-HiRes", Version: "1.9725", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Time-HiRes-1.9725-3.el7.src.rpm", Size: 94069, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads", Version: "1.87", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-threads-1.87-4.el7.src.rpm", Size: 98615, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Carp", Version: "1.26", Release: "244.el7", Arch: "noarch", SourceRpm: "perl-Carp-1.26-244.el7.src.rpm", Size: 28276, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-constant", Version: "1.27", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-constant-1.27-2.el7.src.rpm", Size: 26364, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl-macros", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 5134, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Temp", Version: "0.23.01", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-File-Temp-0.23.01-3.el7.src.rpm", Size: 158781, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-PathTools", Version: "3.40", Release: "5.el7", Arch: "x86_64", SourceRpm: "perl-PathTools-3.40-5.el7.src.rpm", Size: 174131, License: "(GPL+ or Artistic) and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Pod-Simple", Version: "3.28", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Simple-3.28-4.el7.src.rpm", Size: 538320, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(4), Name: "perl", Version: "5.16.3", Release: "294.el7_6", Arch: "x86_64", SourceRpm: "perl-5.16.3-294.el7_6.src.rpm", Size: 23552128, License: "(GPL+ or Artistic) and (GPLv2+ or Artistic) and Copyright Only and MIT and Public Domain and UCD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-TermReadKey", Version: "2.30", Release: "20.el7", Arch: "x86_64", SourceRpm: "perl-TermReadKey-2.30-20.el7.src.rpm", Size: 59999, License: "(Copyright only) and (Artistic or GPL+)", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Test-Harness", Version: "3.28", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Test-Harness-3.28-3.el7.src.rpm", Size: 607239, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat-devel", Version: "2.1.0", Release: "10.el7_3", Arch: "x86_64", SourceRpm: "expat-2.1.0-10.el7_3.src.rpm", Size: 182002, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err-devel", Version: "1.42.9", Release: "13.el7", Arch: "x86_64", SourceRpm: "e2fsprogs-1.42.9-13.el7.src.rpm", Size: 17196, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2018i", Release: "1.el7", Arch: "noarch", SourceRpm: "tzdata-2018i-1.el7.src.rpm", Size: 2007607, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-devel", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 168793, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "5.9", Release: "14.20130511.el7_4", Arch: "noarch", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 223432, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsmartcols", Version: "2.23.2", Release: "59.el7_6.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7_6.1.src.rpm", Size: 164608, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "chkconfig", Version: "1.7.4", Release: "1.el7", Arch: "x86_64", SourceRpm: "chkconfig-1.7.4-1.el7.src.rpm", Size: 779531, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openssh", Version: "7.4p1", Release: "16.el7", Arch: "x86_64", SourceRpm: "openssh-7.4p1-16.el7.src.rpm", Size: 1995364, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 439378, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kernel-headers", Version: "3.10.0", Release: "957.10.1.el7", Arch: "x86_64", SourceRpm: "kernel-3.10.0-957.10.1.el7.src.rpm", Size: 3823832, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.8.71", Release: "10.el7", Arch: "noarch", SourceRpm: "setup-2.8.71-10.el7.src.rpm", Size: 696893, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-devel", Version: "2.17", Release: "260.el7_6.3", Arch: "x86_64", SourceRpm: "glibc-2.17-260.el7_6.3.src.rpm", Size: 1066078, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "10.0", Release: "7.el7.centos", Arch: "noarch", SourceRpm: "basesystem-10.0-7.el7.centos.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "m4", Version: "1.4.16", Release: "10.el7", Arch: "x86_64", SourceRpm: "m4-1.4.16-10.el7.src.rpm", Size: 525707, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.7", Release: "18.el7", Arch: "x86_64", SourceRpm: "zlib-1.2.7-18.el7.src.rpm", Size: 185294, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre-devel", Version: "8.32", Release: "17.el7", Arch: "x86_64", SourceRpm: "pcre-8.32-17.el7.src.rpm", Size: 1505771, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-util", Version: "3.36.0", Release: "1.1.el7_6", Arch: "x86_64", SourceRpm: "nss-util-3.36.0-1.1.el7_6.src.rpm", Size: 199008, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11", Version: "1.6.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "libX11-1.6.5-2.el7.src.rpm", Size: 1325648, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.0.13", Release: "18.el7", Arch: "x86_64", SourceRpm: "libffi-3.0.13-18.el7.src.rpm", Size: 47766, License: "MIT and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libX11-devel", Version: "1.6.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "libX11-1.6.5-2.el7.src.rpm", Size: 1100103, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.4.46", Release: "13.el7", Arch: "x86_64", SourceRpm: "attr-2.4.46-13.el7.src.rpm", Size: 19896, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXext", Version: "1.3.3", Release: "3.el7", Arch: "x86_64", SourceRpm: "libXext-1.3.3-3.el7.src.rpm", Size: 87102, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.2.51", Release: "14.el7", Arch: "x86_64", SourceRpm: "acl-2.2.51-14.el7.src.rpm", Size: 37056, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXpm-devel", Version: "3.5.12", Release: "1.el7", Arch: "x86_64", SourceRpm: "libXpm-3.5.12-1.el7.src.rpm", Size: 68654, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "5.9", Release: "14.20130511.el7_4", Arch: "x86_64", SourceRpm: "ncurses-5.9-14.20130511.el7_4.src.rpm", Size: 1028216, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rsync", Version: "3.1.2", Release: "4.el7", Arch: "x86_64", SourceRpm: "rsync-3.1.2-4.el7.src.rpm", Size: 834618, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "2.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "libsepol-2.5-10.el7.src.rpm", Size: 686640, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error-devel", Version: "1.12", Release: "3.el7", Arch: "x86_64", SourceRpm: "libgpg-error-1.12-3.el7.src.rpm", Size: 29702, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "8.32", Release: "17.el7", Arch: "x86_64", SourceRpm: "pcre-8.32-17.el7.src.rpm", Size: 1475532, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libedit", Version: "3.0", Release: "12.20121213cvs.el7", Arch: "x86_64", SourceRpm: "libedit-3.0-12.20121213cvs.el7.src.rpm", Size: 244257, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.2.2", Release: "5.el7", Arch: "x86_64", SourceRpm: "sed-4.2.2-5.el7.src.rpm", Size: 601208, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Git", Version: "1.8.3.1", Release: "20.el7", Arch: "noarch", SourceRpm: "git-1.8.3.1-20.el7.src.rpm", Size: 58567, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.23.5", Release: "3.el7", Arch: "x86_64", SourceRpm: "p11-kit-0.23.5-3.el7.src.rpm", Size: 1337825, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol-devel", Version: "2.5", Release: "10.el7", Arch: "x86_64", SourceRpm: "libsepol-2.5-10.el7.src.rpm", Size: 126580, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "gmp", Version: "6.0.0", Release: "15.el7", Arch: "x86_64", SourceRpm: "gmp-6.0.0-15.el7.src.rpm", Size: 657046, License: "LGPLv3+ or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libverto-devel", Version: "0.2.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libverto-0.2.5-4.el7.src.rpm", Size: 25871, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "4.10", Release: "1.el7", Arch: "x86_64", SourceRpm: "libtasn1-4.10-1.el7.src.rpm", Size: 424486, License: "GPLv3+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl-devel", Version: "1.0.2k", Release: "16.el7_6.1", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-16.el7_6.1.src.rpm", Size: 3268426, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2018.2.22", Release: "70.0.el7_5", Arch: "noarch", SourceRpm: "ca-certificates-2018.2.22-70.0.el7_5.src.rpm", Size: 973960, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxslt-devel", Version: "1.1.28", Release: "5.el7", Arch: "x86_64", SourceRpm: "libxslt-1.1.28-5.el7.src.rpm", Size: 2502662, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.22", Release: "23.el7", Arch: "x86_64", SourceRpm: "coreutils-8.22-23.el7.src.rpm", Size: 14588674, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "automake", Version: "1.13.4", Release: "3.el7", Arch: "noarch", SourceRpm: "automake-1.13.4-3.el7.src.rpm", Size: 1731909, License: "GPLv2+ and GFDL and Public Domain and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release", Version: "7", Release: "6.1810.2.el7.centos", Arch: "x86_64", SourceRpm: "centos-release-7-6.1810.2.el7.centos.src.rpm", Size: 41271, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "postgresql-devel", Version: "9.2.24", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "postgresql-9.2.24-1.el7_5.src.rpm", Size: 4147268, License: "PostgreSQL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.6", Release: "13.el7", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-13.el7.src.rpm", Size: 70093, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "wget", Version: "1.14", Release: "18.el7", Arch: "x86_64", SourceRpm: "wget-1.14-18.el7.src.rpm", Size: 2055557, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 913443, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl-devel", Version: "7.29.0", Release: "51.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-51.el7.src.rpm", Size: 638377, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1710062, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite-devel", Version: "3.7.17", Release: "8.el7", Arch: "x86_64", SourceRpm: "sqlite-3.7.17-8.el7.src.rpm", Size: 374846, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "6.2", Release: "10.el7", Arch: "x86_64", SourceRpm: "readline-6.2-10.el7.src.rpm", Size: 460464, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "make", Version: "3.82", Release: "23.el7", Arch: "x86_64", SourceRpm: "make-3.82-23.el7.src.rpm", Size: 1160684, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cpio", Version: "2.11", Release: "27.el7", Arch: "x86_64", SourceRpm: "cpio-2.11-27.el7.src.rpm", Size: 689335, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35-runtime", Version: "2.0", Release: "2.el7", Arch: "x86_64", SourceRpm: "rh-python35-2.0-2.el7.src.rpm", Size: 21152, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util", Version: "1.5.2", Release: "6.el7", Arch: "x86_64", SourceRpm: "apr-util-1.5.2-6.el7.src.rpm", Size: 198751, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.56.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "glib2-2.56.1-2.el7.src.rpm", Size: 12166425, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgfortran", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 1186168, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite", Version: "3.7.17", Release: "8.el7", Arch: "x86_64", SourceRpm: "sqlite-3.7.17-8.el7.src.rpm", Size: 814231, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35-python-setuptools", Version: "18.0.1", Release: "2.el7", Arch: "noarch", SourceRpm: "rh-python35-python-setuptools-18.0.1-2.el7.src.rpm", Size: 1785163, License: "Python or ZPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 209610, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35-python", Version: "3.5.1", Release: "11.el7", Arch: "x86_64", SourceRpm: "rh-python35-python-3.5.1-11.el7.src.rpm", Size: 42812, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn", Version: "1.28", Release: "4.el7", Arch: "x86_64", SourceRpm: "libidn-1.28-4.el7.src.rpm", Size: 630407, License: "LGPLv2+ and GPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libnfnetlink", Version: "1.0.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "libnfnetlink-1.0.1-4.el7.src.rpm", Size: 47123, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libnetfilter_conntrack", Version: "1.0.6", Release: "1.el7_3", Arch: "x86_64", SourceRpm: "libnetfilter_conntrack-1.0.6-1.el7_3.src.rpm", Size: 143566, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap-ng", Version: "0.7.5", Release: "4.el7", Arch: "x86_64", SourceRpm: "libcap-ng-0.7.5-4.el7.src.rpm", Size: 50510, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "iproute", Version: "4.11.0", Release: "14.el7", Arch: "x86_64", SourceRpm: "iproute-4.11.0-14.el7.src.rpm", Size: 1788731, License: "GPLv2+ and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib-dicts", Version: "2.9.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "cracklib-2.9.0-11.el7.src.rpm", Size: 9389116, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libquadmath-devel", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 18514, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpwquality", Version: "1.2.3", Release: "5.el7", Arch: "x86_64", SourceRpm: "libpwquality-1.2.3-5.el7.src.rpm", Size: 332421, License: "BSD or GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util-openssl", Version: "1.5.2", Release: "6.el7", Arch: "x86_64", SourceRpm: "apr-util-1.5.2-6.el7.src.rpm", Size: 19896, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-pem", Version: "1.0.3", Release: "5.el7", Arch: "x86_64", SourceRpm: "nss-pem-1.0.3-5.el7.src.rpm", Size: 205539, License: "MPLv1.1", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hunspell-en-US", Version: "0.20121024", Release: "6.el7", Arch: "noarch", SourceRpm: "hunspell-en-0.20121024-6.el7.src.rpm", Size: 549311, License: "LGPLv2+ and LGPLv2 and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss-sysinit", Version: "3.36.0", Release: "7.1.el7_6", Arch: "x86_64", SourceRpm: "nss-3.36.0-7.1.el7_6.src.rpm", Size: 14067, License: "MPLv2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-utils", Version: "2.5", Release: "14.1.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-14.1.el7.src.rpm", Size: 172645, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz", Version: "5.2.2", Release: "1.el7", Arch: "x86_64", SourceRpm: "xz-5.2.2-1.el7.src.rpm", Size: 798130, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zip", Version: "3.0", Release: "11.el7", Arch: "x86_64", SourceRpm: "zip-3.0-11.el7.src.rpm", Size: 815173, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.11", Release: "35.el7", Arch: "x86_64", SourceRpm: "file-5.11-35.el7.src.rpm", Size: 3076831, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xml-common", Version: "0.6.3", Release: "39.el7", Arch: "noarch", SourceRpm: "sgml-common-0.6.3-39.el7.src.rpm", Size: 46969, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lz4", Version: "1.7.5", Release: "2.el7", Arch: "x86_64", SourceRpm: "lz4-1.7.5-2.el7.src.rpm", Size: 366872, License: "GPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux-python", Version: "2.5", Release: "14.1.el7", Arch: "x86_64", SourceRpm: "libselinux-2.5-14.1.el7.src.rpm", Size: 603236, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "checkpolicy", Version: "2.5", Release: "8.el7", Arch: "x86_64", SourceRpm: "checkpolicy-2.5-8.el7.src.rpm", Size: 1288327, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl", Version: "1.0.2k", Release: "16.el7_6.1", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-16.el7_6.1.src.rpm", Size: 833657, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-IPy", Version: "0.75", Release: "6.el7", Arch: "noarch", SourceRpm: "python-IPy-0.75-6.el7.src.rpm", Size: 121946, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "pkgconfig", Version: "0.27.1", Release: "4.el7", Arch: "x86_64", SourceRpm: "pkgconfig-0.27.1-4.el7.src.rpm", Size: 105522, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "redhat-rpm-config", Version: "9.1.0", Release: "87.el7.centos", Arch: "noarch", SourceRpm: "redhat-rpm-config-9.1.0-87.el7.centos.src.rpm", Size: 174572, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35-python-devel", Version: "3.5.1", Release: "11.el7", Arch: "x86_64", SourceRpm: "rh-python35-python-3.5.1-11.el7.src.rpm", Size: 600179, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.26", Release: "23.el7", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.26-23.el7.src.rpm", Size: 396911, License: "BSD with advertising", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sysvinit-tools", Version: "2.88", Release: "14.dsf.el7", Arch: "x86_64", SourceRpm: "sysvinit-2.88-14.dsf.el7.src.rpm", Size: 109118, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "binutils", Version: "2.27", Release: "34.base.el7", Arch: "x86_64", SourceRpm: "binutils-2.27-34.base.el7.src.rpm", Size: 25150000, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl", Version: "2.1.26", Release: "23.el7", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.26-23.el7.src.rpm", Size: 144492, License: "BSD with advertising", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.29.0", Release: "51.el7", Arch: "x86_64", SourceRpm: "curl-7.29.0-51.el7.src.rpm", Size: 435192, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap-devel", Version: "2.4.44", Release: "21.el7_6", Arch: "x86_64", SourceRpm: "openldap-2.4.44-21.el7_6.src.rpm", Size: 3847489, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 611536, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "apr-util-devel", Version: "1.5.2", Release: "6.el7", Arch: "x86_64", SourceRpm: "apr-util-1.5.2-6.el7.src.rpm", Size: 298354, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.44", Release: "21.el7_6", Arch: "x86_64", SourceRpm: "openldap-2.4.44-21.el7_6.src.rpm", Size: 1037424, License: "OpenLDAP", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setools-libs", Version: "3.3.8", Release: "4.el7", Arch: "x86_64", SourceRpm: "setools-3.3.8-4.el7.src.rpm", Size: 1917790, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "policycoreutils-python", Version: "2.5", Release: "29.el7_6.1", Arch: "x86_64", SourceRpm: "policycoreutils-2.5-29.el7_6.1.src.rpm", Size: 1299284, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pinentry", Version: "0.8.1", Release: "17.el7", Arch: "x86_64", SourceRpm: "pinentry-0.8.1-17.el7.src.rpm", Size: 159929, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-libcurl", Version: "7.61.1", Release: "1.el7", Arch: "x86_64", SourceRpm: "httpd24-curl-7.61.1-1.el7.src.rpm", Size: 545704, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-httpd-tools", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 177148, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ustr", Version: "1.0.4", Release: "16.el7", Arch: "x86_64", SourceRpm: "ustr-1.0.4-16.el7.src.rpm", Size: 285943, License: "MIT or LGPLv2+ or BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-mod_auth_kerb", Version: "5.4", Release: "33.el7", Arch: "x86_64", SourceRpm: "httpd24-mod_auth_kerb-5.4-33.el7.src.rpm", Size: 67371, License: "BSD and MIT and ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.1.5.1", Release: "25.el7", Arch: "x86_64", SourceRpm: "shadow-utils-4.1.5.1-25.el7.src.rpm", Size: 3540592, License: "BSD and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24-mod_ldap", Version: "2.4.34", Release: "7.el7", Arch: "x86_64", SourceRpm: "httpd24-httpd-2.4.34-7.el7.src.rpm", Size: 134213, License: "ASL 2.0", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "hardlink", Version: "1.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "hardlink-1.0-19.el7.src.rpm", Size: 16545, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "httpd24", Version: "1.1", Release: "18.el7", Arch: "x86_64", SourceRpm: "httpd24-1.1-18.el7.src.rpm", Size: 0, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gdbm", Version: "1.10", Release: "8.el7", Arch: "x86_64", SourceRpm: "gdbm-1.10-8.el7.src.rpm", Size: 184322, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-python35", Version: "2.0", Release: "2.el7", Arch: "x86_64", SourceRpm: "rh-python35-2.0-2.el7.src.rpm", Size: 0, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python", Version: "2.7.5", Release: "76.el7", Arch: "x86_64", SourceRpm: "python-2.7.5-76.el7.src.rpm", Size: 80907, License: "Python", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gcc-gfortran", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 16443248, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2-python", Version: "2.9.1", Release: "6.el7_2.3", Arch: "x86_64", SourceRpm: "libxml2-2.9.1-6.el7_2.3.src.rpm", Size: 1503050, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nss_wrapper", Version: "1.0.3", Release: "1.el7", Arch: "x86_64", SourceRpm: "nss_wrapper-1.0.3-1.el7.src.rpm", Size: 61024, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi-devel", Version: "3.0.13", Release: "18.el7", Arch: "x86_64", SourceRpm: "libffi-3.0.13-18.el7.src.rpm", Size: 27170, License: "MIT and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-metadata-parser", Version: "1.1.4", Release: "10.el7", Arch: "x86_64", SourceRpm: "yum-metadata-parser-1.1.4-10.el7.src.rpm", Size: 58789, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pycurl", Version: "7.19.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "python-pycurl-7.19.0-19.el7.src.rpm", Size: 241513, License: "LGPLv2+ or MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-iniparse", Version: "0.4", Release: "9.el7", Arch: "noarch", SourceRpm: "python-iniparse-0.4-9.el7.src.rpm", Size: 115166, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-chardet", Version: "2.2.1", Release: "1.el7_1", Arch: "noarch", SourceRpm: "python-chardet-2.2.1-1.el7_1.src.rpm", Size: 1156541, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "hostname", Version: "3.13", Release: "3.el7", Arch: "x86_64", SourceRpm: "hostname-3.13-3.el7.src.rpm", Size: 19449, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "kpartx", Version: "0.4.9", Release: "123.el7", Arch: "x86_64", SourceRpm: "device-mapper-multipath-0.4.9-123.el7.src.rpm", Size: 41363, License: "GPL+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(7), Name: "device-mapper-libs", Version: "1.02.149", Release: "10.el7_6.3", Arch: "x86_64", SourceRpm: "lvm2-2.02.180-10.el7_6.3.src.rpm", Size: 400607, License: "LGPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dracut", Version: "033", Release: "554.el7", Arch: "x86_64", SourceRpm: "dracut-033-554.el7.src.rpm", Size: 903230, License: "GPLv2+ and LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.172", Release: "2.el7", Arch: "x86_64", SourceRpm: "elfutils-0.172-2.el7.src.rpm", Size: 793087, License: "GPLv2+ or LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus-libs", Version: "1.10.24", Release: "12.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-12.el7.src.rpm", Size: 362560, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "dbus", Version: "1.10.24", Release: "12.el7", Arch: "x86_64", SourceRpm: "dbus-1.10.24-12.el7.src.rpm", Size: 595210, License: "(GPLv2+ or AFL) and GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dbus-python", Version: "1.1.1", Release: "9.el7", Arch: "x86_64", SourceRpm: "dbus-python-1.1.1-9.el7.src.rpm", Size: 848122, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.0.22", Release: "5.el7_5", Arch: "x86_64", SourceRpm: "gnupg2-2.0.22-5.el7_5.src.rpm", Size: 6637796, License: "GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-python", Version: "4.11.3", Release: "35.el7", Arch: "x86_64", SourceRpm: "rpm-4.11.3-35.el7.src.rpm", Size: 149898, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pygpgme", Version: "0.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "pygpgme-0.3-9.el7.src.rpm", Size: 197501, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "3.4.3", Release: "161.el7.centos", Arch: "noarch", SourceRpm: "yum-3.4.3-161.el7.centos.src.rpm", Size: 5824869, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum-plugin-ovl", Version: "1.1.31", Release: "50.el7", Arch: "noarch", SourceRpm: "yum-utils-1.1.31-50.el7.src.rpm", Size: 22399, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "11.el7", Arch: "noarch", SourceRpm: "rootfiles-8.1-11.el7.src.rpm", Size: 599, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgomp", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 212184, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libunistring", Version: "0.9.3", Release: "9.el7", Arch: "x86_64", SourceRpm: "libunistring-0.9.3-9.el7.src.rpm", Size: 1145761, License: "LGPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gettext-libs", Version: "0.19.8.1", Release: "2.el7", Arch: "x86_64", SourceRpm: "gettext-0.19.8.1-2.el7.src.rpm", Size: 1546952, License: "LGPLv2+ and GPLv3+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bsdtar", Version: "3.1.2", Release: "10.el7_2", Arch: "x86_64", SourceRpm: "libarchive-3.1.2-10.el7_2.src.rpm", Size: 110459, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "unzip", Version: "6.0", Release: "19.el7", Arch: "x86_64", SourceRpm: "unzip-6.0-19.el7.src.rpm", Size: 373986, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "scl-utils", Version: "20130529", Release: "19.el7", Arch: "x86_64", SourceRpm: "scl-utils-20130529-19.el7.src.rpm", Size: 25141, License: "GPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "centos-release-scl", Version: "2", Release: "3.el7.centos", Arch: "noarch", SourceRpm: "centos-release-scl-2-3.el7.centos.src.rpm", Size: 20042, License: "GPLv2", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl-libs", Version: "1.0.2k", Release: "16.el7_6.1", Arch: "x86_64", SourceRpm: "openssl-1.0.2k-16.el7_6.1.src.rpm", Size: 3204708, License: "OpenSSL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib-devel", Version: "1.2.7", Release: "18.el7", Arch: "x86_64", SourceRpm: "zlib-1.2.7-18.el7.src.rpm", Size: 135536, License: "zlib and Boost", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc", Version: "4.8.5", Release: "36.el7_6.1", Arch: "x86_64", SourceRpm: "gcc-4.8.5-36.el7_6.1.src.rpm", Size: 179328, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmpc", Version: "1.0.1", Release: "3.el7", Arch: "x86_64", SourceRpm: "libmpc-1.0.1-3.el7.src.rpm", Size: 113833, License: "LGPLv3+ and GFDL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "freetype", Version: "2.8", Release: "12.el7_6.1", Arch: "x86_64", SourceRpm: "freetype-2.8-12.el7_6.1.src.rpm", Size: 824497, License: "(FTL or GPLv2+) and BSD and MIT and Public Domain and zlib with acknowledgement", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "freetype-devel", Version: "2.8", Release: "12.el7_6.1", Arch: "x86_64", SourceRpm: "freetype-2.8-12.el7_6.1.src.rpm", Size: 2980705, License: "(FTL or GPLv2+) and BSD and MIT and Public Domain and zlib with acknowledgement", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "postgresql-libs", Version: "9.2.24", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "postgresql-9.2.24-1.el7_5.src.rpm", Size: 683495, License: "PostgreSQL", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fontpackages-filesystem", Version: "1.44", Release: "8.el7", Arch: "noarch", SourceRpm: "fontpackages-1.44-8.el7.src.rpm", Size: 0, License: "Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libICE", Version: "1.0.9", Release: "9.el7", Arch: "x86_64", SourceRpm: "libICE-1.0.9-9.el7.src.rpm", Size: 152213, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxcb", Version: "1.13", Release: "1.el7", Arch: "x86_64", SourceRpm: "libxcb-1.13-1.el7.src.rpm", Size: 1011680, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libXau-devel", Version: "1.0.8", Release: "2.1.el7", Arch: "x86_64", SourceRpm: "libXau-1.0.8-2.1.el7.src.rpm", Size: 6519, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libSM", Version: "1.2.2", Release: "2.el7", Arch: "x86_64", SourceRpm: "libSM-1.2.2-2.el7.src.rpm", Size: 81546, License: "MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rh-nodejs10-nodejs", Version: "10.10.0", Release: "2.el7", Arch: "x86_64", SourceRpm: "rh-nodejs10-nodejs-10.10.0-2.el7.src.rpm", Size: 28549171, License: "MIT and ASL 2.0 and ISC and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dejavu-sans-fonts", Version: "2.33", Release: "6.el7", Arch: "noarch", SourceRpm: "dejavu-fonts-2.33-6.el7.src.rpm", Size: 5395167, License: "Bitstream Vera and Public Domain", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libjpeg-turbo-devel", Version: "1.2.90", Release: "6.el7", Arch: "x86_64", SourceRpm: "libjpeg-turbo-1.2.90-6.el7.src.rpm", Size: 321845, License: "IJG", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmount", Version: "2.23.2", Release: "59.el7_6.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7_6.1.src.rpm", Size: 278173, License: "LGPLv2+", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "mariadb-libs", Version: "5.5.60", Release: "1.el7_5", Arch: "x86_64", SourceRpm: "mariadb-5.5.60-1.el7_5.src.rpm", Size: 4615006, License: "GPLv2 with exceptions and LGPLv2 and BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid-devel", Version: "2.23.2", Release: "59.el7_6.1", Arch: "x86_64", SourceRpm: "util-linux-2.23.2-59.el7_6.1.src.rpm", Size: 17258, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fipscheck", Version: "1.4.1", Release: "6.el7", Arch: "x86_64", SourceRpm: "fipscheck-1.4.1-6.el7.src.rpm", Size: 38839, License: "BSD", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-HTTP-Tiny", Version: "0.033", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-HTTP-Tiny-0.033-3.el7.src.rpm", Size: 97210, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Perldoc", Version: "3.20", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Pod-Perldoc-3.20-4.el7.src.rpm", Size: 166910, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Text-ParseWords", Version: "3.29", Release: "4.el7", Arch: "noarch", SourceRpm: "perl-Text-ParseWords-3.29-4.el7.src.rpm", Size: 16431, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Pod-Usage", Version: "1.63", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Pod-Usage-1.63-3.el7.src.rpm", Size: 44671, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Socket", Version: "2.010", Release: "4.el7", Arch: "x86_64", SourceRpm: "perl-Socket-2.010-4.el7.src.rpm", Size: 114497, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Filter", Version: "1.49", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Filter-1.49-3.el7.src.rpm", Size: 148475, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Storable", Version: "2.45", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Storable-2.45-3.el7.src.rpm", Size: 181031, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Exporter", Version: "5.68", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Exporter-5.68-3.el7.src.rpm", Size: 56612, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Time-Local", Version: "1.2300", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Time-Local-1.2300-2.el7.src.rpm", Size: 44062, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-threads-shared", Version: "1.43", Release: "6.el7", Arch: "x86_64", SourceRpm: "perl-threads-shared-1.43-6.el7.src.rpm", Size: 73972, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-File-Path", Version: "2.09", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-File-Path-2.09-2.el7.src.rpm", Size: 50067, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Scalar-List-Utils", Version: "1.27", Release: "248.el7", Arch: "x86_64", SourceRpm: "perl-Scalar-List-Utils-1.27-248.el7.src.rpm", Size: 67994, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Getopt-Long", Version: "2.40", Release: "3.el7", Arch: "noarch", SourceRpm: "perl-Getopt-Long-2.40-3.el7.src.rpm", Size: 134846, License: "GPLv2+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "perl-Error", Version: "0.17020", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Error-0.17020-2.el7.src.rpm", Size: 50015, License: "(GPL+ or Artistic) and MIT", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Data-Dumper", Version: "2.145", Release: "3.el7", Arch: "x86_64", SourceRpm: "perl-Data-Dumper-2.145-3.el7.src.rpm", Size: 99287, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-Thread-Queue", Version: "3.02", Release: "2.el7", Arch: "noarch", SourceRpm: "perl-Thread-Queue-3.02-2.el7.src.rpm", Size: 27642, License: "GPL+ or Artistic", Vendor: "CentOS", DigestAlgorithm: PGPHASHALGO_SHA256},
+	}
+
+	// docker run --rm -it registry.suse.com/bci/bci-minimal:15.3 bash
+	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
+	SLE15BCI = []PackageInfo{
+		{Epoch: intRef(), Name: "system-user-root", Version: "20190513", Release: "3.3.1", Arch: "noarch", SourceRpm: "system-user-root-20190513-3.3.1.src.rpm", Size: 186, License: "MIT", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "15.0", Release: "11.3.2", Arch: "x86_64", SourceRpm: "filesystem-15.0-11.3.2.src.rpm", Size: 535, License: "MIT", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.31", Release: "9.3.2", Arch: "x86_64", SourceRpm: "glibc-2.31-9.3.2.src.rpm", Size: 6183407, License: "LGPL-2.1-or-later AND LGPL-2.1-or-later WITH GCC-exception-2.0 AND GPL-2.0-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpcre1", Version: "8.45", Release: "20.10.1", Arch: "x86_64", SourceRpm: "pcre-8.45-20.10.1.src.rpm", Size: 938295, License: "BSD-3-Clause", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgmp10", Version: "6.1.2", Release: "4.6.1", Arch: "x86_64", SourceRpm: "gmp-6.1.2-4.6.1.src.rpm", Size: 711445, License: "LGPL-3.0-or-later OR GPL-2.0-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcc_s1", Version: "11.2.1+git610", Release: "1.3.9", Arch: "x86_64", SourceRpm: "gcc11-11.2.1+git610-1.3.9.src.rpm", Size: 101024, License: "GPL-3.0-or-later WITH GCC-exception-3.1", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap2", Version: "2.26", Release: "4.6.1", Arch: "x86_64", SourceRpm: "libcap-2.26-4.6.1.src.rpm", Size: 39224, License: "BSD-3-Clause or GPL-2.0", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++6", Version: "11.2.1+git610", Release: "1.3.9", Arch: "x86_64", SourceRpm: "gcc11-11.2.1+git610-1.3.9.src.rpm", Size: 2161776, License: "GPL-3.0-or-later WITH GCC-exception-3.1", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libncurses6", Version: "6.1", Release: "5.9.1", Arch: "x86_64", SourceRpm: "ncurses-6.1-5.9.1.src.rpm", Size: 1116008, License: "MIT", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "terminfo-base", Version: "6.1", Release: "5.9.1", Arch: "x86_64", SourceRpm: "ncurses-6.1-5.9.1.src.rpm", Size: 1179602, License: "MIT", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr1", Version: "2.4.47", Release: "2.19", Arch: "x86_64", SourceRpm: "attr-2.4.47-2.19.src.rpm", Size: 46233, License: "GPL-2.0-or-later AND LGPL-2.1-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux1", Version: "3.0", Release: "1.31", Arch: "x86_64", SourceRpm: "libselinux-3.0-1.31.src.rpm", Size: 159424, License: "SUSE-Public-Domain", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libreadline7", Version: "7.0", Release: "19.6.1", Arch: "x86_64", SourceRpm: "bash-4.4-19.6.1.src.rpm", Size: 396195, License: "GPL-3.0-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "4.4", Release: "19.6.1", Arch: "x86_64", SourceRpm: "bash-4.4-19.6.1.src.rpm", Size: 1114706, License: "GPL-3.0-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl1", Version: "2.2.52", Release: "4.3.1", Arch: "x86_64", SourceRpm: "acl-2.2.52-4.3.1.src.rpm", Size: 35424, License: "GPL-2.0+ and LGPL-2.1+", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.32", Release: "3.2.1", Arch: "x86_64", SourceRpm: "coreutils-8.32-3.2.1.src.rpm", Size: 6488992, License: "GPL-3.0-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sles-release", Version: "15.3", Release: "55.4.1", Arch: "x86_64", SourceRpm: "sles-release-15.3-55.4.1.src.rpm", Size: 342491, License: "MIT", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates-mozilla-prebuilt", Version: "2.44", Release: "21.1", Arch: "noarch", SourceRpm: "ca-certificates-mozilla-prebuilt-2.44-21.1.src.rpm", Size: 836576, License: "MPL-2.0", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error0", Version: "1.29", Release: "1.8", Arch: "x86_64", SourceRpm: "libgpg-error-1.29-1.8.src.rpm", Size: 565983, License: "GPL-2.0-or-later AND LGPL-2.1-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpopt0", Version: "1.16", Release: "3.22", Arch: "x86_64", SourceRpm: "popt-1.16-3.22.src.rpm", Size: 124686, License: "MIT", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-magic", Version: "5.32", Release: "7.14.1", Arch: "noarch", SourceRpm: "file-5.32-7.14.1.src.rpm", Size: 5916442, License: "BSD-2-Clause", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libbz2-1", Version: "1.0.6", Release: "5.11.1", Arch: "x86_64", SourceRpm: "bzip2-1.0.6-5.11.1.src.rpm", Size: 120168, License: "BSD-3-Clause", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "liblua5_3-5", Version: "5.3.6", Release: "3.6.1", Arch: "x86_64", SourceRpm: "lua53-5.3.6-3.6.1.src.rpm", Size: 237296, License: "MIT", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "liblzma5", Version: "5.2.3", Release: "4.3.1", Arch: "x86_64", SourceRpm: "xz-5.2.3-4.3.1.src.rpm", Size: 235576, License: "SUSE-Public-Domain", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libz1", Version: "1.2.11", Release: "3.21.1", Arch: "x86_64", SourceRpm: "zlib-1.2.11-3.21.1.src.rpm", Size: 110685, License: "Zlib", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libzstd1", Version: "1.4.4", Release: "1.6.1", Arch: "x86_64", SourceRpm: "zstd-1.4.4-1.6.1.src.rpm", Size: 682141, License: "BSD-3-Clause AND GPL-2.0-only", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmagic1", Version: "5.32", Release: "7.14.1", Arch: "x86_64", SourceRpm: "file-5.32-7.14.1.src.rpm", Size: 138472, License: "BSD-2-Clause", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdw1", Version: "0.168", Release: "4.5.3", Arch: "x86_64", SourceRpm: "elfutils-0.168-4.5.3.src.rpm", Size: 294456, License: "SUSE-GPL-2.0-with-OSI-exception", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libebl-plugins", Version: "0.168", Release: "4.5.3", Arch: "x86_64", SourceRpm: "elfutils-0.168-4.5.3.src.rpm", Size: 372800, License: "SUSE-GPL-2.0-with-OSI-exception", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libelf1", Version: "0.168", Release: "4.5.3", Arch: "x86_64", SourceRpm: "elfutils-0.168-4.5.3.src.rpm", Size: 96880, License: "SUSE-GPL-2.0-with-OSI-exception", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcrypt1", Version: "4.4.15", Release: "2.51", Arch: "x86_64", SourceRpm: "libxcrypt-4.4.15-2.51.src.rpm", Size: 265241, License: "LGPL-2.1-or-later AND BSD-2-Clause AND BSD-3-Clause AND SUSE-Public-Domain", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "perl-base", Version: "5.26.1", Release: "15.87", Arch: "x86_64", SourceRpm: "perl-5.26.1-15.87.src.rpm", Size: 4299811, License: "Artistic-1.0 or GPL-2.0+", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt20", Version: "1.8.2", Release: "8.39.1", Arch: "x86_64", SourceRpm: "libgcrypt-1.8.2-8.39.1.src.rpm", Size: 1198761, License: "GPL-2.0+ AND LGPL-2.1+", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-config-SUSE", Version: "1", Release: "5.6.1", Arch: "noarch", SourceRpm: "rpm-config-SUSE-1-5.6.1.src.rpm", Size: 38001, License: "GPL-2.0-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-ndb", Version: "4.14.3", Release: "40.1", Arch: "x86_64", SourceRpm: "rpm-ndb-4.14.3-40.1.src.rpm", Size: 3132579, License: "GPL-2.0-or-later", Vendor: "SUSE LLC <https://www.suse.com/>", DigestAlgorithm: PGPHASHALGO_SHA256},
+	}
+
+	// docker run --rm -it fedora:35 bash
+	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\"\},\n" | sed "s/^{(none)/{0/g" | sed "s/(none)//g"
+	Fedora35 = []PackageInfo{
+		{Epoch: intRef(), Name: "libgcc", Version: "11.2.1", Release: "1.fc35", Arch: "x86_64", SourceRpm: "gcc-11.2.1-1.fc35.src.rpm", Size: 194980, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "crypto-policies", Version: "20210819", Release: "1.gitd0fdcfb.fc35", Arch: "noarch", SourceRpm: "crypto-policies-20210819-1.gitd0fdcfb.fc35.src.rpm", Size: 86107, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tzdata", Version: "2021e", Release: "1.fc35", Arch: "noarch", SourceRpm: "tzdata-2021e-1.fc35.src.rpm", Size: 1800709, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fedora-release-identity-container", Version: "35", Release: "35", Arch: "noarch", SourceRpm: "fedora-release-35-35.src.rpm", Size: 1512, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-setuptools-wheel", Version: "57.4.0", Release: "1.fc35", Arch: "noarch", SourceRpm: "python-setuptools-57.4.0-1.fc35.src.rpm", Size: 596568, License: "MIT and (BSD or ASL 2.0)", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "publicsuffix-list-dafsa", Version: "20210518", Release: "2.fc35", Arch: "noarch", SourceRpm: "publicsuffix-list-20210518-2.fc35.src.rpm", Size: 68815, License: "MPLv2.0", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre2-syntax", Version: "10.37", Release: "4.fc35", Arch: "noarch", SourceRpm: "pcre2-10.37-4.fc35.src.rpm", Size: 222822, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-base", Version: "6.2", Release: "8.20210508.fc35", Arch: "noarch", SourceRpm: "ncurses-6.2-8.20210508.fc35.src.rpm", Size: 307293, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh-config", Version: "0.9.6", Release: "1.fc35", Arch: "noarch", SourceRpm: "libssh-0.9.6-1.fc35.src.rpm", Size: 277, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libreport-filesystem", Version: "2.15.2", Release: "6.fc35", Arch: "noarch", SourceRpm: "libreport-2.15.2-6.fc35.src.rpm", Size: 0, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dnf-data", Version: "4.9.0", Release: "1.fc35", Arch: "noarch", SourceRpm: "dnf-4.9.0-1.fc35.src.rpm", Size: 38568, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fedora-gpg-keys", Version: "35", Release: "1", Arch: "noarch", SourceRpm: "fedora-repos-35-1.src.rpm", Size: 118311, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fedora-release-container", Version: "35", Release: "35", Arch: "noarch", SourceRpm: "fedora-release-35-35.src.rpm", Size: 0, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fedora-repos", Version: "35", Release: "1", Arch: "noarch", SourceRpm: "fedora-repos-35-1.src.rpm", Size: 4597, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fedora-release-common", Version: "35", Release: "35", Arch: "noarch", SourceRpm: "fedora-release-35-35.src.rpm", Size: 17557, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "setup", Version: "2.13.9.1", Release: "2.fc35", Arch: "noarch", SourceRpm: "setup-2.13.9.1-2.fc35.src.rpm", Size: 736053, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "filesystem", Version: "3.14", Release: "7.fc35", Arch: "x86_64", SourceRpm: "filesystem-3.14-7.fc35.src.rpm", Size: 106, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "basesystem", Version: "11", Release: "12.fc35", Arch: "noarch", SourceRpm: "basesystem-11-12.fc35.src.rpm", Size: 0, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bash", Version: "5.1.8", Release: "2.fc35", Arch: "x86_64", SourceRpm: "bash-5.1.8-2.fc35.src.rpm", Size: 7739604, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ncurses-libs", Version: "6.2", Release: "8.20210508.fc35", Arch: "x86_64", SourceRpm: "ncurses-6.2-8.20210508.fc35.src.rpm", Size: 996375, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-common", Version: "2.34", Release: "8.fc35", Arch: "x86_64", SourceRpm: "glibc-2.34-8.fc35.src.rpm", Size: 1089462, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+ and GPLv2+ with exceptions and BSD and Inner-Net and ISC and Public Domain and GFDL", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc-minimal-langpack", Version: "2.34", Release: "8.fc35", Arch: "x86_64", SourceRpm: "glibc-2.34-8.fc35.src.rpm", Size: 0, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+ and GPLv2+ with exceptions and BSD and Inner-Net and ISC and Public Domain and GFDL", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glibc", Version: "2.34", Release: "8.fc35", Arch: "x86_64", SourceRpm: "glibc-2.34-8.fc35.src.rpm", Size: 6237291, License: "LGPLv2+ and LGPLv2+ with exceptions and GPLv2+ and GPLv2+ with exceptions and BSD and Inner-Net and ISC and Public Domain and GFDL", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zlib", Version: "1.2.11", Release: "30.fc35", Arch: "x86_64", SourceRpm: "zlib-1.2.11-30.fc35.src.rpm", Size: 203449, License: "zlib and Boost", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "bzip2-libs", Version: "1.0.8", Release: "9.fc35", Arch: "x86_64", SourceRpm: "bzip2-1.0.8-9.fc35.src.rpm", Size: 78660, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "xz-libs", Version: "5.2.5", Release: "7.fc35", Arch: "x86_64", SourceRpm: "xz-5.2.5-7.fc35.src.rpm", Size: 181437, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libzstd", Version: "1.5.0", Release: "2.fc35", Arch: "x86_64", SourceRpm: "zstd-1.5.0-2.fc35.src.rpm", Size: 1028163, License: "BSD and GPLv2", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sqlite-libs", Version: "3.36.0", Release: "3.fc35", Arch: "x86_64", SourceRpm: "sqlite-3.36.0-3.fc35.src.rpm", Size: 1334505, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "gmp", Version: "6.2.0", Release: "7.fc35", Arch: "x86_64", SourceRpm: "gmp-6.2.0-7.fc35.src.rpm", Size: 809478, License: "LGPLv3+ or GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap", Version: "2.48", Release: "3.fc35", Arch: "x86_64", SourceRpm: "libcap-2.48-3.fc35.src.rpm", Size: 180511, License: "BSD or GPLv2", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "popt", Version: "1.18", Release: "6.fc35", Arch: "x86_64", SourceRpm: "popt-1.18-6.fc35.src.rpm", Size: 130256, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgpg-error", Version: "1.43", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libgpg-error-1.43-1.fc35.src.rpm", Size: 851181, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxml2", Version: "2.9.12", Release: "6.fc35", Arch: "x86_64", SourceRpm: "libxml2-2.9.12-6.fc35.src.rpm", Size: 1923894, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcom_err", Version: "1.46.3", Release: "1.fc35", Arch: "x86_64", SourceRpm: "e2fsprogs-1.46.3-1.fc35.src.rpm", Size: 68441, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libstdc++", Version: "11.2.1", Release: "1.fc35", Arch: "x86_64", SourceRpm: "gcc-11.2.1-1.fc35.src.rpm", Size: 2476520, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libxcrypt", Version: "4.4.26", Release: "4.fc35", Arch: "x86_64", SourceRpm: "libxcrypt-4.4.26-4.fc35.src.rpm", Size: 275090, License: "LGPLv2+ and BSD and Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lua-libs", Version: "5.4.3", Release: "2.fc35", Arch: "x86_64", SourceRpm: "lua-5.4.3-2.fc35.src.rpm", Size: 555606, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libelf", Version: "0.185", Release: "5.fc35", Arch: "x86_64", SourceRpm: "elfutils-0.185-5.fc35.src.rpm", Size: 992174, License: "GPLv2+ or LGPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "file-libs", Version: "5.40", Release: "9.fc35", Arch: "x86_64", SourceRpm: "file-5.40-9.fc35.src.rpm", Size: 8529778, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libattr", Version: "2.5.1", Release: "3.fc35", Arch: "x86_64", SourceRpm: "attr-2.5.1-3.fc35.src.rpm", Size: 29341, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libacl", Version: "2.3.1", Release: "2.fc35", Arch: "x86_64", SourceRpm: "acl-2.3.1-2.fc35.src.rpm", Size: 41090, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libffi", Version: "3.1", Release: "29.fc35", Arch: "x86_64", SourceRpm: "libffi-3.1-29.fc35.src.rpm", Size: 56872, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit", Version: "0.23.22", Release: "4.fc35", Arch: "x86_64", SourceRpm: "p11-kit-0.23.22-4.fc35.src.rpm", Size: 1659536, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libunistring", Version: "0.9.10", Release: "14.fc35", Arch: "x86_64", SourceRpm: "libunistring-0.9.10-14.fc35.src.rpm", Size: 1642923, License: "GPLv2+ or LGPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libidn2", Version: "2.3.2", Release: "3.fc35", Arch: "x86_64", SourceRpm: "libidn2-2.3.2-3.fc35.src.rpm", Size: 291720, License: "(GPLv2+ or LGPLv3+) and GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libuuid", Version: "2.37.2", Release: "1.fc35", Arch: "x86_64", SourceRpm: "util-linux-2.37.2-1.fc35.src.rpm", Size: 34389, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "readline", Version: "8.1", Release: "3.fc35", Arch: "x86_64", SourceRpm: "readline-8.1-3.fc35.src.rpm", Size: 492684, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libassuan", Version: "2.5.5", Release: "3.fc35", Arch: "x86_64", SourceRpm: "libassuan-2.5.5-3.fc35.src.rpm", Size: 171069, License: "LGPLv2+ and GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "expat", Version: "2.4.1", Release: "2.fc35", Arch: "x86_64", SourceRpm: "expat-2.4.1-2.fc35.src.rpm", Size: 295041, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "json-c", Version: "0.15", Release: "2.fc35", Arch: "x86_64", SourceRpm: "json-c-0.15-2.fc35.src.rpm", Size: 79583, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "keyutils-libs", Version: "1.6.1", Release: "3.fc35", Arch: "x86_64", SourceRpm: "keyutils-1.6.1-3.fc35.src.rpm", Size: 55801, License: "GPLv2+ and LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsigsegv", Version: "2.13", Release: "3.fc35", Arch: "x86_64", SourceRpm: "libsigsegv-2.13-3.fc35.src.rpm", Size: 50250, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsmartcols", Version: "2.37.2", Release: "1.fc35", Arch: "x86_64", SourceRpm: "util-linux-2.37.2-1.fc35.src.rpm", Size: 135371, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtasn1", Version: "4.16.0", Release: "6.fc35", Arch: "x86_64", SourceRpm: "libtasn1-4.16.0-6.fc35.src.rpm", Size: 183868, License: "GPLv3+ and LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre", Version: "8.45", Release: "1.fc35", Arch: "x86_64", SourceRpm: "pcre-8.45-1.fc35.src.rpm", Size: 539220, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "grep", Version: "3.6", Release: "4.fc35", Arch: "x86_64", SourceRpm: "grep-3.6-4.fc35.src.rpm", Size: 857744, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "gdbm-libs", Version: "1.22", Release: "1.fc35", Arch: "x86_64", SourceRpm: "gdbm-1.22-1.fc35.src.rpm", Size: 128594, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsepol", Version: "3.3", Release: "2.fc35", Arch: "x86_64", SourceRpm: "libsepol-3.3-2.fc35.src.rpm", Size: 755891, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcomps", Version: "0.1.18", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libcomps-0.1.18-1.fc35.src.rpm", Size: 214999, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpsl", Version: "0.21.1", Release: "4.fc35", Arch: "x86_64", SourceRpm: "libpsl-0.21.1-4.fc35.src.rpm", Size: 78520, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mpdecimal", Version: "2.5.1", Release: "2.fc35", Arch: "x86_64", SourceRpm: "mpdecimal-2.5.1-2.fc35.src.rpm", Size: 246955, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgcrypt", Version: "1.9.4", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libgcrypt-1.9.4-1.fc35.src.rpm", Size: 1392828, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libksba", Version: "1.6.0", Release: "2.fc35", Arch: "x86_64", SourceRpm: "libksba-1.6.0-2.fc35.src.rpm", Size: 401600, License: "(LGPLv3+ or GPLv2+) and GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "mpfr", Version: "4.1.0", Release: "8.fc35", Arch: "x86_64", SourceRpm: "mpfr-4.1.0-8.fc35.src.rpm", Size: 802431, License: "LGPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gawk", Version: "5.1.0", Release: "4.fc35", Arch: "x86_64", SourceRpm: "gawk-5.1.0-4.fc35.src.rpm", Size: 1684030, License: "GPLv3+ and GPLv2+ and LGPLv2+ and BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "nettle", Version: "3.7.3", Release: "2.fc35", Arch: "x86_64", SourceRpm: "nettle-3.7.3-2.fc35.src.rpm", Size: 735221, License: "LGPLv3+ or GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "alternatives", Version: "1.19", Release: "1.fc35", Arch: "x86_64", SourceRpm: "chkconfig-1.19-1.fc35.src.rpm", Size: 63264, License: "GPLv2", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "p11-kit-trust", Version: "0.23.22", Release: "4.fc35", Arch: "x86_64", SourceRpm: "p11-kit-0.23.22-4.fc35.src.rpm", Size: 451087, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnutls", Version: "3.7.2", Release: "2.fc35", Arch: "x86_64", SourceRpm: "gnutls-3.7.2-2.fc35.src.rpm", Size: 3141270, License: "GPLv3+ and LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libbrotli", Version: "1.0.9", Release: "6.fc35", Arch: "x86_64", SourceRpm: "brotli-1.0.9-6.fc35.src.rpm", Size: 784274, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcap-ng", Version: "0.8.2", Release: "6.fc35", Arch: "x86_64", SourceRpm: "libcap-ng-0.8.2-6.fc35.src.rpm", Size: 75012, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "audit-libs", Version: "3.0.6", Release: "1.fc35", Arch: "x86_64", SourceRpm: "audit-3.0.6-1.fc35.src.rpm", Size: 307177, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdb", Version: "5.3.28", Release: "50.fc35", Arch: "x86_64", SourceRpm: "libdb-5.3.28-50.fc35.src.rpm", Size: 1922782, License: "BSD and LGPLv2 and Sleepycat", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libeconf", Version: "0.4.0", Release: "2.fc35", Arch: "x86_64", SourceRpm: "libeconf-0.4.0-2.fc35.src.rpm", Size: 46171, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libgomp", Version: "11.2.1", Release: "1.fc35", Arch: "x86_64", SourceRpm: "gcc-11.2.1-1.fc35.src.rpm", Size: 413740, License: "GPLv3+ and GPLv3+ with exceptions and GPLv2+ with exceptions and LGPLv2+ and BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libnghttp2", Version: "1.45.1", Release: "1.fc35", Arch: "x86_64", SourceRpm: "nghttp2-1.45.1-1.fc35.src.rpm", Size: 162468, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libverto", Version: "0.3.2", Release: "2.fc35", Arch: "x86_64", SourceRpm: "libverto-0.3.2-2.fc35.src.rpm", Size: 30277, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libyaml", Version: "0.2.5", Release: "6.fc35", Arch: "x86_64", SourceRpm: "libyaml-0.2.5-6.fc35.src.rpm", Size: 138211, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "lz4-libs", Version: "1.9.3", Release: "3.fc35", Arch: "x86_64", SourceRpm: "lz4-1.9.3-3.fc35.src.rpm", Size: 145387, License: "GPLv2+ and BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "npth", Version: "1.6", Release: "7.fc35", Arch: "x86_64", SourceRpm: "npth-1.6-7.fc35.src.rpm", Size: 50531, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pcre2", Version: "10.37", Release: "4.fc35", Arch: "x86_64", SourceRpm: "pcre2-10.37-4.fc35.src.rpm", Size: 633138, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libselinux", Version: "3.3", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libselinux-3.3-1.fc35.src.rpm", Size: 169365, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sed", Version: "4.8", Release: "8.fc35", Arch: "x86_64", SourceRpm: "sed-4.8-8.fc35.src.rpm", Size: 813479, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsemanage", Version: "3.3", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libsemanage-3.3-1.fc35.src.rpm", Size: 303824, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "shadow-utils", Version: "4.9", Release: "7.fc35", Arch: "x86_64", SourceRpm: "shadow-utils-4.9-7.fc35.src.rpm", Size: 3836461, License: "BSD and GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "vim-minimal", Version: "8.2.3642", Release: "1.fc35", Arch: "x86_64", SourceRpm: "vim-8.2.3642-1.fc35.src.rpm", Size: 1529767, License: "Vim and MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-default-yama-scope", Version: "0.185", Release: "5.fc35", Arch: "noarch", SourceRpm: "elfutils-0.185-5.fc35.src.rpm", Size: 1810, License: "GPLv2+ or LGPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "elfutils-libs", Version: "0.185", Release: "5.fc35", Arch: "x86_64", SourceRpm: "elfutils-0.185-5.fc35.src.rpm", Size: 709117, License: "GPLv2+ or LGPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils-common", Version: "8.32", Release: "31.fc35", Arch: "x86_64", SourceRpm: "coreutils-8.32-31.fc35.src.rpm", Size: 10880210, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(1), Name: "openssl-libs", Version: "1.1.1l", Release: "2.fc35", Arch: "x86_64", SourceRpm: "openssl-1.1.1l-2.fc35.src.rpm", Size: 3855396, License: "OpenSSL and ASL 2.0", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "coreutils", Version: "8.32", Release: "31.fc35", Arch: "x86_64", SourceRpm: "coreutils-8.32-31.fc35.src.rpm", Size: 6040898, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ca-certificates", Version: "2021.2.50", Release: "3.fc35", Arch: "noarch", SourceRpm: "ca-certificates-2021.2.50-3.fc35.src.rpm", Size: 939948, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "krb5-libs", Version: "1.19.2", Release: "2.fc35", Arch: "x86_64", SourceRpm: "krb5-1.19.2-2.fc35.src.rpm", Size: 2198421, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libtirpc", Version: "1.3.2", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libtirpc-1.3.2-1.fc35.src.rpm", Size: 208122, License: "SISSL and BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libnsl2", Version: "1.3.0", Release: "4.fc35", Arch: "x86_64", SourceRpm: "libnsl2-1.3.0-4.fc35.src.rpm", Size: 130270, License: "BSD and LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "zchunk-libs", Version: "1.1.15", Release: "2.fc35", Arch: "x86_64", SourceRpm: "zchunk-1.1.15-2.fc35.src.rpm", Size: 90820, License: "BSD and MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libfsverity", Version: "1.4", Release: "6.fc35", Arch: "x86_64", SourceRpm: "fsverity-utils-1.4-6.fc35.src.rpm", Size: 29672, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cyrus-sasl-lib", Version: "2.1.27", Release: "13.fc35", Arch: "x86_64", SourceRpm: "cyrus-sasl-2.1.27-13.fc35.src.rpm", Size: 2409736, License: "BSD with advertising", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "openldap", Version: "2.4.59", Release: "3.fc35", Arch: "x86_64", SourceRpm: "openldap-2.4.59-3.fc35.src.rpm", Size: 718501, License: "OpenLDAP", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gnupg2", Version: "2.3.3", Release: "1.fc35", Arch: "x86_64", SourceRpm: "gnupg2-2.3.3-1.fc35.src.rpm", Size: 9244445, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpgme", Version: "1.15.1", Release: "6.fc35", Arch: "x86_64", SourceRpm: "gpgme-1.15.1-6.fc35.src.rpm", Size: 573957, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libssh", Version: "0.9.6", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libssh-0.9.6-1.fc35.src.rpm", Size: 513049, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libcurl", Version: "7.79.1", Release: "1.fc35", Arch: "x86_64", SourceRpm: "curl-7.79.1-1.fc35.src.rpm", Size: 681030, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "tpm2-tss", Version: "3.1.0", Release: "3.fc35", Arch: "x86_64", SourceRpm: "tpm2-tss-3.1.0-3.fc35.src.rpm", Size: 2227128, License: "BSD and TCGL", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "ima-evm-utils", Version: "1.3.2", Release: "3.fc35", Arch: "x86_64", SourceRpm: "ima-evm-utils-1.3.2-3.fc35.src.rpm", Size: 141126, License: "GPLv2", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "curl", Version: "7.79.1", Release: "1.fc35", Arch: "x86_64", SourceRpm: "curl-7.79.1-1.fc35.src.rpm", Size: 723076, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python-pip-wheel", Version: "21.2.3", Release: "4.fc35", Arch: "noarch", SourceRpm: "python-pip-21.2.3-4.fc35.src.rpm", Size: 1220638, License: "MIT and Python and ASL 2.0 and BSD and ISC and LGPLv2 and MPLv2.0 and (ASL 2.0 or BSD)", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3", Version: "3.10.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "python3.10-3.10.0-1.fc35.src.rpm", Size: 33090, License: "Python", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3-libs", Version: "3.10.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "python3.10-3.10.0-1.fc35.src.rpm", Size: 33027906, License: "Python", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3-libcomps", Version: "0.1.18", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libcomps-0.1.18-1.fc35.src.rpm", Size: 146971, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3-gpg", Version: "1.15.1", Release: "6.fc35", Arch: "x86_64", SourceRpm: "gpgme-1.15.1-6.fc35.src.rpm", Size: 1394334, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gzip", Version: "1.10", Release: "5.fc35", Arch: "x86_64", SourceRpm: "gzip-1.10-5.fc35.src.rpm", Size: 357298, License: "GPLv3+ and GFDL", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "cracklib", Version: "2.9.6", Release: "27.fc35", Arch: "x86_64", SourceRpm: "cracklib-2.9.6-27.fc35.src.rpm", Size: 251474, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libpwquality", Version: "1.4.4", Release: "6.fc35", Arch: "x86_64", SourceRpm: "libpwquality-1.4.4-6.fc35.src.rpm", Size: 415452, License: "BSD or GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "pam", Version: "1.5.2", Release: "5.fc35", Arch: "x86_64", SourceRpm: "pam-1.5.2-5.fc35.src.rpm", Size: 1947788, License: "BSD and GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libblkid", Version: "2.37.2", Release: "1.fc35", Arch: "x86_64", SourceRpm: "util-linux-2.37.2-1.fc35.src.rpm", Size: 230761, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmount", Version: "2.37.2", Release: "1.fc35", Arch: "x86_64", SourceRpm: "util-linux-2.37.2-1.fc35.src.rpm", Size: 311125, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "glib2", Version: "2.70.1", Release: "1.fc35", Arch: "x86_64", SourceRpm: "glib2-2.70.1-1.fc35.src.rpm", Size: 13474770, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "librepo", Version: "1.14.2", Release: "1.fc35", Arch: "x86_64", SourceRpm: "librepo-1.14.2-1.fc35.src.rpm", Size: 241974, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libarchive", Version: "3.5.2", Release: "2.fc35", Arch: "x86_64", SourceRpm: "libarchive-3.5.2-2.fc35.src.rpm", Size: 907245, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-libs", Version: "4.17.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "rpm-4.17.0-1.fc35.src.rpm", Size: 775132, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm", Version: "4.17.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "rpm-4.17.0-1.fc35.src.rpm", Size: 2948898, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libmodulemd", Version: "2.13.0", Release: "3.fc35", Arch: "x86_64", SourceRpm: "libmodulemd-2.13.0-3.fc35.src.rpm", Size: 733689, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libsolv", Version: "0.7.19", Release: "3.fc35", Arch: "x86_64", SourceRpm: "libsolv-0.7.19-3.fc35.src.rpm", Size: 897882, License: "BSD", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "libdnf", Version: "0.64.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libdnf-0.64.0-1.fc35.src.rpm", Size: 2045581, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3-libdnf", Version: "0.64.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libdnf-0.64.0-1.fc35.src.rpm", Size: 3766775, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3-hawkey", Version: "0.64.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "libdnf-0.64.0-1.fc35.src.rpm", Size: 310028, License: "LGPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-build-libs", Version: "4.17.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "rpm-4.17.0-1.fc35.src.rpm", Size: 199518, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rpm-sign-libs", Version: "4.17.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "rpm-4.17.0-1.fc35.src.rpm", Size: 40492, License: "GPLv2+ and LGPLv2+ with exceptions", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3-rpm", Version: "4.17.0", Release: "1.fc35", Arch: "x86_64", SourceRpm: "rpm-4.17.0-1.fc35.src.rpm", Size: 378257, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "python3-dnf", Version: "4.9.0", Release: "1.fc35", Arch: "noarch", SourceRpm: "dnf-4.9.0-1.fc35.src.rpm", Size: 1898937, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "dnf", Version: "4.9.0", Release: "1.fc35", Arch: "noarch", SourceRpm: "dnf-4.9.0-1.fc35.src.rpm", Size: 2203005, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "yum", Version: "4.9.0", Release: "1.fc35", Arch: "noarch", SourceRpm: "dnf-4.9.0-1.fc35.src.rpm", Size: 22042, License: "GPLv2+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "sudo", Version: "1.9.7p2", Release: "2.fc35", Arch: "x86_64", SourceRpm: "sudo-1.9.7p2-2.fc35.src.rpm", Size: 4324216, License: "ISC", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(2), Name: "tar", Version: "1.34", Release: "2.fc35", Arch: "x86_64", SourceRpm: "tar-1.34-2.fc35.src.rpm", Size: 3156278, License: "GPLv3+", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "fedora-repos-modular", Version: "35", Release: "1", Arch: "noarch", SourceRpm: "fedora-repos-35-1.src.rpm", Size: 4042, License: "MIT", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "rootfiles", Version: "8.1", Release: "30.fc35", Arch: "noarch", SourceRpm: "rootfiles-8.1-30.fc35.src.rpm", Size: 817, License: "Public Domain", Vendor: "Fedora Project", DigestAlgorithm: PGPHASHALGO_SHA256},
+		{Epoch: intRef(), Name: "gpg-pubkey", Version: "9867c58f", Release: "601c49ca", Arch: "", SourceRpm: "", Size: 0, License: "pubkey", Vendor: ""},
 	}
 )
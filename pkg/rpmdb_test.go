@@ -1,6 +1,7 @@
 package rpmdb
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"path"
@@ -46,6 +47,14 @@ func TestPackageList(t *testing.T) {
 			file:    "testdata/centos7-httpd24/Packages",
 			pkgList: CentOS7Httpd24,
 		},
+		{
+			file:    "testdata/sle15-bci/Packages.db",
+			pkgList: SLE15BCI,
+		},
+		{
+			file:    "testdata/fedora35/rpmdb.sqlite",
+			pkgList: Fedora35,
+		},
 	}
 
 	for _, v := range vectors {
@@ -117,11 +126,11 @@ func TestPackageFileList(t *testing.T) {
 			file: "testdata/centos6-plain/Packages",
 			fileList: map[string][]FileInfo{
 				"libffi": {
-					{Path: "/usr/lib64/libffi.so.5", Mode: 41471, Digest: "", Size: 15, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/lib64/libffi.so.5.0.6", Mode: 33261, Digest: "2009cab32d65011e653d7c87b49ad74541484467b3dc96be05bb2198b6c7a730", Size: 31720, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/share/doc/libffi-3.0.5", Mode: 16877, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/share/doc/libffi-3.0.5/LICENSE", Mode: 33188, Digest: "b0421fa2fcb17d5d603cc46c66d69a8d943a03d48edbdfd672f24068bf6b2b65", Size: 1119, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/doc/libffi-3.0.5/README", Mode: 33188, Digest: "d8a1231d9090231272d547f7a7ee922298c20d34d4c79772f5ed4badc3a86f8d", Size: 10042, Username: "root", Groupname: "root", Flags: 2},
+					{Path: "/usr/lib64/libffi.so.5", Mode: 41471, LinkTo: "libffi.so.5.0.6", Digest: "", Size: 15, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Class: "symbolic link to `libffi.so.5.0.6'", Depends: []FileDependency{{'R', 6}}, MTime: 1289507112, Device: 64768, Inode: 265506},
+					{Path: "/usr/lib64/libffi.so.5.0.6", Mode: 33261, Digest: "2009cab32d65011e653d7c87b49ad74541484467b3dc96be05bb2198b6c7a730", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 31720, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, stripped", Depends: []FileDependency{{'P', 0}, {'R', 2}, {'R', 3}, {'R', 4}, {'R', 5}, {'R', 10}}, MTime: 1289507112, Device: 64768, Inode: 265510},
+					{Path: "/usr/share/doc/libffi-3.0.5", Mode: 16877, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Class: "directory", MTime: 1289507112, Device: 64768, Inode: 265545, State: 2},
+					{Path: "/usr/share/doc/libffi-3.0.5/LICENSE", Mode: 33188, Digest: "b0421fa2fcb17d5d603cc46c66d69a8d943a03d48edbdfd672f24068bf6b2b65", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 1119, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "ASCII text", MTime: 1203038644, Device: 64768, Inode: 265546, State: 2},
+					{Path: "/usr/share/doc/libffi-3.0.5/README", Mode: 33188, Digest: "d8a1231d9090231272d547f7a7ee922298c20d34d4c79772f5ed4badc3a86f8d", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 10042, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "UTF-8 Unicode text", MTime: 1207237361, Device: 64768, Inode: 265547, State: 2},
 				},
 			},
 		},
@@ -129,35 +138,35 @@ func TestPackageFileList(t *testing.T) {
 			file: "testdata/centos7-plain/Packages",
 			fileList: map[string][]FileInfo{
 				"ncurses": {
-					{Path: "/usr/bin/captoinfo", Mode: 41471, Digest: "", Size: 3, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/clear", Mode: 33261, Digest: "68353b0b989463d9e202362c843ee42c408dd1e08dd5e8e93733753749a96208", Size: 7192, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/infocmp", Mode: 33261, Digest: "469fd67a3bdc7967a4c05b39a1b9a87635448520a619e608e702310480cef153", Size: 57416, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/infotocap", Mode: 41471, Digest: "", Size: 3, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/reset", Mode: 41471, Digest: "", Size: 4, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/tabs", Mode: 33261, Digest: "85a7fb2d93019eb9ff1dd907dc649e9be5a49c704a26d94572418aea77affe46", Size: 15680, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/tic", Mode: 33261, Digest: "df2ea23f0fdcd9a13a846de6d1880197d2fd60afe7b9b2945aa77f8595137a0c", Size: 65800, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/toe", Mode: 33261, Digest: "b6cad57397f83d187c1361daf20d2b6a59982f9aa553a95d659edebe3116d26a", Size: 15800, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/tput", Mode: 33261, Digest: "737da2a672c9ac17f86ebba733d316639365ad8459e16939fa03faea8e7d720f", Size: 15784, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/bin/tset", Mode: 33261, Digest: "50fa6ec48545da72f5c92040a39fbacb61ff1e45e14f9998a281b6c3285564c1", Size: 20072, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/share/doc/ncurses-5.9", Mode: 16877, Digest: "", Size: 75, Username: "root", Groupname: "root", Flags: 0},
-					{Path: "/usr/share/doc/ncurses-5.9/ANNOUNCE", Mode: 33188, Digest: "1694388b7f5ce0819e1f8fd1c2b40979e82df58541ceb0c8b60c683f29378b78", Size: 13750, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/doc/ncurses-5.9/AUTHORS", Mode: 33188, Digest: "5e59823796c266525a92a6cd31bf144603a7d1b65362e48aa85e74a2b8093d50", Size: 2529, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/doc/ncurses-5.9/NEWS.bz2", Mode: 33188, Digest: "bb48de080557f81b9626ebd0baf48e559ae241dace93d57b7d618a441f8737fb", Size: 131412, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/doc/ncurses-5.9/README", Mode: 33188, Digest: "37e56186af1edbc4b0c41b85e224295fe2ef114399a488651ebc658f57bf80c7", Size: 10212, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/doc/ncurses-5.9/TO-DO", Mode: 33188, Digest: "9a40247610befa57d2c47d0fcd5d3ff3587edad07287f17a8279b98e4221692a", Size: 9651, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/captoinfo.1m.gz", Mode: 33188, Digest: "40940eef25e38baaaa2ceb1cd7edb3508718400846485ed6f5c1e13bba1f1a34", Size: 2904, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/clear.1.gz", Mode: 33188, Digest: "1ce7d795bb239d39ca5e11808f0766b456766ad1a914c6097beb7f9c8af638b9", Size: 1262, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/infocmp.1m.gz", Mode: 33188, Digest: "2649e8bf304f00eb5624293515c4bd6eb7c7f847f33c3308dd8b76c5e44122dd", Size: 6952, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/infotocap.1m.gz", Mode: 33188, Digest: "edd4d4bb4d79044d32f3422d5ba1e15302769b8a9a5e2fe0f8ce13967443bc25", Size: 1579, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/reset.1.gz", Mode: 41471, Digest: "", Size: 9, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/tabs.1.gz", Mode: 33188, Digest: "d9841dc62123346f2973dafb79874f794690f88725135a4d21805284cb973492", Size: 2253, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/tic.1m.gz", Mode: 33188, Digest: "a5f8512a7a0e252225bd18efd0bcdbcee752e9bf5d539aef5948d3ab9230da8e", Size: 5677, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/toe.1m.gz", Mode: 33188, Digest: "ca295431aa6b43954409c314bb15687dfc93b95ad8fbd5fcc183bd205008f995", Size: 1874, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/tput.1.gz", Mode: 33188, Digest: "2f0d53ffbf8bef6d1a932a9955701ada4842f133ecdfb5b324604a703376bd2f", Size: 4529, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man1/tset.1.gz", Mode: 33188, Digest: "7a2332f6d2305af034eafc9c94ed427f5d63c12087f611c4a499546fa9240a9c", Size: 4907, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man5/term.5.gz", Mode: 33188, Digest: "0d53e8274fcd0c91ec79d1c7911c68d6993025335f0ed688413c38cf80edb04a", Size: 4431, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man5/terminfo.5.gz", Mode: 33188, Digest: "c94c45d9713db4c2380b53fc5130e41ec3034e256a0cfc6f523676a49cf7f02e", Size: 33598, Username: "root", Groupname: "root", Flags: 2},
-					{Path: "/usr/share/man/man7/term.7.gz", Mode: 33188, Digest: "29346e334d22d23120a45e692b0dc8f2d8262ef077149dbac3f775fbe0c9125d", Size: 4114, Username: "root", Groupname: "root", Flags: 2},
+					{Path: "/usr/bin/captoinfo", Mode: 41471, LinkTo: "tic", Digest: "", Size: 3, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, MTime: 1504735688, Device: 1, Inode: 1},
+					{Path: "/usr/bin/clear", Mode: 33261, Digest: "68353b0b989463d9e202362c843ee42c408dd1e08dd5e8e93733753749a96208", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 7192, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.32, BuildID[sha1]=8009462f0b3c9791f7a9517a61d4e0ce8daeb921, stripped", Depends: []FileDependency{{'R', 2}, {'R', 7}, {'R', 0}, {'R', 12}}, MTime: 1504735700, Device: 1, Inode: 2},
+					{Path: "/usr/bin/infocmp", Mode: 33261, Digest: "469fd67a3bdc7967a4c05b39a1b9a87635448520a619e608e702310480cef153", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 57416, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.32, BuildID[sha1]=9563e8c63b41d9756be04a45633ac38efb64eed4, stripped", Depends: []FileDependency{{'R', 3}, {'R', 1}, {'R', 5}, {'R', 2}, {'R', 4}, {'R', 6}, {'R', 7}, {'R', 0}, {'R', 12}}, MTime: 1504735700, Device: 1, Inode: 3},
+					{Path: "/usr/bin/infotocap", Mode: 41471, LinkTo: "tic", Digest: "", Size: 3, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, MTime: 1504735688, Device: 1, Inode: 4},
+					{Path: "/usr/bin/reset", Mode: 41471, LinkTo: "tset", Digest: "", Size: 4, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, MTime: 1504735688, Device: 1, Inode: 5},
+					{Path: "/usr/bin/tabs", Mode: 33261, Digest: "85a7fb2d93019eb9ff1dd907dc649e9be5a49c704a26d94572418aea77affe46", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 15680, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.32, BuildID[sha1]=d639256b36e36878075322d453b3182aac649d5d, stripped", Depends: []FileDependency{{'R', 3}, {'R', 4}, {'R', 2}, {'R', 7}, {'R', 0}, {'R', 12}}, MTime: 1504735700, Device: 1, Inode: 6},
+					{Path: "/usr/bin/tic", Mode: 33261, Digest: "df2ea23f0fdcd9a13a846de6d1880197d2fd60afe7b9b2945aa77f8595137a0c", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 65800, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.32, BuildID[sha1]=c8b635f25a421d7e54347c64400ec101b12a23e6, stripped", Depends: []FileDependency{{'R', 3}, {'R', 1}, {'R', 5}, {'R', 2}, {'R', 4}, {'R', 6}, {'R', 7}, {'R', 0}, {'R', 12}}, MTime: 1504735700, Device: 1, Inode: 7},
+					{Path: "/usr/bin/toe", Mode: 33261, Digest: "b6cad57397f83d187c1361daf20d2b6a59982f9aa553a95d659edebe3116d26a", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 15800, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.32, BuildID[sha1]=670d8cdd5aa65c0c42f0910e56a41f389431325c, stripped", Depends: []FileDependency{{'R', 3}, {'R', 4}, {'R', 1}, {'R', 5}, {'R', 2}, {'R', 6}, {'R', 7}, {'R', 0}, {'R', 12}}, MTime: 1504735700, Device: 1, Inode: 8},
+					{Path: "/usr/bin/tput", Mode: 33261, Digest: "737da2a672c9ac17f86ebba733d316639365ad8459e16939fa03faea8e7d720f", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 15784, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.32, BuildID[sha1]=35c12dc8dd36c8e7d155d192fff85f37b1d9d55b, stripped", Depends: []FileDependency{{'R', 3}, {'R', 5}, {'R', 4}, {'R', 2}, {'R', 7}, {'R', 0}, {'R', 12}}, MTime: 1504735700, Device: 1, Inode: 9},
+					{Path: "/usr/bin/tset", Mode: 33261, Digest: "50fa6ec48545da72f5c92040a39fbacb61ff1e45e14f9998a281b6c3285564c1", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 20072, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.32, BuildID[sha1]=6a3abe69b29b7e5b5284e75878e75821038a0758, stripped", Depends: []FileDependency{{'R', 3}, {'R', 5}, {'R', 4}, {'R', 2}, {'R', 7}, {'R', 0}, {'R', 12}}, MTime: 1504735700, Device: 1, Inode: 10},
+					{Path: "/usr/share/doc/ncurses-5.9", Mode: 16877, Digest: "", Size: 75, Username: "root", Groupname: "root", Flags: 0, VerifyFlags: RPMVERIFY_ALL, Class: "directory", MTime: 1504735706, Device: 1, Inode: 11, State: 2},
+					{Path: "/usr/share/doc/ncurses-5.9/ANNOUNCE", Mode: 33188, Digest: "1694388b7f5ce0819e1f8fd1c2b40979e82df58541ceb0c8b60c683f29378b78", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 13750, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "ASCII text", MTime: 1301910393, Device: 1, Inode: 12, State: 2},
+					{Path: "/usr/share/doc/ncurses-5.9/AUTHORS", Mode: 33188, Digest: "5e59823796c266525a92a6cd31bf144603a7d1b65362e48aa85e74a2b8093d50", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 2529, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "ASCII text", MTime: 1162071892, Device: 1, Inode: 13, State: 2},
+					{Path: "/usr/share/doc/ncurses-5.9/NEWS.bz2", Mode: 33188, Digest: "bb48de080557f81b9626ebd0baf48e559ae241dace93d57b7d618a441f8737fb", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 131412, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "ASCII text (bzip2 compressed data, block size = 900k)", MTime: 1504735654, Device: 1, Inode: 14, State: 2},
+					{Path: "/usr/share/doc/ncurses-5.9/README", Mode: 33188, Digest: "37e56186af1edbc4b0c41b85e224295fe2ef114399a488651ebc658f57bf80c7", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 10212, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "ASCII text", MTime: 1504735654, Device: 1, Inode: 15, State: 2},
+					{Path: "/usr/share/doc/ncurses-5.9/TO-DO", Mode: 33188, Digest: "9a40247610befa57d2c47d0fcd5d3ff3587edad07287f17a8279b98e4221692a", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 9651, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "ASCII text", MTime: 1301271782, Device: 1, Inode: 16, State: 2},
+					{Path: "/usr/share/man/man1/captoinfo.1m.gz", Mode: 33188, Digest: "40940eef25e38baaaa2ceb1cd7edb3508718400846485ed6f5c1e13bba1f1a34", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 2904, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "FORTRAN program, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735689, Device: 1, Inode: 17, State: 2},
+					{Path: "/usr/share/man/man1/clear.1.gz", Mode: 33188, Digest: "1ce7d795bb239d39ca5e11808f0766b456766ad1a914c6097beb7f9c8af638b9", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 1262, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735690, Device: 1, Inode: 18, State: 2},
+					{Path: "/usr/share/man/man1/infocmp.1m.gz", Mode: 33188, Digest: "2649e8bf304f00eb5624293515c4bd6eb7c7f847f33c3308dd8b76c5e44122dd", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 6952, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735691, Device: 1, Inode: 19, State: 2},
+					{Path: "/usr/share/man/man1/infotocap.1m.gz", Mode: 33188, Digest: "edd4d4bb4d79044d32f3422d5ba1e15302769b8a9a5e2fe0f8ce13967443bc25", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 1579, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735691, Device: 1, Inode: 20, State: 2},
+					{Path: "/usr/share/man/man1/reset.1.gz", Mode: 41471, LinkTo: "tset.1.gz", Digest: "", Size: 9, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, MTime: 1504735701, Device: 1, Inode: 21, State: 2},
+					{Path: "/usr/share/man/man1/tabs.1.gz", Mode: 33188, Digest: "d9841dc62123346f2973dafb79874f794690f88725135a4d21805284cb973492", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 2253, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735692, Device: 1, Inode: 22, State: 2},
+					{Path: "/usr/share/man/man1/tic.1m.gz", Mode: 33188, Digest: "a5f8512a7a0e252225bd18efd0bcdbcee752e9bf5d539aef5948d3ab9230da8e", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 5677, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735692, Device: 1, Inode: 23, State: 2},
+					{Path: "/usr/share/man/man1/toe.1m.gz", Mode: 33188, Digest: "ca295431aa6b43954409c314bb15687dfc93b95ad8fbd5fcc183bd205008f995", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 1874, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735692, Device: 1, Inode: 24, State: 2},
+					{Path: "/usr/share/man/man1/tput.1.gz", Mode: 33188, Digest: "2f0d53ffbf8bef6d1a932a9955701ada4842f133ecdfb5b324604a703376bd2f", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 4529, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735692, Device: 1, Inode: 25, State: 2},
+					{Path: "/usr/share/man/man1/tset.1.gz", Mode: 33188, Digest: "7a2332f6d2305af034eafc9c94ed427f5d63c12087f611c4a499546fa9240a9c", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 4907, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735692, Device: 1, Inode: 26, State: 2},
+					{Path: "/usr/share/man/man5/term.5.gz", Mode: 33188, Digest: "0d53e8274fcd0c91ec79d1c7911c68d6993025335f0ed688413c38cf80edb04a", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 4431, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735692, Device: 1, Inode: 27, State: 2},
+					{Path: "/usr/share/man/man5/terminfo.5.gz", Mode: 33188, Digest: "c94c45d9713db4c2380b53fc5130e41ec3034e256a0cfc6f523676a49cf7f02e", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 33598, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735689, Device: 1, Inode: 28, State: 2},
+					{Path: "/usr/share/man/man7/term.7.gz", Mode: 33188, Digest: "29346e334d22d23120a45e692b0dc8f2d8262ef077149dbac3f775fbe0c9125d", DigestAlgorithm: PGPHASHALGO_SHA256, Size: 4114, Username: "root", Groupname: "root", Flags: 2, VerifyFlags: RPMVERIFY_ALL, Class: "troff or preprocessor input, ASCII text (gzip compressed data, from Unix, max compression)", MTime: 1504735692, Device: 1, Inode: 29, State: 2},
 				},
 			},
 		},
@@ -178,6 +187,16 @@ func TestPackageFileList(t *testing.T) {
 			for _, p := range pkgList {
 				if expected, ok := v.fileList[p.Name]; ok {
 					assertedPkgLists++
+					// the raw digest and the 64-bit size are derived from the hex digest and the size to keep the vectors readable
+					for i := range expected {
+						expected[i].LongSize = int64(expected[i].Size)
+						if expected[i].Digest != "" {
+							expected[i].DigestBytes, err = hex.DecodeString(expected[i].Digest)
+							if err != nil {
+								t.Fatalf("bad test vector: %v", err)
+							}
+						}
+					}
 					diffs := deep.Equal(expected, p.Files)
 					if len(diffs) > 0 {
 						t.Logf("Got files:")
@@ -198,3 +217,45 @@ func TestPackageFileList(t *testing.T) {
 		})
 	}
 }
+
+func TestPackageFileList_OnlyInstalledFiles(t *testing.T) {
+	// the centos images are built with tsflags=nodocs, so the docs are recorded in the header but not on disk
+	const name = "ncurses"
+	fixture := "testdata/centos7-plain/Packages"
+
+	find := func(opts ...Option) *PackageInfo {
+		db, err := Open(fixture, opts...)
+		if err != nil {
+			t.Fatalf("Open() error: %v", err)
+		}
+		pkgList, err := db.ListPackages()
+		if err != nil {
+			t.Fatalf("ListPackages() error: %v", err)
+		}
+		for _, p := range pkgList {
+			if p.Name == name {
+				return p
+			}
+		}
+		t.Fatalf("package %q not found", name)
+		return nil
+	}
+
+	raw := find()
+	filtered := find(WithOnlyInstalledFiles())
+
+	var expected []FileInfo
+	for _, f := range raw.Files {
+		if f.State == RPMFILE_STATE_NORMAL || f.State == RPMFILE_STATE_REPLACED {
+			expected = append(expected, f)
+		}
+	}
+
+	assert.Len(t, raw.Files, 29)
+	assert.Len(t, filtered.Files, 10)
+	assert.Equal(t, expected, filtered.Files)
+
+	for _, f := range filtered.Files {
+		assert.NotContains(t, f.Path, "/usr/share/man/", "doc file should be filtered")
+	}
+}
@@ -0,0 +1,59 @@
+package rpmdb
+
+// Scriptlet is a script that rpm runs at a certain point of a transaction (e.g. %post).
+type Scriptlet struct {
+	// Program is the interpreter along with its arguments (e.g. ["/bin/sh"], or ["/sbin/ldconfig"] for a
+	// "%post -p /sbin/ldconfig" scriptlet), empty when the header does not record it and rpm falls back to /bin/sh.
+	Program []string
+	// Script is the body of the scriptlet, empty when only the program is run.
+	Script string
+}
+
+// scriptletTags are the tags of the body and the program of a scriptlet.
+type scriptletTags struct {
+	name    string
+	script  Tag
+	program Tag
+}
+
+var (
+	preInTags  = scriptletTags{name: "prein", script: RPMTAG_PREIN, program: RPMTAG_PREINPROG}
+	postInTags = scriptletTags{name: "postin", script: RPMTAG_POSTIN, program: RPMTAG_POSTINPROG}
+	preUnTags  = scriptletTags{name: "preun", script: RPMTAG_PREUN, program: RPMTAG_PREUNPROG}
+	postUnTags = scriptletTags{name: "postun", script: RPMTAG_POSTUN, program: RPMTAG_POSTUNPROG}
+	// transaction scriptlets, run once before and after the whole transaction
+	preTransTags  = scriptletTags{name: "pretrans", script: RPMTAG_PRETRANS, program: RPMTAG_PRETRANSPROG}
+	postTransTags = scriptletTags{name: "posttrans", script: RPMTAG_POSTTRANS, program: RPMTAG_POSTTRANSPROG}
+)
+
+// parseScriptlet returns the scriptlet described by the given tags, or nil when the package does not have one.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmscript.c (rpmScriptFromTag)
+func parseScriptlet(indexEntries []indexEntry, tags scriptletTags) (*Scriptlet, error) {
+	var scriptlet *Scriptlet
+	for _, entry := range indexEntries {
+		switch Tag(entry.Info.Tag) {
+		case tags.script:
+			if entry.Info.Type != RPM_STRING_TYPE {
+				return nil, newTagTypeError(tags.name, entry.Info, RPM_STRING_TYPE)
+			}
+			if scriptlet == nil {
+				scriptlet = &Scriptlet{}
+			}
+			scriptlet.Script = parseString(entry.Data)
+		case tags.program:
+			// note: older packages record the program as a single string, newer ones as the program and its arguments
+			if scriptlet == nil {
+				scriptlet = &Scriptlet{}
+			}
+			switch entry.Info.Type {
+			case RPM_STRING_TYPE:
+				scriptlet.Program = []string{parseString(entry.Data)}
+			case RPM_STRING_ARRAY_TYPE:
+				scriptlet.Program = parseStringArray(entry.Data, entry.Info.Count)
+			default:
+				return nil, newTagTypeError(tags.name+"-prog", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+		}
+	}
+	return scriptlet, nil
+}
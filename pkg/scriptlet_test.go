@@ -0,0 +1,118 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_Scriptlets(t *testing.T) {
+	for _, fixture := range []string{"testdata/centos7-plain/Packages", sqliteFixture} {
+		t.Run(fixture, func(t *testing.T) {
+			pkgs := map[string]*PackageInfo{}
+			for _, pkg := range listFixturePackages(t, fixture) {
+				pkgs[pkg.Name] = pkg
+			}
+
+			// a program without a body ("%post -p /sbin/ldconfig")
+			assert.Equal(t, &Scriptlet{Program: []string{"/sbin/ldconfig"}}, pkgs["nspr"].PostIn)
+			assert.Equal(t, &Scriptlet{Program: []string{"/sbin/ldconfig"}}, pkgs["nspr"].PostUn)
+			assert.Nil(t, pkgs["nspr"].PreIn)
+			assert.Nil(t, pkgs["nspr"].PreUn)
+
+			// shell scriptlets
+			assert.Equal(t, &Scriptlet{
+				Program: []string{"/bin/sh"},
+				Script:  "/sbin/install-info --quiet --info-dir=/usr/share/info /usr/share/info/grep.info.gz || :",
+			}, pkgs["grep"].PostIn)
+			require.NotNil(t, pkgs["grep"].PreUn)
+			assert.Contains(t, pkgs["grep"].PreUn.Script, "--delete /usr/share/info/grep.info.gz")
+
+			// embedded lua
+			require.NotNil(t, pkgs["glibc"].PreIn)
+			assert.Equal(t, []string{"<lua>"}, pkgs["glibc"].PreIn.Program)
+			assert.Contains(t, pkgs["glibc"].PreIn.Script, "FATAL: kernel too old")
+
+			assert.Nil(t, pkgs["bash"].PreIn)
+		})
+	}
+}
+
+func TestPackageInfo_TransactionScriptlets(t *testing.T) {
+	pkgs := map[string]*PackageInfo{}
+	for _, pkg := range listFixturePackages(t, "testdata/centos6-many/Packages") {
+		pkgs[pkg.Name] = pkg
+	}
+
+	// copy-jdk-configs stashes the configuration in %pretrans and removes the stash in %posttrans
+	jdkConfigs := pkgs["copy-jdk-configs"]
+	require.NotNil(t, jdkConfigs)
+	require.NotNil(t, jdkConfigs.PreTrans)
+	assert.Equal(t, []string{"<lua>"}, jdkConfigs.PreTrans.Program)
+	assert.Contains(t, jdkConfigs.PreTrans.Script, "function createPretransScript()")
+	require.NotNil(t, jdkConfigs.PostTrans)
+	assert.Equal(t, []string{"/bin/sh"}, jdkConfigs.PostTrans.Program)
+	assert.Contains(t, jdkConfigs.PostTrans.Script, "# remove file created in pretrans")
+
+	assert.Nil(t, pkgs["bash"].PreTrans)
+	assert.Nil(t, pkgs["bash"].PostTrans)
+}
+
+func TestParseScriptlet(t *testing.T) {
+	t.Run("program with arguments", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_PREUN, Type: RPM_STRING_TYPE, Value: "systemctl stop foo"},
+			testEntry{Tag: RPMTAG_PREUNPROG, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/bin/bash", "-e"}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, &Scriptlet{Program: []string{"/bin/bash", "-e"}, Script: "systemctl stop foo"}, pkg.PreUn)
+		assert.Nil(t, pkg.PreIn)
+		assert.Nil(t, pkg.PostIn)
+		assert.Nil(t, pkg.PostUn)
+	})
+
+	t.Run("single string program", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_POSTIN, Type: RPM_STRING_TYPE, Value: "echo installed"},
+			testEntry{Tag: RPMTAG_POSTINPROG, Type: RPM_STRING_TYPE, Value: "/bin/sh"},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, &Scriptlet{Program: []string{"/bin/sh"}, Script: "echo installed"}, pkg.PostIn)
+	})
+
+	t.Run("body without program", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_POSTUN, Type: RPM_STRING_TYPE, Value: "echo removed"},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, &Scriptlet{Script: "echo removed"}, pkg.PostUn)
+	})
+
+	t.Run("transaction scriptlets", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_PRETRANS, Type: RPM_STRING_TYPE, Value: "print('pretrans')"},
+			testEntry{Tag: RPMTAG_PRETRANSPROG, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"<lua>"}},
+			testEntry{Tag: RPMTAG_POSTTRANSPROG, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/bin/update-mime-database", "/usr/share/mime"}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, &Scriptlet{Program: []string{"<lua>"}, Script: "print('pretrans')"}, pkg.PreTrans)
+		assert.Equal(t, &Scriptlet{Program: []string{"/usr/bin/update-mime-database", "/usr/share/mime"}}, pkg.PostTrans)
+		assert.Nil(t, pkg.PreIn)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_PREINPROG, Type: RPM_INT32_TYPE, Value: []int32{1}},
+		))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "prein-prog", typeErr.Name)
+	})
+}
@@ -0,0 +1,88 @@
+package rpmdb
+
+import "fmt"
+
+// SELinuxPolicyModule is a SELinux policy module shipped with a package through a %sepolicy section, which rpm loads
+// into the policy store on install.
+type SELinuxPolicyModule struct {
+	Name string
+	// Types are the policy types the module is installed into (e.g. "targeted" or "mls").
+	Types []string
+	Flags int32
+	// Module is the base64 encoded policy module, the same value as the matching entry of PackageInfo.SELinuxPolicies.
+	Module string
+}
+
+// parseSELinuxPolicyModules zips the policy, name and flags arrays, and assigns the policy types to the modules
+// through the type index array. Modules with arrays that do not line up are ignored, which is reported as a warning.
+func parseSELinuxPolicyModules(indexEntries []indexEntry) ([]SELinuxPolicyModule, []string, error) {
+	var policies, names, types []string
+	var flags, typeIndexes []int32
+	var hasFlags bool
+	var err error
+
+	for _, entry := range indexEntries {
+		switch entry.Info.Tag {
+		case RPMTAG_POLICIES:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError("policies", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			policies = parseStringArray(entry.Data, entry.Info.Count)
+		case RPMTAG_POLICYNAMES:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError("policy-names", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			names = parseStringArray(entry.Data, entry.Info.Count)
+		case RPMTAG_POLICYTYPES:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError("policy-types", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			types = parseStringArray(entry.Data, entry.Info.Count)
+		case RPMTAG_POLICYTYPESINDEXES:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, nil, newTagTypeError("policy-types-indexes", entry.Info, RPM_INT32_TYPE)
+			}
+			typeIndexes, err = parseInt32Array(entry.Data, int(entry.Info.Count)*sizeOfInt32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse policy-types-indexes: %w", err)
+			}
+		case RPMTAG_POLICYFLAGS:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, nil, newTagTypeError("policy-flags", entry.Info, RPM_INT32_TYPE)
+			}
+			flags, err = parseInt32Array(entry.Data, int(entry.Info.Count)*sizeOfInt32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse policy-flags: %w", err)
+			}
+			hasFlags = true
+		}
+	}
+
+	// note: packages built before rpm recorded the names only carry the modules (see PackageInfo.SELinuxPolicies)
+	if len(names) == 0 {
+		return nil, nil, nil
+	}
+	if len(policies) != len(names) || (hasFlags && len(flags) != len(names)) || len(typeIndexes) != len(types) {
+		warning := fmt.Sprintf("ignoring selinux policy modules: %d policies, %d names, %d flags, %d types and %d type indexes",
+			len(policies), len(names), len(flags), len(types), len(typeIndexes))
+		return nil, []string{warning}, nil
+	}
+
+	modules := make([]SELinuxPolicyModule, len(names))
+	for i, name := range names {
+		modules[i].Name = name
+		modules[i].Module = policies[i]
+		if hasFlags {
+			modules[i].Flags = flags[i]
+		}
+	}
+	for i, policyType := range types {
+		index := typeIndexes[i]
+		if index < 0 || int(index) >= len(modules) {
+			warning := fmt.Sprintf("ignoring selinux policy modules: type %q references module %d of %d", policyType, index, len(modules))
+			return nil, []string{warning}, nil
+		}
+		modules[index].Types = append(modules[index].Types, policyType)
+	}
+	return modules, nil, nil
+}
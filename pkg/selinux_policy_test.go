@@ -0,0 +1,75 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSELinuxPolicyModules(t *testing.T) {
+	t.Run("zipped", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_POLICIES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"Zm9v", "YmFy"}},
+			testEntry{Tag: RPMTAG_POLICYNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar"}},
+			testEntry{Tag: RPMTAG_POLICYFLAGS, Type: RPM_INT32_TYPE, Value: []int32{0, 1}},
+			testEntry{Tag: RPMTAG_POLICYTYPES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"targeted", "mls", "targeted"}},
+			testEntry{Tag: RPMTAG_POLICYTYPESINDEXES, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 1}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Zm9v", "YmFy"}, pkg.SELinuxPolicies)
+		assert.Equal(t, []SELinuxPolicyModule{
+			{Name: "foo", Types: []string{"targeted", "mls"}, Module: "Zm9v"},
+			{Name: "bar", Types: []string{"targeted"}, Flags: 1, Module: "YmFy"},
+		}, pkg.SELinuxPolicyModules)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("modules only", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_POLICIES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"Zm9v"}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Zm9v"}, pkg.SELinuxPolicies)
+		assert.Nil(t, pkg.SELinuxPolicyModules)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("mismatched arrays", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_POLICIES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"Zm9v"}},
+			testEntry{Tag: RPMTAG_POLICYNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo", "bar"}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.SELinuxPolicyModules)
+		assert.Equal(t, []string{"ignoring selinux policy modules: 1 policies, 2 names, 0 flags, 0 types and 0 type indexes"}, pkg.Warnings)
+	})
+
+	t.Run("invalid type index", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_POLICIES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"Zm9v"}},
+			testEntry{Tag: RPMTAG_POLICYNAMES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"foo"}},
+			testEntry{Tag: RPMTAG_POLICYTYPES, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"mls"}},
+			testEntry{Tag: RPMTAG_POLICYTYPESINDEXES, Type: RPM_INT32_TYPE, Value: []int32{1}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.SELinuxPolicyModules)
+		assert.Equal(t, []string{`ignoring selinux policy modules: type "mls" references module 1 of 1`}, pkg.Warnings)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_POLICYNAMES, Type: RPM_STRING_TYPE, Value: "foo"},
+		))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "policy-names", typeErr.Name)
+		assert.Contains(t, err.Error(), "failed to read selinux policy modules")
+	})
+}
@@ -0,0 +1,96 @@
+package rpmdb
+
+// Signatures holds the tags that originate from the signature header. For installed packages rpm merges these
+// into the main header, renumbering them into the HEADER_SIGBASE range.
+type Signatures struct {
+	// Size is the combined size of the header and the compressed payload (RPMTAG_SIGSIZE, or RPMTAG_LONGSIGSIZE for
+	// packages of 4 GiB and more).
+	Size int
+	// MD5 is the digest over the header and the compressed payload (RPMTAG_SIGMD5).
+	MD5 []byte
+	// PGP indicates that a header+payload OpenPGP signature was recorded (RPMTAG_SIGPGP or RPMTAG_SIGGPG).
+	PGP bool
+	// SigPGP is the raw OpenPGP RSA signature packet over the header and the compressed payload (RPMTAG_SIGPGP).
+	SigPGP []byte
+	// SigGPG is the raw OpenPGP DSA signature packet over the header and the compressed payload (RPMTAG_SIGGPG).
+	SigGPG []byte
+	// DSA is the raw OpenPGP DSA signature packet over the header only (RPMTAG_DSAHEADER).
+	DSA []byte
+	// RSA is the raw OpenPGP RSA signature packet over the header only (RPMTAG_RSAHEADER).
+	RSA []byte
+	// HeaderSHA1 is the hex encoded SHA1 digest of the immutable header region (RPMTAG_SHA1HEADER).
+	HeaderSHA1 string
+	// HeaderSHA256 is the hex encoded SHA256 digest of the immutable header region (RPMTAG_SHA256HEADER), only
+	// recorded by rpm 4.14 and later.
+	HeaderSHA256 string
+	// VerityAlgorithm is the fs-verity hash algorithm of the file digests signed by the per-file fs-verity signatures
+	// (see FileInfo.VeritySignature), one of the FS_VERITY_HASH_ALG_* values or zero when the package carries none
+	// (RPMTAG_VERITYSIGNATUREALGO).
+	VerityAlgorithm int
+}
+
+const (
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/header.h
+	HEADER_SIGBASE = 256
+	HEADER_TAGBASE = 1000
+
+	// tags as they appear once merged into the main header
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmtag.h
+	RPMTAG_SIGSIZE      = HEADER_SIGBASE + 1  /* i */
+	RPMTAG_SIGPGP       = HEADER_SIGBASE + 3  /* x */
+	RPMTAG_SIGMD5       = HEADER_SIGBASE + 5  /* x */
+	RPMTAG_SIGGPG       = HEADER_SIGBASE + 6  /* x */
+	RPMTAG_PUBKEYS      = HEADER_SIGBASE + 10 /* s[] */
+	RPMTAG_DSAHEADER    = HEADER_SIGBASE + 11 /* x */
+	RPMTAG_RSAHEADER    = HEADER_SIGBASE + 12 /* x */
+	RPMTAG_SHA1HEADER   = HEADER_SIGBASE + 13 /* s */
+	RPMTAG_LONGSIGSIZE  = HEADER_SIGBASE + 14 /* l */
+	RPMTAG_SHA256HEADER = HEADER_SIGBASE + 17 /* s */
+
+	// fs-verity signatures, only recorded by rpm 4.17 and later
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.17.0-release/lib/rpmtag.h
+	RPMTAG_VERITYSIGNATURES    = HEADER_SIGBASE + 20 /* s[] */
+	RPMTAG_VERITYSIGNATUREALGO = HEADER_SIGBASE + 21 /* i */
+
+	// the fs-verity hash algorithms (RPMTAG_VERITYSIGNATUREALGO)
+	// ref. https://github.com/torvalds/linux/blob/v5.15/include/uapi/linux/fsverity.h
+	FS_VERITY_HASH_ALG_SHA256 = 1
+	FS_VERITY_HASH_ALG_SHA512 = 2
+
+	// tags as they appear within a standalone signature header (e.g. from an .rpm file)
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/rpmtag.h
+	RPMSIGTAG_SIZE        = 1000 /* i */
+	RPMSIGTAG_LEMD5_1     = 1001 /* internal, obsolete */
+	RPMSIGTAG_PGP         = 1002 /* x */
+	RPMSIGTAG_LEMD5_2     = 1003 /* internal, obsolete */
+	RPMSIGTAG_MD5         = 1004 /* x */
+	RPMSIGTAG_GPG         = 1005 /* x */
+	RPMSIGTAG_PGP5        = 1006 /* internal, obsolete */
+	RPMSIGTAG_PAYLOADSIZE = 1007 /* i */
+)
+
+// signatureTagToHeaderTag maps a tag read from a standalone signature header to the number it is stored under once
+// merged into the main header (e.g. RPMSIGTAG_MD5 (1004) -> RPMTAG_SIGMD5 (261)). Tags that are not remapped by
+// rpm are returned unchanged.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/package.c (headerMergeLegacySigs)
+func signatureTagToHeaderTag(tag int32) int32 {
+	switch tag {
+	case RPMSIGTAG_SIZE, RPMSIGTAG_LEMD5_1, RPMSIGTAG_PGP, RPMSIGTAG_LEMD5_2, RPMSIGTAG_MD5, RPMSIGTAG_GPG, RPMSIGTAG_PGP5:
+		return tag - HEADER_TAGBASE + HEADER_SIGBASE + 1
+	case RPMSIGTAG_PAYLOADSIZE:
+		return RPMTAG_ARCHIVESIZE
+	}
+	return tag
+}
+
+// KeyID returns the ID of the key that made the header-only signature as 16 hex characters (the last 8 of which are
+// the version of the corresponding gpg-pubkey package), or an empty string for unsigned packages. Packages signed
+// before rpm recorded header-only signatures fall back to the key of the header+payload signature.
+func (s Signatures) KeyID() string {
+	for _, packet := range [][]byte{s.RSA, s.DSA, s.SigPGP, s.SigGPG} {
+		if keyID := pgpSignatureKeyID(packet); keyID != "" {
+			return keyID
+		}
+	}
+	return ""
+}
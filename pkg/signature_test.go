@@ -0,0 +1,173 @@
+package rpmdb
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureTagToHeaderTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      int32
+		expected int32
+	}{
+		{name: "size", tag: RPMSIGTAG_SIZE, expected: 257},
+		{name: "pgp", tag: RPMSIGTAG_PGP, expected: 259},
+		{name: "md5", tag: RPMSIGTAG_MD5, expected: 261},
+		{name: "gpg", tag: RPMSIGTAG_GPG, expected: 262},
+		{name: "payload size", tag: RPMSIGTAG_PAYLOADSIZE, expected: RPMTAG_ARCHIVESIZE},
+		{name: "rsa is not remapped", tag: RPMTAG_RSAHEADER, expected: 268},
+		{name: "sha1 is not remapped", tag: RPMTAG_SHA1HEADER, expected: 269},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, signatureTagToHeaderTag(test.tag))
+		})
+	}
+
+	// sanity check the named constants against the raw values documented by rpm
+	assert.Equal(t, 257, RPMTAG_SIGSIZE)
+	assert.Equal(t, 259, RPMTAG_SIGPGP)
+	assert.Equal(t, 261, RPMTAG_SIGMD5)
+	assert.Equal(t, 262, RPMTAG_SIGGPG)
+	assert.Equal(t, 267, RPMTAG_DSAHEADER)
+	assert.Equal(t, 268, RPMTAG_RSAHEADER)
+	assert.Equal(t, 273, RPMTAG_SHA256HEADER)
+}
+
+func TestPackageSignatures(t *testing.T) {
+	tests := []struct {
+		file  string
+		name  string
+		size  int
+		md5   string
+		sha1  string
+		keyID string
+	}{
+		{
+			file:  "testdata/centos7-plain/Packages",
+			name:  "ncurses",
+			size:  309544,
+			md5:   "8ca93e2831102818759a22e22e871268",
+			sha1:  "59c0dac1682f246cb2a5b3deea9b9b9b1034b84d",
+			keyID: "24c6a8a7f4a80eb5",
+		},
+		{
+			file:  "testdata/centos6-plain/Packages",
+			name:  "basesystem",
+			size:  3400,
+			md5:   "bf36e0d2771604b8ad56d9696b5edd3c",
+			sha1:  "d542f398dcd0609e9b6a6bf95e5fd09276c43344",
+			keyID: "0946fca2c105b9de",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := Open(test.file)
+			if err != nil {
+				t.Fatalf("Open() error: %v", err)
+			}
+			pkgList, err := db.ListPackages()
+			if err != nil {
+				t.Fatalf("ListPackages() error: %v", err)
+			}
+
+			var pkg *PackageInfo
+			for _, p := range pkgList {
+				if p.Name == test.name {
+					pkg = p
+				}
+			}
+			if pkg == nil {
+				t.Fatalf("package %q not found", test.name)
+			}
+
+			sigs := pkg.Signatures
+			assert.Equal(t, test.size, sigs.Size)
+			assert.Equal(t, test.md5, hex.EncodeToString(sigs.MD5))
+			assert.Equal(t, test.md5, pkg.PkgID)
+			assert.True(t, sigs.PGP)
+			assert.Nil(t, sigs.DSA)
+			assert.Nil(t, sigs.SigGPG)
+			assert.Equal(t, test.sha1, sigs.HeaderSHA1)
+			// the sha256 header digest was only introduced with rpm 4.14
+			assert.Empty(t, sigs.HeaderSHA256)
+
+			// the header-only RSA signature is an OpenPGP v3 signature packet which embeds the signing key ID
+			assert.Equal(t, byte(0x89), sigs.RSA[0], "not an OpenPGP signature packet")
+			assert.Equal(t, test.keyID, sigs.KeyID())
+
+			// the header+payload signature is made with the same key
+			assert.Equal(t, test.keyID, pgpSignatureKeyID(sigs.SigPGP))
+		})
+	}
+}
+
+func TestSignatures_KeyID(t *testing.T) {
+	signature := func(keyID byte) []byte {
+		return oldFormatPacket(pgpTagSignature, []byte{3, 5, 0x00, 0x5f, 0x00, 0x00, 0x00, 0, 0, 0, 0, 0, 0, 0, keyID, 1, 2, 0xde, 0xad})
+	}
+
+	tests := []struct {
+		name     string
+		sigs     Signatures
+		expected string
+	}{
+		{name: "unsigned"},
+		{
+			name:     "header-only signatures take precedence",
+			sigs:     Signatures{RSA: signature(1), DSA: signature(2), SigPGP: signature(3), SigGPG: signature(4)},
+			expected: "0000000000000001",
+		},
+		{
+			name:     "dsa header-only signature",
+			sigs:     Signatures{DSA: signature(2), SigGPG: signature(4)},
+			expected: "0000000000000002",
+		},
+		{
+			name:     "header+payload signature only",
+			sigs:     Signatures{SigGPG: signature(4)},
+			expected: "0000000000000004",
+		},
+		{
+			name:     "unparsable header-only signature",
+			sigs:     Signatures{RSA: []byte{0xff}, SigPGP: signature(3)},
+			expected: "0000000000000003",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.sigs.KeyID())
+		})
+	}
+}
+
+func TestPackageSignatures_HeaderDigests(t *testing.T) {
+	sha256Digest := "0a1b6a3c6a20b9f1b1e5e7f6e6a2a3b2d9c8e2e5f4b0c1d2e3f4a5b6c7d8e9f0"
+	indexEntries, err := headerImport(newTestHeader(
+		testEntry{Tag: RPMTAG_SHA1HEADER, Type: RPM_STRING_TYPE, Value: "59c0dac1682f246cb2a5b3deea9b9b9b1034b84d"},
+		testEntry{Tag: RPMTAG_SHA256HEADER, Type: RPM_STRING_TYPE, Value: sha256Digest},
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+	))
+	require.NoError(t, err)
+	pkg, err := newPackage(indexEntries, options{})
+	require.NoError(t, err)
+	assert.Equal(t, "59c0dac1682f246cb2a5b3deea9b9b9b1034b84d", pkg.Signatures.HeaderSHA1)
+	assert.Equal(t, sha256Digest, pkg.Signatures.HeaderSHA256)
+
+	indexEntries, err = headerImport(newTestHeader(
+		testEntry{Tag: RPMTAG_SHA256HEADER, Type: RPM_BIN_TYPE, Value: []byte{0x0a, 0x1b}},
+	))
+	require.NoError(t, err)
+	_, err = newPackage(indexEntries, options{})
+	var typeErr *TagTypeError
+	require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+	assert.Equal(t, "sha256header", typeErr.Name)
+}
@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/anchore/go-rpmdb/pkg/dbi"
+)
+
+// journalMagic is the header of a rollback journal that has not been committed (or rolled back) yet.
+// ref. https://www.sqlite.org/fileformat.html#the_rollback_journal
+var journalMagic = []byte{0xd9, 0xd5, 0x05, 0xf9, 0x20, 0xa1, 0x63, 0xd7}
+
+// inspect looks for signs that the database was in use when it was copied. This must be done before opening the
+// database, since sqlite may roll back (and remove) a hot journal on first access.
+func inspect(path string) dbi.Status {
+	status := dbi.Status{
+		Cleanliness: dbi.Clean,
+	}
+
+	if hasJournalMagic(path + "-journal") {
+		status.Mark(dbi.RecoveryRequired, "hot journal is present, a transaction was interrupted and must be rolled back")
+	}
+
+	// rpm keeps the database in WAL mode, so committed transactions may only be found in the WAL until a checkpoint
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/sqlite.c
+	if hasWAL(path) {
+		status.Mark(dbi.PossiblyDirty, "write-ahead log is present, it must be copied atomically with the database")
+	}
+
+	return status
+}
+
+// hasWAL reports whether the database has a write-ahead log that is not empty.
+func hasWAL(path string) bool {
+	info, err := os.Stat(path + "-wal")
+	return err == nil && info.Size() > 0
+}
+
+func hasJournalMagic(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	header := make([]byte, len(journalMagic))
+	if _, err := io.ReadFull(file, header); err != nil {
+		return false
+	}
+	// note: a zeroed header (journal_mode=PERSIST) is not a hot journal
+	return bytes.Equal(header, journalMagic)
+}
+
+// Inspect reports how consistent the database files appeared when opened (unknown for caller supplied connections).
+func (s *SQLite) Inspect() dbi.Status {
+	return s.status
+}
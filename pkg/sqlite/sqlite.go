@@ -0,0 +1,240 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/go-rpmdb/pkg/dbi"
+
+	// the default (pure-Go) driver, callers may supply a connection from any other driver via New
+	_ "modernc.org/sqlite"
+)
+
+// DriverName is the database/sql driver used by Open.
+const DriverName = "sqlite"
+
+// maxSchemaVersion is the newest schema version (PRAGMA user_version) written by the rpm sqlite backend that is
+// understood here, databases created before the version was recorded report 0.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/lib/backend/sqlite.c
+const maxSchemaVersion = 1
+
+// rpmPackagesSchemas are the statements the rpm sqlite backend creates the Packages table with (as recorded in the
+// sqlite schema table), by the first rpm release writing them. Every release since rpm 4.16 uses the same statement.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/sqlite.c (init_table)
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/lib/backend/sqlite.c (init_table)
+var rpmPackagesSchemas = map[string]string{
+	"CREATE TABLE 'Packages' (hnum INTEGER PRIMARY KEY AUTOINCREMENT,blob BLOB NOT NULL)": "rpm 4.16",
+}
+
+// SQLite reads package headers from an rpm database using the SQLite backend (rpm 4.16+).
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/sqlite.c
+type SQLite struct {
+	db *sql.DB
+	// owned indicates the connection was opened here (and should be closed here)
+	owned  bool
+	status dbi.Status
+	// tempDir holds a copy of the database (when reading it in place would modify it), removed by Close
+	tempDir string
+}
+
+// Open opens the database at the given path with the default driver. The database is never modified: it is opened
+// read-only as an immutable file, so sqlite neither locks it nor creates journal or shared-memory files next to it.
+// An immutable database is read without its write-ahead log, so when the log holds transactions the database and
+// the log are read from a temporary copy instead.
+func Open(path string) (*SQLite, error) {
+	status := inspect(path)
+
+	var tempDir string
+	uri := "file:" + (&url.URL{Path: path}).EscapedPath() + "?mode=ro&immutable=1"
+	if hasWAL(path) {
+		var err error
+		tempDir, err = copyWithWAL(path)
+		if err != nil {
+			return nil, err
+		}
+		uri = "file:" + (&url.URL{Path: filepath.Join(tempDir, filepath.Base(path))}).EscapedPath()
+	}
+
+	db, err := sql.Open(DriverName, uri)
+	if err != nil {
+		removeTempDir(tempDir)
+		return nil, fmt.Errorf("failed to open sqlite db: %w", err)
+	}
+
+	s, err := New(db)
+	if err != nil {
+		_ = db.Close()
+		removeTempDir(tempDir)
+		return nil, err
+	}
+	s.owned = true
+	s.status = status
+	s.tempDir = tempDir
+	return s, nil
+}
+
+// copyWithWAL copies the database and its write-ahead log to a new temporary directory.
+func copyWithWAL(path string) (string, error) {
+	tempDir, err := os.MkdirTemp("", "rpmdb-sqlite-")
+	if err != nil {
+		return "", err
+	}
+	for _, name := range []string{path, path + "-wal"} {
+		if err := copyFile(name, filepath.Join(tempDir, filepath.Base(name))); err != nil {
+			removeTempDir(tempDir)
+			return "", fmt.Errorf("failed to copy %q: %w", filepath.Base(name), err)
+		}
+	}
+	return tempDir, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func removeTempDir(dir string) {
+	if dir != "" {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// New reads packages over an existing connection, which may be from any database/sql driver. The caller retains
+// ownership of the connection (Close will not close it).
+func New(db *sql.DB) (*SQLite, error) {
+	var tables, packages int
+	err := db.QueryRow("SELECT count(*), count(CASE WHEN name = 'Packages' THEN 1 END) FROM sqlite_master WHERE type = 'table'").Scan(&tables, &packages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sqlite schema: %w", err)
+	}
+	if tables == 0 {
+		return nil, fmt.Errorf("no tables found: %w", dbi.ErrEmptyDatabase)
+	}
+	if packages == 0 {
+		return nil, fmt.Errorf("no Packages table found: %w", dbi.ErrUnsupported)
+	}
+	if err := checkSchema(db); err != nil {
+		return nil, err
+	}
+
+	return &SQLite{
+		db: db,
+	}, nil
+}
+
+// checkSchema verifies that the Packages table has the layout of a known schema version. The layout is detected from
+// the statement that created the table in the sqlite schema table, tables created by other tools (e.g. a converted
+// database) are accepted when they have the hnum and blob columns of the rpm layout. Only the Packages table is ever
+// read, the index tables maintained by rpm alongside it (Name, Basenames, Providename, ...) are skipped.
+func checkSchema(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version > maxSchemaVersion {
+		return fmt.Errorf("schema version %d is newer than version %d: %w", version, maxSchemaVersion, dbi.ErrUnsupportedSchema)
+	}
+
+	var statement sql.NullString
+	err := db.QueryRow("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'Packages'").Scan(&statement)
+	if err != nil {
+		return fmt.Errorf("failed to read Packages table schema: %w", err)
+	}
+	if _, ok := rpmPackagesSchemas[strings.Join(strings.Fields(statement.String), " ")]; ok {
+		return nil
+	}
+
+	rows, err := db.Query("PRAGMA table_info('Packages')")
+	if err != nil {
+		return fmt.Errorf("failed to read Packages table schema: %w", err)
+	}
+	defer rows.Close()
+
+	// note: columns are always selected by name, so their order does not matter
+	columns := make(map[string]string)
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, columnType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &pk); err != nil {
+			return fmt.Errorf("failed to read Packages table schema: %w", err)
+		}
+		columns[strings.ToLower(name)] = strings.ToUpper(columnType)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read Packages table schema: %w", err)
+	}
+
+	if columns["hnum"] != "INTEGER" || columns["blob"] != "BLOB" {
+		return fmt.Errorf("schema version %d has an unexpected Packages table (%q): %w", version, statement.String, dbi.ErrUnsupportedSchema)
+	}
+	return nil
+}
+
+func (s *SQLite) Read() <-chan dbi.Entry {
+	entries := make(chan dbi.Entry)
+
+	go func() {
+		defer close(entries)
+
+		rows, err := s.db.Query("SELECT hnum, blob FROM Packages ORDER BY hnum")
+		if err != nil {
+			entries <- dbi.Entry{
+				Err: fmt.Errorf("failed to query packages: %w", err),
+			}
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var hnum uint32
+			var blob []byte
+			if err := rows.Scan(&hnum, &blob); err != nil {
+				entries <- dbi.Entry{
+					Err: fmt.Errorf("failed to read package blob: %w", err),
+				}
+				return
+			}
+			entries <- dbi.Entry{
+				Instance: hnum,
+				Value:    blob,
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			entries <- dbi.Entry{
+				Err: fmt.Errorf("failed to read packages: %w", err),
+			}
+		}
+	}()
+
+	return entries
+}
+
+// Close closes the underlying connection, only when it was opened by Open.
+func (s *SQLite) Close() error {
+	if !s.owned {
+		return nil
+	}
+	err := s.db.Close()
+	removeTempDir(s.tempDir)
+	return err
+}
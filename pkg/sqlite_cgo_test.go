@@ -0,0 +1,11 @@
+//go:build cgo
+
+package rpmdb
+
+import (
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func init() {
+	sqliteDrivers = append(sqliteDrivers, "sqlite3")
+}
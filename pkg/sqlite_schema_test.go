@@ -0,0 +1,156 @@
+package rpmdb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSQLiteSchemaFixture copies the first few headers of the sqlite fixture into a new database with the given
+// schema, which must create a Packages table that accepts (hnum, blob) inserts.
+func newSQLiteSchemaFixture(t *testing.T, userVersion int, schema ...string) string {
+	t.Helper()
+
+	src, err := sql.Open(sqlite.DriverName, sqliteFixture)
+	require.NoError(t, err)
+	defer src.Close()
+
+	path := filepath.Join(t.TempDir(), "rpmdb.sqlite")
+	dst, err := sql.Open(sqlite.DriverName, path)
+	require.NoError(t, err)
+	defer dst.Close()
+
+	for _, stmt := range schema {
+		_, err := dst.Exec(stmt)
+		require.NoError(t, err)
+	}
+	_, err = dst.Exec(fmt.Sprintf("PRAGMA user_version = %d", userVersion))
+	require.NoError(t, err)
+
+	rows, err := src.Query("SELECT hnum, blob FROM Packages ORDER BY hnum LIMIT 5")
+	require.NoError(t, err)
+	defer rows.Close()
+	for rows.Next() {
+		var hnum int
+		var blob []byte
+		require.NoError(t, rows.Scan(&hnum, &blob))
+		_, err := dst.Exec("INSERT INTO Packages (hnum, blob) VALUES (?, ?)", hnum, blob)
+		require.NoError(t, err)
+	}
+	require.NoError(t, rows.Err())
+
+	return path
+}
+
+func TestOpen_SQLiteSchema(t *testing.T) {
+	// the layout written by the rpm sqlite backend, along with a few of the index tables rpm maintains next to it
+	rpmSchema := []string{
+		"CREATE TABLE 'Packages' (hnum INTEGER PRIMARY KEY AUTOINCREMENT,blob BLOB NOT NULL)",
+		"CREATE TABLE 'Name' (key 'TEXT' NOT NULL, hnum INTEGER NOT NULL, idx INTEGER NOT NULL, FOREIGN KEY (hnum) REFERENCES 'Packages'(hnum))",
+		"CREATE TABLE 'Basenames' (key 'TEXT' NOT NULL, hnum INTEGER NOT NULL, idx INTEGER NOT NULL, FOREIGN KEY (hnum) REFERENCES 'Packages'(hnum))",
+		"CREATE TABLE 'Providename' (key 'TEXT' NOT NULL, hnum INTEGER NOT NULL, idx INTEGER NOT NULL, FOREIGN KEY (hnum) REFERENCES 'Packages'(hnum))",
+		"CREATE INDEX 'Name_key_idx' ON 'Name'(key ASC)",
+	}
+
+	tests := []struct {
+		name        string
+		userVersion int
+		schema      []string
+		expectedErr string
+	}{
+		{
+			name:        "version 0",
+			userVersion: 0,
+			schema:      rpmSchema,
+		},
+		{
+			name:        "version 1",
+			userVersion: 1,
+			schema:      rpmSchema,
+		},
+		{
+			name:        "reordered columns",
+			userVersion: 1,
+			schema:      []string{"CREATE TABLE 'Packages' (blob BLOB NOT NULL, hnum INTEGER PRIMARY KEY AUTOINCREMENT)"},
+		},
+		{
+			name:        "future version",
+			userVersion: 2,
+			schema:      rpmSchema,
+			expectedErr: "schema version 2",
+		},
+		{
+			name:        "unknown Packages layout",
+			userVersion: 1,
+			schema:      []string{"CREATE TABLE 'Packages' (hnum INTEGER PRIMARY KEY, header BLOB, blob TEXT)"},
+			expectedErr: "schema version 1",
+		},
+	}
+
+	expected := listFixturePackages(t, sqliteFixture)[:5]
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := newSQLiteSchemaFixture(t, test.userVersion, test.schema...)
+
+			db, err := Open(path)
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, ErrUnsupportedSchema), "unexpected error: %v", err)
+				assert.True(t, errors.Is(err, ErrUnsupported), "unexpected error: %v", err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			assert.Equal(t, expected, pkgList)
+		})
+	}
+}
+
+func TestOpen_SQLiteSchema_Fixtures(t *testing.T) {
+	// databases written by the rpm sqlite backend itself (no fixtures of other rpm releases are available yet)
+	tests := []struct {
+		fixture       string
+		expectedCount int
+		expectedRPM   string
+	}{
+		{
+			fixture:       "testdata/fedora35/rpmdb.sqlite",
+			expectedCount: 138,
+			expectedRPM:   "4.17.0",
+		},
+		{
+			fixture:       "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			expectedCount: 129,
+			expectedRPM:   "4.17.0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			db, err := Open(test.fixture)
+			require.NoError(t, err)
+			defer db.Close()
+			assert.Equal(t, BackendSQLite, db.backend())
+
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			assert.Len(t, pkgList, test.expectedCount)
+			for _, pkg := range pkgList {
+				if pkg.Name == "rpm" {
+					assert.Equal(t, test.expectedRPM, pkg.Version)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package rpmdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anchore/go-rpmdb/pkg/sqlite"
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the sqlite fixture holds the same headers (in the same order) as testdata/centos7-plain, loaded into the schema
+// used by the rpm sqlite backend
+const sqliteFixture = "testdata/centos7-plain-sqlite/rpmdb.sqlite"
+
+// sqliteDrivers are the database/sql drivers used to read the fixture via WithSQLiteDB, drivers that depend on
+// cgo are registered separately
+var sqliteDrivers = []string{sqlite.DriverName}
+
+func TestPackageList_SQLite(t *testing.T) {
+	expected := listFixturePackages(t, "testdata/centos7-plain/Packages")
+	require.Len(t, expected, 144)
+
+	assertPackages := func(t *testing.T, db *RpmDB) {
+		pkgList, err := db.ListPackages()
+		require.NoError(t, err)
+		require.Len(t, pkgList, len(expected))
+
+		for i := range expected {
+			for _, d := range deep.Equal(expected[i], pkgList[i]) {
+				t.Errorf("%s: %s", expected[i].Name, d)
+			}
+		}
+	}
+
+	t.Run("detected by path", func(t *testing.T) {
+		db, err := Open(sqliteFixture)
+		require.NoError(t, err)
+		defer db.Close()
+
+		_, ok := db.db.(*sqlite.SQLite)
+		assert.True(t, ok, "expected the sqlite backend, got %T", db.db)
+		assertPackages(t, db)
+	})
+
+	for _, driver := range sqliteDrivers {
+		t.Run("driver "+driver, func(t *testing.T) {
+			conn, err := sql.Open(driver, sqliteFixture)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			db, err := Open("", WithSQLiteDB(conn))
+			require.NoError(t, err)
+			assertPackages(t, db)
+
+			// the caller owns the connection
+			require.NoError(t, db.Close())
+			assert.NoError(t, conn.Ping())
+		})
+	}
+}
+
+func TestOpen_SQLiteWithoutPackages(t *testing.T) {
+	conn, err := sql.Open(sqlite.DriverName, ":memory:")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// no schema at all
+	_, err = Open("", WithSQLiteDB(conn))
+	assert.True(t, errors.Is(err, ErrEmptyDatabase), "unexpected error: %v", err)
+
+	// a schema, but not the rpm schema
+	_, err = conn.Exec("CREATE TABLE Other (id INTEGER)")
+	require.NoError(t, err)
+	_, err = Open("", WithSQLiteDB(conn))
+	assert.True(t, errors.Is(err, ErrUnsupported), "unexpected error: %v", err)
+}
+
+func TestListPackages_SQLiteReleasesConnection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rpmdb.sqlite")
+	data, err := ioutil.ReadFile(sqliteFixture)
+	require.NoError(t, err)
+	writeFile(t, path, data)
+
+	conn, err := sql.Open(sqlite.DriverName, path)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetMaxOpenConns(1)
+
+	// a header that fails to parse ends the listing early
+	_, err = conn.Exec("UPDATE Packages SET blob = x'00' WHERE hnum = 2")
+	require.NoError(t, err)
+
+	db, err := Open("", WithSQLiteDB(conn))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ListPackages()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "package instance 2")
+
+	// the only connection must have been released for other queries
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var count int
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT count(*) FROM Packages").Scan(&count))
+	assert.Equal(t, 144, count)
+}
+
+func TestOpen_SQLiteReadOnly(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/fedora35/rpmdb.sqlite")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		setup         func(t *testing.T, dir string)
+		expectedCount int
+	}{
+		{
+			name: "database only",
+			setup: func(t *testing.T, dir string) {
+				writeFile(t, filepath.Join(dir, "rpmdb.sqlite"), data)
+			},
+			expectedCount: 138,
+		},
+		{
+			// the database was copied while rpm had a transaction in the write-ahead log (not checkpointed yet)
+			name: "write-ahead log",
+			setup: func(t *testing.T, dir string) {
+				live := filepath.Join(t.TempDir(), "rpmdb.sqlite")
+				writeFile(t, live, data)
+				conn, err := sql.Open(sqlite.DriverName, live)
+				require.NoError(t, err)
+				defer conn.Close()
+				conn.SetMaxOpenConns(1)
+				for _, statement := range []string{"PRAGMA journal_mode = WAL", "PRAGMA wal_autocheckpoint = 0", "DELETE FROM Packages WHERE hnum = 2"} {
+					_, err = conn.Exec(statement)
+					require.NoError(t, err)
+				}
+
+				for _, suffix := range []string{"", "-wal"} {
+					contents, err := ioutil.ReadFile(live + suffix)
+					require.NoError(t, err)
+					require.NotEmpty(t, contents)
+					writeFile(t, filepath.Join(dir, "rpmdb.sqlite"+suffix), contents)
+				}
+			},
+			expectedCount: 137,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			test.setup(t, dir)
+
+			// the database may be on a read-only filesystem (e.g. an extracted image layer)
+			before := map[string][]byte{}
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			for _, entry := range entries {
+				path := filepath.Join(dir, entry.Name())
+				require.NoError(t, os.Chmod(path, 0o444))
+				before[entry.Name()], err = ioutil.ReadFile(path)
+				require.NoError(t, err)
+			}
+			require.NoError(t, os.Chmod(dir, 0o555))
+			t.Cleanup(func() { _ = os.Chmod(dir, 0o755) })
+
+			db, err := Open(filepath.Join(dir, "rpmdb.sqlite"))
+			require.NoError(t, err)
+			pkgList, err := db.ListPackages()
+			require.NoError(t, err)
+			assert.Len(t, pkgList, test.expectedCount)
+			require.NoError(t, db.Close())
+
+			// no files were added (e.g. the shared-memory index), changed or removed
+			after := map[string][]byte{}
+			entries, err = os.ReadDir(dir)
+			require.NoError(t, err)
+			for _, entry := range entries {
+				after[entry.Name()], err = ioutil.ReadFile(filepath.Join(dir, entry.Name()))
+				require.NoError(t, err)
+			}
+			assert.Equal(t, before, after)
+		})
+	}
+}
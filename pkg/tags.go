@@ -0,0 +1,30 @@
+package rpmdb
+
+import "sort"
+
+// Tag identifies a header entry (e.g. RPMTAG_VENDOR).
+type Tag int32
+
+// HasTag indicates if the given tag was present in the original header, which distinguishes an absent value from an
+// empty one (e.g. a package without a vendor from one with an empty or "(none)" vendor).
+func (p *PackageInfo) HasTag(tag Tag) bool {
+	i := sort.Search(len(p.tags), func(i int) bool { return p.tags[i] >= tag })
+	return i < len(p.tags) && p.tags[i] == tag
+}
+
+// headerTags returns the (sorted, unique) tags present in the header.
+func headerTags(indexEntries []indexEntry) []Tag {
+	tags := make([]Tag, 0, len(indexEntries))
+	for _, entry := range indexEntries {
+		tags = append(tags, Tag(entry.Info.Tag))
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+
+	unique := tags[:0]
+	for i, tag := range tags {
+		if i == 0 || tag != tags[i-1] {
+			unique = append(unique, tag)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,57 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_HasTag(t *testing.T) {
+	pkgList := listFixturePackages(t, "testdata/centos7-many/Packages")
+
+	var pubkey, pkg *PackageInfo
+	for _, p := range pkgList {
+		switch p.Name {
+		case "gpg-pubkey":
+			pubkey = p
+		case "ncurses":
+			pkg = p
+		}
+	}
+	require.NotNil(t, pubkey)
+	require.NotNil(t, pkg)
+
+	// the vendor is genuinely missing from public keys
+	assert.Empty(t, pubkey.Vendor)
+	assert.False(t, pubkey.HasTag(RPMTAG_VENDOR))
+	assert.True(t, pubkey.HasTag(RPMTAG_NAME))
+	assert.True(t, pubkey.HasTag(RPMTAG_PUBKEYS))
+
+	assert.Equal(t, "CentOS", pkg.Vendor)
+	assert.True(t, pkg.HasTag(RPMTAG_VENDOR))
+	assert.True(t, pkg.HasTag(RPMTAG_FILEDIGESTALGO))
+	assert.False(t, pkg.HasTag(RPMTAG_PUBKEYS))
+	assert.False(t, pkg.HasTag(RPMTAG_PAYLOADDIGEST))
+}
+
+func TestPackageInfo_HasTag_NoneValue(t *testing.T) {
+	indexEntries, err := headerImport(newTestHeader(
+		testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+		testEntry{Tag: RPMTAG_VENDOR, Type: RPM_STRING_TYPE, Value: "(none)"},
+		testEntry{Tag: RPMTAG_LICENSE, Type: RPM_STRING_TYPE, Value: ""},
+	))
+	require.NoError(t, err)
+
+	pkg, err := newPackage(indexEntries, options{})
+	require.NoError(t, err)
+
+	// present, but without a meaningful value
+	assert.Empty(t, pkg.Vendor)
+	assert.True(t, pkg.HasTag(RPMTAG_VENDOR))
+	assert.Empty(t, pkg.License)
+	assert.True(t, pkg.HasTag(RPMTAG_LICENSE))
+
+	assert.False(t, pkg.HasTag(RPMTAG_SOURCERPM))
+	assert.False(t, (&PackageInfo{}).HasTag(RPMTAG_NAME))
+}
@@ -0,0 +1,186 @@
+package rpmdb
+
+import "fmt"
+
+// Trigger is a trigger scriptlet of a package, run when one of the packages named by the conditions is installed or
+// removed. For file triggers the conditions are path prefixes instead, matched against the files of the packages
+// being installed or removed.
+type Trigger struct {
+	// Type is the kind of trigger: "triggerprein", "triggerin", "triggerun" or "triggerpostun", or for file triggers
+	// "filetriggerin", "filetriggerun", "filetriggerpostun", "transfiletriggerin", "transfiletriggerun" or
+	// "transfiletriggerpostun".
+	Type string
+	Scriptlet
+	// Conditions are the packages (optionally constrained to a version) or path prefixes the trigger fires on.
+	Conditions []Dependency
+	// Priority orders file triggers that fire at the same time, it is always zero for package triggers.
+	Priority int
+}
+
+// triggerTypes maps the sense flag of the trigger conditions to the name of the trigger.
+var triggerTypes = []struct {
+	flag DependencyFlags
+	name string
+}{
+	{flag: RPMSENSE_TRIGGERPREIN, name: "triggerprein"},
+	{flag: RPMSENSE_TRIGGERIN, name: "triggerin"},
+	{flag: RPMSENSE_TRIGGERUN, name: "triggerun"},
+	{flag: RPMSENSE_TRIGGERPOSTUN, name: "triggerpostun"},
+}
+
+// triggerTags are the tags of a list of triggers: the per-script tags (scripts, programs and priorities) and the per-condition
+// tags (names, versions, flags and the index of the script each condition belongs to).
+type triggerTags struct {
+	name       string
+	typePrefix string
+	scripts    Tag
+	programs   Tag
+	names      Tag
+	versions   Tag
+	flags      Tag
+	index      Tag
+	priorities Tag
+}
+
+var packageTriggerTags = triggerTags{
+	name:     "trigger",
+	scripts:  RPMTAG_TRIGGERSCRIPTS,
+	programs: RPMTAG_TRIGGERSCRIPTPROG,
+	names:    RPMTAG_TRIGGERNAME,
+	versions: RPMTAG_TRIGGERVERSION,
+	flags:    RPMTAG_TRIGGERFLAGS,
+	index:    RPMTAG_TRIGGERINDEX,
+}
+
+var fileTriggerTags = triggerTags{
+	name:       "filetrigger",
+	typePrefix: "file",
+	scripts:    RPMTAG_FILETRIGGERSCRIPTS,
+	programs:   RPMTAG_FILETRIGGERSCRIPTPROG,
+	names:      RPMTAG_FILETRIGGERNAME,
+	versions:   RPMTAG_FILETRIGGERVERSION,
+	flags:      RPMTAG_FILETRIGGERFLAGS,
+	index:      RPMTAG_FILETRIGGERINDEX,
+	priorities: RPMTAG_FILETRIGGERPRIORITIES,
+}
+
+var transFileTriggerTags = triggerTags{
+	name:       "transfiletrigger",
+	typePrefix: "transfile",
+	scripts:    RPMTAG_TRANSFILETRIGGERSCRIPTS,
+	programs:   RPMTAG_TRANSFILETRIGGERSCRIPTPROG,
+	names:      RPMTAG_TRANSFILETRIGGERNAME,
+	versions:   RPMTAG_TRANSFILETRIGGERVERSION,
+	flags:      RPMTAG_TRANSFILETRIGGERFLAGS,
+	index:      RPMTAG_TRANSFILETRIGGERINDEX,
+	priorities: RPMTAG_TRANSFILETRIGGERPRIORITIES,
+}
+
+// parseTriggers associates the trigger scripts with their conditions through the index array. Like the other
+// parallel arrays, triggers that are inconsistent (e.g. a condition referencing a script that does not exist) are
+// ignored as a whole, which is reported as a warning.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.11.3-release/lib/tagexts.c (triggercondsTag)
+func parseTriggers(indexEntries []indexEntry, tags triggerTags) ([]Trigger, []string, error) {
+	var scripts, programs, names, versions []string
+	var flags, indexes, priorities []int32
+	var hasVersions, hasFlags bool
+	var err error
+
+	for _, entry := range indexEntries {
+		switch Tag(entry.Info.Tag) {
+		case tags.scripts:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-scripts", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			scripts = parseStringArray(entry.Data, entry.Info.Count)
+		case tags.programs:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-script-progs", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			programs = parseStringArray(entry.Data, entry.Info.Count)
+		case tags.names:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-names", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			names = parseStringArray(entry.Data, entry.Info.Count)
+		case tags.versions:
+			if entry.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-versions", entry.Info, RPM_STRING_ARRAY_TYPE)
+			}
+			versions, hasVersions = parseStringArray(entry.Data, entry.Info.Count), true
+		case tags.flags:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-flags", entry.Info, RPM_INT32_TYPE)
+			}
+			flags, err = parseInt32Array(entry.Data, int(entry.Info.Count)*sizeOfInt32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse %s-flags: %w", tags.name, err)
+			}
+			hasFlags = true
+		case tags.priorities:
+			if tags.priorities == 0 {
+				continue
+			}
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-priorities", entry.Info, RPM_INT32_TYPE)
+			}
+			priorities, err = parseInt32Array(entry.Data, int(entry.Info.Count)*sizeOfInt32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse %s-priorities: %w", tags.name, err)
+			}
+		case tags.index:
+			if entry.Info.Type != RPM_INT32_TYPE {
+				return nil, nil, newTagTypeError(tags.name+"-index", entry.Info, RPM_INT32_TYPE)
+			}
+			indexes, err = parseInt32Array(entry.Data, int(entry.Info.Count)*sizeOfInt32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse %s-index: %w", tags.name, err)
+			}
+		}
+	}
+
+	if len(scripts) == 0 && len(names) == 0 {
+		return nil, nil, nil
+	}
+	if (programs != nil && len(programs) != len(scripts)) || (priorities != nil && len(priorities) != len(scripts)) ||
+		len(indexes) != len(names) || (hasVersions && len(versions) != len(names)) || (hasFlags && len(flags) != len(names)) {
+		warning := fmt.Sprintf("ignoring %ss: %d scripts, %d programs, %d priorities, %d names, %d versions, %d flags and %d indexes",
+			tags.name, len(scripts), len(programs), len(priorities), len(names), len(versions), len(flags), len(indexes))
+		return nil, []string{warning}, nil
+	}
+
+	triggers := make([]Trigger, len(scripts))
+	for i, script := range scripts {
+		triggers[i].Script = script
+		if programs != nil {
+			triggers[i].Program = []string{programs[i]}
+		}
+		if priorities != nil {
+			triggers[i].Priority = int(priorities[i])
+		}
+	}
+	for i, name := range names {
+		index := indexes[i]
+		if index < 0 || int(index) >= len(triggers) {
+			warning := fmt.Sprintf("ignoring %ss: condition %q references script %d of %d", tags.name, name, index, len(triggers))
+			return nil, []string{warning}, nil
+		}
+
+		condition := Dependency{Name: name}
+		if hasVersions {
+			condition.Version = versions[i]
+		}
+		if hasFlags {
+			condition.Flags = DependencyFlags(flags[i])
+		}
+
+		trigger := &triggers[index]
+		trigger.Conditions = append(trigger.Conditions, condition)
+		for _, t := range triggerTypes {
+			if condition.Flags&t.flag != 0 {
+				trigger.Type = tags.typePrefix + t.name
+			}
+		}
+	}
+	return triggers, nil, nil
+}
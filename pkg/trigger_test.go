@@ -0,0 +1,163 @@
+package rpmdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_Triggers(t *testing.T) {
+	pkgs := map[string]*PackageInfo{}
+	for _, pkg := range listFixturePackages(t, "testdata/centos6-many/Packages") {
+		pkgs[pkg.Name] = pkg
+	}
+
+	cscope := pkgs["cscope"]
+	require.NotNil(t, cscope)
+	require.Len(t, cscope.Triggers, 4)
+	var summary []string
+	for _, trigger := range cscope.Triggers {
+		require.Len(t, trigger.Conditions, 1)
+		assert.Equal(t, []string{"/bin/sh"}, trigger.Program)
+		summary = append(summary, trigger.Type+" "+trigger.Conditions[0].String())
+	}
+	assert.Equal(t, []string{"triggerin xemacs", "triggerin emacs", "triggerun xemacs", "triggerun emacs"}, summary)
+	assert.Contains(t, cscope.Triggers[1].Script, "ln -sf /usr/share/cscope/xcscope.el /usr/share/emacs")
+
+	// a program without a body, fired by upgrades from old versions
+	assert.Equal(t, []Trigger{{
+		Type:       "triggerpostun",
+		Scriptlet:  Scriptlet{Program: []string{"/sbin/ldconfig"}},
+		Conditions: []Dependency{{Name: "cracklib", Version: "2.7-24", Flags: RPMSENSE_TRIGGERPOSTUN | RPMSENSE_LESS}},
+	}}, pkgs["cracklib"].Triggers)
+
+	assert.Nil(t, pkgs["bash"].Triggers)
+}
+
+func TestParseTriggers(t *testing.T) {
+	t.Run("conditions share a script", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_TRIGGERSCRIPTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"echo in", "print('un')"}},
+			testEntry{Tag: RPMTAG_TRIGGERSCRIPTPROG, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/bin/sh", "<lua>"}},
+			testEntry{Tag: RPMTAG_TRIGGERNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar", "baz", "bar"}},
+			testEntry{Tag: RPMTAG_TRIGGERVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"", "2.0", ""}},
+			testEntry{Tag: RPMTAG_TRIGGERFLAGS, Type: RPM_INT32_TYPE, Value: []int32{
+				int32(RPMSENSE_TRIGGERIN),
+				int32(RPMSENSE_TRIGGERIN | RPMSENSE_GREATER | RPMSENSE_EQUAL),
+				int32(RPMSENSE_TRIGGERUN),
+			}},
+			testEntry{Tag: RPMTAG_TRIGGERINDEX, Type: RPM_INT32_TYPE, Value: []int32{0, 0, 1}},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []Trigger{
+			{
+				Type:      "triggerin",
+				Scriptlet: Scriptlet{Program: []string{"/bin/sh"}, Script: "echo in"},
+				Conditions: []Dependency{
+					{Name: "bar", Flags: RPMSENSE_TRIGGERIN},
+					{Name: "baz", Version: "2.0", Flags: RPMSENSE_TRIGGERIN | RPMSENSE_GREATER | RPMSENSE_EQUAL},
+				},
+			},
+			{
+				Type:       "triggerun",
+				Scriptlet:  Scriptlet{Program: []string{"<lua>"}, Script: "print('un')"},
+				Conditions: []Dependency{{Name: "bar", Flags: RPMSENSE_TRIGGERUN}},
+			},
+		}, pkg.Triggers)
+		assert.Equal(t, "baz >= 2.0", pkg.Triggers[0].Conditions[1].String())
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_TRIGGERSCRIPTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"echo in"}},
+			testEntry{Tag: RPMTAG_TRIGGERNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar"}},
+			testEntry{Tag: RPMTAG_TRIGGERFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_TRIGGERIN)}},
+			testEntry{Tag: RPMTAG_TRIGGERINDEX, Type: RPM_INT32_TYPE, Value: []int32{1}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Triggers)
+		assert.Equal(t, []string{`ignoring triggers: condition "bar" references script 1 of 1`}, pkg.Warnings)
+	})
+
+	t.Run("missing index", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_TRIGGERSCRIPTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"echo in"}},
+			testEntry{Tag: RPMTAG_TRIGGERNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"bar"}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Triggers)
+		assert.Equal(t, []string{"ignoring triggers: 1 scripts, 0 programs, 0 priorities, 1 names, 0 versions, 0 flags and 0 indexes"}, pkg.Warnings)
+	})
+
+	t.Run("file triggers", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "glib2"},
+			testEntry{Tag: RPMTAG_FILETRIGGERSCRIPTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"gio-querymodules-64 /usr/lib64/gio/modules", "gio-querymodules-64 /usr/lib64/gio/modules"}},
+			testEntry{Tag: RPMTAG_FILETRIGGERSCRIPTPROG, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/bin/sh", "/bin/sh"}},
+			testEntry{Tag: RPMTAG_FILETRIGGERNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/lib64/gio/modules", "/usr/lib64/gio/modules"}},
+			testEntry{Tag: RPMTAG_FILETRIGGERVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"", ""}},
+			testEntry{Tag: RPMTAG_FILETRIGGERFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_TRIGGERIN), int32(RPMSENSE_TRIGGERPOSTUN)}},
+			testEntry{Tag: RPMTAG_FILETRIGGERINDEX, Type: RPM_INT32_TYPE, Value: []int32{0, 1}},
+			testEntry{Tag: RPMTAG_FILETRIGGERPRIORITIES, Type: RPM_INT32_TYPE, Value: []int32{1000000, 1000000}},
+			testEntry{Tag: RPMTAG_TRANSFILETRIGGERSCRIPTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"glib-compile-schemas /usr/share/glib-2.0/schemas"}},
+			testEntry{Tag: RPMTAG_TRANSFILETRIGGERSCRIPTPROG, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/bin/sh"}},
+			testEntry{Tag: RPMTAG_TRANSFILETRIGGERNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/share/glib-2.0/schemas"}},
+			testEntry{Tag: RPMTAG_TRANSFILETRIGGERVERSION, Type: RPM_STRING_ARRAY_TYPE, Value: []string{""}},
+			testEntry{Tag: RPMTAG_TRANSFILETRIGGERFLAGS, Type: RPM_INT32_TYPE, Value: []int32{int32(RPMSENSE_TRIGGERIN)}},
+			testEntry{Tag: RPMTAG_TRANSFILETRIGGERINDEX, Type: RPM_INT32_TYPE, Value: []int32{0}},
+			testEntry{Tag: RPMTAG_TRANSFILETRIGGERPRIORITIES, Type: RPM_INT32_TYPE, Value: []int32{1000000}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.Triggers)
+		assert.Equal(t, []Trigger{
+			{
+				Type:       "filetriggerin",
+				Scriptlet:  Scriptlet{Program: []string{"/bin/sh"}, Script: "gio-querymodules-64 /usr/lib64/gio/modules"},
+				Conditions: []Dependency{{Name: "/usr/lib64/gio/modules", Flags: RPMSENSE_TRIGGERIN}},
+				Priority:   1000000,
+			},
+			{
+				Type:       "filetriggerpostun",
+				Scriptlet:  Scriptlet{Program: []string{"/bin/sh"}, Script: "gio-querymodules-64 /usr/lib64/gio/modules"},
+				Conditions: []Dependency{{Name: "/usr/lib64/gio/modules", Flags: RPMSENSE_TRIGGERPOSTUN}},
+				Priority:   1000000,
+			},
+			{
+				Type:       "transfiletriggerin",
+				Scriptlet:  Scriptlet{Program: []string{"/bin/sh"}, Script: "glib-compile-schemas /usr/share/glib-2.0/schemas"},
+				Conditions: []Dependency{{Name: "/usr/share/glib-2.0/schemas", Flags: RPMSENSE_TRIGGERIN}},
+				Priority:   1000000,
+			},
+		}, pkg.FileTriggers)
+		assert.Empty(t, pkg.Warnings)
+	})
+
+	t.Run("mismatched file trigger priorities", func(t *testing.T) {
+		pkg, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_FILETRIGGERSCRIPTS, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"true"}},
+			testEntry{Tag: RPMTAG_FILETRIGGERNAME, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"/usr/lib"}},
+			testEntry{Tag: RPMTAG_FILETRIGGERINDEX, Type: RPM_INT32_TYPE, Value: []int32{0}},
+			testEntry{Tag: RPMTAG_FILETRIGGERPRIORITIES, Type: RPM_INT32_TYPE, Value: []int32{1, 2}},
+		))
+		require.NoError(t, err)
+		assert.Nil(t, pkg.FileTriggers)
+		assert.Equal(t, []string{"ignoring filetriggers: 1 scripts, 0 programs, 2 priorities, 1 names, 0 versions, 0 flags and 1 indexes"}, pkg.Warnings)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := ParseHeader(newTestHeader(
+			testEntry{Tag: RPMTAG_NAME, Type: RPM_STRING_TYPE, Value: "foo"},
+			testEntry{Tag: RPMTAG_TRIGGERINDEX, Type: RPM_STRING_ARRAY_TYPE, Value: []string{"0"}},
+		))
+		var typeErr *TagTypeError
+		require.True(t, errors.As(err, &typeErr), "unexpected error: %v", err)
+		assert.Equal(t, "trigger-index", typeErr.Name)
+	})
+}